@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/maxuanquang/ojs/internal/configs"
+	"github.com/maxuanquang/ojs/internal/sandbox"
 	"github.com/maxuanquang/ojs/internal/utils"
 	"github.com/maxuanquang/ojs/internal/wiring"
 	"github.com/spf13/cobra"
@@ -119,6 +120,19 @@ func cron() *cobra.Command {
 	return command
 }
 
+// sandboxInit is started by the native sandbox inside new namespaces, it is not meant to be run by hand.
+func sandboxInit() *cobra.Command {
+	command := &cobra.Command{
+		Use:    "sandbox-init",
+		Hidden: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			sandbox.RunNativeSandboxInit()
+		},
+	}
+
+	return command
+}
+
 func main() {
 	rootCommand := &cobra.Command{
 		Version: fmt.Sprintf("%s-%s", version, commitHash),
@@ -128,6 +142,7 @@ func main() {
 		httpServer(),
 		worker(),
 		cron(),
+		sandboxInit(),
 	)
 
 	if err := rootCommand.Execute(); err != nil {
@@ -1,14 +1,37 @@
 package configs
 
-import "time"
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// KeyEncryptionKeyEnv is the environment variable holding the token key encryption key, used when no file is
+	// configured.
+	KeyEncryptionKeyEnv = "OJS_TOKEN_KEY_ENCRYPTION_KEY"
+)
+
+var (
+	ErrKeyEncryptionKeyMissing = fmt.Errorf("token key encryption key is missing, set %s or key_encryption_key_file", KeyEncryptionKeyEnv)
+)
 
 type Hash struct {
 	Cost int `yaml:"cost"`
 }
 
 type Token struct {
-	Duration            string `yaml:"duration"`
-	RS512KeyPairBitSize uint16 `yaml:"rs512_key_pair_bit_size"`
+	// Duration is how long an access token is valid, access tokens are meant to be short-lived and renewed with
+	// the refresh token of their session.
+	Duration             string `yaml:"duration"`
+	RefreshTokenDuration string `yaml:"refresh_token_duration"`
+	RS512KeyPairBitSize  uint16 `yaml:"rs512_key_pair_bit_size"`
+	// KeyEncryptionKeyFile is the secret file holding the base64 encoded AES-256 key encrypting the private keys of
+	// tokens stored in database, the key is read from the KeyEncryptionKeyEnv environment variable if empty. It has
+	// to be the same for every instance.
+	KeyEncryptionKeyFile string `yaml:"key_encryption_key_file"`
 }
 
 func (t *Token) GetTokenDuration() time.Duration {
@@ -16,6 +39,36 @@ func (t *Token) GetTokenDuration() time.Duration {
 	return duration
 }
 
+func (t *Token) GetRefreshTokenDuration() time.Duration {
+	duration, _ := time.ParseDuration(t.RefreshTokenDuration)
+	return duration
+}
+
+// GetKeyEncryptionKey returns the token key encryption key, ErrKeyEncryptionKeyMissing is returned if none is given.
+func (t *Token) GetKeyEncryptionKey() ([]byte, error) {
+	encodedKey := os.Getenv(KeyEncryptionKeyEnv)
+	if t.KeyEncryptionKeyFile != "" {
+		keyBytes, err := os.ReadFile(t.KeyEncryptionKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading token key encryption key file: %w", err)
+		}
+
+		encodedKey = string(keyBytes)
+	}
+
+	encodedKey = strings.TrimSpace(encodedKey)
+	if encodedKey == "" {
+		return nil, ErrKeyEncryptionKeyMissing
+	}
+
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding token key encryption key: %w", err)
+	}
+
+	return key, nil
+}
+
 type Auth struct {
 	Hash  Hash  `yaml:"hash"`
 	Token Token `yaml:"token"`
@@ -20,6 +20,7 @@ type Config struct {
 	MQ       MQ       `yaml:"mq"`
 	Judge    Judge    `yaml:"judge"`
 	Cron     Cron     `yaml:"cron"`
+	Worker   Worker   `yaml:"worker"`
 }
 
 func NewConfig(configFilePath ConfigFilePath) (Config, error) {
@@ -1,5 +1,7 @@
 package configs
 
+import "time"
+
 type Account struct {
 	Name     string `yaml:"name"`
 	Password string `yaml:"password"`
@@ -11,6 +13,54 @@ type CreateSystemAccounts struct {
 	Worker   Account `yaml:"worker"`
 }
 
+// PurgeStaleWorkspaces configures the removal of judging workspaces left behind on the host,
+// such as the ones of a worker that crashed while judging. Workspaces are purged by each worker on its own host,
+// when it starts and then every interval.
+type PurgeStaleWorkspaces struct {
+	Interval string `yaml:"interval"`
+	MaxAge   string `yaml:"max_age"`
+}
+
+func (p PurgeStaleWorkspaces) GetInterval() (time.Duration, error) {
+	return time.ParseDuration(p.Interval)
+}
+
+func (p PurgeStaleWorkspaces) GetMaxAge() (time.Duration, error) {
+	return time.ParseDuration(p.MaxAge)
+}
+
+// ResetExpiredJudgingLeases configures the recovery of submissions stuck in judging,
+// using the judging lease duration of the worker config.
+type ResetExpiredJudgingLeases struct {
+	Schedule string `yaml:"schedule"`
+}
+
+// RelayOutboxMessages configures the publishing of outbox messages that failed to be published right away.
+// Sent messages are kept for the retention, to help investigating duplicates.
+type RelayOutboxMessages struct {
+	Schedule             string `yaml:"schedule"`
+	SentMessageRetention string `yaml:"sent_message_retention"`
+}
+
+func (r RelayOutboxMessages) GetSentMessageRetention() (time.Duration, error) {
+	return time.ParseDuration(r.SentMessageRetention)
+}
+
+// RotateTokenKeys configures the replacement of the key signing tokens once it is older than the max age. Retired keys
+// are deleted once no token they signed can still be valid.
+type RotateTokenKeys struct {
+	Schedule  string `yaml:"schedule"`
+	KeyMaxAge string `yaml:"key_max_age"`
+}
+
+func (r RotateTokenKeys) GetKeyMaxAge() (time.Duration, error) {
+	return time.ParseDuration(r.KeyMaxAge)
+}
+
 type Cron struct {
-	CreateSystemAccounts CreateSystemAccounts `yaml:"create_system_accounts"`
+	CreateSystemAccounts      CreateSystemAccounts      `yaml:"create_system_accounts"`
+	PurgeStaleWorkspaces      PurgeStaleWorkspaces      `yaml:"purge_stale_workspaces"`
+	ResetExpiredJudgingLeases ResetExpiredJudgingLeases `yaml:"reset_expired_judging_leases"`
+	RelayOutboxMessages       RelayOutboxMessages       `yaml:"relay_outbox_messages"`
+	RotateTokenKeys           RotateTokenKeys           `yaml:"rotate_token_keys"`
 }
@@ -1,12 +1,58 @@
 package configs
 
+import "github.com/dustin/go-humanize"
+
+const (
+	defaultLimitMultiplier   = 1
+	defaultParallelTestCases = 1
+)
+
 type Judge struct {
-	Languages []Language `yaml:"languages"`
+	Sandbox           Sandbox    `yaml:"sandbox"`
+	Languages         []Language `yaml:"languages"`
+	OutputExcerptSize string     `yaml:"output_excerpt_size"`
+	CompileOutputSize string     `yaml:"compile_output_size"`
+	ParallelTestCases int        `yaml:"parallel_test_cases"`
+}
+
+// GetParallelTestCases returns how many test cases of a submission may run at the same time.
+func (j Judge) GetParallelTestCases() int {
+	if j.ParallelTestCases <= 0 {
+		return defaultParallelTestCases
+	}
+
+	return j.ParallelTestCases
+}
+
+func (j Judge) GetOutputExcerptSizeInBytes() (uint64, error) {
+	return humanize.ParseBytes(j.OutputExcerptSize)
+}
+
+func (j Judge) GetCompileOutputSizeInBytes() (uint64, error) {
+	return humanize.ParseBytes(j.CompileOutputSize)
 }
 
 type Language struct {
-	Value   string   `yaml:"value"`
-	Name    string   `yaml:"name"`
-	Compile *Compile `yaml:"compile"`
-	Execute *Execute `yaml:"execute"`
+	Value                 string   `yaml:"value"`
+	Name                  string   `yaml:"name"`
+	TimeLimitMultiplier   float64  `yaml:"time_limit_multiplier"`
+	MemoryLimitMultiplier float64  `yaml:"memory_limit_multiplier"`
+	Compile               *Compile `yaml:"compile"`
+	Execute               *Execute `yaml:"execute"`
+}
+
+func (l Language) GetTimeLimitMultiplier() float64 {
+	if l.TimeLimitMultiplier <= 0 {
+		return defaultLimitMultiplier
+	}
+
+	return l.TimeLimitMultiplier
+}
+
+func (l Language) GetMemoryLimitMultiplier() float64 {
+	if l.MemoryLimitMultiplier <= 0 {
+		return defaultLimitMultiplier
+	}
+
+	return l.MemoryLimitMultiplier
 }
@@ -1,9 +1,49 @@
 package configs
 
+import "time"
+
+type MQType string
+
+const (
+	MQTypeKafka    MQType = "kafka"
+	MQTypeInMemory MQType = "in_memory"
+	MQTypeRedis    MQType = "redis"
+
+	defaultMaxAttempts = 1
+)
+
+// MQRetry configures how many times the handling of a message is attempted before it is dead-lettered,
+// waiting for a backoff doubling after each failed attempt, up to the max backoff.
+type MQRetry struct {
+	MaxAttempts    int    `yaml:"max_attempts"`
+	InitialBackoff string `yaml:"initial_backoff"`
+	MaxBackoff     string `yaml:"max_backoff"`
+}
+
+func (r MQRetry) GetMaxAttempts() int {
+	if r.MaxAttempts <= 0 {
+		return defaultMaxAttempts
+	}
+
+	return r.MaxAttempts
+}
+
+func (r MQRetry) GetInitialBackoff() (time.Duration, error) {
+	return time.ParseDuration(r.InitialBackoff)
+}
+
+func (r MQRetry) GetMaxBackoff() (time.Duration, error) {
+	return time.ParseDuration(r.MaxBackoff)
+}
+
 type MQ struct {
+	Type            MQType   `yaml:"type"`
 	Addresses       []string `yaml:"addresses"`
 	ClientID        string   `yaml:"client_id"`
 	ConsumerGroupID string   `yaml:"consumer_group_id"`
-	Topic           string   `yaml:"topic"`
-	NumPartitions   int      `yaml:"num_partitions"`
+	// ConsumerName names the consumer within its group for redis streams, defaulting to the hostname. It must be
+	// unique within the group, and kept across restarts so that the messages left pending are found again.
+	ConsumerName  string  `yaml:"consumer_name"`
+	NumPartitions int     `yaml:"num_partitions"`
+	Retry         MQRetry `yaml:"retry"`
 }
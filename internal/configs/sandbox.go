@@ -0,0 +1,33 @@
+package configs
+
+type SandboxType string
+
+const (
+	SandboxTypeDocker SandboxType = "docker"
+	SandboxTypeNative SandboxType = "native"
+)
+
+type Sandbox struct {
+	Type   SandboxType   `yaml:"type"`
+	Docker DockerSandbox `yaml:"docker"`
+	Native NativeSandbox `yaml:"native"`
+}
+
+// DockerSandbox configures where the resource usage of containers is read from. Each container is put in its own
+// cgroup under CgroupParent, which has to be read from the cgroup v2 filesystem of the Docker host mounted at
+// CgroupMountPath. This requires the cgroupfs cgroup driver. If CgroupParent is empty, resource usage is sampled from
+// the stats of containers instead, which misses the usage since the last sample.
+type DockerSandbox struct {
+	CgroupParent    string `yaml:"cgroup_parent"`
+	CgroupMountPath string `yaml:"cgroup_mount_path"`
+}
+
+// NativeSandbox configures the sandbox running programs directly on a Linux host, which requires root.
+// Compilers and runtimes are taken from the host, so the images of the languages are ignored.
+type NativeSandbox struct {
+	CgroupRoot    string   `yaml:"cgroup_root"`
+	ReadOnlyPaths []string `yaml:"read_only_paths"`
+	UID           int      `yaml:"uid"`
+	GID           int      `yaml:"gid"`
+	MaxProcesses  int      `yaml:"max_processes"`
+}
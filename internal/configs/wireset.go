@@ -13,4 +13,6 @@ var WireSet = wire.NewSet(
 	wire.FieldsOf(new(Config), "MQ"),
 	wire.FieldsOf(new(Config), "Judge"),
 	wire.FieldsOf(new(Config), "Cron"),
+	wire.FieldsOf(new(Config), "Worker"),
+	wire.FieldsOf(new(Judge), "Sandbox"),
 )
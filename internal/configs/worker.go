@@ -0,0 +1,53 @@
+package configs
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	defaultMaxConcurrentSubmissions = 1
+)
+
+// Worker configures how many submissions a worker judges at the same time. Each submission is judged in a slot of
+// its own, pinned to the CPUs of the slot's CPU set if CPU sets are given, so that they do not disturb each other's timing.
+//
+// A worker holds a lease on each submission it judges, renewed every heartbeat interval. Submissions whose lease
+// has not been renewed for the lease duration, such as the ones of a crashed worker, are judged again.
+type Worker struct {
+	Name                     string   `yaml:"name"` // the host name followed by a random suffix if empty
+	MaxConcurrentSubmissions int      `yaml:"max_concurrent_submissions"`
+	CPUSets                  []string `yaml:"cpu_sets"`
+	HeartbeatInterval        string   `yaml:"heartbeat_interval"`
+	JudgingLeaseDuration     string   `yaml:"judging_lease_duration"`
+}
+
+func (w Worker) GetHeartbeatInterval() (time.Duration, error) {
+	return time.ParseDuration(w.HeartbeatInterval)
+}
+
+func (w Worker) GetJudgingLeaseDuration() (time.Duration, error) {
+	return time.ParseDuration(w.JudgingLeaseDuration)
+}
+
+func (w Worker) GetMaxConcurrentSubmissions() int {
+	if w.MaxConcurrentSubmissions <= 0 {
+		return defaultMaxConcurrentSubmissions
+	}
+
+	return w.MaxConcurrentSubmissions
+}
+
+// GetCPUSets returns the CPU set of each slot, in the cpuset list format like "0-3,8". Slots are not pinned
+// if no CPU sets are given.
+func (w Worker) GetCPUSets() ([]string, error) {
+	if len(w.CPUSets) == 0 {
+		return make([]string, w.GetMaxConcurrentSubmissions()), nil
+	}
+
+	if len(w.CPUSets) < w.GetMaxConcurrentSubmissions() {
+		return nil, fmt.Errorf("expect a cpu set for each of the %d slots, got %d", w.GetMaxConcurrentSubmissions(), len(w.CPUSets))
+	}
+
+	return w.CPUSets[:w.GetMaxConcurrentSubmissions()], nil
+}
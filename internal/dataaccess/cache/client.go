@@ -13,6 +13,10 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	inMemorySubscriberBufferSize = 16
+)
+
 var (
 	ErrCacheMissed = errors.New("cache miss")
 )
@@ -22,17 +26,38 @@ type Client interface {
 	Get(ctx context.Context, key string) (any, error)
 	AddToSet(ctx context.Context, key string, value ...any) error
 	IsValueInSet(ctx context.Context, key string, value any) (bool, error)
+	RemoveFromSet(ctx context.Context, key string, value ...any) error
+	// SetHashFields sets the given fields of the hash at key at once, leaving its other fields as they are.
+	SetHashFields(ctx context.Context, key string, fields map[string]string) error
+	// GetHash returns every field of the hash at key, ErrCacheMissed is returned if there is none.
+	GetHash(ctx context.Context, key string) (map[string]string, error)
+	Publish(ctx context.Context, channel string, message string) error
+	// Subscribe returns a channel receiving messages published to the given channel.
+	// The subscription ends and the returned channel is closed when ctx is done.
+	Subscribe(ctx context.Context, channel string) (<-chan string, error)
+}
+
+// NewRedisConnection returns the connection to the redis server of the cache config, shared by everything using redis.
+// The connection is only opened once it is used.
+func NewRedisConnection(cacheConfig configs.Cache) *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Addr:     cacheConfig.Addr,
+		Username: cacheConfig.Username,
+		Password: cacheConfig.Password,
+		DB:       cacheConfig.DB,
+	})
 }
 
 func NewClient(
 	cacheConfig configs.Cache,
+	redisConnection *redis.Client,
 	logger *zap.Logger,
 ) (Client, error) {
 	switch cacheConfig.Type {
 	case configs.CacheTypeInMemory:
 		return NewInMemoryClient(cacheConfig, logger)
 	case configs.CacheTypeRedis:
-		return NewRedisClient(cacheConfig, logger)
+		return NewRedisClient(redisConnection, logger)
 	default:
 		err := fmt.Errorf(`invalid cache type, expect one of ["redis", "in-memory"], got %s`, string(cacheConfig.Type))
 		logger.With(zap.Error(err)).Error("invalid cache type")
@@ -41,25 +66,18 @@ func NewClient(
 }
 
 func NewRedisClient(
-	cacheConfig configs.Cache,
+	redisConnection *redis.Client,
 	logger *zap.Logger,
 ) (Client, error) {
 
-	client := redis.NewClient(&redis.Options{
-		Addr:     cacheConfig.Addr,
-		Username: cacheConfig.Username,
-		Password: cacheConfig.Password,
-		DB:       cacheConfig.DB,
-	})
-
-	_, err := client.Ping(context.Background()).Result()
+	_, err := redisConnection.Ping(context.Background()).Result()
 	if err != nil {
 		logger.Error("can not connect to redis client", zap.Error(err))
 		return nil, err
 	}
 
 	return &redisClient{
-		client: client,
+		client: redisConnection,
 		logger: logger,
 	}, nil
 }
@@ -97,6 +115,20 @@ func (c *redisClient) IsValueInSet(ctx context.Context, key string, value interf
 	return exists, nil
 }
 
+// RemoveFromSet implements Client.
+func (c *redisClient) RemoveFromSet(ctx context.Context, key string, value ...any) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).
+		With(zap.String("key", key)).
+		With(zap.Any("value", value))
+
+	err := c.client.SRem(ctx, key, value...).Err()
+	if err != nil {
+		logger.Error("failed to remove value from set", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
 // Get implements Client.
 func (c *redisClient) Get(ctx context.Context, key string) (any, error) {
 	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key))
@@ -128,21 +160,167 @@ func (c *redisClient) Set(ctx context.Context, key string, value any, ttl time.D
 	return nil
 }
 
+// SetHashFields implements Client.
+func (c *redisClient) SetHashFields(ctx context.Context, key string, fields map[string]string) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key))
+
+	values := make([]any, 0, 2*len(fields))
+	for field, value := range fields {
+		values = append(values, field, value)
+	}
+
+	if err := c.client.HSet(ctx, key, values...).Err(); err != nil {
+		logger.Error("failed to set hash fields into cache", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// GetHash implements Client.
+func (c *redisClient) GetHash(ctx context.Context, key string) (map[string]string, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key))
+
+	fields, err := c.client.HGetAll(ctx, key).Result()
+	if err != nil {
+		logger.Error("failed to get hash from cache", zap.Error(err))
+		return nil, err
+	}
+
+	if len(fields) == 0 {
+		return nil, ErrCacheMissed
+	}
+
+	return fields, nil
+}
+
+// Publish implements Client.
+func (c *redisClient) Publish(ctx context.Context, channel string, message string) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).
+		With(zap.String("channel", channel)).
+		With(zap.String("message", message))
+
+	if err := c.client.Publish(ctx, channel, message).Err(); err != nil {
+		logger.Error("failed to publish message", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// Subscribe implements Client.
+func (c *redisClient) Subscribe(ctx context.Context, channel string) (<-chan string, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("channel", channel))
+
+	pubSub := c.client.Subscribe(ctx, channel)
+
+	// Wait for the subscription to be confirmed, so that no message published after this call returns is missed
+	if _, err := pubSub.Receive(ctx); err != nil {
+		logger.Error("failed to subscribe to channel", zap.Error(err))
+		pubSub.Close()
+		return nil, err
+	}
+
+	messageChan := make(chan string)
+	go func() {
+		defer close(messageChan)
+		defer pubSub.Close()
+
+		redisMessageChan := pubSub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case redisMessage, ok := <-redisMessageChan:
+				if !ok {
+					return
+				}
+
+				select {
+				case messageChan <- redisMessage.Payload:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return messageChan, nil
+}
+
 func NewInMemoryClient(
 	cacheConfig configs.Cache,
 	logger *zap.Logger,
 ) (Client, error) {
 	return &inMemoryClient{
-		cache:      make(map[string]any),
-		cacheMutex: &sync.Mutex{},
-		logger:     logger,
+		cache:            make(map[string]any),
+		cacheMutex:       &sync.Mutex{},
+		subscribers:      make(map[string]map[chan string]struct{}),
+		subscribersMutex: &sync.Mutex{},
+		logger:           logger,
 	}, nil
 }
 
 type inMemoryClient struct {
-	cache      map[string]any
-	cacheMutex *sync.Mutex
-	logger     *zap.Logger
+	cache            map[string]any
+	cacheMutex       *sync.Mutex
+	subscribers      map[string]map[chan string]struct{}
+	subscribersMutex *sync.Mutex
+	logger           *zap.Logger
+}
+
+// Publish implements Client.
+func (i *inMemoryClient) Publish(ctx context.Context, channel string, message string) error {
+	i.subscribersMutex.Lock()
+	defer i.subscribersMutex.Unlock()
+
+	// A slow subscriber loses its oldest message rather than the new one, so that the latest message, which is the
+	// final one when publishing stops, is always delivered
+	for messageChan := range i.subscribers[channel] {
+		for sent := false; !sent; {
+			select {
+			case messageChan <- message:
+				sent = true
+			default:
+				select {
+				case <-messageChan:
+					utils.LoggerWithContext(ctx, i.logger).
+						With(zap.String("channel", channel)).
+						Warn("subscriber is too slow, dropping its oldest message")
+				default:
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Subscribe implements Client.
+func (i *inMemoryClient) Subscribe(ctx context.Context, channel string) (<-chan string, error) {
+	messageChan := make(chan string, inMemorySubscriberBufferSize)
+
+	i.subscribersMutex.Lock()
+	if _, ok := i.subscribers[channel]; !ok {
+		i.subscribers[channel] = make(map[chan string]struct{})
+	}
+	i.subscribers[channel][messageChan] = struct{}{}
+	i.subscribersMutex.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		i.subscribersMutex.Lock()
+		defer i.subscribersMutex.Unlock()
+
+		delete(i.subscribers[channel], messageChan)
+		if len(i.subscribers[channel]) == 0 {
+			delete(i.subscribers, channel)
+		}
+		close(messageChan)
+	}()
+
+	return messageChan, nil
 }
 
 // AddToSet implements Client.
@@ -162,6 +340,20 @@ func (i *inMemoryClient) AddToSet(ctx context.Context, key string, value ...any)
 	return nil
 }
 
+// RemoveFromSet implements Client.
+func (i *inMemoryClient) RemoveFromSet(ctx context.Context, key string, value ...any) error {
+	i.cacheMutex.Lock()
+	defer i.cacheMutex.Unlock()
+
+	if set, ok := i.cache[key].(map[any]struct{}); ok {
+		for _, v := range value {
+			delete(set, v)
+		}
+	}
+
+	return nil
+}
+
 // Get implements Client.
 func (i *inMemoryClient) Get(ctx context.Context, key string) (any, error) {
 	if val, ok := i.cache[key]; ok {
@@ -191,3 +383,39 @@ func (i *inMemoryClient) Set(ctx context.Context, key string, value any, ttl tim
 
 	return nil
 }
+
+// SetHashFields implements Client.
+func (i *inMemoryClient) SetHashFields(ctx context.Context, key string, fields map[string]string) error {
+	i.cacheMutex.Lock()
+	defer i.cacheMutex.Unlock()
+
+	hash, ok := i.cache[key].(map[string]string)
+	if !ok {
+		hash = make(map[string]string)
+		i.cache[key] = hash
+	}
+
+	for field, value := range fields {
+		hash[field] = value
+	}
+
+	return nil
+}
+
+// GetHash implements Client.
+func (i *inMemoryClient) GetHash(ctx context.Context, key string) (map[string]string, error) {
+	i.cacheMutex.Lock()
+	defer i.cacheMutex.Unlock()
+
+	hash, ok := i.cache[key].(map[string]string)
+	if !ok || len(hash) == 0 {
+		return nil, ErrCacheMissed
+	}
+
+	fields := make(map[string]string, len(hash))
+	for field, value := range hash {
+		fields[field] = value
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,85 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+const (
+	// contestScoreboardCompleteField marks a cached scoreboard that holds every finished submission of its contest,
+	// rather than only the ones recorded since it was lost.
+	contestScoreboardCompleteField = "complete"
+)
+
+var (
+	contestScoreboardKeyPrefix string = "contest_scoreboard"
+)
+
+// ContestScoreboard caches the finished submissions of a contest, one field per submission, so that recording
+// submissions concurrently does not lose any of them.
+type ContestScoreboard interface {
+	// Set records the given submissions, keyed by submission ID, and marks the scoreboard as complete.
+	Set(ctx context.Context, contestID uint64, submissions map[uint64][]byte) error
+	// SetSubmission records a single submission, replacing the one recorded before with the same ID.
+	SetSubmission(ctx context.Context, contestID uint64, submissionID uint64, submission []byte) error
+	// Get returns the recorded submissions keyed by submission ID, ErrCacheMissed is returned if the scoreboard is
+	// not complete.
+	Get(ctx context.Context, contestID uint64) (map[uint64][]byte, error)
+}
+
+func NewContestScoreboard(client Client) (ContestScoreboard, error) {
+	return &contestScoreboard{
+		client: client,
+	}, nil
+}
+
+type contestScoreboard struct {
+	client Client
+}
+
+// Get implements ContestScoreboard.
+func (c *contestScoreboard) Get(ctx context.Context, contestID uint64) (map[uint64][]byte, error) {
+	fields, err := c.client.GetHash(ctx, c.getCacheKey(contestID))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := fields[contestScoreboardCompleteField]; !ok {
+		return nil, ErrCacheMissed
+	}
+
+	submissions := make(map[uint64][]byte, len(fields))
+	for field, value := range fields {
+		submissionID, err := strconv.ParseUint(field, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		submissions[submissionID] = []byte(value)
+	}
+
+	return submissions, nil
+}
+
+// Set implements ContestScoreboard.
+func (c *contestScoreboard) Set(ctx context.Context, contestID uint64, submissions map[uint64][]byte) error {
+	fields := make(map[string]string, len(submissions)+1)
+	for submissionID, submission := range submissions {
+		fields[fmt.Sprint(submissionID)] = string(submission)
+	}
+	fields[contestScoreboardCompleteField] = "1"
+
+	return c.client.SetHashFields(ctx, c.getCacheKey(contestID), fields)
+}
+
+// SetSubmission implements ContestScoreboard.
+func (c *contestScoreboard) SetSubmission(ctx context.Context, contestID uint64, submissionID uint64, submission []byte) error {
+	return c.client.SetHashFields(ctx, c.getCacheKey(contestID), map[string]string{
+		fmt.Sprint(submissionID): string(submission),
+	})
+}
+
+func (c *contestScoreboard) getCacheKey(contestID uint64) string {
+	return fmt.Sprintf("%s:%d", contestScoreboardKeyPrefix, contestID)
+}
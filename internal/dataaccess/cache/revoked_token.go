@@ -0,0 +1,57 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	revokedTokenIDPrefix string = "revoked_token"
+)
+
+// RevokedToken is the list of the IDs of access tokens revoked before they expire.
+type RevokedToken interface {
+	// Add revokes the token, it is kept in the list until the token expires.
+	Add(ctx context.Context, tokenID string, expiresAt time.Time) error
+	Has(ctx context.Context, tokenID string) (bool, error)
+}
+
+func NewRevokedToken(client Client) (RevokedToken, error) {
+	return &revokedToken{
+		client: client,
+	}, nil
+}
+
+type revokedToken struct {
+	client Client
+}
+
+// Add implements RevokedToken.
+func (r *revokedToken) Add(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		// The token is already rejected for being expired
+		return nil
+	}
+
+	return r.client.Set(ctx, r.getCacheKey(tokenID), "1", ttl)
+}
+
+// Has implements RevokedToken.
+func (r *revokedToken) Has(ctx context.Context, tokenID string) (bool, error) {
+	_, err := r.client.Get(ctx, r.getCacheKey(tokenID))
+	if err != nil {
+		if errors.Is(err, ErrCacheMissed) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *revokedToken) getCacheKey(tokenID string) string {
+	return fmt.Sprintf("%s:%s", revokedTokenIDPrefix, tokenID)
+}
@@ -0,0 +1,83 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/maxuanquang/ojs/internal/utils"
+	"go.uber.org/zap"
+)
+
+var (
+	submissionUpdateChannelPrefix string = "submission_update"
+)
+
+type SubmissionUpdate struct {
+	Status              int8   `json:"status"`
+	Result              int8   `json:"result"`
+	JudgedTestCaseCount uint64 `json:"judged_test_case_count"`
+	TestCaseCount       uint64 `json:"test_case_count"`
+}
+
+type SubmissionUpdatePubSub interface {
+	Publish(ctx context.Context, submissionID uint64, update SubmissionUpdate) error
+	Subscribe(ctx context.Context, submissionID uint64) (<-chan SubmissionUpdate, error)
+}
+
+func NewSubmissionUpdatePubSub(client Client, logger *zap.Logger) (SubmissionUpdatePubSub, error) {
+	return &submissionUpdatePubSub{
+		client: client,
+		logger: logger,
+	}, nil
+}
+
+type submissionUpdatePubSub struct {
+	client Client
+	logger *zap.Logger
+}
+
+// Publish implements SubmissionUpdatePubSub.
+func (s *submissionUpdatePubSub) Publish(ctx context.Context, submissionID uint64, update SubmissionUpdate) error {
+	message, err := json.Marshal(update)
+	if err != nil {
+		return err
+	}
+
+	return s.client.Publish(ctx, s.getChannel(submissionID), string(message))
+}
+
+// Subscribe implements SubmissionUpdatePubSub.
+func (s *submissionUpdatePubSub) Subscribe(ctx context.Context, submissionID uint64) (<-chan SubmissionUpdate, error) {
+	messageChan, err := s.client.Subscribe(ctx, s.getChannel(submissionID))
+	if err != nil {
+		return nil, err
+	}
+
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("submission_id", submissionID))
+
+	updateChan := make(chan SubmissionUpdate)
+	go func() {
+		defer close(updateChan)
+
+		for message := range messageChan {
+			var update SubmissionUpdate
+			if err := json.Unmarshal([]byte(message), &update); err != nil {
+				logger.With(zap.Error(err)).Error("failed to unmarshal submission update")
+				continue
+			}
+
+			select {
+			case updateChan <- update:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return updateChan, nil
+}
+
+func (s *submissionUpdatePubSub) getChannel(submissionID uint64) string {
+	return fmt.Sprintf("%s:%d", submissionUpdateChannelPrefix, submissionID)
+}
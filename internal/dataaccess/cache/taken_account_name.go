@@ -11,6 +11,7 @@ var (
 type TakenAccountName interface {
 	Add(ctx context.Context, accountName string) error
 	Has(ctx context.Context, accountName string) (bool, error)
+	Remove(ctx context.Context, accountName string) error
 }
 
 func NewTakenAccountName(client Client) (TakenAccountName, error) {
@@ -33,6 +34,11 @@ func (t *takenAccountName) Add(ctx context.Context, accountName string) error {
 	return nil
 }
 
+// Remove implements TakenAccountName.
+func (t *takenAccountName) Remove(ctx context.Context, accountName string) error {
+	return t.client.RemoveFromSet(ctx, setKeyNameTakenAccountName, accountName)
+}
+
 // Has implements TakenAccountName.
 func (t *takenAccountName) Has(ctx context.Context, accountName string) (bool, error) {
 	exists, err := t.client.IsValueInSet(ctx, setKeyNameTakenAccountName, accountName)
@@ -3,7 +3,11 @@ package cache
 import "github.com/google/wire"
 
 var WireSet = wire.NewSet(
+	NewRedisConnection,
 	NewClient,
 	NewTakenAccountName,
 	NewTokenPublicKey,
+	NewRevokedToken,
+	NewSubmissionUpdatePubSub,
+	NewContestScoreboard,
 )
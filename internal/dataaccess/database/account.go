@@ -3,6 +3,7 @@ package database
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/maxuanquang/ojs/internal/utils"
 	"go.uber.org/zap"
@@ -15,15 +16,24 @@ var (
 )
 
 type Account struct {
-	ID   uint64 `gorm:"column:id;primaryKey"`
-	Name string `gorm:"column:name"`
-	Role int8   `gotm:"column:role"`
+	ID         uint64 `gorm:"column:id;primaryKey"`
+	Name       string `gorm:"column:name"`
+	Role       int8   `gotm:"column:role"`
+	IsDisabled bool   `gorm:"column:is_disabled"`
 }
 
 type AccountDataAccessor interface {
 	CreateAccount(ctx context.Context, account Account) (Account, error)
 	GetAccountByID(ctx context.Context, id uint64) (Account, error)
 	GetAccountByName(ctx context.Context, name string) (Account, error)
+	// GetAccountList returns the accounts whose name contains the query, every account if it is empty.
+	GetAccountList(ctx context.Context, query string, offset uint64, limit uint64) ([]Account, error)
+	GetAccountCount(ctx context.Context, query string) (uint64, error)
+	// UpdateAccountName, UpdateAccountRole and DisableAccount only write their own column, so that concurrent
+	// updates of the same account do not revert each other.
+	UpdateAccountName(ctx context.Context, id uint64, name string) error
+	UpdateAccountRole(ctx context.Context, id uint64, role int8) error
+	DisableAccount(ctx context.Context, id uint64) error
 	WithDatabaseTransaction(database Database) AccountDataAccessor
 }
 
@@ -93,6 +103,86 @@ func (a *accountDataAccessor) GetAccountByName(ctx context.Context, name string)
 	return foundAccount, nil
 }
 
+// GetAccountList implements AccountDataAccessor.
+func (a *accountDataAccessor) GetAccountList(ctx context.Context, query string, offset uint64, limit uint64) ([]Account, error) {
+	var accounts []Account
+	result := a.filterAccountByName(query).
+		Order("id ASC").
+		Offset(int(offset)).
+		Limit(int(limit)).
+		Find(&accounts)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("query", query))
+		logger.Error("error getting account list", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return accounts, nil
+}
+
+// GetAccountCount implements AccountDataAccessor.
+func (a *accountDataAccessor) GetAccountCount(ctx context.Context, query string) (uint64, error) {
+	var count int64
+	result := a.filterAccountByName(query).Model(&Account{}).Count(&count)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("query", query))
+		logger.Error("error counting accounts", zap.Error(result.Error))
+		return 0, result.Error
+	}
+
+	return uint64(count), nil
+}
+
+// UpdateAccountName implements AccountDataAccessor.
+func (a *accountDataAccessor) UpdateAccountName(ctx context.Context, id uint64, name string) error {
+	result := a.database.Model(&Account{ID: id}).Update("name", name)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return ErrAccountAlreadyExists
+		}
+
+		logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", id))
+		logger.Error("error updating account name", zap.Error(result.Error))
+		return result.Error
+	}
+
+	return nil
+}
+
+// UpdateAccountRole implements AccountDataAccessor.
+func (a *accountDataAccessor) UpdateAccountRole(ctx context.Context, id uint64, role int8) error {
+	result := a.database.Model(&Account{ID: id}).Update("role", role)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", id))
+		logger.Error("error updating account role", zap.Error(result.Error))
+		return result.Error
+	}
+
+	return nil
+}
+
+// DisableAccount implements AccountDataAccessor.
+func (a *accountDataAccessor) DisableAccount(ctx context.Context, id uint64) error {
+	result := a.database.Model(&Account{ID: id}).Update("is_disabled", true)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", id))
+		logger.Error("error disabling account", zap.Error(result.Error))
+		return result.Error
+	}
+
+	return nil
+}
+
+func (a *accountDataAccessor) filterAccountByName(query string) Database {
+	if query == "" {
+		return a.database
+	}
+
+	// The wildcards of LIKE in the query are matched literally
+	escapedQuery := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(query)
+	return a.database.Where("name LIKE ?", "%"+escapedQuery+"%")
+}
+
 // WithDatabaseTransaction implements AccountDataAccessor.
 func (a *accountDataAccessor) WithDatabaseTransaction(database Database) AccountDataAccessor {
 	return &accountDataAccessor{
@@ -14,6 +14,7 @@ type AccountPassword struct {
 type AccountPasswordDataAccessor interface {
 	CreatePassword(ctx context.Context, ofAccountID uint64, hashedPassword string) (AccountPassword, error)
 	GetPassword(ctx context.Context, ofAccountID uint64) (AccountPassword, error)
+	UpdatePassword(ctx context.Context, ofAccountID uint64, hashedPassword string) error
 	WithDatabaseTransaction(database Database) AccountPasswordDataAccessor
 }
 
@@ -57,6 +58,18 @@ func (a *accountPasswordDataAccessor) CreatePassword(ctx context.Context, ofAcco
 	return createdPassword, nil
 }
 
+// UpdatePassword implements AccountPasswordDataAccessor.
+func (a *accountPasswordDataAccessor) UpdatePassword(ctx context.Context, ofAccountID uint64, hashedPassword string) error {
+	result := a.database.Model(&AccountPassword{}).
+		Where("of_account_id = ?", ofAccountID).
+		Update("hashed", hashedPassword)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
 // WithDatabaseTransaction implements AccountPasswordDataAccessor.
 func (a *accountPasswordDataAccessor) WithDatabaseTransaction(database Database) AccountPasswordDataAccessor {
 	return &accountPasswordDataAccessor{
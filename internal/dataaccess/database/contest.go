@@ -0,0 +1,208 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maxuanquang/ojs/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrContestNotFound = errors.New("contest not found")
+)
+
+type Contest struct {
+	ID               uint64    `gorm:"column:id;primaryKey"`
+	DisplayName      string    `gorm:"column:display_name"`
+	AuthorID         uint64    `gorm:"column:author_id"`
+	Description      string    `gorm:"column:description"`
+	StartTime        time.Time `gorm:"column:start_time"`
+	EndTime          time.Time `gorm:"column:end_time"`
+	IsPublic         bool      `gorm:"column:is_public"`
+	ScoringMode      int8      `gorm:"column:scoring_mode"`
+	FreezeScoreboard bool      `gorm:"column:freeze_scoreboard"`
+}
+
+type ContestDataAccessor interface {
+	CreateContest(ctx context.Context, contest Contest) (Contest, error)
+	GetContestByID(ctx context.Context, id uint64) (Contest, error)
+	GetContestList(ctx context.Context, offset, limit uint64) ([]Contest, error)
+	GetContestCount(ctx context.Context) (uint64, error)
+	// GetVisibleContestList returns public contests and hidden contests authored by the account.
+	GetVisibleContestList(ctx context.Context, accountID, offset, limit uint64) ([]Contest, error)
+	GetVisibleContestCount(ctx context.Context, accountID uint64) (uint64, error)
+	UpdateContest(ctx context.Context, contest Contest) (Contest, error)
+	DeleteContest(ctx context.Context, id uint64) error
+	WithDatabaseTransaction(database Database) ContestDataAccessor
+}
+
+func NewContestDataAccessor(database Database, logger *zap.Logger) ContestDataAccessor {
+	return &contestDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type contestDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreateContest implements ContestDataAccessor.
+func (c *contestDataAccessor) CreateContest(ctx context.Context, contest Contest) (Contest, error) {
+	createdContest := Contest{
+		DisplayName:      contest.DisplayName,
+		AuthorID:         contest.AuthorID,
+		Description:      contest.Description,
+		StartTime:        contest.StartTime,
+		EndTime:          contest.EndTime,
+		IsPublic:         contest.IsPublic,
+		ScoringMode:      contest.ScoringMode,
+		FreezeScoreboard: contest.FreezeScoreboard,
+	}
+	result := c.database.Create(&createdContest)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("display_name", contest.DisplayName))
+		logger.Error("error creating contest", zap.Error(result.Error))
+		return Contest{}, result.Error
+	}
+
+	return createdContest, nil
+}
+
+// GetContestByID implements ContestDataAccessor.
+func (c *contestDataAccessor) GetContestByID(ctx context.Context, id uint64) (Contest, error) {
+	var foundContest Contest
+	result := c.database.First(&foundContest, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return Contest{}, ErrContestNotFound
+		}
+
+		logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("contest_id", id))
+		logger.Error("error getting contest", zap.Error(result.Error))
+		return Contest{}, result.Error
+	}
+
+	return foundContest, nil
+}
+
+// GetContestList implements ContestDataAccessor.
+func (c *contestDataAccessor) GetContestList(ctx context.Context, offset, limit uint64) ([]Contest, error) {
+	var contests []Contest
+	result := c.database.Order("start_time DESC").Offset(int(offset)).Limit(int(limit)).Find(&contests)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, c.logger)
+		logger.Error("error getting contest list", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return contests, nil
+}
+
+// GetContestCount implements ContestDataAccessor.
+func (c *contestDataAccessor) GetContestCount(ctx context.Context) (uint64, error) {
+	var count int64
+	result := c.database.Model(&Contest{}).Count(&count)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, c.logger)
+		logger.Error("error getting contest count", zap.Error(result.Error))
+		return 0, result.Error
+	}
+
+	return uint64(count), nil
+}
+
+// GetVisibleContestList implements ContestDataAccessor.
+func (c *contestDataAccessor) GetVisibleContestList(ctx context.Context, accountID, offset, limit uint64) ([]Contest, error) {
+	var contests []Contest
+	result := c.database.
+		Where("is_public = ? OR author_id = ?", true, accountID).
+		Order("start_time DESC").
+		Offset(int(offset)).
+		Limit(int(limit)).
+		Find(&contests)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("account_id", accountID))
+		logger.Error("error getting visible contest list", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return contests, nil
+}
+
+// GetVisibleContestCount implements ContestDataAccessor.
+func (c *contestDataAccessor) GetVisibleContestCount(ctx context.Context, accountID uint64) (uint64, error) {
+	var count int64
+	result := c.database.Model(&Contest{}).Where("is_public = ? OR author_id = ?", true, accountID).Count(&count)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("account_id", accountID))
+		logger.Error("error getting visible contest count", zap.Error(result.Error))
+		return 0, result.Error
+	}
+
+	return uint64(count), nil
+}
+
+// UpdateContest implements ContestDataAccessor.
+func (c *contestDataAccessor) UpdateContest(ctx context.Context, contest Contest) (Contest, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("contest_id", contest.ID))
+
+	foundContest, err := c.GetContestByID(ctx, contest.ID)
+	if err != nil {
+		return Contest{}, err
+	}
+
+	if contest.DisplayName != "" {
+		foundContest.DisplayName = contest.DisplayName
+	}
+	if contest.Description != "" {
+		foundContest.Description = contest.Description
+	}
+	if !contest.StartTime.IsZero() {
+		foundContest.StartTime = contest.StartTime
+	}
+	if !contest.EndTime.IsZero() {
+		foundContest.EndTime = contest.EndTime
+	}
+	if contest.ScoringMode != 0 {
+		foundContest.ScoringMode = contest.ScoringMode
+	}
+	foundContest.IsPublic = contest.IsPublic
+	foundContest.FreezeScoreboard = contest.FreezeScoreboard
+
+	result := c.database.Save(&foundContest)
+	if result.Error != nil {
+		logger.Error("error updating contest", zap.Error(result.Error))
+		return Contest{}, result.Error
+	}
+
+	return foundContest, nil
+}
+
+// DeleteContest implements ContestDataAccessor.
+func (c *contestDataAccessor) DeleteContest(ctx context.Context, id uint64) error {
+	result := c.database.Delete(&Contest{}, id)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("contest_id", id))
+		logger.Error("error deleting contest", zap.Error(result.Error))
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrContestNotFound
+	}
+
+	return nil
+}
+
+// WithDatabaseTransaction implements ContestDataAccessor.
+func (c *contestDataAccessor) WithDatabaseTransaction(database Database) ContestDataAccessor {
+	return &contestDataAccessor{
+		database: database,
+		logger:   c.logger,
+	}
+}
@@ -0,0 +1,119 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maxuanquang/ojs/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrContestParticipantNotFound      = errors.New("contest participant not found")
+	ErrContestParticipantAlreadyExists = errors.New("contest participant already exists")
+)
+
+type ContestParticipant struct {
+	OfContestID  uint64    `gorm:"column:of_contest_id;primaryKey"`
+	OfAccountID  uint64    `gorm:"column:of_account_id;primaryKey"`
+	RegisteredAt time.Time `gorm:"column:registered_at"`
+}
+
+type ContestParticipantDataAccessor interface {
+	CreateContestParticipant(ctx context.Context, contestParticipant ContestParticipant) (ContestParticipant, error)
+	GetContestParticipant(ctx context.Context, contestID, accountID uint64) (ContestParticipant, error)
+	GetContestParticipantList(ctx context.Context, contestID uint64) ([]ContestParticipant, error)
+	DeleteContestParticipant(ctx context.Context, contestID, accountID uint64) error
+	WithDatabaseTransaction(database Database) ContestParticipantDataAccessor
+}
+
+func NewContestParticipantDataAccessor(database Database, logger *zap.Logger) ContestParticipantDataAccessor {
+	return &contestParticipantDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type contestParticipantDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreateContestParticipant implements ContestParticipantDataAccessor.
+func (c *contestParticipantDataAccessor) CreateContestParticipant(ctx context.Context, contestParticipant ContestParticipant) (ContestParticipant, error) {
+	createdContestParticipant := ContestParticipant{
+		OfContestID:  contestParticipant.OfContestID,
+		OfAccountID:  contestParticipant.OfAccountID,
+		RegisteredAt: contestParticipant.RegisteredAt,
+	}
+	result := c.database.Create(&createdContestParticipant)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return ContestParticipant{}, ErrContestParticipantAlreadyExists
+		}
+
+		logger := utils.LoggerWithContext(ctx, c.logger).
+			With(zap.Uint64("contest_id", contestParticipant.OfContestID)).
+			With(zap.Uint64("account_id", contestParticipant.OfAccountID))
+		logger.Error("error creating contest participant", zap.Error(result.Error))
+		return ContestParticipant{}, result.Error
+	}
+
+	return createdContestParticipant, nil
+}
+
+// GetContestParticipant implements ContestParticipantDataAccessor.
+func (c *contestParticipantDataAccessor) GetContestParticipant(ctx context.Context, contestID, accountID uint64) (ContestParticipant, error) {
+	var foundContestParticipant ContestParticipant
+	result := c.database.Where("of_contest_id = ? AND of_account_id = ?", contestID, accountID).First(&foundContestParticipant)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return ContestParticipant{}, ErrContestParticipantNotFound
+		}
+
+		logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("contest_id", contestID), zap.Uint64("account_id", accountID))
+		logger.Error("error getting contest participant", zap.Error(result.Error))
+		return ContestParticipant{}, result.Error
+	}
+
+	return foundContestParticipant, nil
+}
+
+// GetContestParticipantList implements ContestParticipantDataAccessor.
+func (c *contestParticipantDataAccessor) GetContestParticipantList(ctx context.Context, contestID uint64) ([]ContestParticipant, error) {
+	var contestParticipants []ContestParticipant
+	result := c.database.Where("of_contest_id = ?", contestID).Order("registered_at").Find(&contestParticipants)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("contest_id", contestID))
+		logger.Error("error getting contest participant list", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return contestParticipants, nil
+}
+
+// DeleteContestParticipant implements ContestParticipantDataAccessor.
+func (c *contestParticipantDataAccessor) DeleteContestParticipant(ctx context.Context, contestID, accountID uint64) error {
+	result := c.database.Where("of_contest_id = ? AND of_account_id = ?", contestID, accountID).Delete(&ContestParticipant{})
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("contest_id", contestID), zap.Uint64("account_id", accountID))
+		logger.Error("error deleting contest participant", zap.Error(result.Error))
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrContestParticipantNotFound
+	}
+
+	return nil
+}
+
+// WithDatabaseTransaction implements ContestParticipantDataAccessor.
+func (c *contestParticipantDataAccessor) WithDatabaseTransaction(database Database) ContestParticipantDataAccessor {
+	return &contestParticipantDataAccessor{
+		database: database,
+		logger:   c.logger,
+	}
+}
@@ -0,0 +1,106 @@
+package database
+
+import (
+	"context"
+	"errors"
+
+	"github.com/maxuanquang/ojs/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrContestProblemNotFound = errors.New("contest problem not found")
+)
+
+type ContestProblem struct {
+	OfContestID uint64 `gorm:"column:of_contest_id;primaryKey"`
+	OfProblemID uint64 `gorm:"column:of_problem_id;primaryKey"`
+	Label       string `gorm:"column:label"`
+}
+
+type ContestProblemDataAccessor interface {
+	CreateContestProblemList(ctx context.Context, contestProblems []ContestProblem) error
+	GetContestProblem(ctx context.Context, contestID, problemID uint64) (ContestProblem, error)
+	GetContestProblemList(ctx context.Context, contestID uint64) ([]ContestProblem, error)
+	DeleteContestProblemList(ctx context.Context, contestID uint64) error
+	WithDatabaseTransaction(database Database) ContestProblemDataAccessor
+}
+
+func NewContestProblemDataAccessor(database Database, logger *zap.Logger) ContestProblemDataAccessor {
+	return &contestProblemDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type contestProblemDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreateContestProblemList implements ContestProblemDataAccessor.
+func (c *contestProblemDataAccessor) CreateContestProblemList(ctx context.Context, contestProblems []ContestProblem) error {
+	if len(contestProblems) == 0 {
+		return nil
+	}
+
+	result := c.database.Create(&contestProblems)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("contest_id", contestProblems[0].OfContestID))
+		logger.Error("error creating contest problem list", zap.Error(result.Error))
+		return result.Error
+	}
+
+	return nil
+}
+
+// GetContestProblem implements ContestProblemDataAccessor.
+func (c *contestProblemDataAccessor) GetContestProblem(ctx context.Context, contestID, problemID uint64) (ContestProblem, error) {
+	var foundContestProblem ContestProblem
+	result := c.database.Where("of_contest_id = ? AND of_problem_id = ?", contestID, problemID).First(&foundContestProblem)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return ContestProblem{}, ErrContestProblemNotFound
+		}
+
+		logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("contest_id", contestID), zap.Uint64("problem_id", problemID))
+		logger.Error("error getting contest problem", zap.Error(result.Error))
+		return ContestProblem{}, result.Error
+	}
+
+	return foundContestProblem, nil
+}
+
+// GetContestProblemList implements ContestProblemDataAccessor.
+func (c *contestProblemDataAccessor) GetContestProblemList(ctx context.Context, contestID uint64) ([]ContestProblem, error) {
+	var contestProblems []ContestProblem
+	result := c.database.Where("of_contest_id = ?", contestID).Order("label").Find(&contestProblems)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("contest_id", contestID))
+		logger.Error("error getting contest problem list", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return contestProblems, nil
+}
+
+// DeleteContestProblemList implements ContestProblemDataAccessor.
+func (c *contestProblemDataAccessor) DeleteContestProblemList(ctx context.Context, contestID uint64) error {
+	result := c.database.Where("of_contest_id = ?", contestID).Delete(&ContestProblem{})
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("contest_id", contestID))
+		logger.Error("error deleting contest problem list", zap.Error(result.Error))
+		return result.Error
+	}
+
+	return nil
+}
+
+// WithDatabaseTransaction implements ContestProblemDataAccessor.
+func (c *contestProblemDataAccessor) WithDatabaseTransaction(database Database) ContestProblemDataAccessor {
+	return &contestProblemDataAccessor{
+		database: database,
+		logger:   c.logger,
+	}
+}
@@ -0,0 +1,143 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maxuanquang/ojs/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrDeadLetteredSubmissionNotFound = errors.New("dead-lettered submission not found")
+)
+
+// DeadLetteredSubmission is a submission whose submission created message could not be handled by any worker,
+// kept until it is replayed.
+type DeadLetteredSubmission struct {
+	ID             uint64    `gorm:"column:id;primaryKey"`
+	OfSubmissionID uint64    `gorm:"column:of_submission_id"`
+	Error          string    `gorm:"column:error"`
+	AttemptCount   int       `gorm:"column:attempt_count"`
+	CreatedAt      time.Time `gorm:"column:created_at;->"`
+}
+
+func (DeadLetteredSubmission) TableName() string {
+	return "dead_lettered_submission"
+}
+
+type DeadLetteredSubmissionDataAccessor interface {
+	CreateDeadLetteredSubmission(ctx context.Context, deadLetteredSubmission DeadLetteredSubmission) (DeadLetteredSubmission, error)
+	GetDeadLetteredSubmissionByID(ctx context.Context, id uint64) (DeadLetteredSubmission, error)
+	// GetDeadLetteredSubmissionList returns the dead-lettered submissions, oldest first.
+	GetDeadLetteredSubmissionList(ctx context.Context, offset uint64, limit uint64) ([]DeadLetteredSubmission, error)
+	GetDeadLetteredSubmissionCount(ctx context.Context) (uint64, error)
+	DeleteDeadLetteredSubmission(ctx context.Context, id uint64) error
+	WithDatabaseTransaction(database Database) DeadLetteredSubmissionDataAccessor
+}
+
+func NewDeadLetteredSubmissionDataAccessor(database Database, logger *zap.Logger) DeadLetteredSubmissionDataAccessor {
+	return &deadLetteredSubmissionDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type deadLetteredSubmissionDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreateDeadLetteredSubmission implements DeadLetteredSubmissionDataAccessor.
+func (d *deadLetteredSubmissionDataAccessor) CreateDeadLetteredSubmission(
+	ctx context.Context,
+	deadLetteredSubmission DeadLetteredSubmission,
+) (DeadLetteredSubmission, error) {
+	createdDeadLetteredSubmission := DeadLetteredSubmission{
+		OfSubmissionID: deadLetteredSubmission.OfSubmissionID,
+		Error:          deadLetteredSubmission.Error,
+		AttemptCount:   deadLetteredSubmission.AttemptCount,
+	}
+	result := d.database.Create(&createdDeadLetteredSubmission)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("submission_id", deadLetteredSubmission.OfSubmissionID))
+		logger.Error("error creating dead-lettered submission", zap.Error(result.Error))
+		return DeadLetteredSubmission{}, result.Error
+	}
+
+	return createdDeadLetteredSubmission, nil
+}
+
+// GetDeadLetteredSubmissionByID implements DeadLetteredSubmissionDataAccessor.
+func (d *deadLetteredSubmissionDataAccessor) GetDeadLetteredSubmissionByID(ctx context.Context, id uint64) (DeadLetteredSubmission, error) {
+	var foundDeadLetteredSubmission DeadLetteredSubmission
+	result := d.database.First(&foundDeadLetteredSubmission, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return DeadLetteredSubmission{}, ErrDeadLetteredSubmissionNotFound
+		}
+
+		logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("dead_lettered_submission_id", id))
+		logger.Error("error getting dead-lettered submission", zap.Error(result.Error))
+		return DeadLetteredSubmission{}, result.Error
+	}
+
+	return foundDeadLetteredSubmission, nil
+}
+
+// GetDeadLetteredSubmissionList implements DeadLetteredSubmissionDataAccessor.
+func (d *deadLetteredSubmissionDataAccessor) GetDeadLetteredSubmissionList(
+	ctx context.Context,
+	offset uint64,
+	limit uint64,
+) ([]DeadLetteredSubmission, error) {
+	var deadLetteredSubmissions []DeadLetteredSubmission
+	result := d.database.Order("id").Offset(int(offset)).Limit(int(limit)).Find(&deadLetteredSubmissions)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, d.logger)
+		logger.Error("error getting dead-lettered submission list", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return deadLetteredSubmissions, nil
+}
+
+// GetDeadLetteredSubmissionCount implements DeadLetteredSubmissionDataAccessor.
+func (d *deadLetteredSubmissionDataAccessor) GetDeadLetteredSubmissionCount(ctx context.Context) (uint64, error) {
+	var count int64
+	result := d.database.Model(&DeadLetteredSubmission{}).Count(&count)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, d.logger)
+		logger.Error("error getting dead-lettered submission count", zap.Error(result.Error))
+		return 0, result.Error
+	}
+
+	return uint64(count), nil
+}
+
+// DeleteDeadLetteredSubmission implements DeadLetteredSubmissionDataAccessor.
+func (d *deadLetteredSubmissionDataAccessor) DeleteDeadLetteredSubmission(ctx context.Context, id uint64) error {
+	result := d.database.Delete(&DeadLetteredSubmission{}, id)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("dead_lettered_submission_id", id))
+		logger.Error("error deleting dead-lettered submission", zap.Error(result.Error))
+		return result.Error
+	}
+
+	// The dead-lettered submission may have been replayed concurrently
+	if result.RowsAffected == 0 {
+		return ErrDeadLetteredSubmissionNotFound
+	}
+
+	return nil
+}
+
+// WithDatabaseTransaction implements DeadLetteredSubmissionDataAccessor.
+func (d *deadLetteredSubmissionDataAccessor) WithDatabaseTransaction(database Database) DeadLetteredSubmissionDataAccessor {
+	return &deadLetteredSubmissionDataAccessor{
+		database: database,
+		logger:   d.logger,
+	}
+}
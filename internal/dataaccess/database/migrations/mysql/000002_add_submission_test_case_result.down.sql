@@ -0,0 +1 @@
+DROP TABLE IF EXISTS `submission_test_case_result`;
\ No newline at end of file
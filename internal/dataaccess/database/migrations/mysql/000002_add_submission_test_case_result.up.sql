@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS `submission_test_case_result` (
+    `id` BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    `of_submission_id` BIGINT UNSIGNED NOT NULL,
+    `of_test_case_id` BIGINT UNSIGNED NOT NULL,
+    `result` TINYINT NOT NULL,
+    `wall_time` BIGINT UNSIGNED NOT NULL,
+    `cpu_time` BIGINT UNSIGNED NOT NULL,
+    `memory` BIGINT UNSIGNED NOT NULL,
+    `exit_code` INT NOT NULL,
+    `stdout` TEXT NOT NULL,
+    `stderr` TEXT NOT NULL,
+    FOREIGN KEY (`of_submission_id`) REFERENCES `submission` (`id`) ON DELETE CASCADE,
+    FOREIGN KEY (`of_test_case_id`) REFERENCES `test_case` (`id`) ON DELETE CASCADE
+);
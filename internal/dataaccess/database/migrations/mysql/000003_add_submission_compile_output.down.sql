@@ -0,0 +1 @@
+ALTER TABLE `submission` DROP COLUMN `compile_output`;
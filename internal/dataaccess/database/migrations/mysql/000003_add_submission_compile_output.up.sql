@@ -0,0 +1 @@
+ALTER TABLE `submission` ADD COLUMN `compile_output` TEXT NOT NULL;
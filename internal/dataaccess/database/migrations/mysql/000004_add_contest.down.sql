@@ -0,0 +1,8 @@
+ALTER TABLE `submission`
+    DROP INDEX `submission_of_contest_id_idx`,
+    DROP COLUMN `created_at`,
+    DROP COLUMN `of_contest_id`;
+
+DROP TABLE IF EXISTS `contest_participant`;
+DROP TABLE IF EXISTS `contest_problem`;
+DROP TABLE IF EXISTS `contest`;
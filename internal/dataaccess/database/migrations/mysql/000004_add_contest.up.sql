@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS `contest` (
+    `id` BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    `display_name` VARCHAR(255) NOT NULL,
+    `author_id` BIGINT UNSIGNED NOT NULL,
+    `description` TEXT NOT NULL,
+    `start_time` DATETIME NOT NULL,
+    `end_time` DATETIME NOT NULL,
+    `is_public` TINYINT(1) NOT NULL,
+    FOREIGN KEY (`author_id`) REFERENCES `account` (`id`)
+);
+
+CREATE TABLE IF NOT EXISTS `contest_problem` (
+    `of_contest_id` BIGINT UNSIGNED NOT NULL,
+    `of_problem_id` BIGINT UNSIGNED NOT NULL,
+    `label` VARCHAR(8) NOT NULL,
+    PRIMARY KEY (`of_contest_id`, `of_problem_id`),
+    UNIQUE (`of_contest_id`, `label`),
+    FOREIGN KEY (`of_contest_id`) REFERENCES `contest` (`id`) ON DELETE CASCADE,
+    FOREIGN KEY (`of_problem_id`) REFERENCES `problem` (`id`)
+);
+
+CREATE TABLE IF NOT EXISTS `contest_participant` (
+    `of_contest_id` BIGINT UNSIGNED NOT NULL,
+    `of_account_id` BIGINT UNSIGNED NOT NULL,
+    `registered_at` DATETIME NOT NULL,
+    PRIMARY KEY (`of_contest_id`, `of_account_id`),
+    FOREIGN KEY (`of_contest_id`) REFERENCES `contest` (`id`) ON DELETE CASCADE,
+    FOREIGN KEY (`of_account_id`) REFERENCES `account` (`id`)
+);
+
+ALTER TABLE `submission`
+    ADD COLUMN `of_contest_id` BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    ADD COLUMN `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD INDEX `submission_of_contest_id_idx` (`of_contest_id`);
@@ -0,0 +1,3 @@
+ALTER TABLE `contest`
+    DROP COLUMN `freeze_scoreboard`,
+    DROP COLUMN `scoring_mode`;
@@ -0,0 +1,3 @@
+ALTER TABLE `contest`
+    ADD COLUMN `scoring_mode` TINYINT NOT NULL DEFAULT 1,
+    ADD COLUMN `freeze_scoreboard` TINYINT(1) NOT NULL DEFAULT 0;
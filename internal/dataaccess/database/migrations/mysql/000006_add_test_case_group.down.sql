@@ -0,0 +1,8 @@
+ALTER TABLE `submission`
+    DROP COLUMN `score`;
+
+ALTER TABLE `test_case`
+    DROP INDEX `test_case_of_test_case_group_id_idx`,
+    DROP COLUMN `of_test_case_group_id`;
+
+DROP TABLE IF EXISTS `test_case_group`;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS `test_case_group` (
+    `id` BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    `of_problem_id` BIGINT UNSIGNED NOT NULL,
+    `display_name` VARCHAR(255) NOT NULL,
+    `points` INT UNSIGNED NOT NULL,
+    `scoring_policy` TINYINT NOT NULL,
+    FOREIGN KEY (`of_problem_id`) REFERENCES `problem` (`id`) ON DELETE CASCADE
+);
+
+ALTER TABLE `test_case`
+    ADD COLUMN `of_test_case_group_id` BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    ADD INDEX `test_case_of_test_case_group_id_idx` (`of_test_case_group_id`);
+
+ALTER TABLE `submission`
+    ADD COLUMN `score` INT UNSIGNED NOT NULL DEFAULT 0;
@@ -0,0 +1,4 @@
+ALTER TABLE `problem`
+    DROP COLUMN `version`,
+    DROP COLUMN `checker_source`,
+    DROP COLUMN `checker_language`;
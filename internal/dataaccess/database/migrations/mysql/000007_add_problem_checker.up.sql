@@ -0,0 +1,4 @@
+ALTER TABLE `problem`
+    ADD COLUMN `checker_language` VARCHAR(32) NOT NULL DEFAULT '',
+    ADD COLUMN `checker_source` MEDIUMTEXT NOT NULL,
+    ADD COLUMN `version` INT UNSIGNED NOT NULL DEFAULT 1;
@@ -0,0 +1,3 @@
+ALTER TABLE `problem`
+    DROP COLUMN `float_epsilon`,
+    DROP COLUMN `comparison_mode`;
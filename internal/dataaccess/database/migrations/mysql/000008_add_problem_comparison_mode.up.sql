@@ -0,0 +1,3 @@
+ALTER TABLE `problem`
+    ADD COLUMN `comparison_mode` TINYINT NOT NULL DEFAULT 1,
+    ADD COLUMN `float_epsilon` DOUBLE NOT NULL DEFAULT 0;
@@ -0,0 +1,3 @@
+ALTER TABLE `problem`
+    DROP COLUMN `interactor_source`,
+    DROP COLUMN `interactor_language`;
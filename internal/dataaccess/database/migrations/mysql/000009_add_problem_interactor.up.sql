@@ -0,0 +1,3 @@
+ALTER TABLE `problem`
+    ADD COLUMN `interactor_language` VARCHAR(32) NOT NULL DEFAULT '',
+    ADD COLUMN `interactor_source` MEDIUMTEXT NOT NULL;
@@ -0,0 +1,5 @@
+ALTER TABLE `submission`
+    DROP INDEX `idx_submission_judging_heartbeat_at`,
+    DROP COLUMN `judging_heartbeat_at`,
+    DROP COLUMN `judging_started_at`,
+    DROP COLUMN `judged_by_worker`;
@@ -0,0 +1,5 @@
+ALTER TABLE `submission`
+    ADD COLUMN `judged_by_worker` VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN `judging_started_at` DATETIME NULL,
+    ADD COLUMN `judging_heartbeat_at` DATETIME NULL,
+    ADD INDEX `idx_submission_judging_heartbeat_at` (`judging_heartbeat_at`);
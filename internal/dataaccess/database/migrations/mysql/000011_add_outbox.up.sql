@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS `outbox` (
+    `id` BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    `topic` VARCHAR(255) NOT NULL,
+    `payload` BLOB NOT NULL,
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `sent_at` DATETIME NULL,
+    INDEX `idx_outbox_sent_at` (`sent_at`)
+);
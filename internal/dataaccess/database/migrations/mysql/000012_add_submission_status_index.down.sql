@@ -0,0 +1,2 @@
+ALTER TABLE `submission`
+    DROP INDEX `idx_submission_status`;
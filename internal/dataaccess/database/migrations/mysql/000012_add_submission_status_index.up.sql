@@ -0,0 +1,2 @@
+ALTER TABLE `submission`
+    ADD INDEX `idx_submission_status` (`status`);
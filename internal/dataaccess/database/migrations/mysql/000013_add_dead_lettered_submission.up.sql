@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS `dead_lettered_submission` (
+    `id` BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    `of_submission_id` BIGINT UNSIGNED NOT NULL,
+    `error` TEXT NOT NULL,
+    `attempt_count` INT NOT NULL,
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (`of_submission_id`) REFERENCES `submission`(`id`) ON DELETE CASCADE
+);
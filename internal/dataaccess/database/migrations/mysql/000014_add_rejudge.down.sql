@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS `submission_judge_history`;
+DROP TABLE IF EXISTS `rejudge`;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS `rejudge` (
+    `id` BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    `author_id` BIGINT UNSIGNED NOT NULL,
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (`author_id`) REFERENCES `account` (`id`)
+);
+
+CREATE TABLE IF NOT EXISTS `submission_judge_history` (
+    `id` BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    `of_rejudge_id` BIGINT UNSIGNED NOT NULL,
+    `of_submission_id` BIGINT UNSIGNED NOT NULL,
+    `result` TINYINT NOT NULL,
+    `score` INT UNSIGNED NOT NULL,
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (`of_rejudge_id`) REFERENCES `rejudge` (`id`) ON DELETE CASCADE,
+    FOREIGN KEY (`of_submission_id`) REFERENCES `submission` (`id`) ON DELETE CASCADE
+);
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS `session` (
+    `id` BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    `of_account_id` BIGINT UNSIGNED NOT NULL,
+    `refresh_token_hash` VARCHAR(64) NOT NULL,
+    `access_token_id` VARCHAR(36) NOT NULL,
+    `access_token_expires_at` DATETIME NOT NULL,
+    `expires_at` DATETIME NOT NULL,
+    `revoked_at` DATETIME NULL,
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (`of_account_id`) REFERENCES `account` (`id`) ON DELETE CASCADE,
+    INDEX `idx_session_of_account_id_revoked_at` (`of_account_id`, `revoked_at`)
+);
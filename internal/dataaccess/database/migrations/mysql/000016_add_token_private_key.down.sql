@@ -0,0 +1,4 @@
+ALTER TABLE `token_public_key`
+    DROP COLUMN `retired_at`,
+    DROP COLUMN `created_at`,
+    DROP COLUMN `encrypted_token_private_key_value`;
@@ -0,0 +1,7 @@
+ALTER TABLE `token_public_key`
+    ADD COLUMN `encrypted_token_private_key_value` VARBINARY(8192) NULL,
+    ADD COLUMN `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD COLUMN `retired_at` DATETIME NULL;
+
+-- The private keys of the existing public keys were never stored, they cannot sign anything anymore
+UPDATE `token_public_key` SET `retired_at` = UTC_TIMESTAMP();
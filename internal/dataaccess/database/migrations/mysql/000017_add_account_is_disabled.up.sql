@@ -0,0 +1 @@
+ALTER TABLE `account` ADD COLUMN `is_disabled` TINYINT(1) NOT NULL DEFAULT 0;
@@ -0,0 +1,3 @@
+ALTER TABLE `submission` ADD COLUMN `judging_queue` VARCHAR(255) NOT NULL DEFAULT '';
+UPDATE `submission`
+    SET `judging_queue` = IF(`of_contest_id` <> 0, 'submission_created_contest', 'submission_created');
@@ -0,0 +1,116 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/maxuanquang/ojs/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// OutboxMessage is a message to be published to the message queue, written in the same transaction as the change
+// it announces, so that the message is published if and only if the change is committed.
+type OutboxMessage struct {
+	ID        uint64     `gorm:"column:id;primaryKey"`
+	Topic     string     `gorm:"column:topic"`
+	Payload   []byte     `gorm:"column:payload"`
+	CreatedAt time.Time  `gorm:"column:created_at;->"`
+	SentAt    *time.Time `gorm:"column:sent_at"`
+}
+
+func (OutboxMessage) TableName() string {
+	return "outbox"
+}
+
+type OutboxMessageDataAccessor interface {
+	CreateOutboxMessage(ctx context.Context, outboxMessage OutboxMessage) (OutboxMessage, error)
+	// GetPendingOutboxMessageList returns the oldest messages not sent yet. Within a transaction, the messages are
+	// locked until it ends, and messages locked by other transactions are skipped.
+	GetPendingOutboxMessageList(ctx context.Context, limit uint64) ([]OutboxMessage, error)
+	MarkOutboxMessageSent(ctx context.Context, id uint64) error
+	// DeleteSentOutboxMessageList deletes the messages sent longer than retention ago, as measured by the database's clock.
+	DeleteSentOutboxMessageList(ctx context.Context, retention time.Duration) error
+	WithDatabaseTransaction(database Database) OutboxMessageDataAccessor
+}
+
+func NewOutboxMessageDataAccessor(database Database, logger *zap.Logger) OutboxMessageDataAccessor {
+	return &outboxMessageDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type outboxMessageDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreateOutboxMessage implements OutboxMessageDataAccessor.
+func (o *outboxMessageDataAccessor) CreateOutboxMessage(ctx context.Context, outboxMessage OutboxMessage) (OutboxMessage, error) {
+	createdOutboxMessage := OutboxMessage{
+		Topic:   outboxMessage.Topic,
+		Payload: outboxMessage.Payload,
+	}
+	result := o.database.Create(&createdOutboxMessage)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, o.logger).With(zap.String("topic", outboxMessage.Topic))
+		logger.Error("error creating outbox message", zap.Error(result.Error))
+		return OutboxMessage{}, result.Error
+	}
+
+	return createdOutboxMessage, nil
+}
+
+// GetPendingOutboxMessageList implements OutboxMessageDataAccessor.
+func (o *outboxMessageDataAccessor) GetPendingOutboxMessageList(ctx context.Context, limit uint64) ([]OutboxMessage, error) {
+	var outboxMessages []OutboxMessage
+	result := o.database.
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("sent_at IS NULL").
+		Order("id").
+		Limit(int(limit)).
+		Find(&outboxMessages)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, o.logger)
+		logger.Error("error getting pending outbox message list", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return outboxMessages, nil
+}
+
+// MarkOutboxMessageSent implements OutboxMessageDataAccessor.
+func (o *outboxMessageDataAccessor) MarkOutboxMessageSent(ctx context.Context, id uint64) error {
+	result := o.database.Model(&OutboxMessage{}).
+		Where("id = ?", id).
+		Update("sent_at", gorm.Expr("NOW()"))
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, o.logger).With(zap.Uint64("outbox_message_id", id))
+		logger.Error("error marking outbox message sent", zap.Error(result.Error))
+		return result.Error
+	}
+
+	return nil
+}
+
+// DeleteSentOutboxMessageList implements OutboxMessageDataAccessor.
+func (o *outboxMessageDataAccessor) DeleteSentOutboxMessageList(ctx context.Context, retention time.Duration) error {
+	result := o.database.Where("sent_at < NOW() - INTERVAL ? SECOND", int64(retention.Seconds())).Delete(&OutboxMessage{})
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, o.logger).With(zap.Duration("retention", retention))
+		logger.Error("error deleting sent outbox message list", zap.Error(result.Error))
+		return result.Error
+	}
+
+	return nil
+}
+
+// WithDatabaseTransaction implements OutboxMessageDataAccessor.
+func (o *outboxMessageDataAccessor) WithDatabaseTransaction(database Database) OutboxMessageDataAccessor {
+	return &outboxMessageDataAccessor{
+		database: database,
+		logger:   o.logger,
+	}
+}
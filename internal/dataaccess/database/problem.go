@@ -15,12 +15,19 @@ var (
 )
 
 type Problem struct {
-	ID          uint64 `gorm:"column:id;primaryKey"`
-	DisplayName string `gorm:"column:display_name"`
-	AuthorID    uint64 `gorm:"column:author_id"`
-	Description string `gorm:"column:description"`
-	TimeLimit   uint64 `gorm:"column:time_limit"`
-	MemoryLimit uint64 `gorm:"column:memory_limit"`
+	ID                 uint64  `gorm:"column:id;primaryKey"`
+	DisplayName        string  `gorm:"column:display_name"`
+	AuthorID           uint64  `gorm:"column:author_id"`
+	Description        string  `gorm:"column:description"`
+	TimeLimit          uint64  `gorm:"column:time_limit"`
+	MemoryLimit        uint64  `gorm:"column:memory_limit"`
+	CheckerLanguage    string  `gorm:"column:checker_language"`
+	CheckerSource      string  `gorm:"column:checker_source"`
+	Version            uint64  `gorm:"column:version"` // incremented on every update of the problem
+	ComparisonMode     int8    `gorm:"column:comparison_mode"`
+	FloatEpsilon       float64 `gorm:"column:float_epsilon"`
+	InteractorLanguage string  `gorm:"column:interactor_language"`
+	InteractorSource   string  `gorm:"column:interactor_source"` // empty if the problem is not interactive
 }
 
 type ProblemDataAccessor interface {
@@ -30,6 +37,10 @@ type ProblemDataAccessor interface {
 	GetProblemList(ctx context.Context, offset uint64, limit uint64) ([]Problem, error)
 	GetProblemCount(ctx context.Context) (uint64, error)
 	UpdateProblem(ctx context.Context, id uint64, problem Problem) (Problem, error)
+	// UpdateProblemChecker replaces the checker of the problem, an empty source removes it.
+	UpdateProblemChecker(ctx context.Context, id uint64, checkerLanguage, checkerSource string) (Problem, error)
+	// UpdateProblemInteractor replaces the interactor of the problem, an empty source removes it.
+	UpdateProblemInteractor(ctx context.Context, id uint64, interactorLanguage, interactorSource string) (Problem, error)
 	DeleteProblem(ctx context.Context, id uint64) error
 	WithDatabaseTransaction(database Database) ProblemDataAccessor
 }
@@ -85,11 +96,14 @@ func (p *problemDataAccessor) GetProblemList(ctx context.Context, offset uint64,
 // CreateProblem implements ProblemDataAccessor.
 func (p *problemDataAccessor) CreateProblem(ctx context.Context, problem Problem) (Problem, error) {
 	createdProblem := Problem{
-		DisplayName: problem.DisplayName,
-		AuthorID:    problem.AuthorID,
-		Description: problem.Description,
-		TimeLimit:   problem.TimeLimit,
-		MemoryLimit: problem.MemoryLimit,
+		DisplayName:    problem.DisplayName,
+		AuthorID:       problem.AuthorID,
+		Description:    problem.Description,
+		TimeLimit:      problem.TimeLimit,
+		MemoryLimit:    problem.MemoryLimit,
+		Version:        1,
+		ComparisonMode: problem.ComparisonMode,
+		FloatEpsilon:   problem.FloatEpsilon,
 	}
 	result := p.database.Create(&createdProblem)
 	if result.Error != nil {
@@ -165,6 +179,13 @@ func (p *problemDataAccessor) UpdateProblem(ctx context.Context, id uint64, newP
 	if newProblem.MemoryLimit != 0 {
 		foundProblem.MemoryLimit = newProblem.MemoryLimit
 	}
+	if newProblem.ComparisonMode != 0 {
+		foundProblem.ComparisonMode = newProblem.ComparisonMode
+	}
+	if newProblem.FloatEpsilon != 0 {
+		foundProblem.FloatEpsilon = newProblem.FloatEpsilon
+	}
+	foundProblem.Version++
 
 	result = p.database.Save(&foundProblem)
 	if result.Error != nil {
@@ -176,6 +197,50 @@ func (p *problemDataAccessor) UpdateProblem(ctx context.Context, id uint64, newP
 	return foundProblem, nil
 }
 
+// UpdateProblemChecker implements ProblemDataAccessor.
+func (p *problemDataAccessor) UpdateProblemChecker(ctx context.Context, id uint64, checkerLanguage, checkerSource string) (Problem, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger).With(zap.Uint64("problem_id", id))
+
+	foundProblem, err := p.GetProblemByID(ctx, id)
+	if err != nil {
+		return Problem{}, err
+	}
+
+	foundProblem.CheckerLanguage = checkerLanguage
+	foundProblem.CheckerSource = checkerSource
+	foundProblem.Version++
+
+	result := p.database.Save(&foundProblem)
+	if result.Error != nil {
+		logger.Error("error updating problem checker", zap.Error(result.Error))
+		return Problem{}, result.Error
+	}
+
+	return foundProblem, nil
+}
+
+// UpdateProblemInteractor implements ProblemDataAccessor.
+func (p *problemDataAccessor) UpdateProblemInteractor(ctx context.Context, id uint64, interactorLanguage, interactorSource string) (Problem, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger).With(zap.Uint64("problem_id", id))
+
+	foundProblem, err := p.GetProblemByID(ctx, id)
+	if err != nil {
+		return Problem{}, err
+	}
+
+	foundProblem.InteractorLanguage = interactorLanguage
+	foundProblem.InteractorSource = interactorSource
+	foundProblem.Version++
+
+	result := p.database.Save(&foundProblem)
+	if result.Error != nil {
+		logger.Error("error updating problem interactor", zap.Error(result.Error))
+		return Problem{}, result.Error
+	}
+
+	return foundProblem, nil
+}
+
 // DeleteProblem implements ProblemDataAccessor.
 func (p *problemDataAccessor) DeleteProblem(ctx context.Context, id uint64) error {
 	var foundProblem Problem
@@ -0,0 +1,86 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maxuanquang/ojs/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrRejudgeNotFound = errors.New("rejudge not found")
+)
+
+// Rejudge is a request to judge again a set of already judged submissions, whose previous results are kept
+// in the submission judge history.
+type Rejudge struct {
+	ID        uint64    `gorm:"column:id;primaryKey"`
+	AuthorID  uint64    `gorm:"column:author_id"`
+	CreatedAt time.Time `gorm:"column:created_at;->"`
+}
+
+func (Rejudge) TableName() string {
+	return "rejudge"
+}
+
+type RejudgeDataAccessor interface {
+	CreateRejudge(ctx context.Context, rejudge Rejudge) (Rejudge, error)
+	GetRejudgeByID(ctx context.Context, id uint64) (Rejudge, error)
+	WithDatabaseTransaction(database Database) RejudgeDataAccessor
+}
+
+func NewRejudgeDataAccessor(database Database, logger *zap.Logger) RejudgeDataAccessor {
+	return &rejudgeDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type rejudgeDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreateRejudge implements RejudgeDataAccessor.
+func (r *rejudgeDataAccessor) CreateRejudge(ctx context.Context, rejudge Rejudge) (Rejudge, error) {
+	createdRejudge := Rejudge{
+		AuthorID: rejudge.AuthorID,
+	}
+	result := r.database.Create(&createdRejudge)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, r.logger).With(zap.Uint64("author_id", rejudge.AuthorID))
+		logger.Error("error creating rejudge", zap.Error(result.Error))
+		return Rejudge{}, result.Error
+	}
+
+	// The creation time is set by the database
+	return r.GetRejudgeByID(ctx, createdRejudge.ID)
+}
+
+// GetRejudgeByID implements RejudgeDataAccessor.
+func (r *rejudgeDataAccessor) GetRejudgeByID(ctx context.Context, id uint64) (Rejudge, error) {
+	var foundRejudge Rejudge
+	result := r.database.First(&foundRejudge, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return Rejudge{}, ErrRejudgeNotFound
+		}
+
+		logger := utils.LoggerWithContext(ctx, r.logger).With(zap.Uint64("rejudge_id", id))
+		logger.Error("error getting rejudge", zap.Error(result.Error))
+		return Rejudge{}, result.Error
+	}
+
+	return foundRejudge, nil
+}
+
+// WithDatabaseTransaction implements RejudgeDataAccessor.
+func (r *rejudgeDataAccessor) WithDatabaseTransaction(database Database) RejudgeDataAccessor {
+	return &rejudgeDataAccessor{
+		database: database,
+		logger:   r.logger,
+	}
+}
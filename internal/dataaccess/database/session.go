@@ -0,0 +1,186 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maxuanquang/ojs/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrSessionNotFound            = errors.New("session not found")
+	ErrSessionRefreshTokenInvalid = errors.New("session refresh token invalid")
+)
+
+// Session is a login of an account on a device. It keeps the hash of its current refresh token, and the ID of the
+// only access token that was issued with it and is still valid.
+type Session struct {
+	ID                   uint64     `gorm:"column:id;primaryKey"`
+	OfAccountID          uint64     `gorm:"column:of_account_id"`
+	RefreshTokenHash     string     `gorm:"column:refresh_token_hash"`
+	AccessTokenID        string     `gorm:"column:access_token_id"`
+	AccessTokenExpiresAt time.Time  `gorm:"column:access_token_expires_at"`
+	ExpiresAt            time.Time  `gorm:"column:expires_at"`
+	RevokedAt            *time.Time `gorm:"column:revoked_at"`
+	CreatedAt            time.Time  `gorm:"column:created_at;->"`
+}
+
+func (Session) TableName() string {
+	return "session"
+}
+
+type SessionDataAccessor interface {
+	CreateSession(ctx context.Context, session Session) (Session, error)
+	GetSessionByID(ctx context.Context, id uint64) (Session, error)
+	// GetAccountActiveSessionList returns the sessions of the account that are neither revoked nor expired, locking
+	// them until the end of the transaction so that their tokens are not rotated meanwhile.
+	GetAccountActiveSessionList(ctx context.Context, accountID uint64) ([]Session, error)
+	// RotateSessionTokens replaces the refresh token and access token of an active session, only if its refresh token
+	// is still the given one. ErrSessionRefreshTokenInvalid is returned otherwise.
+	RotateSessionTokens(
+		ctx context.Context,
+		id uint64,
+		fromRefreshTokenHash string,
+		refreshTokenHash string,
+		accessTokenID string,
+		accessTokenExpiresAt time.Time,
+	) error
+	// RevokeSession revokes the session, revoking an already revoked session does nothing.
+	RevokeSession(ctx context.Context, id uint64) error
+	RevokeAccountSessionList(ctx context.Context, accountID uint64) error
+	WithDatabaseTransaction(database Database) SessionDataAccessor
+}
+
+func NewSessionDataAccessor(database Database, logger *zap.Logger) SessionDataAccessor {
+	return &sessionDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type sessionDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreateSession implements SessionDataAccessor.
+func (s *sessionDataAccessor) CreateSession(ctx context.Context, session Session) (Session, error) {
+	createdSession := Session{
+		OfAccountID:          session.OfAccountID,
+		RefreshTokenHash:     session.RefreshTokenHash,
+		AccessTokenID:        session.AccessTokenID,
+		AccessTokenExpiresAt: session.AccessTokenExpiresAt,
+		ExpiresAt:            session.ExpiresAt,
+	}
+	result := s.database.Create(&createdSession)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("account_id", session.OfAccountID))
+		logger.Error("error creating session", zap.Error(result.Error))
+		return Session{}, result.Error
+	}
+
+	return createdSession, nil
+}
+
+// GetSessionByID implements SessionDataAccessor.
+func (s *sessionDataAccessor) GetSessionByID(ctx context.Context, id uint64) (Session, error) {
+	var foundSession Session
+	result := s.database.First(&foundSession, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return Session{}, ErrSessionNotFound
+		}
+
+		logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("session_id", id))
+		logger.Error("error getting session", zap.Error(result.Error))
+		return Session{}, result.Error
+	}
+
+	return foundSession, nil
+}
+
+// GetAccountActiveSessionList implements SessionDataAccessor.
+func (s *sessionDataAccessor) GetAccountActiveSessionList(ctx context.Context, accountID uint64) ([]Session, error) {
+	var sessionList []Session
+	result := s.database.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("of_account_id = ? AND revoked_at IS NULL AND expires_at > ?", accountID, time.Now()).
+		Order("id ASC").
+		Find(&sessionList)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("account_id", accountID))
+		logger.Error("error getting account active session list", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return sessionList, nil
+}
+
+// RotateSessionTokens implements SessionDataAccessor.
+func (s *sessionDataAccessor) RotateSessionTokens(
+	ctx context.Context,
+	id uint64,
+	fromRefreshTokenHash string,
+	refreshTokenHash string,
+	accessTokenID string,
+	accessTokenExpiresAt time.Time,
+) error {
+	result := s.database.Model(&Session{}).
+		Where("id = ? AND refresh_token_hash = ? AND revoked_at IS NULL AND expires_at > ?", id, fromRefreshTokenHash, time.Now()).
+		Updates(map[string]interface{}{
+			"refresh_token_hash":      refreshTokenHash,
+			"access_token_id":         accessTokenID,
+			"access_token_expires_at": accessTokenExpiresAt,
+		})
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("session_id", id))
+		logger.Error("error rotating session tokens", zap.Error(result.Error))
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrSessionRefreshTokenInvalid
+	}
+
+	return nil
+}
+
+// RevokeSession implements SessionDataAccessor.
+func (s *sessionDataAccessor) RevokeSession(ctx context.Context, id uint64) error {
+	result := s.database.Model(&Session{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("session_id", id))
+		logger.Error("error revoking session", zap.Error(result.Error))
+		return result.Error
+	}
+
+	return nil
+}
+
+// RevokeAccountSessionList implements SessionDataAccessor.
+func (s *sessionDataAccessor) RevokeAccountSessionList(ctx context.Context, accountID uint64) error {
+	result := s.database.Model(&Session{}).
+		Where("of_account_id = ? AND revoked_at IS NULL", accountID).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("account_id", accountID))
+		logger.Error("error revoking account session list", zap.Error(result.Error))
+		return result.Error
+	}
+
+	return nil
+}
+
+// WithDatabaseTransaction implements SessionDataAccessor.
+func (s *sessionDataAccessor) WithDatabaseTransaction(database Database) SessionDataAccessor {
+	return &sessionDataAccessor{
+		database: database,
+		logger:   s.logger,
+	}
+}
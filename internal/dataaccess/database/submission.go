@@ -3,24 +3,41 @@ package database
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/maxuanquang/ojs/internal/utils"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var (
-	ErrSubmissionNotFound = errors.New("submission not found")
+	ErrSubmissionNotFound                = errors.New("submission not found")
+	ErrSubmissionJudgingLeaseNotAcquired = errors.New("submission judging lease not acquired")
+	ErrSubmissionJudgingLeaseNotHeld     = errors.New("submission judging lease not held")
+	ErrSubmissionJudgingLeaseNotExpired  = errors.New("submission judging lease not expired")
+	ErrSubmissionStatusNotMatched        = errors.New("submission status not matched")
 )
 
 type Submission struct {
-	ID          uint64 `gorm:"column:id;primaryKey"`
-	OfProblemID uint64 `gorm:"column:of_problem_id"`
-	AuthorID    uint64 `gorm:"column:author_id"`
-	Content     string `gorm:"column:content"`
-	Language    string `gorm:"column:language"`
-	Status      int8   `gorm:"column:status"`
-	Result      int8   `gorm:"column:result"`
+	ID            uint64    `gorm:"column:id;primaryKey"`
+	OfProblemID   uint64    `gorm:"column:of_problem_id"`
+	AuthorID      uint64    `gorm:"column:author_id"`
+	Content       string    `gorm:"column:content"`
+	Language      string    `gorm:"column:language"`
+	Status        int8      `gorm:"column:status"`
+	Result        int8      `gorm:"column:result"`
+	CompileOutput string    `gorm:"column:compile_output"`
+	Score         uint64    `gorm:"column:score"`
+	OfContestID   uint64    `gorm:"column:of_contest_id"`
+	CreatedAt     time.Time `gorm:"column:created_at"`
+	// JudgingQueue is the priority lane the submission was last submitted to, it is submitted again to the same one
+	JudgingQueue string `gorm:"column:judging_queue"`
+
+	// A worker judging the submission holds a lease on it, kept alive by heartbeats
+	JudgedByWorker     string     `gorm:"column:judged_by_worker"`
+	JudgingStartedAt   *time.Time `gorm:"column:judging_started_at"`
+	JudgingHeartbeatAt *time.Time `gorm:"column:judging_heartbeat_at"`
 }
 
 type SubmissionDataAccessor interface {
@@ -32,8 +49,29 @@ type SubmissionDataAccessor interface {
 	GetProblemSubmissionCount(ctx context.Context, problemID uint64) (uint64, error)
 	GetAccountProblemSubmissionList(ctx context.Context, accountID, problemID, offset, limit uint64) ([]Submission, error)
 	GetAccountProblemSubmissionCount(ctx context.Context, accountID, problemID uint64) (uint64, error)
+	GetContestSubmissionListByStatus(ctx context.Context, contestID uint64, status int8) ([]Submission, error)
+	GetProblemSubmissionListByStatus(ctx context.Context, problemID uint64, status int8) ([]Submission, error)
+	GetSubmissionListByIDList(ctx context.Context, ids []uint64) ([]Submission, error)
+	// GetFirstSubmissionByStatusForUpdate locks the oldest submission with the status of the highest priority judging
+	// queue until the end of the transaction, skipping the ones already locked by other transactions. Judging queues
+	// go from the highest priority to the lowest, the submissions of other queues coming last.
+	GetFirstSubmissionByStatusForUpdate(ctx context.Context, status int8, judgingQueues []string) (Submission, error)
 	UpdateSubmission(ctx context.Context, submission Submission) (Submission, error)
 	DeleteSubmission(ctx context.Context, id uint64) error
+	// ResetSubmissionResult clears the result, compile output and score of the submission, and moves it from one
+	// status to another.
+	ResetSubmissionResult(ctx context.Context, id uint64, fromStatus, toStatus int8) error
+	UpdateSubmissionJudgingQueue(ctx context.Context, id uint64, judgingQueue string) error
+	// AcquireSubmissionJudgingLease moves the submission from one status to another, and gives the worker a lease on it.
+	AcquireSubmissionJudgingLease(ctx context.Context, id uint64, fromStatus, toStatus int8, worker string) error
+	RenewSubmissionJudgingLease(ctx context.Context, id uint64, worker string) error
+	ReleaseSubmissionJudgingLease(ctx context.Context, id uint64, worker string) error
+	// GetExpiredJudgingLeaseSubmissionList returns submissions whose lease has not been renewed for leaseDuration,
+	// as measured by the database's clock.
+	GetExpiredJudgingLeaseSubmissionList(ctx context.Context, leaseDuration time.Duration, limit uint64) ([]Submission, error)
+	// ResetExpiredSubmissionJudgingLease takes the lease away from its worker and moves the submission to the status,
+	// unless the lease has been renewed since it expired.
+	ResetExpiredSubmissionJudgingLease(ctx context.Context, id uint64, leaseDuration time.Duration, status int8) error
 	WithDatabaseTransaction(database Database) SubmissionDataAccessor
 }
 
@@ -52,12 +90,14 @@ type submissionDataAccessor struct {
 // CreateSubmission implements SubmissionDataAccessor.
 func (s *submissionDataAccessor) CreateSubmission(ctx context.Context, submission Submission) (Submission, error) {
 	createdSubmission := Submission{
-		OfProblemID: submission.OfProblemID,
-		AuthorID:    submission.AuthorID,
-		Content:     submission.Content,
-		Language:    submission.Language,
-		Status:      submission.Status,
-		Result:      submission.Result,
+		OfProblemID:  submission.OfProblemID,
+		AuthorID:     submission.AuthorID,
+		Content:      submission.Content,
+		Language:     submission.Language,
+		Status:       submission.Status,
+		Result:       submission.Result,
+		OfContestID:  submission.OfContestID,
+		JudgingQueue: submission.JudgingQueue,
 	}
 	result := s.database.Create(&createdSubmission)
 	if result.Error != nil {
@@ -149,6 +189,90 @@ func (s *submissionDataAccessor) GetAccountProblemSubmissionCount(ctx context.Co
 	return uint64(count), nil
 }
 
+// GetContestSubmissionListByStatus implements SubmissionDataAccessor.
+func (s *submissionDataAccessor) GetContestSubmissionListByStatus(ctx context.Context, contestID uint64, status int8) ([]Submission, error) {
+	var submissions []Submission
+	result := s.database.Where("of_contest_id = ? AND status = ?", contestID, status).Order("id").Find(&submissions)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("contest_id", contestID), zap.Int8("status", status))
+		logger.Error("error getting submission list for contest", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return submissions, nil
+}
+
+// GetProblemSubmissionListByStatus implements SubmissionDataAccessor.
+func (s *submissionDataAccessor) GetProblemSubmissionListByStatus(ctx context.Context, problemID uint64, status int8) ([]Submission, error) {
+	var submissions []Submission
+	result := s.database.Where("of_problem_id = ? AND status = ?", problemID, status).Order("id").Find(&submissions)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("problem_id", problemID), zap.Int8("status", status))
+		logger.Error("error getting submission list for problem", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return submissions, nil
+}
+
+// GetSubmissionListByIDList implements SubmissionDataAccessor.
+func (s *submissionDataAccessor) GetSubmissionListByIDList(ctx context.Context, ids []uint64) ([]Submission, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var submissions []Submission
+	result := s.database.Where("id IN ?", ids).Order("id").Find(&submissions)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Int("submission_count", len(ids)))
+		logger.Error("error getting submission list by id list", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return submissions, nil
+}
+
+// GetFirstSubmissionByStatusForUpdate implements SubmissionDataAccessor.
+func (s *submissionDataAccessor) GetFirstSubmissionByStatusForUpdate(
+	ctx context.Context,
+	status int8,
+	judgingQueues []string,
+) (Submission, error) {
+	// FIELD gives 0 to the queues not listed, the queues are therefore listed from the lowest priority to the highest
+	// and sorted in descending order
+	reversedJudgingQueues := make([]any, 0, len(judgingQueues))
+	for i := len(judgingQueues) - 1; i >= 0; i-- {
+		reversedJudgingQueues = append(reversedJudgingQueues, judgingQueues[i])
+	}
+
+	query := s.database.
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("status = ?", status)
+	if len(reversedJudgingQueues) > 0 {
+		query = query.Clauses(clause.OrderBy{Expression: clause.Expr{
+			SQL:                "FIELD(judging_queue, ?) DESC, id",
+			Vars:               []any{reversedJudgingQueues},
+			WithoutParentheses: true,
+		}})
+	} else {
+		query = query.Order("id")
+	}
+
+	var foundSubmission Submission
+	result := query.Take(&foundSubmission)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return Submission{}, ErrSubmissionNotFound
+		}
+
+		logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Int8("status", status))
+		logger.Error("error getting first submission by status", zap.Error(result.Error))
+		return Submission{}, result.Error
+	}
+
+	return foundSubmission, nil
+}
+
 // UpdateSubmission implements SubmissionDataAccessor.
 func (s *submissionDataAccessor) UpdateSubmission(ctx context.Context, submission Submission) (Submission, error) {
 	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Any("submission", submission))
@@ -159,13 +283,18 @@ func (s *submissionDataAccessor) UpdateSubmission(ctx context.Context, submissio
 		return Submission{}, err
 	}
 
-	// just update status and result
+	// just update status, result, compile output and score
 	if submission.Status != 0 {
 		foundSubmission.Status = submission.Status
 	}
 	if submission.Result != 0 {
 		foundSubmission.Result = submission.Result
 	}
+	if submission.CompileOutput != "" {
+		foundSubmission.CompileOutput = submission.CompileOutput
+	}
+	// The score is always overwritten, since a rejudged submission may lose points
+	foundSubmission.Score = submission.Score
 
 	result := s.database.Save(&foundSubmission)
 	if result.Error != nil {
@@ -188,6 +317,159 @@ func (s *submissionDataAccessor) DeleteSubmission(ctx context.Context, id uint64
 	return nil
 }
 
+// ResetSubmissionResult implements SubmissionDataAccessor.
+func (s *submissionDataAccessor) ResetSubmissionResult(ctx context.Context, id uint64, fromStatus, toStatus int8) error {
+	result := s.database.Model(&Submission{}).
+		Where("id = ? AND status = ?", id, fromStatus).
+		Updates(map[string]interface{}{
+			"status":         toStatus,
+			"result":         0,
+			"compile_output": "",
+			"score":          0,
+		})
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("submission_id", id))
+		logger.Error("error resetting submission result", zap.Error(result.Error))
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrSubmissionStatusNotMatched
+	}
+
+	return nil
+}
+
+// UpdateSubmissionJudgingQueue implements SubmissionDataAccessor.
+func (s *submissionDataAccessor) UpdateSubmissionJudgingQueue(ctx context.Context, id uint64, judgingQueue string) error {
+	result := s.database.Model(&Submission{ID: id}).Update("judging_queue", judgingQueue)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("submission_id", id))
+		logger.Error("error updating submission judging queue", zap.Error(result.Error))
+		return result.Error
+	}
+
+	return nil
+}
+
+// AcquireSubmissionJudgingLease implements SubmissionDataAccessor.
+func (s *submissionDataAccessor) AcquireSubmissionJudgingLease(
+	ctx context.Context,
+	id uint64,
+	fromStatus int8,
+	toStatus int8,
+	worker string,
+) error {
+	result := s.database.Model(&Submission{}).
+		Where("id = ? AND status = ?", id, fromStatus).
+		Updates(map[string]interface{}{
+			"status":               toStatus,
+			"judged_by_worker":     worker,
+			"judging_started_at":   gorm.Expr("NOW()"),
+			"judging_heartbeat_at": gorm.Expr("NOW()"),
+		})
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("submission_id", id)).With(zap.String("worker", worker))
+		logger.Error("error acquiring submission judging lease", zap.Error(result.Error))
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrSubmissionJudgingLeaseNotAcquired
+	}
+
+	return nil
+}
+
+// RenewSubmissionJudgingLease implements SubmissionDataAccessor.
+func (s *submissionDataAccessor) RenewSubmissionJudgingLease(ctx context.Context, id uint64, worker string) error {
+	result := s.database.Model(&Submission{}).
+		Where("id = ? AND judged_by_worker = ?", id, worker).
+		Update("judging_heartbeat_at", gorm.Expr("NOW()"))
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("submission_id", id)).With(zap.String("worker", worker))
+		logger.Error("error renewing submission judging lease", zap.Error(result.Error))
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrSubmissionJudgingLeaseNotHeld
+	}
+
+	return nil
+}
+
+// ReleaseSubmissionJudgingLease implements SubmissionDataAccessor.
+func (s *submissionDataAccessor) ReleaseSubmissionJudgingLease(ctx context.Context, id uint64, worker string) error {
+	result := s.database.Model(&Submission{}).
+		Where("id = ? AND judged_by_worker = ?", id, worker).
+		Updates(map[string]interface{}{
+			"judged_by_worker":     "",
+			"judging_started_at":   nil,
+			"judging_heartbeat_at": nil,
+		})
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("submission_id", id)).With(zap.String("worker", worker))
+		logger.Error("error releasing submission judging lease", zap.Error(result.Error))
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrSubmissionJudgingLeaseNotHeld
+	}
+
+	return nil
+}
+
+// GetExpiredJudgingLeaseSubmissionList implements SubmissionDataAccessor.
+func (s *submissionDataAccessor) GetExpiredJudgingLeaseSubmissionList(
+	ctx context.Context,
+	leaseDuration time.Duration,
+	limit uint64,
+) ([]Submission, error) {
+	var submissions []Submission
+	result := s.database.
+		Where("judging_heartbeat_at < NOW() - INTERVAL ? SECOND", int64(leaseDuration.Seconds())).
+		Order("judging_heartbeat_at").
+		Limit(int(limit)).
+		Find(&submissions)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Duration("lease_duration", leaseDuration))
+		logger.Error("error getting submission list with expired judging lease", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return submissions, nil
+}
+
+// ResetExpiredSubmissionJudgingLease implements SubmissionDataAccessor.
+func (s *submissionDataAccessor) ResetExpiredSubmissionJudgingLease(
+	ctx context.Context,
+	id uint64,
+	leaseDuration time.Duration,
+	status int8,
+) error {
+	result := s.database.Model(&Submission{}).
+		Where("id = ? AND judging_heartbeat_at < NOW() - INTERVAL ? SECOND", id, int64(leaseDuration.Seconds())).
+		Updates(map[string]interface{}{
+			"status":               status,
+			"judged_by_worker":     "",
+			"judging_started_at":   nil,
+			"judging_heartbeat_at": nil,
+		})
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("submission_id", id))
+		logger.Error("error resetting submission judging lease", zap.Error(result.Error))
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrSubmissionJudgingLeaseNotExpired
+	}
+
+	return nil
+}
+
 // WithDatabaseTransaction implements SubmissionDataAccessor.
 func (s *submissionDataAccessor) WithDatabaseTransaction(database Database) SubmissionDataAccessor {
 	return &submissionDataAccessor{
@@ -0,0 +1,84 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/maxuanquang/ojs/internal/utils"
+	"go.uber.org/zap"
+)
+
+// SubmissionJudgeHistory is the result a submission had before it was rejudged.
+type SubmissionJudgeHistory struct {
+	ID             uint64    `gorm:"column:id;primaryKey"`
+	OfRejudgeID    uint64    `gorm:"column:of_rejudge_id"`
+	OfSubmissionID uint64    `gorm:"column:of_submission_id"`
+	Result         int8      `gorm:"column:result"`
+	Score          uint64    `gorm:"column:score"`
+	CreatedAt      time.Time `gorm:"column:created_at;->"`
+}
+
+func (SubmissionJudgeHistory) TableName() string {
+	return "submission_judge_history"
+}
+
+type SubmissionJudgeHistoryDataAccessor interface {
+	CreateSubmissionJudgeHistoryList(ctx context.Context, submissionJudgeHistories []SubmissionJudgeHistory) error
+	GetRejudgeSubmissionJudgeHistoryList(ctx context.Context, rejudgeID uint64) ([]SubmissionJudgeHistory, error)
+	WithDatabaseTransaction(database Database) SubmissionJudgeHistoryDataAccessor
+}
+
+func NewSubmissionJudgeHistoryDataAccessor(database Database, logger *zap.Logger) SubmissionJudgeHistoryDataAccessor {
+	return &submissionJudgeHistoryDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type submissionJudgeHistoryDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreateSubmissionJudgeHistoryList implements SubmissionJudgeHistoryDataAccessor.
+func (s *submissionJudgeHistoryDataAccessor) CreateSubmissionJudgeHistoryList(
+	ctx context.Context,
+	submissionJudgeHistories []SubmissionJudgeHistory,
+) error {
+	if len(submissionJudgeHistories) == 0 {
+		return nil
+	}
+
+	result := s.database.Create(&submissionJudgeHistories)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("rejudge_id", submissionJudgeHistories[0].OfRejudgeID))
+		logger.Error("error creating submission judge history list", zap.Error(result.Error))
+		return result.Error
+	}
+
+	return nil
+}
+
+// GetRejudgeSubmissionJudgeHistoryList implements SubmissionJudgeHistoryDataAccessor.
+func (s *submissionJudgeHistoryDataAccessor) GetRejudgeSubmissionJudgeHistoryList(
+	ctx context.Context,
+	rejudgeID uint64,
+) ([]SubmissionJudgeHistory, error) {
+	var submissionJudgeHistories []SubmissionJudgeHistory
+	result := s.database.Where("of_rejudge_id = ?", rejudgeID).Order("of_submission_id").Find(&submissionJudgeHistories)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("rejudge_id", rejudgeID))
+		logger.Error("error getting submission judge history list of rejudge", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return submissionJudgeHistories, nil
+}
+
+// WithDatabaseTransaction implements SubmissionJudgeHistoryDataAccessor.
+func (s *submissionJudgeHistoryDataAccessor) WithDatabaseTransaction(database Database) SubmissionJudgeHistoryDataAccessor {
+	return &submissionJudgeHistoryDataAccessor{
+		database: database,
+		logger:   s.logger,
+	}
+}
@@ -0,0 +1,101 @@
+package database
+
+import (
+	"context"
+
+	"github.com/maxuanquang/ojs/internal/utils"
+	"go.uber.org/zap"
+)
+
+type SubmissionTestCaseResult struct {
+	ID             uint64 `gorm:"column:id;primaryKey"`
+	OfSubmissionID uint64 `gorm:"column:of_submission_id"`
+	OfTestCaseID   uint64 `gorm:"column:of_test_case_id"`
+	Result         int8   `gorm:"column:result"`
+	WallTime       uint64 `gorm:"column:wall_time"`
+	CPUTime        uint64 `gorm:"column:cpu_time"`
+	Memory         uint64 `gorm:"column:memory"`
+	ExitCode       int32  `gorm:"column:exit_code"`
+	Stdout         string `gorm:"column:stdout"`
+	Stderr         string `gorm:"column:stderr"`
+}
+
+type SubmissionTestCaseResultDataAccessor interface {
+	CreateSubmissionTestCaseResult(ctx context.Context, submissionTestCaseResult SubmissionTestCaseResult) (SubmissionTestCaseResult, error)
+	GetSubmissionTestCaseResultList(ctx context.Context, submissionID uint64) ([]SubmissionTestCaseResult, error)
+	DeleteSubmissionTestCaseResultList(ctx context.Context, submissionID uint64) error
+	WithDatabaseTransaction(database Database) SubmissionTestCaseResultDataAccessor
+}
+
+func NewSubmissionTestCaseResultDataAccessor(database Database, logger *zap.Logger) SubmissionTestCaseResultDataAccessor {
+	return &submissionTestCaseResultDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type submissionTestCaseResultDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreateSubmissionTestCaseResult implements SubmissionTestCaseResultDataAccessor.
+func (s *submissionTestCaseResultDataAccessor) CreateSubmissionTestCaseResult(
+	ctx context.Context,
+	submissionTestCaseResult SubmissionTestCaseResult,
+) (SubmissionTestCaseResult, error) {
+	createdSubmissionTestCaseResult := SubmissionTestCaseResult{
+		OfSubmissionID: submissionTestCaseResult.OfSubmissionID,
+		OfTestCaseID:   submissionTestCaseResult.OfTestCaseID,
+		Result:         submissionTestCaseResult.Result,
+		WallTime:       submissionTestCaseResult.WallTime,
+		CPUTime:        submissionTestCaseResult.CPUTime,
+		Memory:         submissionTestCaseResult.Memory,
+		ExitCode:       submissionTestCaseResult.ExitCode,
+		Stdout:         submissionTestCaseResult.Stdout,
+		Stderr:         submissionTestCaseResult.Stderr,
+	}
+	result := s.database.Create(&createdSubmissionTestCaseResult)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, s.logger).
+			With(zap.Uint64("submission_id", submissionTestCaseResult.OfSubmissionID)).
+			With(zap.Uint64("test_case_id", submissionTestCaseResult.OfTestCaseID))
+		logger.Error("error creating submission test case result", zap.Error(result.Error))
+		return SubmissionTestCaseResult{}, result.Error
+	}
+
+	return createdSubmissionTestCaseResult, nil
+}
+
+// GetSubmissionTestCaseResultList implements SubmissionTestCaseResultDataAccessor.
+func (s *submissionTestCaseResultDataAccessor) GetSubmissionTestCaseResultList(ctx context.Context, submissionID uint64) ([]SubmissionTestCaseResult, error) {
+	var submissionTestCaseResults []SubmissionTestCaseResult
+	result := s.database.Where("of_submission_id = ?", submissionID).Order("id").Find(&submissionTestCaseResults)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("submission_id", submissionID))
+		logger.Error("error getting submission test case result list", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return submissionTestCaseResults, nil
+}
+
+// DeleteSubmissionTestCaseResultList implements SubmissionTestCaseResultDataAccessor.
+func (s *submissionTestCaseResultDataAccessor) DeleteSubmissionTestCaseResultList(ctx context.Context, submissionID uint64) error {
+	result := s.database.Where("of_submission_id = ?", submissionID).Delete(&SubmissionTestCaseResult{})
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("submission_id", submissionID))
+		logger.Error("error deleting submission test case result list", zap.Error(result.Error))
+		return result.Error
+	}
+
+	return nil
+}
+
+// WithDatabaseTransaction implements SubmissionTestCaseResultDataAccessor.
+func (s *submissionTestCaseResultDataAccessor) WithDatabaseTransaction(database Database) SubmissionTestCaseResultDataAccessor {
+	return &submissionTestCaseResultDataAccessor{
+		database: database,
+		logger:   s.logger,
+	}
+}
@@ -14,11 +14,12 @@ var (
 )
 
 type TestCase struct {
-	ID          uint64 `gorm:"column:id;primaryKey"`
-	OfProblemID uint64 `gorm:"column:of_problem_id"`
-	Input       string `gorm:"column:input"`
-	Output      string `gorm:"column:output"`
-	IsHidden    bool   `gorm:"column:is_hidden"`
+	ID                uint64 `gorm:"column:id;primaryKey"`
+	OfProblemID       uint64 `gorm:"column:of_problem_id"`
+	Input             string `gorm:"column:input"`
+	Output            string `gorm:"column:output"`
+	IsHidden          bool   `gorm:"column:is_hidden"`
+	OfTestCaseGroupID uint64 `gorm:"column:of_test_case_group_id"` // 0 if the test case is not in any group
 }
 
 type TestCaseDataAccessor interface {
@@ -29,6 +30,8 @@ type TestCaseDataAccessor interface {
 	GetProblemTestCaseListAll(ctx context.Context, problemID uint64) ([]TestCase, error)
 	GetProblemTestCaseCount(ctx context.Context, problemID uint64) (uint64, error)
 	UpdateTestCase(ctx context.Context, testCase TestCase) (TestCase, error)
+	// UngroupTestCaseList removes all test cases from the group, without deleting them.
+	UngroupTestCaseList(ctx context.Context, testCaseGroupID uint64) error
 	WithDatabaseTransaction(database Database) TestCaseDataAccessor
 }
 
@@ -47,10 +50,11 @@ type testCaseDataAccessor struct {
 // CreateTestCase implements TestCaseDataAccessor.
 func (t *testCaseDataAccessor) CreateTestCase(ctx context.Context, testCase TestCase) (TestCase, error) {
 	createdTestCase := TestCase{
-		OfProblemID: testCase.OfProblemID,
-		Input:       testCase.Input,
-		Output:      testCase.Output,
-		IsHidden:    testCase.IsHidden,
+		OfProblemID:       testCase.OfProblemID,
+		Input:             testCase.Input,
+		Output:            testCase.Output,
+		IsHidden:          testCase.IsHidden,
+		OfTestCaseGroupID: testCase.OfTestCaseGroupID,
 	}
 	result := t.database.Create(&createdTestCase)
 	if result.Error != nil {
@@ -125,6 +129,9 @@ func (t *testCaseDataAccessor) UpdateTestCase(ctx context.Context, testCase Test
 	if testCase.IsHidden != existingTestCase.IsHidden {
 		existingTestCase.IsHidden = testCase.IsHidden
 	}
+	if testCase.OfTestCaseGroupID != 0 {
+		existingTestCase.OfTestCaseGroupID = testCase.OfTestCaseGroupID
+	}
 
 	result = t.database.Save(&existingTestCase)
 	if result.Error != nil {
@@ -136,6 +143,20 @@ func (t *testCaseDataAccessor) UpdateTestCase(ctx context.Context, testCase Test
 	return existingTestCase, nil
 }
 
+// UngroupTestCaseList implements TestCaseDataAccessor.
+func (t *testCaseDataAccessor) UngroupTestCaseList(ctx context.Context, testCaseGroupID uint64) error {
+	result := t.database.Model(&TestCase{}).
+		Where("of_test_case_group_id = ?", testCaseGroupID).
+		Update("of_test_case_group_id", 0)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("test_case_group_id", testCaseGroupID))
+		logger.Error("error ungrouping test cases", zap.Error(result.Error))
+		return result.Error
+	}
+
+	return nil
+}
+
 // GetProblemTestCaseList implements TestCaseDataAccessor.
 func (t *testCaseDataAccessor) GetProblemTestCaseList(ctx context.Context, problemID uint64, offset uint64, limit uint64) ([]TestCase, error) {
 	var testCases []TestCase
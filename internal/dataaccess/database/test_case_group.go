@@ -0,0 +1,141 @@
+package database
+
+import (
+	"context"
+	"errors"
+
+	"github.com/maxuanquang/ojs/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrTestCaseGroupNotFound = errors.New("test case group not found")
+)
+
+type TestCaseGroup struct {
+	ID            uint64 `gorm:"column:id;primaryKey"`
+	OfProblemID   uint64 `gorm:"column:of_problem_id"`
+	DisplayName   string `gorm:"column:display_name"`
+	Points        uint64 `gorm:"column:points"`
+	ScoringPolicy int8   `gorm:"column:scoring_policy"`
+}
+
+type TestCaseGroupDataAccessor interface {
+	CreateTestCaseGroup(ctx context.Context, testCaseGroup TestCaseGroup) (TestCaseGroup, error)
+	GetTestCaseGroupByID(ctx context.Context, id uint64) (TestCaseGroup, error)
+	GetProblemTestCaseGroupList(ctx context.Context, problemID uint64) ([]TestCaseGroup, error)
+	UpdateTestCaseGroup(ctx context.Context, testCaseGroup TestCaseGroup) (TestCaseGroup, error)
+	DeleteTestCaseGroup(ctx context.Context, id uint64) error
+	WithDatabaseTransaction(database Database) TestCaseGroupDataAccessor
+}
+
+func NewTestCaseGroupDataAccessor(database Database, logger *zap.Logger) TestCaseGroupDataAccessor {
+	return &testCaseGroupDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+type testCaseGroupDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+// CreateTestCaseGroup implements TestCaseGroupDataAccessor.
+func (t *testCaseGroupDataAccessor) CreateTestCaseGroup(ctx context.Context, testCaseGroup TestCaseGroup) (TestCaseGroup, error) {
+	createdTestCaseGroup := TestCaseGroup{
+		OfProblemID:   testCaseGroup.OfProblemID,
+		DisplayName:   testCaseGroup.DisplayName,
+		Points:        testCaseGroup.Points,
+		ScoringPolicy: testCaseGroup.ScoringPolicy,
+	}
+	result := t.database.Create(&createdTestCaseGroup)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("problem_id", testCaseGroup.OfProblemID))
+		logger.Error("error creating test case group", zap.Error(result.Error))
+		return TestCaseGroup{}, result.Error
+	}
+
+	return createdTestCaseGroup, nil
+}
+
+// GetTestCaseGroupByID implements TestCaseGroupDataAccessor.
+func (t *testCaseGroupDataAccessor) GetTestCaseGroupByID(ctx context.Context, id uint64) (TestCaseGroup, error) {
+	var foundTestCaseGroup TestCaseGroup
+	result := t.database.First(&foundTestCaseGroup, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return TestCaseGroup{}, ErrTestCaseGroupNotFound
+		}
+
+		logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("test_case_group_id", id))
+		logger.Error("error getting test case group", zap.Error(result.Error))
+		return TestCaseGroup{}, result.Error
+	}
+
+	return foundTestCaseGroup, nil
+}
+
+// GetProblemTestCaseGroupList implements TestCaseGroupDataAccessor.
+func (t *testCaseGroupDataAccessor) GetProblemTestCaseGroupList(ctx context.Context, problemID uint64) ([]TestCaseGroup, error) {
+	var testCaseGroups []TestCaseGroup
+	result := t.database.Where("of_problem_id = ?", problemID).Order("id").Find(&testCaseGroups)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("problem_id", problemID))
+		logger.Error("error getting test case groups of problem", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return testCaseGroups, nil
+}
+
+// UpdateTestCaseGroup implements TestCaseGroupDataAccessor.
+func (t *testCaseGroupDataAccessor) UpdateTestCaseGroup(ctx context.Context, testCaseGroup TestCaseGroup) (TestCaseGroup, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("test_case_group_id", testCaseGroup.ID))
+
+	foundTestCaseGroup, err := t.GetTestCaseGroupByID(ctx, testCaseGroup.ID)
+	if err != nil {
+		return TestCaseGroup{}, err
+	}
+
+	if testCaseGroup.DisplayName != "" {
+		foundTestCaseGroup.DisplayName = testCaseGroup.DisplayName
+	}
+	if testCaseGroup.ScoringPolicy != 0 {
+		foundTestCaseGroup.ScoringPolicy = testCaseGroup.ScoringPolicy
+	}
+	foundTestCaseGroup.Points = testCaseGroup.Points
+
+	result := t.database.Save(&foundTestCaseGroup)
+	if result.Error != nil {
+		logger.Error("error updating test case group", zap.Error(result.Error))
+		return TestCaseGroup{}, result.Error
+	}
+
+	return foundTestCaseGroup, nil
+}
+
+// DeleteTestCaseGroup implements TestCaseGroupDataAccessor.
+func (t *testCaseGroupDataAccessor) DeleteTestCaseGroup(ctx context.Context, id uint64) error {
+	result := t.database.Delete(&TestCaseGroup{}, id)
+	if result.Error != nil {
+		logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("test_case_group_id", id))
+		logger.Error("error deleting test case group", zap.Error(result.Error))
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrTestCaseGroupNotFound
+	}
+
+	return nil
+}
+
+// WithDatabaseTransaction implements TestCaseGroupDataAccessor.
+func (t *testCaseGroupDataAccessor) WithDatabaseTransaction(database Database) TestCaseGroupDataAccessor {
+	return &testCaseGroupDataAccessor{
+		database: database,
+		logger:   t.logger,
+	}
+}
@@ -2,19 +2,40 @@ package database
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/maxuanquang/ojs/internal/utils"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
+var (
+	ErrTokenPublicKeyNotFound = errors.New("token public key not found")
+)
+
+// TokenPublicKey is the public key verifying tokens, stored along with the encrypted private key signing them.
+// A retired key does not sign tokens anymore, but still verifies the ones it signed until they expire.
 type TokenPublicKey struct {
-	TokenPublicKeyID    uint64 `gorm:"column:token_public_key_id;primaryKey"`
-	TokenPublicKeyValue []byte `gorm:"column:token_public_key_value"`
+	TokenPublicKeyID              uint64     `gorm:"column:token_public_key_id;primaryKey"`
+	TokenPublicKeyValue           []byte     `gorm:"column:token_public_key_value"`
+	EncryptedTokenPrivateKeyValue []byte     `gorm:"column:encrypted_token_private_key_value"`
+	CreatedAt                     time.Time  `gorm:"column:created_at"`
+	RetiredAt                     *time.Time `gorm:"column:retired_at"`
 }
 
 type TokenPublicKeyDataAccessor interface {
 	CreatePublicKey(ctx context.Context, tokenPublicKey TokenPublicKey) (uint64, error)
 	GetPublicKey(ctx context.Context, tokenPublicKeyID uint64) (TokenPublicKey, error)
+	// GetActivePublicKey returns the newest key that is not retired, ErrTokenPublicKeyNotFound is returned if every
+	// key is retired.
+	GetActivePublicKey(ctx context.Context) (TokenPublicKey, error)
+	// GetPublicKeyList returns every key that was not deleted yet, oldest first.
+	GetPublicKeyList(ctx context.Context) ([]TokenPublicKey, error)
+	// RetirePublicKeyList retires every key but the given one.
+	RetirePublicKeyList(ctx context.Context, exceptTokenPublicKeyID uint64) error
+	DeleteRetiredPublicKeyList(ctx context.Context, retiredBefore time.Time) error
+	WithDatabaseTransaction(database Database) TokenPublicKeyDataAccessor
 }
 
 func NewTokenPublicKeyDataAccessor(
@@ -36,8 +57,11 @@ type tokenPublicKeyDataAccessor struct {
 func (t *tokenPublicKeyDataAccessor) CreatePublicKey(ctx context.Context, tokenPublicKey TokenPublicKey) (uint64, error) {
 	logger := utils.LoggerWithContext(ctx, t.logger)
 
+	// The creation time is set here rather than by the database, as the age of keys is compared to the current time
 	var createdTokenPublicKey = TokenPublicKey{
-		TokenPublicKeyValue: tokenPublicKey.TokenPublicKeyValue,
+		TokenPublicKeyValue:           tokenPublicKey.TokenPublicKeyValue,
+		EncryptedTokenPrivateKeyValue: tokenPublicKey.EncryptedTokenPrivateKeyValue,
+		CreatedAt:                     time.Now(),
 	}
 	result := t.database.Create(&createdTokenPublicKey)
 
@@ -56,9 +80,84 @@ func (t *tokenPublicKeyDataAccessor) GetPublicKey(ctx context.Context, tokenPubl
 	var foundTokenPublicKey TokenPublicKey
 	result := t.database.First(&foundTokenPublicKey, tokenPublicKeyID)
 	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return TokenPublicKey{}, ErrTokenPublicKeyNotFound
+		}
+
 		logger.Error("failed to get public key", zap.Error(result.Error))
 		return TokenPublicKey{}, result.Error
 	}
 
 	return foundTokenPublicKey, nil
 }
+
+// GetActivePublicKey implements TokenPublicKeyDataAccessor.
+func (t *tokenPublicKeyDataAccessor) GetActivePublicKey(ctx context.Context) (TokenPublicKey, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	var foundTokenPublicKey TokenPublicKey
+	result := t.database.
+		Where("retired_at IS NULL AND encrypted_token_private_key_value IS NOT NULL").
+		Order("token_public_key_id DESC").
+		First(&foundTokenPublicKey)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return TokenPublicKey{}, ErrTokenPublicKeyNotFound
+		}
+
+		logger.Error("failed to get active public key", zap.Error(result.Error))
+		return TokenPublicKey{}, result.Error
+	}
+
+	return foundTokenPublicKey, nil
+}
+
+// GetPublicKeyList implements TokenPublicKeyDataAccessor.
+func (t *tokenPublicKeyDataAccessor) GetPublicKeyList(ctx context.Context) ([]TokenPublicKey, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	var tokenPublicKeyList []TokenPublicKey
+	result := t.database.Order("token_public_key_id ASC").Find(&tokenPublicKeyList)
+	if result.Error != nil {
+		logger.Error("failed to get public key list", zap.Error(result.Error))
+		return nil, result.Error
+	}
+
+	return tokenPublicKeyList, nil
+}
+
+// RetirePublicKeyList implements TokenPublicKeyDataAccessor.
+func (t *tokenPublicKeyDataAccessor) RetirePublicKeyList(ctx context.Context, exceptTokenPublicKeyID uint64) error {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("except_token_public_key_id", exceptTokenPublicKeyID))
+
+	result := t.database.Model(&TokenPublicKey{}).
+		Where("token_public_key_id != ? AND retired_at IS NULL", exceptTokenPublicKeyID).
+		Update("retired_at", time.Now())
+	if result.Error != nil {
+		logger.Error("failed to retire public key list", zap.Error(result.Error))
+		return result.Error
+	}
+
+	return nil
+}
+
+// DeleteRetiredPublicKeyList implements TokenPublicKeyDataAccessor.
+func (t *tokenPublicKeyDataAccessor) DeleteRetiredPublicKeyList(ctx context.Context, retiredBefore time.Time) error {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Time("retired_before", retiredBefore))
+
+	result := t.database.Where("retired_at < ?", retiredBefore).Delete(&TokenPublicKey{})
+	if result.Error != nil {
+		logger.Error("failed to delete retired public key list", zap.Error(result.Error))
+		return result.Error
+	}
+
+	return nil
+}
+
+// WithDatabaseTransaction implements TokenPublicKeyDataAccessor.
+func (t *tokenPublicKeyDataAccessor) WithDatabaseTransaction(database Database) TokenPublicKeyDataAccessor {
+	return &tokenPublicKeyDataAccessor{
+		database: database,
+		logger:   t.logger,
+	}
+}
@@ -11,4 +11,14 @@ var WireSet = wire.NewSet(
 	NewProblemDataAccessor,
 	NewSubmissionDataAccessor,
 	NewTestCaseDataAccessor,
+	NewTestCaseGroupDataAccessor,
+	NewSubmissionTestCaseResultDataAccessor,
+	NewContestDataAccessor,
+	NewContestProblemDataAccessor,
+	NewContestParticipantDataAccessor,
+	NewOutboxMessageDataAccessor,
+	NewDeadLetteredSubmissionDataAccessor,
+	NewRejudgeDataAccessor,
+	NewSubmissionJudgeHistoryDataAccessor,
+	NewSessionDataAccessor,
 )
@@ -10,7 +10,8 @@ import (
 )
 
 type Admin interface {
-	Setup(ctx context.Context) error
+	// Setup creates the topics if they do not exist yet, with the number of partitions of the mq config.
+	Setup(ctx context.Context, topics ...string) error
 }
 
 func NewAdmin(
@@ -39,11 +40,22 @@ type admin struct {
 	logger       *zap.Logger
 }
 
-// Setup implements Broker.
-func (b *admin) Setup(ctx context.Context) error {
+// Setup implements Admin.
+func (b *admin) Setup(ctx context.Context, topics ...string) error {
+	for _, topic := range topics {
+		if err := b.setupTopic(topic); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *admin) setupTopic(topic string) error {
+	logger := b.logger.With(zap.String("topic", topic))
 
 	err := b.clusterAdmin.CreateTopic(
-		b.mqConfig.Topic,
+		topic,
 		&sarama.TopicDetail{
 			NumPartitions:     int32(b.mqConfig.NumPartitions),
 			ReplicationFactor: 1,
@@ -51,21 +63,21 @@ func (b *admin) Setup(ctx context.Context) error {
 		false)
 	if err != nil {
 		if errors.Is(err, sarama.ErrTopicAlreadyExists) {
-			b.logger.Info("topic already exists")
+			logger.Info("topic already exists")
 		} else {
-			b.logger.With(zap.Error(err)).Error("failed to create topic")
+			logger.With(zap.Error(err)).Error("failed to create topic")
 			return err
 		}
 	}
 
 	err = b.clusterAdmin.CreatePartitions(
-		b.mqConfig.Topic,
+		topic,
 		int32(b.mqConfig.NumPartitions),
 		make([][]int32, 0),
 		false,
 	)
 	if err != nil {
-		b.logger.With(zap.Error(err)).Warn("failed to set number of partitions")
+		logger.With(zap.Error(err)).Warn("failed to set number of partitions")
 	}
 
 	return nil
@@ -0,0 +1,58 @@
+package consumer
+
+import (
+	"context"
+
+	"github.com/maxuanquang/ojs/internal/dataaccess/mq/inmemory"
+	"github.com/maxuanquang/ojs/internal/utils"
+	"go.uber.org/zap"
+)
+
+func NewInMemoryConsumer(broker inmemory.Broker, logger *zap.Logger) Consumer {
+	return &inMemoryConsumer{
+		broker:                    broker,
+		logger:                    logger,
+		queueNameToHandlerFuncMap: make(map[string]HandlerFunc),
+	}
+}
+
+type inMemoryConsumer struct {
+	broker                    inmemory.Broker
+	logger                    *zap.Logger
+	queueNameToHandlerFuncMap map[string]HandlerFunc
+}
+
+// RegisterHandler implements Consumer.
+func (c *inMemoryConsumer) RegisterHandler(queueName string, handlerFunc HandlerFunc) {
+	c.queueNameToHandlerFuncMap[queueName] = handlerFunc
+}
+
+// Start implements Consumer.
+func (c *inMemoryConsumer) Start(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, c.logger)
+
+	ctx, cancelFunc := context.WithCancel(ctx)
+	defer cancelFunc()
+
+	for queueName, handlerFunc := range c.queueNameToHandlerFuncMap {
+		go func(queueName string, handlerFunc HandlerFunc) {
+			messages := c.broker.Subscribe(queueName)
+			for {
+				select {
+				case <-ctx.Done():
+					logger.Info("consumer stopped")
+					return
+				case payload := <-messages:
+					// Messages are not redelivered, a message whose handling failed is lost
+					err := handlerFunc(ctx, payload)
+					if err != nil {
+						logger.With(zap.String("queueName", queueName)).With(zap.Error(err)).Error("failed to handle message from queue")
+					}
+				}
+			}
+		}(queueName, handlerFunc)
+	}
+
+	waitForExit(ctx)
+	return nil
+}
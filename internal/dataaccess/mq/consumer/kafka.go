@@ -0,0 +1,141 @@
+package consumer
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+	"github.com/maxuanquang/ojs/internal/configs"
+	"github.com/maxuanquang/ojs/internal/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	consumerClientID = "ojs-consumer"
+)
+
+func NewKafkaConsumer(
+	mqConfig configs.MQ,
+	logger *zap.Logger,
+) (Consumer, error) {
+	saramaConsumerGroup, err := sarama.NewConsumerGroup(mqConfig.Addresses, mqConfig.ConsumerGroupID, newSaramaConfig(mqConfig))
+	if err != nil {
+		logger.With(zap.Error(err)).Error("can not create sarama consumer group")
+		return nil, err
+	}
+
+	return &kafkaConsumer{
+		saramaConsumerGroup:       saramaConsumerGroup,
+		logger:                    logger,
+		queueNameToHandlerFuncMap: make(map[string]HandlerFunc),
+	}, nil
+}
+
+type kafkaConsumer struct {
+	logger                    *zap.Logger
+	queueNameToHandlerFuncMap map[string]HandlerFunc
+	saramaConsumerGroup       sarama.ConsumerGroup
+}
+
+// RegisterHandler implements Consumer.
+func (c *kafkaConsumer) RegisterHandler(queueName string, handlerFunc HandlerFunc) {
+	c.queueNameToHandlerFuncMap[queueName] = handlerFunc
+}
+
+// Start implements Consumer.
+func (c *kafkaConsumer) Start(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, c.logger)
+
+	// Consuming stops, ending the sessions of every queue, once the process is interrupted or ctx is done
+	ctx, cancelFunc := context.WithCancel(ctx)
+	defer cancelFunc()
+
+	// A sarama consumer group runs one session at a time, every queue is therefore consumed by the same session,
+	// each claim being handled by the handler of its topic
+	queueNames := make([]string, 0, len(c.queueNameToHandlerFuncMap))
+	for queueName := range c.queueNameToHandlerFuncMap {
+		queueNames = append(queueNames, queueName)
+	}
+
+	consumeDone := make(chan struct{})
+	go func() {
+		defer close(consumeDone)
+
+		for ctx.Err() == nil {
+			err := c.saramaConsumerGroup.Consume(ctx, queueNames, newConsumerHandler(c.queueNameToHandlerFuncMap, c.logger))
+			if err != nil {
+				logger.With(zap.Strings("queueNames", queueNames)).With(zap.Error(err)).Error("failed to consume message from queues")
+				break
+			}
+		}
+		logger.Info("consumer stopped")
+	}()
+
+	waitForExit(ctx)
+	cancelFunc()
+	<-consumeDone
+	return nil
+}
+
+func newSaramaConfig(_ configs.MQ) *sarama.Config {
+	config := sarama.NewConfig()
+	config.Consumer.Offsets.Initial = sarama.OffsetNewest
+	config.ClientID = consumerClientID
+	config.Metadata.Full = true
+	return config
+}
+
+func newConsumerHandler(
+	queueNameToHandlerFuncMap map[string]HandlerFunc,
+	logger *zap.Logger,
+) sarama.ConsumerGroupHandler {
+	return &consumerHandler{
+		queueNameToHandlerFuncMap: queueNameToHandlerFuncMap,
+		logger:                    logger,
+	}
+}
+
+type consumerHandler struct {
+	queueNameToHandlerFuncMap map[string]HandlerFunc
+	logger                    *zap.Logger
+}
+
+// ConsumeClaim implements sarama.ConsumerGroupHandler.
+func (c *consumerHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	handlerFunc := c.queueNameToHandlerFuncMap[claim.Topic()]
+	for {
+		select {
+		case message, ok := <-claim.Messages():
+			if !ok {
+				session.Commit()
+				return nil
+			}
+
+			// Returning an error would end the session of the whole consumer group, failed messages are rather
+			// retried or dead-lettered by the handler itself
+			err := handlerFunc(session.Context(), message.Value)
+			if err != nil {
+				// A message interrupted by the end of the session is left unmarked, to be consumed again
+				if session.Context().Err() != nil {
+					return nil
+				}
+
+				c.logger.With(zap.String("topic", message.Topic)).With(zap.Error(err)).Error("failed to handle message")
+			}
+
+			session.MarkMessage(message, "")
+		case <-session.Context().Done():
+			session.Commit()
+			return nil
+		}
+	}
+}
+
+// Cleanup implements sarama.ConsumerGroupHandler.
+func (c *consumerHandler) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+// Setup implements sarama.ConsumerGroupHandler.
+func (c *consumerHandler) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
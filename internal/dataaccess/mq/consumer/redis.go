@@ -0,0 +1,190 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/maxuanquang/ojs/internal/configs"
+	"github.com/maxuanquang/ojs/internal/dataaccess/mq/producer"
+	"github.com/maxuanquang/ojs/internal/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	redisReadBlockDuration = 5 * time.Second
+	redisRetryInterval     = time.Second
+	// redisStreamNewestID makes a consumer group start from the messages produced after its creation, like Kafka's
+	// newest offset.
+	redisStreamNewestID = "$"
+	// redisStreamUndeliveredID reads the messages never delivered to any consumer of the group.
+	redisStreamUndeliveredID = ">"
+	// redisStreamFirstID starts claiming pending messages from the oldest one.
+	redisStreamFirstID = "0-0"
+	// redisClaimInterval is how often the messages left pending for too long are claimed.
+	redisClaimInterval = time.Minute
+	// redisClaimMinIdleTime is how long a message is left pending before it is claimed, such as when the consumer
+	// it was delivered to has crashed or has failed to handle it.
+	redisClaimMinIdleTime = 5 * time.Minute
+	redisClaimBatchSize   = 10
+)
+
+// NewRedisConsumer returns a consumer reading redis streams as a member of the consumer group of the mq config,
+// so that each message is handled by only one consumer of the group.
+func NewRedisConsumer(mqConfig configs.MQ, redisConnection *redis.Client, logger *zap.Logger) (Consumer, error) {
+	_, err := redisConnection.Ping(context.Background()).Result()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("can not connect to redis")
+		return nil, err
+	}
+
+	consumerName := mqConfig.ConsumerName
+	if consumerName == "" {
+		consumerName, err = os.Hostname()
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to get hostname")
+			return nil, err
+		}
+	}
+
+	return &redisConsumer{
+		redisConnection:           redisConnection,
+		consumerGroupID:           mqConfig.ConsumerGroupID,
+		consumerName:              consumerName,
+		logger:                    logger,
+		queueNameToHandlerFuncMap: make(map[string]HandlerFunc),
+	}, nil
+}
+
+type redisConsumer struct {
+	redisConnection           *redis.Client
+	consumerGroupID           string
+	consumerName              string
+	logger                    *zap.Logger
+	queueNameToHandlerFuncMap map[string]HandlerFunc
+}
+
+// RegisterHandler implements Consumer.
+func (c *redisConsumer) RegisterHandler(queueName string, handlerFunc HandlerFunc) {
+	c.queueNameToHandlerFuncMap[queueName] = handlerFunc
+}
+
+// Start implements Consumer.
+func (c *redisConsumer) Start(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("consumer_name", c.consumerName))
+
+	for queueName := range c.queueNameToHandlerFuncMap {
+		err := c.redisConnection.XGroupCreateMkStream(ctx, queueName, c.consumerGroupID, redisStreamNewestID).Err()
+		if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+			logger.With(zap.String("queueName", queueName)).With(zap.Error(err)).Error("failed to create consumer group")
+			return err
+		}
+	}
+
+	ctx, cancelFunc := context.WithCancel(ctx)
+	defer cancelFunc()
+
+	for queueName, handlerFunc := range c.queueNameToHandlerFuncMap {
+		go func(queueName string, handlerFunc HandlerFunc) {
+			c.consume(ctx, queueName, handlerFunc, logger.With(zap.String("queueName", queueName)))
+			logger.Info("consumer stopped")
+		}(queueName, handlerFunc)
+	}
+
+	waitForExit(ctx)
+	return nil
+}
+
+// consume handles the messages of the queue one at a time until ctx is done. A message is only acknowledged once
+// it has been handled, the ones whose handling failed are left pending in the consumer group until they are claimed
+// again.
+func (c *redisConsumer) consume(ctx context.Context, queueName string, handlerFunc HandlerFunc, logger *zap.Logger) {
+	var lastClaimedAt time.Time
+	for ctx.Err() == nil {
+		if time.Since(lastClaimedAt) >= redisClaimInterval {
+			c.claimIdleMessages(ctx, queueName, handlerFunc, logger)
+			lastClaimedAt = time.Now()
+		}
+
+		streams, err := c.redisConnection.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    c.consumerGroupID,
+			Consumer: c.consumerName,
+			Streams:  []string{queueName, redisStreamUndeliveredID},
+			Count:    1,
+			Block:    redisReadBlockDuration,
+		}).Result()
+		if err != nil {
+			if errors.Is(err, redis.Nil) || ctx.Err() != nil {
+				continue
+			}
+
+			logger.With(zap.Error(err)).Error("failed to read message from queue")
+			time.Sleep(redisRetryInterval)
+			continue
+		}
+
+		for _, stream := range streams {
+			for _, message := range stream.Messages {
+				c.handleMessage(ctx, queueName, handlerFunc, message, logger)
+			}
+		}
+	}
+}
+
+// claimIdleMessages takes over and handles the messages of the queue left pending for too long, by any consumer
+// of the group including this one.
+func (c *redisConsumer) claimIdleMessages(ctx context.Context, queueName string, handlerFunc HandlerFunc, logger *zap.Logger) {
+	start := redisStreamFirstID
+	for ctx.Err() == nil {
+		messages, nextStart, err := c.redisConnection.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+			Stream:   queueName,
+			Group:    c.consumerGroupID,
+			Consumer: c.consumerName,
+			MinIdle:  redisClaimMinIdleTime,
+			Start:    start,
+			Count:    redisClaimBatchSize,
+		}).Result()
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.With(zap.Error(err)).Error("failed to claim idle messages from queue")
+			}
+			return
+		}
+
+		for _, message := range messages {
+			logger.With(zap.String("message_id", message.ID)).Warn("claimed idle message from queue")
+			c.handleMessage(ctx, queueName, handlerFunc, message, logger)
+		}
+
+		if nextStart == redisStreamFirstID {
+			return
+		}
+		start = nextStart
+	}
+}
+
+func (c *redisConsumer) handleMessage(
+	ctx context.Context,
+	queueName string,
+	handlerFunc HandlerFunc,
+	message redis.XMessage,
+	logger *zap.Logger,
+) {
+	logger = logger.With(zap.String("message_id", message.ID))
+
+	payload, ok := message.Values[producer.RedisStreamPayloadField].(string)
+	if !ok {
+		logger.Error("message has no payload, dropping it")
+	} else if err := handlerFunc(ctx, []byte(payload)); err != nil {
+		logger.With(zap.Error(err)).Error("failed to handle message from queue")
+		return
+	}
+
+	err := c.redisConnection.XAck(ctx, queueName, c.consumerGroupID, message.ID).Err()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to acknowledge message")
+	}
+}
@@ -0,0 +1,68 @@
+package inmemory
+
+import (
+	"errors"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+const (
+	queueBufferSize = 1024
+)
+
+var (
+	ErrQueueFull = errors.New("queue is full")
+)
+
+// Broker passes messages between the producer and the consumer of a single process, such as the standalone server.
+// Messages are lost when the process exits, and messages not consumed yet are only kept up to the size of a buffer.
+type Broker interface {
+	Publish(queueName string, payload []byte) error
+	// Subscribe returns the channel messages published to the queue are received from. Subscribers of the same queue
+	// share its messages, each message is received only once.
+	Subscribe(queueName string) <-chan []byte
+}
+
+func NewBroker(logger *zap.Logger) Broker {
+	return &broker{
+		queues: make(map[string]chan []byte),
+		logger: logger,
+	}
+}
+
+type broker struct {
+	queuesMutex sync.Mutex
+	queues      map[string]chan []byte
+	logger      *zap.Logger
+}
+
+// Publish implements Broker.
+func (b *broker) Publish(queueName string, payload []byte) error {
+	// Publishing never blocks, a full queue is rather reported to the producer so that it can try again later
+	select {
+	case b.getQueue(queueName) <- payload:
+		return nil
+	default:
+		b.logger.With(zap.String("queue_name", queueName)).Warn("in-memory queue is full")
+		return ErrQueueFull
+	}
+}
+
+// Subscribe implements Broker.
+func (b *broker) Subscribe(queueName string) <-chan []byte {
+	return b.getQueue(queueName)
+}
+
+func (b *broker) getQueue(queueName string) chan []byte {
+	b.queuesMutex.Lock()
+	defer b.queuesMutex.Unlock()
+
+	queue, ok := b.queues[queueName]
+	if !ok {
+		queue = make(chan []byte, queueBufferSize)
+		b.queues[queueName] = queue
+	}
+
+	return queue
+}
@@ -1,7 +1,7 @@
-package admin
+package inmemory
 
 import "github.com/google/wire"
 
 var WireSet = wire.NewSet(
-	NewAdmin,
-)
\ No newline at end of file
+	NewBroker,
+)
@@ -4,10 +4,9 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/IBM/sarama"
+	"github.com/go-redis/redis/v8"
 	"github.com/maxuanquang/ojs/internal/configs"
-	"github.com/maxuanquang/ojs/internal/dataaccess/mq/admin"
-	"github.com/maxuanquang/ojs/internal/utils"
+	"github.com/maxuanquang/ojs/internal/dataaccess/mq/inmemory"
 	"go.uber.org/zap"
 )
 
@@ -15,52 +14,22 @@ type Client interface {
 	Produce(ctx context.Context, queueName string, payload []byte) error
 }
 
-func NewClient(mqConfig configs.MQ, logger *zap.Logger, admin admin.Admin) (Client, error) {
-	err := admin.Setup(context.Background())
-	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to setup kafka broker")
+func NewClient(
+	mqConfig configs.MQ,
+	inMemoryBroker inmemory.Broker,
+	redisConnection *redis.Client,
+	logger *zap.Logger,
+) (Client, error) {
+	switch mqConfig.Type {
+	case configs.MQTypeKafka:
+		return NewKafkaClient(mqConfig, logger)
+	case configs.MQTypeInMemory:
+		return NewInMemoryClient(inMemoryBroker, logger), nil
+	case configs.MQTypeRedis:
+		return NewRedisClient(redisConnection, logger)
+	default:
+		err := fmt.Errorf(`invalid mq type, expect one of ["kafka", "in_memory", "redis"], got %s`, string(mqConfig.Type))
+		logger.With(zap.Error(err)).Error("invalid mq type")
 		return nil, err
 	}
-
-	producer, err := sarama.NewSyncProducer(mqConfig.Addresses, newSaramaConfig(mqConfig))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create sarama sync producer: %w", err)
-	}
-
-	return &client{
-		saramaSyncProducer: producer,
-		logger:             logger,
-	}, nil
-}
-
-type client struct {
-	saramaSyncProducer sarama.SyncProducer
-	logger             *zap.Logger
-}
-
-// Produce implements Client.
-func (c *client) Produce(ctx context.Context, queueName string, payload []byte) error {
-	logger := utils.LoggerWithContext(ctx, c.logger).
-		With(zap.String("queue_name", queueName)).
-		With(zap.ByteString("payload", payload))
-
-	_, _, err := c.saramaSyncProducer.SendMessage(&sarama.ProducerMessage{
-		Topic: queueName,
-		Value: sarama.ByteEncoder(payload),
-	})
-	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to produce message")
-		return err
-	}
-
-	logger.Info("payload sent to broker")
-	return nil
-}
-
-func newSaramaConfig(mqConfig configs.MQ) *sarama.Config {
-	saramaConfig := sarama.NewConfig()
-	saramaConfig.Producer.RequiredAcks = sarama.WaitForAll
-	saramaConfig.Producer.Return.Successes = true
-	saramaConfig.ClientID = mqConfig.ClientID
-	return saramaConfig
 }
@@ -0,0 +1,37 @@
+package producer
+
+import (
+	"context"
+
+	"github.com/maxuanquang/ojs/internal/dataaccess/mq/inmemory"
+	"github.com/maxuanquang/ojs/internal/utils"
+	"go.uber.org/zap"
+)
+
+func NewInMemoryClient(broker inmemory.Broker, logger *zap.Logger) Client {
+	return &inMemoryClient{
+		broker: broker,
+		logger: logger,
+	}
+}
+
+type inMemoryClient struct {
+	broker inmemory.Broker
+	logger *zap.Logger
+}
+
+// Produce implements Client.
+func (c *inMemoryClient) Produce(ctx context.Context, queueName string, payload []byte) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).
+		With(zap.String("queue_name", queueName)).
+		With(zap.ByteString("payload", payload))
+
+	err := c.broker.Publish(queueName, payload)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to produce message")
+		return err
+	}
+
+	logger.Info("payload sent to broker")
+	return nil
+}
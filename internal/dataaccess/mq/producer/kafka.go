@@ -0,0 +1,68 @@
+package producer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/sarama"
+	"github.com/maxuanquang/ojs/internal/configs"
+	"github.com/maxuanquang/ojs/internal/dataaccess/mq/admin"
+	"github.com/maxuanquang/ojs/internal/utils"
+	"go.uber.org/zap"
+)
+
+func NewKafkaClient(mqConfig configs.MQ, logger *zap.Logger) (Client, error) {
+	kafkaAdmin, err := admin.NewAdmin(logger, mqConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	topics := append([]string{MessageQueueSubmissionCreatedDeadLetter}, SubmissionCreatedQueueNames...)
+	err = kafkaAdmin.Setup(context.Background(), topics...)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to setup kafka broker")
+		return nil, err
+	}
+
+	producer, err := sarama.NewSyncProducer(mqConfig.Addresses, newSaramaConfig(mqConfig))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sarama sync producer: %w", err)
+	}
+
+	return &kafkaClient{
+		saramaSyncProducer: producer,
+		logger:             logger,
+	}, nil
+}
+
+type kafkaClient struct {
+	saramaSyncProducer sarama.SyncProducer
+	logger             *zap.Logger
+}
+
+// Produce implements Client.
+func (c *kafkaClient) Produce(ctx context.Context, queueName string, payload []byte) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).
+		With(zap.String("queue_name", queueName)).
+		With(zap.ByteString("payload", payload))
+
+	_, _, err := c.saramaSyncProducer.SendMessage(&sarama.ProducerMessage{
+		Topic: queueName,
+		Value: sarama.ByteEncoder(payload),
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to produce message")
+		return err
+	}
+
+	logger.Info("payload sent to broker")
+	return nil
+}
+
+func newSaramaConfig(mqConfig configs.MQ) *sarama.Config {
+	saramaConfig := sarama.NewConfig()
+	saramaConfig.Producer.RequiredAcks = sarama.WaitForAll
+	saramaConfig.Producer.Return.Successes = true
+	saramaConfig.ClientID = mqConfig.ClientID
+	return saramaConfig
+}
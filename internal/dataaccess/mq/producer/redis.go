@@ -0,0 +1,56 @@
+package producer
+
+import (
+	"context"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/maxuanquang/ojs/internal/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	// RedisStreamPayloadField is the field of a stream entry holding the message's payload.
+	RedisStreamPayloadField = "payload"
+	// redisStreamMaxLength bounds the length of a queue's stream, older entries are trimmed once it is exceeded.
+	redisStreamMaxLength = 100000
+)
+
+func NewRedisClient(redisConnection *redis.Client, logger *zap.Logger) (Client, error) {
+	_, err := redisConnection.Ping(context.Background()).Result()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("can not connect to redis")
+		return nil, err
+	}
+
+	return &redisClient{
+		redisConnection: redisConnection,
+		logger:          logger,
+	}, nil
+}
+
+// redisClient produces messages to redis streams, one stream per queue named after it.
+type redisClient struct {
+	redisConnection *redis.Client
+	logger          *zap.Logger
+}
+
+// Produce implements Client.
+func (c *redisClient) Produce(ctx context.Context, queueName string, payload []byte) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).
+		With(zap.String("queue_name", queueName)).
+		With(zap.ByteString("payload", payload))
+
+	err := c.redisConnection.XAdd(ctx, &redis.XAddArgs{
+		Stream: queueName,
+		MaxLen: redisStreamMaxLength,
+		Approx: true,
+		Values: map[string]interface{}{RedisStreamPayloadField: payload},
+	}).Err()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to produce message")
+		return err
+	}
+
+	logger.Info("payload sent to broker")
+	return nil
+}
@@ -1,48 +1,25 @@
 package producer
 
-import (
-	"context"
-	"encoding/json"
-
-	"github.com/maxuanquang/ojs/internal/utils"
-	"go.uber.org/zap"
-)
+import "encoding/json"
 
 const (
-	MessageQueueSubmissionCreated = "submission_created"
+	// MessageQueueSubmissionCreatedContest carries submission created messages of submissions made during a contest.
+	MessageQueueSubmissionCreatedContest = "submission_created_contest"
+	MessageQueueSubmissionCreated        = "submission_created"
+	// MessageQueueSubmissionRejudged carries submission created messages of rejudged submissions.
+	MessageQueueSubmissionRejudged = "submission_rejudged"
 )
 
-type SubmissionCreatedProducer interface {
-	Produce(ctx context.Context, submissionID uint64) error
+// SubmissionCreatedQueueNames are the priority lanes of submissions to judge, from the highest priority to the lowest.
+// Workers only judge a submission of a lane when no submission of a higher priority lane is waiting, so that a bulk
+// rejudge does not delay contestants.
+var SubmissionCreatedQueueNames = []string{
+	MessageQueueSubmissionCreatedContest,
+	MessageQueueSubmissionCreated,
+	MessageQueueSubmissionRejudged,
 }
 
-func NewSubmissionCreatedProducer(client Client, logger *zap.Logger) (SubmissionCreatedProducer, error) {
-	return &submissionCreatedProducer{
-		client: client,
-		logger: logger,
-	}, nil
-}
-
-type submissionCreatedProducer struct {
-	client Client
-	logger *zap.Logger
-}
-
-// Produce implements DownloadTaskCreatedProducer.
-func (d *submissionCreatedProducer) Produce(ctx context.Context, submissionID uint64) error {
-	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Any("download_task_id", submissionID))
-
-	payload, err := json.Marshal(submissionID)
-	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to marshal event submission created")
-		return err
-	}
-
-	err = d.client.Produce(ctx, MessageQueueSubmissionCreated, payload)
-	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to produce message submission created")
-		return err
-	}
-
-	return nil
+// NewSubmissionCreatedPayload returns the payload of a submission created message.
+func NewSubmissionCreatedPayload(submissionID uint64) ([]byte, error) {
+	return json.Marshal(submissionID)
 }
@@ -0,0 +1,58 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/maxuanquang/ojs/internal/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	// DeadLetterQueueSuffix is appended to the name of a queue to get the queue its unhandled messages are moved to.
+	DeadLetterQueueSuffix = ".dlq"
+
+	MessageQueueSubmissionCreatedDeadLetter = MessageQueueSubmissionCreated + DeadLetterQueueSuffix
+)
+
+// SubmissionCreatedDeadLetter is a submission created message whose handling failed after all of its attempts.
+type SubmissionCreatedDeadLetter struct {
+	SubmissionID uint64 `json:"submission_id"`
+	Error        string `json:"error"`
+	AttemptCount int    `json:"attempt_count"`
+}
+
+type SubmissionCreatedDeadLetterProducer interface {
+	Produce(ctx context.Context, deadLetter SubmissionCreatedDeadLetter) error
+}
+
+func NewSubmissionCreatedDeadLetterProducer(client Client, logger *zap.Logger) SubmissionCreatedDeadLetterProducer {
+	return &submissionCreatedDeadLetterProducer{
+		client: client,
+		logger: logger,
+	}
+}
+
+type submissionCreatedDeadLetterProducer struct {
+	client Client
+	logger *zap.Logger
+}
+
+// Produce implements SubmissionCreatedDeadLetterProducer.
+func (s *submissionCreatedDeadLetterProducer) Produce(ctx context.Context, deadLetter SubmissionCreatedDeadLetter) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("submission_id", deadLetter.SubmissionID))
+
+	payload, err := json.Marshal(deadLetter)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to marshal submission created dead letter")
+		return err
+	}
+
+	err = s.client.Produce(ctx, MessageQueueSubmissionCreatedDeadLetter, payload)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to produce submission created dead letter")
+		return err
+	}
+
+	return nil
+}
@@ -77,6 +77,113 @@ func (Role) EnumDescriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{0}
 }
 
+type OutputComparisonMode int32
+
+const (
+	OutputComparisonMode_UndefinedComparisonMode  OutputComparisonMode = 0
+	OutputComparisonMode_Exact                    OutputComparisonMode = 1
+	OutputComparisonMode_IgnoreTrailingWhitespace OutputComparisonMode = 2
+	OutputComparisonMode_Token                    OutputComparisonMode = 3
+	OutputComparisonMode_CaseInsensitive          OutputComparisonMode = 4
+	OutputComparisonMode_Float                    OutputComparisonMode = 5
+)
+
+// Enum value maps for OutputComparisonMode.
+var (
+	OutputComparisonMode_name = map[int32]string{
+		0: "UndefinedComparisonMode",
+		1: "Exact",
+		2: "IgnoreTrailingWhitespace",
+		3: "Token",
+		4: "CaseInsensitive",
+		5: "Float",
+	}
+	OutputComparisonMode_value = map[string]int32{
+		"UndefinedComparisonMode":  0,
+		"Exact":                    1,
+		"IgnoreTrailingWhitespace": 2,
+		"Token":                    3,
+		"CaseInsensitive":          4,
+		"Float":                    5,
+	}
+)
+
+func (x OutputComparisonMode) Enum() *OutputComparisonMode {
+	p := new(OutputComparisonMode)
+	*p = x
+	return p
+}
+
+func (x OutputComparisonMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OutputComparisonMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_ojs_proto_enumTypes[1].Descriptor()
+}
+
+func (OutputComparisonMode) Type() protoreflect.EnumType {
+	return &file_ojs_proto_enumTypes[1]
+}
+
+func (x OutputComparisonMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OutputComparisonMode.Descriptor instead.
+func (OutputComparisonMode) EnumDescriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{1}
+}
+
+type TestCaseGroupScoringPolicy int32
+
+const (
+	TestCaseGroupScoringPolicy_UndefinedScoringPolicy TestCaseGroupScoringPolicy = 0
+	TestCaseGroupScoringPolicy_AllOrNothing           TestCaseGroupScoringPolicy = 1
+	TestCaseGroupScoringPolicy_Proportional           TestCaseGroupScoringPolicy = 2
+)
+
+// Enum value maps for TestCaseGroupScoringPolicy.
+var (
+	TestCaseGroupScoringPolicy_name = map[int32]string{
+		0: "UndefinedScoringPolicy",
+		1: "AllOrNothing",
+		2: "Proportional",
+	}
+	TestCaseGroupScoringPolicy_value = map[string]int32{
+		"UndefinedScoringPolicy": 0,
+		"AllOrNothing":           1,
+		"Proportional":           2,
+	}
+)
+
+func (x TestCaseGroupScoringPolicy) Enum() *TestCaseGroupScoringPolicy {
+	p := new(TestCaseGroupScoringPolicy)
+	*p = x
+	return p
+}
+
+func (x TestCaseGroupScoringPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TestCaseGroupScoringPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_ojs_proto_enumTypes[2].Descriptor()
+}
+
+func (TestCaseGroupScoringPolicy) Type() protoreflect.EnumType {
+	return &file_ojs_proto_enumTypes[2]
+}
+
+func (x TestCaseGroupScoringPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TestCaseGroupScoringPolicy.Descriptor instead.
+func (TestCaseGroupScoringPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{2}
+}
+
 type SubmissionStatus int32
 
 const (
@@ -113,11 +220,11 @@ func (x SubmissionStatus) String() string {
 }
 
 func (SubmissionStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_ojs_proto_enumTypes[1].Descriptor()
+	return file_ojs_proto_enumTypes[3].Descriptor()
 }
 
 func (SubmissionStatus) Type() protoreflect.EnumType {
-	return &file_ojs_proto_enumTypes[1]
+	return &file_ojs_proto_enumTypes[3]
 }
 
 func (x SubmissionStatus) Number() protoreflect.EnumNumber {
@@ -126,7 +233,7 @@ func (x SubmissionStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SubmissionStatus.Descriptor instead.
 func (SubmissionStatus) EnumDescriptor() ([]byte, []int) {
-	return file_ojs_proto_rawDescGZIP(), []int{1}
+	return file_ojs_proto_rawDescGZIP(), []int{3}
 }
 
 type SubmissionResult int32
@@ -177,11 +284,11 @@ func (x SubmissionResult) String() string {
 }
 
 func (SubmissionResult) Descriptor() protoreflect.EnumDescriptor {
-	return file_ojs_proto_enumTypes[2].Descriptor()
+	return file_ojs_proto_enumTypes[4].Descriptor()
 }
 
 func (SubmissionResult) Type() protoreflect.EnumType {
-	return &file_ojs_proto_enumTypes[2]
+	return &file_ojs_proto_enumTypes[4]
 }
 
 func (x SubmissionResult) Number() protoreflect.EnumNumber {
@@ -190,7 +297,56 @@ func (x SubmissionResult) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SubmissionResult.Descriptor instead.
 func (SubmissionResult) EnumDescriptor() ([]byte, []int) {
-	return file_ojs_proto_rawDescGZIP(), []int{2}
+	return file_ojs_proto_rawDescGZIP(), []int{4}
+}
+
+type ContestScoringMode int32
+
+const (
+	ContestScoringMode_UndefinedScoringMode ContestScoringMode = 0
+	ContestScoringMode_ICPC                 ContestScoringMode = 1
+	ContestScoringMode_IOI                  ContestScoringMode = 2
+)
+
+// Enum value maps for ContestScoringMode.
+var (
+	ContestScoringMode_name = map[int32]string{
+		0: "UndefinedScoringMode",
+		1: "ICPC",
+		2: "IOI",
+	}
+	ContestScoringMode_value = map[string]int32{
+		"UndefinedScoringMode": 0,
+		"ICPC":                 1,
+		"IOI":                  2,
+	}
+)
+
+func (x ContestScoringMode) Enum() *ContestScoringMode {
+	p := new(ContestScoringMode)
+	*p = x
+	return p
+}
+
+func (x ContestScoringMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContestScoringMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_ojs_proto_enumTypes[5].Descriptor()
+}
+
+func (ContestScoringMode) Type() protoreflect.EnumType {
+	return &file_ojs_proto_enumTypes[5]
+}
+
+func (x ContestScoringMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContestScoringMode.Descriptor instead.
+func (ContestScoringMode) EnumDescriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{5}
 }
 
 type GetServerInfoRequest struct {
@@ -337,9 +493,10 @@ type Account struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Role Role   `protobuf:"varint,3,opt,name=role,proto3,enum=ojs.Role" json:"role,omitempty"`
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role       Role   `protobuf:"varint,3,opt,name=role,proto3,enum=ojs.Role" json:"role,omitempty"`
+	IsDisabled bool   `protobuf:"varint,4,opt,name=is_disabled,json=isDisabled,proto3" json:"is_disabled,omitempty"`
 }
 
 func (x *Account) Reset() {
@@ -395,6 +552,13 @@ func (x *Account) GetRole() Role {
 	return Role_UndefinedRole
 }
 
+func (x *Account) GetIsDisabled() bool {
+	if x != nil {
+		return x.IsDisabled
+	}
+	return false
+}
+
 type CreateAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -536,17 +700,19 @@ func (x *GetAccountResponse) GetAccount() *Account {
 	return nil
 }
 
-type CreateSessionRequest struct {
+type GetAccountListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// query filters the accounts whose name contains it
+	Query  string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *CreateSessionRequest) Reset() {
-	*x = CreateSessionRequest{}
+func (x *GetAccountListRequest) Reset() {
+	*x = GetAccountListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -554,13 +720,13 @@ func (x *CreateSessionRequest) Reset() {
 	}
 }
 
-func (x *CreateSessionRequest) String() string {
+func (x *GetAccountListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSessionRequest) ProtoMessage() {}
+func (*GetAccountListRequest) ProtoMessage() {}
 
-func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAccountListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -572,35 +738,43 @@ func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSessionRequest.ProtoReflect.Descriptor instead.
-func (*CreateSessionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAccountListRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountListRequest) Descriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreateSessionRequest) GetName() string {
+func (x *GetAccountListRequest) GetQuery() string {
 	if x != nil {
-		return x.Name
+		return x.Query
 	}
 	return ""
 }
 
-func (x *CreateSessionRequest) GetPassword() string {
+func (x *GetAccountListRequest) GetOffset() uint64 {
 	if x != nil {
-		return x.Password
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-type CreateSessionResponse struct {
+func (x *GetAccountListRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetAccountListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Accounts          []*Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	TotalAccountCount uint64     `protobuf:"varint,2,opt,name=total_account_count,json=totalAccountCount,proto3" json:"total_account_count,omitempty"`
 }
 
-func (x *CreateSessionResponse) Reset() {
-	*x = CreateSessionResponse{}
+func (x *GetAccountListResponse) Reset() {
+	*x = GetAccountListResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -608,13 +782,13 @@ func (x *CreateSessionResponse) Reset() {
 	}
 }
 
-func (x *CreateSessionResponse) String() string {
+func (x *GetAccountListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSessionResponse) ProtoMessage() {}
+func (*GetAccountListResponse) ProtoMessage() {}
 
-func (x *CreateSessionResponse) ProtoReflect() protoreflect.Message {
+func (x *GetAccountListResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -626,26 +800,36 @@ func (x *CreateSessionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSessionResponse.ProtoReflect.Descriptor instead.
-func (*CreateSessionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAccountListResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountListResponse) Descriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CreateSessionResponse) GetAccount() *Account {
+func (x *GetAccountListResponse) GetAccounts() []*Account {
 	if x != nil {
-		return x.Account
+		return x.Accounts
 	}
 	return nil
 }
 
-type DeleteSessionRequest struct {
+func (x *GetAccountListResponse) GetTotalAccountCount() uint64 {
+	if x != nil {
+		return x.TotalAccountCount
+	}
+	return 0
+}
+
+type UpdateAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *DeleteSessionRequest) Reset() {
-	*x = DeleteSessionRequest{}
+func (x *UpdateAccountRequest) Reset() {
+	*x = UpdateAccountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -653,13 +837,13 @@ func (x *DeleteSessionRequest) Reset() {
 	}
 }
 
-func (x *DeleteSessionRequest) String() string {
+func (x *UpdateAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSessionRequest) ProtoMessage() {}
+func (*UpdateAccountRequest) ProtoMessage() {}
 
-func (x *DeleteSessionRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -671,19 +855,35 @@ func (x *DeleteSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSessionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSessionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateAccountRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAccountRequest) Descriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{9}
 }
 
-type DeleteSessionResponse struct {
+func (x *UpdateAccountRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (x *DeleteSessionResponse) Reset() {
-	*x = DeleteSessionResponse{}
+func (x *UpdateAccountResponse) Reset() {
+	*x = UpdateAccountResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -691,13 +891,13 @@ func (x *DeleteSessionResponse) Reset() {
 	}
 }
 
-func (x *DeleteSessionResponse) String() string {
+func (x *UpdateAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSessionResponse) ProtoMessage() {}
+func (*UpdateAccountResponse) ProtoMessage() {}
 
-func (x *DeleteSessionResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateAccountResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -709,24 +909,30 @@ func (x *DeleteSessionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSessionResponse.ProtoReflect.Descriptor instead.
-func (*DeleteSessionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateAccountResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAccountResponse) Descriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{10}
 }
 
-type CreateProblemRequest struct {
+func (x *UpdateAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type ChangePasswordRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DisplayName string `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	TimeLimit   string `protobuf:"bytes,3,opt,name=time_limit,json=timeLimit,proto3" json:"time_limit,omitempty"`
-	MemoryLimit string `protobuf:"bytes,4,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OldPassword string `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword string `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
 }
 
-func (x *CreateProblemRequest) Reset() {
-	*x = CreateProblemRequest{}
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -734,13 +940,13 @@ func (x *CreateProblemRequest) Reset() {
 	}
 }
 
-func (x *CreateProblemRequest) String() string {
+func (x *ChangePasswordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProblemRequest) ProtoMessage() {}
+func (*ChangePasswordRequest) ProtoMessage() {}
 
-func (x *CreateProblemRequest) ProtoReflect() protoreflect.Message {
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -752,55 +958,40 @@ func (x *CreateProblemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProblemRequest.ProtoReflect.Descriptor instead.
-func (*CreateProblemRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CreateProblemRequest) GetDisplayName() string {
-	if x != nil {
-		return x.DisplayName
-	}
-	return ""
-}
-
-func (x *CreateProblemRequest) GetDescription() string {
+func (x *ChangePasswordRequest) GetId() uint64 {
 	if x != nil {
-		return x.Description
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateProblemRequest) GetTimeLimit() string {
+func (x *ChangePasswordRequest) GetOldPassword() string {
 	if x != nil {
-		return x.TimeLimit
+		return x.OldPassword
 	}
 	return ""
 }
 
-func (x *CreateProblemRequest) GetMemoryLimit() string {
+func (x *ChangePasswordRequest) GetNewPassword() string {
 	if x != nil {
-		return x.MemoryLimit
+		return x.NewPassword
 	}
 	return ""
 }
 
-type Problem struct {
+type ChangePasswordResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	AuthorId    uint64 `protobuf:"varint,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	AuthorName  string `protobuf:"bytes,4,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
-	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	TimeLimit   string `protobuf:"bytes,6,opt,name=time_limit,json=timeLimit,proto3" json:"time_limit,omitempty"`
-	MemoryLimit string `protobuf:"bytes,7,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
 }
 
-func (x *Problem) Reset() {
-	*x = Problem{}
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -808,13 +999,13 @@ func (x *Problem) Reset() {
 	}
 }
 
-func (x *Problem) String() string {
+func (x *ChangePasswordResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Problem) ProtoMessage() {}
+func (*ChangePasswordResponse) ProtoMessage() {}
 
-func (x *Problem) ProtoReflect() protoreflect.Message {
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -826,118 +1017,76 @@ func (x *Problem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Problem.ProtoReflect.Descriptor instead.
-func (*Problem) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Problem) GetId() uint64 {
-	if x != nil {
-		return x.Id
+type SetAccountRoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Role Role   `protobuf:"varint,2,opt,name=role,proto3,enum=ojs.Role" json:"role,omitempty"`
+}
+
+func (x *SetAccountRoleRequest) Reset() {
+	*x = SetAccountRoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Problem) GetDisplayName() string {
-	if x != nil {
-		return x.DisplayName
+func (x *SetAccountRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccountRoleRequest) ProtoMessage() {}
+
+func (x *SetAccountRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Problem) GetAuthorId() uint64 {
+// Deprecated: Use SetAccountRoleRequest.ProtoReflect.Descriptor instead.
+func (*SetAccountRoleRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetAccountRoleRequest) GetId() uint64 {
 	if x != nil {
-		return x.AuthorId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *Problem) GetAuthorName() string {
+func (x *SetAccountRoleRequest) GetRole() Role {
 	if x != nil {
-		return x.AuthorName
+		return x.Role
 	}
-	return ""
+	return Role_UndefinedRole
 }
 
-func (x *Problem) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *Problem) GetTimeLimit() string {
-	if x != nil {
-		return x.TimeLimit
-	}
-	return ""
-}
-
-func (x *Problem) GetMemoryLimit() string {
-	if x != nil {
-		return x.MemoryLimit
-	}
-	return ""
-}
-
-type CreateProblemResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Problem *Problem `protobuf:"bytes,1,opt,name=problem,proto3" json:"problem,omitempty"`
-}
-
-func (x *CreateProblemResponse) Reset() {
-	*x = CreateProblemResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ojs_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *CreateProblemResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateProblemResponse) ProtoMessage() {}
-
-func (x *CreateProblemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ojs_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateProblemResponse.ProtoReflect.Descriptor instead.
-func (*CreateProblemResponse) Descriptor() ([]byte, []int) {
-	return file_ojs_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *CreateProblemResponse) GetProblem() *Problem {
-	if x != nil {
-		return x.Problem
-	}
-	return nil
-}
-
-type GetProblemListRequest struct {
+type SetAccountRoleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit  uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (x *GetProblemListRequest) Reset() {
-	*x = GetProblemListRequest{}
+func (x *SetAccountRoleResponse) Reset() {
+	*x = SetAccountRoleResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -945,13 +1094,13 @@ func (x *GetProblemListRequest) Reset() {
 	}
 }
 
-func (x *GetProblemListRequest) String() string {
+func (x *SetAccountRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProblemListRequest) ProtoMessage() {}
+func (*SetAccountRoleResponse) ProtoMessage() {}
 
-func (x *GetProblemListRequest) ProtoReflect() protoreflect.Message {
+func (x *SetAccountRoleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -963,36 +1112,28 @@ func (x *GetProblemListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProblemListRequest.ProtoReflect.Descriptor instead.
-func (*GetProblemListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetAccountRoleResponse.ProtoReflect.Descriptor instead.
+func (*SetAccountRoleResponse) Descriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetProblemListRequest) GetOffset() uint64 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
-}
-
-func (x *GetProblemListRequest) GetLimit() uint64 {
+func (x *SetAccountRoleResponse) GetAccount() *Account {
 	if x != nil {
-		return x.Limit
+		return x.Account
 	}
-	return 0
+	return nil
 }
 
-type GetProblemListResponse struct {
+type DisableAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Problems          []*Problem `protobuf:"bytes,1,rep,name=problems,proto3" json:"problems,omitempty"`
-	TotalProblemCount uint64     `protobuf:"varint,2,opt,name=total_problem_count,json=totalProblemCount,proto3" json:"total_problem_count,omitempty"`
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetProblemListResponse) Reset() {
-	*x = GetProblemListResponse{}
+func (x *DisableAccountRequest) Reset() {
+	*x = DisableAccountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1000,13 +1141,13 @@ func (x *GetProblemListResponse) Reset() {
 	}
 }
 
-func (x *GetProblemListResponse) String() string {
+func (x *DisableAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProblemListResponse) ProtoMessage() {}
+func (*DisableAccountRequest) ProtoMessage() {}
 
-func (x *GetProblemListResponse) ProtoReflect() protoreflect.Message {
+func (x *DisableAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1018,35 +1159,28 @@ func (x *GetProblemListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProblemListResponse.ProtoReflect.Descriptor instead.
-func (*GetProblemListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DisableAccountRequest.ProtoReflect.Descriptor instead.
+func (*DisableAccountRequest) Descriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetProblemListResponse) GetProblems() []*Problem {
-	if x != nil {
-		return x.Problems
-	}
-	return nil
-}
-
-func (x *GetProblemListResponse) GetTotalProblemCount() uint64 {
+func (x *DisableAccountRequest) GetId() uint64 {
 	if x != nil {
-		return x.TotalProblemCount
+		return x.Id
 	}
 	return 0
 }
 
-type GetProblemRequest struct {
+type DisableAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (x *GetProblemRequest) Reset() {
-	*x = GetProblemRequest{}
+func (x *DisableAccountResponse) Reset() {
+	*x = DisableAccountResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1054,13 +1188,13 @@ func (x *GetProblemRequest) Reset() {
 	}
 }
 
-func (x *GetProblemRequest) String() string {
+func (x *DisableAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProblemRequest) ProtoMessage() {}
+func (*DisableAccountResponse) ProtoMessage() {}
 
-func (x *GetProblemRequest) ProtoReflect() protoreflect.Message {
+func (x *DisableAccountResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1072,28 +1206,29 @@ func (x *GetProblemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProblemRequest.ProtoReflect.Descriptor instead.
-func (*GetProblemRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DisableAccountResponse.ProtoReflect.Descriptor instead.
+func (*DisableAccountResponse) Descriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetProblemRequest) GetId() uint64 {
+func (x *DisableAccountResponse) GetAccount() *Account {
 	if x != nil {
-		return x.Id
+		return x.Account
 	}
-	return 0
+	return nil
 }
 
-type GetProblemResponse struct {
+type CreateSessionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Problem *Problem `protobuf:"bytes,1,opt,name=problem,proto3" json:"problem,omitempty"`
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *GetProblemResponse) Reset() {
-	*x = GetProblemResponse{}
+func (x *CreateSessionRequest) Reset() {
+	*x = CreateSessionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1101,13 +1236,13 @@ func (x *GetProblemResponse) Reset() {
 	}
 }
 
-func (x *GetProblemResponse) String() string {
+func (x *CreateSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProblemResponse) ProtoMessage() {}
+func (*CreateSessionRequest) ProtoMessage() {}
 
-func (x *GetProblemResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1119,32 +1254,35 @@ func (x *GetProblemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProblemResponse.ProtoReflect.Descriptor instead.
-func (*GetProblemResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSessionRequest) Descriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetProblemResponse) GetProblem() *Problem {
+func (x *CreateSessionRequest) GetName() string {
 	if x != nil {
-		return x.Problem
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type UpdateProblemRequest struct {
+func (x *CreateSessionRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type CreateSessionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	TimeLimit   string `protobuf:"bytes,4,opt,name=time_limit,json=timeLimit,proto3" json:"time_limit,omitempty"`
-	MemoryLimit string `protobuf:"bytes,5,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (x *UpdateProblemRequest) Reset() {
-	*x = UpdateProblemRequest{}
+func (x *CreateSessionResponse) Reset() {
+	*x = CreateSessionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1152,13 +1290,13 @@ func (x *UpdateProblemRequest) Reset() {
 	}
 }
 
-func (x *UpdateProblemRequest) String() string {
+func (x *CreateSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProblemRequest) ProtoMessage() {}
+func (*CreateSessionResponse) ProtoMessage() {}
 
-func (x *UpdateProblemRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateSessionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1170,56 +1308,26 @@ func (x *UpdateProblemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProblemRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProblemRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSessionResponse.ProtoReflect.Descriptor instead.
+func (*CreateSessionResponse) Descriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *UpdateProblemRequest) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *UpdateProblemRequest) GetDisplayName() string {
-	if x != nil {
-		return x.DisplayName
-	}
-	return ""
-}
-
-func (x *UpdateProblemRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *UpdateProblemRequest) GetTimeLimit() string {
-	if x != nil {
-		return x.TimeLimit
-	}
-	return ""
-}
-
-func (x *UpdateProblemRequest) GetMemoryLimit() string {
+func (x *CreateSessionResponse) GetAccount() *Account {
 	if x != nil {
-		return x.MemoryLimit
+		return x.Account
 	}
-	return ""
+	return nil
 }
 
-type UpdateProblemResponse struct {
+type DeleteSessionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Problem *Problem `protobuf:"bytes,1,opt,name=problem,proto3" json:"problem,omitempty"`
 }
 
-func (x *UpdateProblemResponse) Reset() {
-	*x = UpdateProblemResponse{}
+func (x *DeleteSessionRequest) Reset() {
+	*x = DeleteSessionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1227,13 +1335,13 @@ func (x *UpdateProblemResponse) Reset() {
 	}
 }
 
-func (x *UpdateProblemResponse) String() string {
+func (x *DeleteSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProblemResponse) ProtoMessage() {}
+func (*DeleteSessionRequest) ProtoMessage() {}
 
-func (x *UpdateProblemResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteSessionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1245,28 +1353,19 @@ func (x *UpdateProblemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProblemResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProblemResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteSessionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSessionRequest) Descriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *UpdateProblemResponse) GetProblem() *Problem {
-	if x != nil {
-		return x.Problem
-	}
-	return nil
-}
-
-type DeleteProblemRequest struct {
+type DeleteSessionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *DeleteProblemRequest) Reset() {
-	*x = DeleteProblemRequest{}
+func (x *DeleteSessionResponse) Reset() {
+	*x = DeleteSessionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1274,13 +1373,13 @@ func (x *DeleteProblemRequest) Reset() {
 	}
 }
 
-func (x *DeleteProblemRequest) String() string {
+func (x *DeleteSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProblemRequest) ProtoMessage() {}
+func (*DeleteSessionResponse) ProtoMessage() {}
 
-func (x *DeleteProblemRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteSessionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1292,26 +1391,19 @@ func (x *DeleteProblemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProblemRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProblemRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteSessionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSessionResponse) Descriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *DeleteProblemRequest) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-type DeleteProblemResponse struct {
+type RefreshSessionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DeleteProblemResponse) Reset() {
-	*x = DeleteProblemResponse{}
+func (x *RefreshSessionRequest) Reset() {
+	*x = RefreshSessionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1319,13 +1411,13 @@ func (x *DeleteProblemResponse) Reset() {
 	}
 }
 
-func (x *DeleteProblemResponse) String() string {
+func (x *RefreshSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProblemResponse) ProtoMessage() {}
+func (*RefreshSessionRequest) ProtoMessage() {}
 
-func (x *DeleteProblemResponse) ProtoReflect() protoreflect.Message {
+func (x *RefreshSessionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1337,24 +1429,21 @@ func (x *DeleteProblemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProblemResponse.ProtoReflect.Descriptor instead.
-func (*DeleteProblemResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshSessionRequest.ProtoReflect.Descriptor instead.
+func (*RefreshSessionRequest) Descriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{21}
 }
 
-type CreateTestCaseRequest struct {
+type RefreshSessionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OfProblemId uint64 `protobuf:"varint,1,opt,name=of_problem_id,json=ofProblemId,proto3" json:"of_problem_id,omitempty"`
-	Input       string `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
-	Output      string `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
-	IsHidden    bool   `protobuf:"varint,4,opt,name=is_hidden,json=isHidden,proto3" json:"is_hidden,omitempty"`
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (x *CreateTestCaseRequest) Reset() {
-	*x = CreateTestCaseRequest{}
+func (x *RefreshSessionResponse) Reset() {
+	*x = RefreshSessionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1362,13 +1451,13 @@ func (x *CreateTestCaseRequest) Reset() {
 	}
 }
 
-func (x *CreateTestCaseRequest) String() string {
+func (x *RefreshSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTestCaseRequest) ProtoMessage() {}
+func (*RefreshSessionResponse) ProtoMessage() {}
 
-func (x *CreateTestCaseRequest) ProtoReflect() protoreflect.Message {
+func (x *RefreshSessionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1380,53 +1469,26 @@ func (x *CreateTestCaseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTestCaseRequest.ProtoReflect.Descriptor instead.
-func (*CreateTestCaseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshSessionResponse.ProtoReflect.Descriptor instead.
+func (*RefreshSessionResponse) Descriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *CreateTestCaseRequest) GetOfProblemId() uint64 {
-	if x != nil {
-		return x.OfProblemId
-	}
-	return 0
-}
-
-func (x *CreateTestCaseRequest) GetInput() string {
-	if x != nil {
-		return x.Input
-	}
-	return ""
-}
-
-func (x *CreateTestCaseRequest) GetOutput() string {
-	if x != nil {
-		return x.Output
-	}
-	return ""
-}
-
-func (x *CreateTestCaseRequest) GetIsHidden() bool {
+func (x *RefreshSessionResponse) GetAccount() *Account {
 	if x != nil {
-		return x.IsHidden
+		return x.Account
 	}
-	return false
+	return nil
 }
 
-type TestCase struct {
+type DeleteAllSessionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	OfProblemId uint64 `protobuf:"varint,2,opt,name=of_problem_id,json=ofProblemId,proto3" json:"of_problem_id,omitempty"`
-	Input       string `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
-	Output      string `protobuf:"bytes,4,opt,name=output,proto3" json:"output,omitempty"`
-	IsHidden    bool   `protobuf:"varint,5,opt,name=is_hidden,json=isHidden,proto3" json:"is_hidden,omitempty"`
 }
 
-func (x *TestCase) Reset() {
-	*x = TestCase{}
+func (x *DeleteAllSessionsRequest) Reset() {
+	*x = DeleteAllSessionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1434,13 +1496,13 @@ func (x *TestCase) Reset() {
 	}
 }
 
-func (x *TestCase) String() string {
+func (x *DeleteAllSessionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestCase) ProtoMessage() {}
+func (*DeleteAllSessionsRequest) ProtoMessage() {}
 
-func (x *TestCase) ProtoReflect() protoreflect.Message {
+func (x *DeleteAllSessionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1452,56 +1514,19 @@ func (x *TestCase) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestCase.ProtoReflect.Descriptor instead.
-func (*TestCase) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteAllSessionsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAllSessionsRequest) Descriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *TestCase) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *TestCase) GetOfProblemId() uint64 {
-	if x != nil {
-		return x.OfProblemId
-	}
-	return 0
-}
-
-func (x *TestCase) GetInput() string {
-	if x != nil {
-		return x.Input
-	}
-	return ""
-}
-
-func (x *TestCase) GetOutput() string {
-	if x != nil {
-		return x.Output
-	}
-	return ""
-}
-
-func (x *TestCase) GetIsHidden() bool {
-	if x != nil {
-		return x.IsHidden
-	}
-	return false
-}
-
-type CreateTestCaseResponse struct {
+type DeleteAllSessionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	TestCase *TestCase `protobuf:"bytes,1,opt,name=test_case,json=testCase,proto3" json:"test_case,omitempty"`
 }
 
-func (x *CreateTestCaseResponse) Reset() {
-	*x = CreateTestCaseResponse{}
+func (x *DeleteAllSessionsResponse) Reset() {
+	*x = DeleteAllSessionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1509,13 +1534,13 @@ func (x *CreateTestCaseResponse) Reset() {
 	}
 }
 
-func (x *CreateTestCaseResponse) String() string {
+func (x *DeleteAllSessionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTestCaseResponse) ProtoMessage() {}
+func (*DeleteAllSessionsResponse) ProtoMessage() {}
 
-func (x *CreateTestCaseResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteAllSessionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1527,30 +1552,27 @@ func (x *CreateTestCaseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTestCaseResponse.ProtoReflect.Descriptor instead.
-func (*CreateTestCaseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteAllSessionsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAllSessionsResponse) Descriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *CreateTestCaseResponse) GetTestCase() *TestCase {
-	if x != nil {
-		return x.TestCase
-	}
-	return nil
-}
-
-type GetProblemTestCaseListRequest struct {
+// JSONWebKey is a public key verifying tokens, in the JSON Web Key format of RFC 7517.
+type JSONWebKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit  uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Kid string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	Kty string `protobuf:"bytes,2,opt,name=kty,proto3" json:"kty,omitempty"`
+	Alg string `protobuf:"bytes,3,opt,name=alg,proto3" json:"alg,omitempty"`
+	Use string `protobuf:"bytes,4,opt,name=use,proto3" json:"use,omitempty"`
+	N   string `protobuf:"bytes,5,opt,name=n,proto3" json:"n,omitempty"`
+	E   string `protobuf:"bytes,6,opt,name=e,proto3" json:"e,omitempty"`
 }
 
-func (x *GetProblemTestCaseListRequest) Reset() {
-	*x = GetProblemTestCaseListRequest{}
+func (x *JSONWebKey) Reset() {
+	*x = JSONWebKey{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1558,13 +1580,13 @@ func (x *GetProblemTestCaseListRequest) Reset() {
 	}
 }
 
-func (x *GetProblemTestCaseListRequest) String() string {
+func (x *JSONWebKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProblemTestCaseListRequest) ProtoMessage() {}
+func (*JSONWebKey) ProtoMessage() {}
 
-func (x *GetProblemTestCaseListRequest) ProtoReflect() protoreflect.Message {
+func (x *JSONWebKey) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1576,43 +1598,61 @@ func (x *GetProblemTestCaseListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProblemTestCaseListRequest.ProtoReflect.Descriptor instead.
-func (*GetProblemTestCaseListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use JSONWebKey.ProtoReflect.Descriptor instead.
+func (*JSONWebKey) Descriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetProblemTestCaseListRequest) GetId() uint64 {
+func (x *JSONWebKey) GetKid() string {
 	if x != nil {
-		return x.Id
+		return x.Kid
 	}
-	return 0
+	return ""
 }
 
-func (x *GetProblemTestCaseListRequest) GetOffset() uint64 {
+func (x *JSONWebKey) GetKty() string {
 	if x != nil {
-		return x.Offset
+		return x.Kty
 	}
-	return 0
+	return ""
 }
 
-func (x *GetProblemTestCaseListRequest) GetLimit() uint64 {
+func (x *JSONWebKey) GetAlg() string {
 	if x != nil {
-		return x.Limit
+		return x.Alg
 	}
-	return 0
+	return ""
 }
 
-type GetProblemTestCaseListResponse struct {
+func (x *JSONWebKey) GetUse() string {
+	if x != nil {
+		return x.Use
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetN() string {
+	if x != nil {
+		return x.N
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetE() string {
+	if x != nil {
+		return x.E
+	}
+	return ""
+}
+
+type GetJSONWebKeySetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	TestCases           []*TestCase `protobuf:"bytes,1,rep,name=test_cases,json=testCases,proto3" json:"test_cases,omitempty"`
-	TotalTestCasesCount uint64      `protobuf:"varint,2,opt,name=total_test_cases_count,json=totalTestCasesCount,proto3" json:"total_test_cases_count,omitempty"`
 }
 
-func (x *GetProblemTestCaseListResponse) Reset() {
-	*x = GetProblemTestCaseListResponse{}
+func (x *GetJSONWebKeySetRequest) Reset() {
+	*x = GetJSONWebKeySetRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1620,13 +1660,13 @@ func (x *GetProblemTestCaseListResponse) Reset() {
 	}
 }
 
-func (x *GetProblemTestCaseListResponse) String() string {
+func (x *GetJSONWebKeySetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProblemTestCaseListResponse) ProtoMessage() {}
+func (*GetJSONWebKeySetRequest) ProtoMessage() {}
 
-func (x *GetProblemTestCaseListResponse) ProtoReflect() protoreflect.Message {
+func (x *GetJSONWebKeySetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1638,35 +1678,21 @@ func (x *GetProblemTestCaseListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProblemTestCaseListResponse.ProtoReflect.Descriptor instead.
-func (*GetProblemTestCaseListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetJSONWebKeySetRequest.ProtoReflect.Descriptor instead.
+func (*GetJSONWebKeySetRequest) Descriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetProblemTestCaseListResponse) GetTestCases() []*TestCase {
-	if x != nil {
-		return x.TestCases
-	}
-	return nil
-}
-
-func (x *GetProblemTestCaseListResponse) GetTotalTestCasesCount() uint64 {
-	if x != nil {
-		return x.TotalTestCasesCount
-	}
-	return 0
-}
-
-type GetTestCaseRequest struct {
+type GetJSONWebKeySetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Keys []*JSONWebKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
-func (x *GetTestCaseRequest) Reset() {
-	*x = GetTestCaseRequest{}
+func (x *GetJSONWebKeySetResponse) Reset() {
+	*x = GetJSONWebKeySetResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1674,13 +1700,13 @@ func (x *GetTestCaseRequest) Reset() {
 	}
 }
 
-func (x *GetTestCaseRequest) String() string {
+func (x *GetJSONWebKeySetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTestCaseRequest) ProtoMessage() {}
+func (*GetJSONWebKeySetResponse) ProtoMessage() {}
 
-func (x *GetTestCaseRequest) ProtoReflect() protoreflect.Message {
+func (x *GetJSONWebKeySetResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1692,28 +1718,34 @@ func (x *GetTestCaseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTestCaseRequest.ProtoReflect.Descriptor instead.
-func (*GetTestCaseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetJSONWebKeySetResponse.ProtoReflect.Descriptor instead.
+func (*GetJSONWebKeySetResponse) Descriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetTestCaseRequest) GetId() uint64 {
+func (x *GetJSONWebKeySetResponse) GetKeys() []*JSONWebKey {
 	if x != nil {
-		return x.Id
+		return x.Keys
 	}
-	return 0
+	return nil
 }
 
-type GetTestCaseResponse struct {
+type CreateProblemRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TestCase *TestCase `protobuf:"bytes,1,opt,name=test_case,json=testCase,proto3" json:"test_case,omitempty"`
+	DisplayName    string               `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Description    string               `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	TimeLimit      string               `protobuf:"bytes,3,opt,name=time_limit,json=timeLimit,proto3" json:"time_limit,omitempty"`
+	MemoryLimit    string               `protobuf:"bytes,4,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
+	ComparisonMode OutputComparisonMode `protobuf:"varint,5,opt,name=comparison_mode,json=comparisonMode,proto3,enum=ojs.OutputComparisonMode" json:"comparison_mode,omitempty"`
+	// Maximum absolute or relative error of numbers, used by the Float comparison mode.
+	FloatEpsilon float64 `protobuf:"fixed64,6,opt,name=float_epsilon,json=floatEpsilon,proto3" json:"float_epsilon,omitempty"`
 }
 
-func (x *GetTestCaseResponse) Reset() {
-	*x = GetTestCaseResponse{}
+func (x *CreateProblemRequest) Reset() {
+	*x = CreateProblemRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1721,13 +1753,13 @@ func (x *GetTestCaseResponse) Reset() {
 	}
 }
 
-func (x *GetTestCaseResponse) String() string {
+func (x *CreateProblemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTestCaseResponse) ProtoMessage() {}
+func (*CreateProblemRequest) ProtoMessage() {}
 
-func (x *GetTestCaseResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateProblemRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1739,31 +1771,75 @@ func (x *GetTestCaseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTestCaseResponse.ProtoReflect.Descriptor instead.
-func (*GetTestCaseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateProblemRequest.ProtoReflect.Descriptor instead.
+func (*CreateProblemRequest) Descriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetTestCaseResponse) GetTestCase() *TestCase {
+func (x *CreateProblemRequest) GetDisplayName() string {
 	if x != nil {
-		return x.TestCase
+		return x.DisplayName
 	}
-	return nil
+	return ""
 }
 
-type UpdateTestCaseRequest struct {
+func (x *CreateProblemRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateProblemRequest) GetTimeLimit() string {
+	if x != nil {
+		return x.TimeLimit
+	}
+	return ""
+}
+
+func (x *CreateProblemRequest) GetMemoryLimit() string {
+	if x != nil {
+		return x.MemoryLimit
+	}
+	return ""
+}
+
+func (x *CreateProblemRequest) GetComparisonMode() OutputComparisonMode {
+	if x != nil {
+		return x.ComparisonMode
+	}
+	return OutputComparisonMode_UndefinedComparisonMode
+}
+
+func (x *CreateProblemRequest) GetFloatEpsilon() float64 {
+	if x != nil {
+		return x.FloatEpsilon
+	}
+	return 0
+}
+
+type Problem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Input    string `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
-	Output   string `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
-	IsHidden bool   `protobuf:"varint,4,opt,name=is_hidden,json=isHidden,proto3" json:"is_hidden,omitempty"`
+	Id                 uint64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DisplayName        string               `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	AuthorId           uint64               `protobuf:"varint,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	AuthorName         string               `protobuf:"bytes,4,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	Description        string               `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	TimeLimit          string               `protobuf:"bytes,6,opt,name=time_limit,json=timeLimit,proto3" json:"time_limit,omitempty"`
+	MemoryLimit        string               `protobuf:"bytes,7,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
+	HasChecker         bool                 `protobuf:"varint,8,opt,name=has_checker,json=hasChecker,proto3" json:"has_checker,omitempty"`
+	CheckerLanguage    string               `protobuf:"bytes,9,opt,name=checker_language,json=checkerLanguage,proto3" json:"checker_language,omitempty"`
+	ComparisonMode     OutputComparisonMode `protobuf:"varint,10,opt,name=comparison_mode,json=comparisonMode,proto3,enum=ojs.OutputComparisonMode" json:"comparison_mode,omitempty"`
+	FloatEpsilon       float64              `protobuf:"fixed64,11,opt,name=float_epsilon,json=floatEpsilon,proto3" json:"float_epsilon,omitempty"`
+	HasInteractor      bool                 `protobuf:"varint,12,opt,name=has_interactor,json=hasInteractor,proto3" json:"has_interactor,omitempty"`
+	InteractorLanguage string               `protobuf:"bytes,13,opt,name=interactor_language,json=interactorLanguage,proto3" json:"interactor_language,omitempty"`
 }
 
-func (x *UpdateTestCaseRequest) Reset() {
-	*x = UpdateTestCaseRequest{}
+func (x *Problem) Reset() {
+	*x = Problem{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1771,13 +1847,13 @@ func (x *UpdateTestCaseRequest) Reset() {
 	}
 }
 
-func (x *UpdateTestCaseRequest) String() string {
+func (x *Problem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTestCaseRequest) ProtoMessage() {}
+func (*Problem) ProtoMessage() {}
 
-func (x *UpdateTestCaseRequest) ProtoReflect() protoreflect.Message {
+func (x *Problem) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1789,49 +1865,112 @@ func (x *UpdateTestCaseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTestCaseRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTestCaseRequest) Descriptor() ([]byte, []int) {
-	return file_ojs_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use Problem.ProtoReflect.Descriptor instead.
+func (*Problem) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *UpdateTestCaseRequest) GetId() uint64 {
+func (x *Problem) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateTestCaseRequest) GetInput() string {
+func (x *Problem) GetDisplayName() string {
 	if x != nil {
-		return x.Input
+		return x.DisplayName
 	}
 	return ""
 }
 
-func (x *UpdateTestCaseRequest) GetOutput() string {
+func (x *Problem) GetAuthorId() uint64 {
 	if x != nil {
-		return x.Output
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *Problem) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
 	}
 	return ""
 }
 
-func (x *UpdateTestCaseRequest) GetIsHidden() bool {
+func (x *Problem) GetDescription() string {
 	if x != nil {
-		return x.IsHidden
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Problem) GetTimeLimit() string {
+	if x != nil {
+		return x.TimeLimit
+	}
+	return ""
+}
+
+func (x *Problem) GetMemoryLimit() string {
+	if x != nil {
+		return x.MemoryLimit
+	}
+	return ""
+}
+
+func (x *Problem) GetHasChecker() bool {
+	if x != nil {
+		return x.HasChecker
 	}
 	return false
 }
 
-type UpdateTestCaseResponse struct {
+func (x *Problem) GetCheckerLanguage() string {
+	if x != nil {
+		return x.CheckerLanguage
+	}
+	return ""
+}
+
+func (x *Problem) GetComparisonMode() OutputComparisonMode {
+	if x != nil {
+		return x.ComparisonMode
+	}
+	return OutputComparisonMode_UndefinedComparisonMode
+}
+
+func (x *Problem) GetFloatEpsilon() float64 {
+	if x != nil {
+		return x.FloatEpsilon
+	}
+	return 0
+}
+
+func (x *Problem) GetHasInteractor() bool {
+	if x != nil {
+		return x.HasInteractor
+	}
+	return false
+}
+
+func (x *Problem) GetInteractorLanguage() string {
+	if x != nil {
+		return x.InteractorLanguage
+	}
+	return ""
+}
+
+type CreateProblemResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TestCase *TestCase `protobuf:"bytes,1,opt,name=test_case,json=testCase,proto3" json:"test_case,omitempty"`
+	Problem *Problem `protobuf:"bytes,1,opt,name=problem,proto3" json:"problem,omitempty"`
 }
 
-func (x *UpdateTestCaseResponse) Reset() {
-	*x = UpdateTestCaseResponse{}
+func (x *CreateProblemResponse) Reset() {
+	*x = CreateProblemResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1839,13 +1978,13 @@ func (x *UpdateTestCaseResponse) Reset() {
 	}
 }
 
-func (x *UpdateTestCaseResponse) String() string {
+func (x *CreateProblemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTestCaseResponse) ProtoMessage() {}
+func (*CreateProblemResponse) ProtoMessage() {}
 
-func (x *UpdateTestCaseResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateProblemResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1857,28 +1996,29 @@ func (x *UpdateTestCaseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTestCaseResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTestCaseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateProblemResponse.ProtoReflect.Descriptor instead.
+func (*CreateProblemResponse) Descriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *UpdateTestCaseResponse) GetTestCase() *TestCase {
+func (x *CreateProblemResponse) GetProblem() *Problem {
 	if x != nil {
-		return x.TestCase
+		return x.Problem
 	}
 	return nil
 }
 
-type DeleteTestCaseRequest struct {
+type GetProblemListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *DeleteTestCaseRequest) Reset() {
-	*x = DeleteTestCaseRequest{}
+func (x *GetProblemListRequest) Reset() {
+	*x = GetProblemListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1886,13 +2026,13 @@ func (x *DeleteTestCaseRequest) Reset() {
 	}
 }
 
-func (x *DeleteTestCaseRequest) String() string {
+func (x *GetProblemListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTestCaseRequest) ProtoMessage() {}
+func (*GetProblemListRequest) ProtoMessage() {}
 
-func (x *DeleteTestCaseRequest) ProtoReflect() protoreflect.Message {
+func (x *GetProblemListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1904,26 +2044,36 @@ func (x *DeleteTestCaseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTestCaseRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTestCaseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetProblemListRequest.ProtoReflect.Descriptor instead.
+func (*GetProblemListRequest) Descriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *DeleteTestCaseRequest) GetId() uint64 {
+func (x *GetProblemListRequest) GetOffset() uint64 {
 	if x != nil {
-		return x.Id
+		return x.Offset
 	}
 	return 0
 }
 
-type DeleteTestCaseResponse struct {
+func (x *GetProblemListRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetProblemListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Problems          []*Problem `protobuf:"bytes,1,rep,name=problems,proto3" json:"problems,omitempty"`
+	TotalProblemCount uint64     `protobuf:"varint,2,opt,name=total_problem_count,json=totalProblemCount,proto3" json:"total_problem_count,omitempty"`
 }
 
-func (x *DeleteTestCaseResponse) Reset() {
-	*x = DeleteTestCaseResponse{}
+func (x *GetProblemListResponse) Reset() {
+	*x = GetProblemListResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1931,13 +2081,13 @@ func (x *DeleteTestCaseResponse) Reset() {
 	}
 }
 
-func (x *DeleteTestCaseResponse) String() string {
+func (x *GetProblemListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTestCaseResponse) ProtoMessage() {}
+func (*GetProblemListResponse) ProtoMessage() {}
 
-func (x *DeleteTestCaseResponse) ProtoReflect() protoreflect.Message {
+func (x *GetProblemListResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1949,23 +2099,35 @@ func (x *DeleteTestCaseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTestCaseResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTestCaseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetProblemListResponse.ProtoReflect.Descriptor instead.
+func (*GetProblemListResponse) Descriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{32}
 }
 
-type CreateSubmissionRequest struct {
+func (x *GetProblemListResponse) GetProblems() []*Problem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+func (x *GetProblemListResponse) GetTotalProblemCount() uint64 {
+	if x != nil {
+		return x.TotalProblemCount
+	}
+	return 0
+}
+
+type GetProblemRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OfProblemId uint64 `protobuf:"varint,1,opt,name=of_problem_id,json=ofProblemId,proto3" json:"of_problem_id,omitempty"`
-	Content     string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	Language    string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *CreateSubmissionRequest) Reset() {
-	*x = CreateSubmissionRequest{}
+func (x *GetProblemRequest) Reset() {
+	*x = GetProblemRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ojs_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1973,13 +2135,13 @@ func (x *CreateSubmissionRequest) Reset() {
 	}
 }
 
-func (x *CreateSubmissionRequest) String() string {
+func (x *GetProblemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSubmissionRequest) ProtoMessage() {}
+func (*GetProblemRequest) ProtoMessage() {}
 
-func (x *CreateSubmissionRequest) ProtoReflect() protoreflect.Message {
+func (x *GetProblemRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ojs_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1991,63 +2153,96 @@ func (x *CreateSubmissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSubmissionRequest.ProtoReflect.Descriptor instead.
-func (*CreateSubmissionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetProblemRequest.ProtoReflect.Descriptor instead.
+func (*GetProblemRequest) Descriptor() ([]byte, []int) {
 	return file_ojs_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *CreateSubmissionRequest) GetOfProblemId() uint64 {
+func (x *GetProblemRequest) GetId() uint64 {
 	if x != nil {
-		return x.OfProblemId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *CreateSubmissionRequest) GetContent() string {
-	if x != nil {
-		return x.Content
+type GetProblemResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Problem *Problem `protobuf:"bytes,1,opt,name=problem,proto3" json:"problem,omitempty"`
+}
+
+func (x *GetProblemResponse) Reset() {
+	*x = GetProblemResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *CreateSubmissionRequest) GetLanguage() string {
+func (x *GetProblemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProblemResponse) ProtoMessage() {}
+
+func (x *GetProblemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProblemResponse.ProtoReflect.Descriptor instead.
+func (*GetProblemResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetProblemResponse) GetProblem() *Problem {
 	if x != nil {
-		return x.Language
+		return x.Problem
 	}
-	return ""
+	return nil
 }
 
-type Submission struct {
+type UpdateProblemRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          uint64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	OfProblemId uint64           `protobuf:"varint,2,opt,name=of_problem_id,json=ofProblemId,proto3" json:"of_problem_id,omitempty"`
-	AuthorId    uint64           `protobuf:"varint,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	Content     string           `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	Language    string           `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
-	Status      SubmissionStatus `protobuf:"varint,6,opt,name=status,proto3,enum=ojs.SubmissionStatus" json:"status,omitempty"`
-	Result      SubmissionResult `protobuf:"varint,7,opt,name=result,proto3,enum=ojs.SubmissionResult" json:"result,omitempty"`
+	Id             uint64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DisplayName    string               `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Description    string               `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	TimeLimit      string               `protobuf:"bytes,4,opt,name=time_limit,json=timeLimit,proto3" json:"time_limit,omitempty"`
+	MemoryLimit    string               `protobuf:"bytes,5,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
+	ComparisonMode OutputComparisonMode `protobuf:"varint,6,opt,name=comparison_mode,json=comparisonMode,proto3,enum=ojs.OutputComparisonMode" json:"comparison_mode,omitempty"`
+	FloatEpsilon   float64              `protobuf:"fixed64,7,opt,name=float_epsilon,json=floatEpsilon,proto3" json:"float_epsilon,omitempty"`
 }
 
-func (x *Submission) Reset() {
-	*x = Submission{}
+func (x *UpdateProblemRequest) Reset() {
+	*x = UpdateProblemRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ojs_proto_msgTypes[34]
+		mi := &file_ojs_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Submission) String() string {
+func (x *UpdateProblemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Submission) ProtoMessage() {}
+func (*UpdateProblemRequest) ProtoMessage() {}
 
-func (x *Submission) ProtoReflect() protoreflect.Message {
-	mi := &file_ojs_proto_msgTypes[34]
+func (x *UpdateProblemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2058,85 +2253,85 @@ func (x *Submission) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Submission.ProtoReflect.Descriptor instead.
-func (*Submission) Descriptor() ([]byte, []int) {
-	return file_ojs_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use UpdateProblemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProblemRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *Submission) GetId() uint64 {
+func (x *UpdateProblemRequest) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Submission) GetOfProblemId() uint64 {
+func (x *UpdateProblemRequest) GetDisplayName() string {
 	if x != nil {
-		return x.OfProblemId
+		return x.DisplayName
 	}
-	return 0
+	return ""
 }
 
-func (x *Submission) GetAuthorId() uint64 {
+func (x *UpdateProblemRequest) GetDescription() string {
 	if x != nil {
-		return x.AuthorId
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *Submission) GetContent() string {
+func (x *UpdateProblemRequest) GetTimeLimit() string {
 	if x != nil {
-		return x.Content
+		return x.TimeLimit
 	}
 	return ""
 }
 
-func (x *Submission) GetLanguage() string {
+func (x *UpdateProblemRequest) GetMemoryLimit() string {
 	if x != nil {
-		return x.Language
+		return x.MemoryLimit
 	}
 	return ""
 }
 
-func (x *Submission) GetStatus() SubmissionStatus {
+func (x *UpdateProblemRequest) GetComparisonMode() OutputComparisonMode {
 	if x != nil {
-		return x.Status
+		return x.ComparisonMode
 	}
-	return SubmissionStatus_UndefinedStatus
+	return OutputComparisonMode_UndefinedComparisonMode
 }
 
-func (x *Submission) GetResult() SubmissionResult {
+func (x *UpdateProblemRequest) GetFloatEpsilon() float64 {
 	if x != nil {
-		return x.Result
+		return x.FloatEpsilon
 	}
-	return SubmissionResult_UndefinedResult
+	return 0
 }
 
-type CreateSubmissionResponse struct {
+type UpdateProblemResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Submission *Submission `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+	Problem *Problem `protobuf:"bytes,1,opt,name=problem,proto3" json:"problem,omitempty"`
 }
 
-func (x *CreateSubmissionResponse) Reset() {
-	*x = CreateSubmissionResponse{}
+func (x *UpdateProblemResponse) Reset() {
+	*x = UpdateProblemResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ojs_proto_msgTypes[35]
+		mi := &file_ojs_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateSubmissionResponse) String() string {
+func (x *UpdateProblemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSubmissionResponse) ProtoMessage() {}
+func (*UpdateProblemResponse) ProtoMessage() {}
 
-func (x *CreateSubmissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ojs_proto_msgTypes[35]
+func (x *UpdateProblemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2147,19 +2342,19 @@ func (x *CreateSubmissionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSubmissionResponse.ProtoReflect.Descriptor instead.
-func (*CreateSubmissionResponse) Descriptor() ([]byte, []int) {
-	return file_ojs_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use UpdateProblemResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProblemResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *CreateSubmissionResponse) GetSubmission() *Submission {
+func (x *UpdateProblemResponse) GetProblem() *Problem {
 	if x != nil {
-		return x.Submission
+		return x.Problem
 	}
 	return nil
 }
 
-type GetSubmissionRequest struct {
+type DeleteProblemRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2167,23 +2362,23 @@ type GetSubmissionRequest struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetSubmissionRequest) Reset() {
-	*x = GetSubmissionRequest{}
+func (x *DeleteProblemRequest) Reset() {
+	*x = DeleteProblemRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ojs_proto_msgTypes[36]
+		mi := &file_ojs_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSubmissionRequest) String() string {
+func (x *DeleteProblemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubmissionRequest) ProtoMessage() {}
+func (*DeleteProblemRequest) ProtoMessage() {}
 
-func (x *GetSubmissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ojs_proto_msgTypes[36]
+func (x *DeleteProblemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2194,43 +2389,41 @@ func (x *GetSubmissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSubmissionRequest.ProtoReflect.Descriptor instead.
-func (*GetSubmissionRequest) Descriptor() ([]byte, []int) {
-	return file_ojs_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use DeleteProblemRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProblemRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetSubmissionRequest) GetId() uint64 {
+func (x *DeleteProblemRequest) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type GetSubmissionResponse struct {
+type DeleteProblemResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Submission *Submission `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
 }
 
-func (x *GetSubmissionResponse) Reset() {
-	*x = GetSubmissionResponse{}
+func (x *DeleteProblemResponse) Reset() {
+	*x = DeleteProblemResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ojs_proto_msgTypes[37]
+		mi := &file_ojs_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSubmissionResponse) String() string {
+func (x *DeleteProblemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubmissionResponse) ProtoMessage() {}
+func (*DeleteProblemResponse) ProtoMessage() {}
 
-func (x *GetSubmissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ojs_proto_msgTypes[37]
+func (x *DeleteProblemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2241,44 +2434,39 @@ func (x *GetSubmissionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSubmissionResponse.ProtoReflect.Descriptor instead.
-func (*GetSubmissionResponse) Descriptor() ([]byte, []int) {
-	return file_ojs_proto_rawDescGZIP(), []int{37}
-}
-
-func (x *GetSubmissionResponse) GetSubmission() *Submission {
-	if x != nil {
-		return x.Submission
-	}
-	return nil
+// Deprecated: Use DeleteProblemResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProblemResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{38}
 }
 
-type GetSubmissionListRequest struct {
+type UpdateProblemCheckerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit  uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Language string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	// An empty source removes the checker of the problem.
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
 }
 
-func (x *GetSubmissionListRequest) Reset() {
-	*x = GetSubmissionListRequest{}
+func (x *UpdateProblemCheckerRequest) Reset() {
+	*x = UpdateProblemCheckerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ojs_proto_msgTypes[38]
+		mi := &file_ojs_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSubmissionListRequest) String() string {
+func (x *UpdateProblemCheckerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubmissionListRequest) ProtoMessage() {}
+func (*UpdateProblemCheckerRequest) ProtoMessage() {}
 
-func (x *GetSubmissionListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ojs_proto_msgTypes[38]
+func (x *UpdateProblemCheckerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2289,51 +2477,57 @@ func (x *GetSubmissionListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSubmissionListRequest.ProtoReflect.Descriptor instead.
-func (*GetSubmissionListRequest) Descriptor() ([]byte, []int) {
-	return file_ojs_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use UpdateProblemCheckerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProblemCheckerRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetSubmissionListRequest) GetOffset() uint64 {
+func (x *UpdateProblemCheckerRequest) GetId() uint64 {
 	if x != nil {
-		return x.Offset
+		return x.Id
 	}
 	return 0
 }
 
-func (x *GetSubmissionListRequest) GetLimit() uint64 {
+func (x *UpdateProblemCheckerRequest) GetLanguage() string {
 	if x != nil {
-		return x.Limit
+		return x.Language
 	}
-	return 0
+	return ""
 }
 
-type GetSubmissionListResponse struct {
+func (x *UpdateProblemCheckerRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type UpdateProblemCheckerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Submissions           []*Submission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
-	TotalSubmissionsCount uint64        `protobuf:"varint,2,opt,name=total_submissions_count,json=totalSubmissionsCount,proto3" json:"total_submissions_count,omitempty"`
+	Problem *Problem `protobuf:"bytes,1,opt,name=problem,proto3" json:"problem,omitempty"`
 }
 
-func (x *GetSubmissionListResponse) Reset() {
-	*x = GetSubmissionListResponse{}
+func (x *UpdateProblemCheckerResponse) Reset() {
+	*x = UpdateProblemCheckerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ojs_proto_msgTypes[39]
+		mi := &file_ojs_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSubmissionListResponse) String() string {
+func (x *UpdateProblemCheckerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubmissionListResponse) ProtoMessage() {}
+func (*UpdateProblemCheckerResponse) ProtoMessage() {}
 
-func (x *GetSubmissionListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ojs_proto_msgTypes[39]
+func (x *UpdateProblemCheckerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2344,52 +2538,46 @@ func (x *GetSubmissionListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSubmissionListResponse.ProtoReflect.Descriptor instead.
-func (*GetSubmissionListResponse) Descriptor() ([]byte, []int) {
-	return file_ojs_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use UpdateProblemCheckerResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProblemCheckerResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetSubmissionListResponse) GetSubmissions() []*Submission {
+func (x *UpdateProblemCheckerResponse) GetProblem() *Problem {
 	if x != nil {
-		return x.Submissions
+		return x.Problem
 	}
 	return nil
 }
 
-func (x *GetSubmissionListResponse) GetTotalSubmissionsCount() uint64 {
-	if x != nil {
-		return x.TotalSubmissionsCount
-	}
-	return 0
-}
-
-type GetProblemSubmissionListRequest struct {
+type UpdateProblemInteractorRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit  uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Language string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	// An empty source removes the interactor, making the problem non-interactive again.
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
 }
 
-func (x *GetProblemSubmissionListRequest) Reset() {
-	*x = GetProblemSubmissionListRequest{}
+func (x *UpdateProblemInteractorRequest) Reset() {
+	*x = UpdateProblemInteractorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ojs_proto_msgTypes[40]
+		mi := &file_ojs_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetProblemSubmissionListRequest) String() string {
+func (x *UpdateProblemInteractorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProblemSubmissionListRequest) ProtoMessage() {}
+func (*UpdateProblemInteractorRequest) ProtoMessage() {}
 
-func (x *GetProblemSubmissionListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ojs_proto_msgTypes[40]
+func (x *UpdateProblemInteractorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2400,58 +2588,57 @@ func (x *GetProblemSubmissionListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProblemSubmissionListRequest.ProtoReflect.Descriptor instead.
-func (*GetProblemSubmissionListRequest) Descriptor() ([]byte, []int) {
-	return file_ojs_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use UpdateProblemInteractorRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProblemInteractorRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetProblemSubmissionListRequest) GetId() uint64 {
+func (x *UpdateProblemInteractorRequest) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *GetProblemSubmissionListRequest) GetOffset() uint64 {
+func (x *UpdateProblemInteractorRequest) GetLanguage() string {
 	if x != nil {
-		return x.Offset
+		return x.Language
 	}
-	return 0
+	return ""
 }
 
-func (x *GetProblemSubmissionListRequest) GetLimit() uint64 {
+func (x *UpdateProblemInteractorRequest) GetSource() string {
 	if x != nil {
-		return x.Limit
+		return x.Source
 	}
-	return 0
+	return ""
 }
 
-type GetProblemSubmissionListResponse struct {
+type UpdateProblemInteractorResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Submissions           []*Submission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
-	TotalSubmissionsCount uint64        `protobuf:"varint,2,opt,name=total_submissions_count,json=totalSubmissionsCount,proto3" json:"total_submissions_count,omitempty"`
+	Problem *Problem `protobuf:"bytes,1,opt,name=problem,proto3" json:"problem,omitempty"`
 }
 
-func (x *GetProblemSubmissionListResponse) Reset() {
-	*x = GetProblemSubmissionListResponse{}
+func (x *UpdateProblemInteractorResponse) Reset() {
+	*x = UpdateProblemInteractorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ojs_proto_msgTypes[41]
+		mi := &file_ojs_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetProblemSubmissionListResponse) String() string {
+func (x *UpdateProblemInteractorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProblemSubmissionListResponse) ProtoMessage() {}
+func (*UpdateProblemInteractorResponse) ProtoMessage() {}
 
-func (x *GetProblemSubmissionListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ojs_proto_msgTypes[41]
+func (x *UpdateProblemInteractorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2462,53 +2649,47 @@ func (x *GetProblemSubmissionListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProblemSubmissionListResponse.ProtoReflect.Descriptor instead.
-func (*GetProblemSubmissionListResponse) Descriptor() ([]byte, []int) {
-	return file_ojs_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use UpdateProblemInteractorResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProblemInteractorResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetProblemSubmissionListResponse) GetSubmissions() []*Submission {
+func (x *UpdateProblemInteractorResponse) GetProblem() *Problem {
 	if x != nil {
-		return x.Submissions
+		return x.Problem
 	}
 	return nil
 }
 
-func (x *GetProblemSubmissionListResponse) GetTotalSubmissionsCount() uint64 {
-	if x != nil {
-		return x.TotalSubmissionsCount
-	}
-	return 0
-}
-
-type GetAccountProblemSubmissionListRequest struct {
+type CreateTestCaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	ProblemId uint64 `protobuf:"varint,2,opt,name=problem_id,json=problemId,proto3" json:"problem_id,omitempty"`
-	Offset    uint64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit     uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	OfProblemId       uint64 `protobuf:"varint,1,opt,name=of_problem_id,json=ofProblemId,proto3" json:"of_problem_id,omitempty"`
+	Input             string `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	Output            string `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+	IsHidden          bool   `protobuf:"varint,4,opt,name=is_hidden,json=isHidden,proto3" json:"is_hidden,omitempty"`
+	OfTestCaseGroupId uint64 `protobuf:"varint,5,opt,name=of_test_case_group_id,json=ofTestCaseGroupId,proto3" json:"of_test_case_group_id,omitempty"`
 }
 
-func (x *GetAccountProblemSubmissionListRequest) Reset() {
-	*x = GetAccountProblemSubmissionListRequest{}
+func (x *CreateTestCaseRequest) Reset() {
+	*x = CreateTestCaseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ojs_proto_msgTypes[42]
+		mi := &file_ojs_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAccountProblemSubmissionListRequest) String() string {
+func (x *CreateTestCaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAccountProblemSubmissionListRequest) ProtoMessage() {}
+func (*CreateTestCaseRequest) ProtoMessage() {}
 
-func (x *GetAccountProblemSubmissionListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ojs_proto_msgTypes[42]
+func (x *CreateTestCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2519,65 +2700,76 @@ func (x *GetAccountProblemSubmissionListRequest) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAccountProblemSubmissionListRequest.ProtoReflect.Descriptor instead.
-func (*GetAccountProblemSubmissionListRequest) Descriptor() ([]byte, []int) {
-	return file_ojs_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use CreateTestCaseRequest.ProtoReflect.Descriptor instead.
+func (*CreateTestCaseRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetAccountProblemSubmissionListRequest) GetAccountId() uint64 {
+func (x *CreateTestCaseRequest) GetOfProblemId() uint64 {
 	if x != nil {
-		return x.AccountId
+		return x.OfProblemId
 	}
 	return 0
 }
 
-func (x *GetAccountProblemSubmissionListRequest) GetProblemId() uint64 {
+func (x *CreateTestCaseRequest) GetInput() string {
 	if x != nil {
-		return x.ProblemId
+		return x.Input
 	}
-	return 0
+	return ""
 }
 
-func (x *GetAccountProblemSubmissionListRequest) GetOffset() uint64 {
+func (x *CreateTestCaseRequest) GetOutput() string {
 	if x != nil {
-		return x.Offset
+		return x.Output
 	}
-	return 0
+	return ""
 }
 
-func (x *GetAccountProblemSubmissionListRequest) GetLimit() uint64 {
+func (x *CreateTestCaseRequest) GetIsHidden() bool {
 	if x != nil {
-		return x.Limit
+		return x.IsHidden
+	}
+	return false
+}
+
+func (x *CreateTestCaseRequest) GetOfTestCaseGroupId() uint64 {
+	if x != nil {
+		return x.OfTestCaseGroupId
 	}
 	return 0
 }
 
-type GetAccountProblemSubmissionListResponse struct {
+type TestCase struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Submissions           []*Submission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
-	TotalSubmissionsCount uint64        `protobuf:"varint,2,opt,name=total_submissions_count,json=totalSubmissionsCount,proto3" json:"total_submissions_count,omitempty"`
+	Id                uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OfProblemId       uint64 `protobuf:"varint,2,opt,name=of_problem_id,json=ofProblemId,proto3" json:"of_problem_id,omitempty"`
+	Input             string `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
+	Output            string `protobuf:"bytes,4,opt,name=output,proto3" json:"output,omitempty"`
+	IsHidden          bool   `protobuf:"varint,5,opt,name=is_hidden,json=isHidden,proto3" json:"is_hidden,omitempty"`
+	OfTestCaseGroupId uint64 `protobuf:"varint,6,opt,name=of_test_case_group_id,json=ofTestCaseGroupId,proto3" json:"of_test_case_group_id,omitempty"`
 }
 
-func (x *GetAccountProblemSubmissionListResponse) Reset() {
-	*x = GetAccountProblemSubmissionListResponse{}
+func (x *TestCase) Reset() {
+	*x = TestCase{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ojs_proto_msgTypes[43]
+		mi := &file_ojs_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAccountProblemSubmissionListResponse) String() string {
+func (x *TestCase) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAccountProblemSubmissionListResponse) ProtoMessage() {}
+func (*TestCase) ProtoMessage() {}
 
-func (x *GetAccountProblemSubmissionListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ojs_proto_msgTypes[43]
+func (x *TestCase) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2588,48 +2780,78 @@ func (x *GetAccountProblemSubmissionListResponse) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAccountProblemSubmissionListResponse.ProtoReflect.Descriptor instead.
-func (*GetAccountProblemSubmissionListResponse) Descriptor() ([]byte, []int) {
-	return file_ojs_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use TestCase.ProtoReflect.Descriptor instead.
+func (*TestCase) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetAccountProblemSubmissionListResponse) GetSubmissions() []*Submission {
+func (x *TestCase) GetId() uint64 {
 	if x != nil {
-		return x.Submissions
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *GetAccountProblemSubmissionListResponse) GetTotalSubmissionsCount() uint64 {
+func (x *TestCase) GetOfProblemId() uint64 {
 	if x != nil {
-		return x.TotalSubmissionsCount
+		return x.OfProblemId
 	}
 	return 0
 }
 
-type GetAndUpdateFirstSubmittedSubmissionToExecutingRequest struct {
+func (x *TestCase) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+func (x *TestCase) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *TestCase) GetIsHidden() bool {
+	if x != nil {
+		return x.IsHidden
+	}
+	return false
+}
+
+func (x *TestCase) GetOfTestCaseGroupId() uint64 {
+	if x != nil {
+		return x.OfTestCaseGroupId
+	}
+	return 0
+}
+
+type CreateTestCaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	TestCase *TestCase `protobuf:"bytes,1,opt,name=test_case,json=testCase,proto3" json:"test_case,omitempty"`
 }
 
-func (x *GetAndUpdateFirstSubmittedSubmissionToExecutingRequest) Reset() {
-	*x = GetAndUpdateFirstSubmittedSubmissionToExecutingRequest{}
+func (x *CreateTestCaseResponse) Reset() {
+	*x = CreateTestCaseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ojs_proto_msgTypes[44]
+		mi := &file_ojs_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAndUpdateFirstSubmittedSubmissionToExecutingRequest) String() string {
+func (x *CreateTestCaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAndUpdateFirstSubmittedSubmissionToExecutingRequest) ProtoMessage() {}
+func (*CreateTestCaseResponse) ProtoMessage() {}
 
-func (x *GetAndUpdateFirstSubmittedSubmissionToExecutingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ojs_proto_msgTypes[44]
+func (x *CreateTestCaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2640,34 +2862,45 @@ func (x *GetAndUpdateFirstSubmittedSubmissionToExecutingRequest) ProtoReflect()
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAndUpdateFirstSubmittedSubmissionToExecutingRequest.ProtoReflect.Descriptor instead.
-func (*GetAndUpdateFirstSubmittedSubmissionToExecutingRequest) Descriptor() ([]byte, []int) {
-	return file_ojs_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use CreateTestCaseResponse.ProtoReflect.Descriptor instead.
+func (*CreateTestCaseResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{45}
 }
 
-type GetAndUpdateFirstSubmittedSubmissionToExecutingResponse struct {
+func (x *CreateTestCaseResponse) GetTestCase() *TestCase {
+	if x != nil {
+		return x.TestCase
+	}
+	return nil
+}
+
+type GetProblemTestCaseListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *GetAndUpdateFirstSubmittedSubmissionToExecutingResponse) Reset() {
-	*x = GetAndUpdateFirstSubmittedSubmissionToExecutingResponse{}
+func (x *GetProblemTestCaseListRequest) Reset() {
+	*x = GetProblemTestCaseListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ojs_proto_msgTypes[45]
+		mi := &file_ojs_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAndUpdateFirstSubmittedSubmissionToExecutingResponse) String() string {
+func (x *GetProblemTestCaseListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAndUpdateFirstSubmittedSubmissionToExecutingResponse) ProtoMessage() {}
+func (*GetProblemTestCaseListRequest) ProtoMessage() {}
 
-func (x *GetAndUpdateFirstSubmittedSubmissionToExecutingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ojs_proto_msgTypes[45]
+func (x *GetProblemTestCaseListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2678,682 +2911,7106 @@ func (x *GetAndUpdateFirstSubmittedSubmissionToExecutingResponse) ProtoReflect()
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAndUpdateFirstSubmittedSubmissionToExecutingResponse.ProtoReflect.Descriptor instead.
-func (*GetAndUpdateFirstSubmittedSubmissionToExecutingResponse) Descriptor() ([]byte, []int) {
-	return file_ojs_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use GetProblemTestCaseListRequest.ProtoReflect.Descriptor instead.
+func (*GetProblemTestCaseListRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{46}
 }
 
-type UpdateSettingRequest struct {
+func (x *GetProblemTestCaseListRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetProblemTestCaseListRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetProblemTestCaseListRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetProblemTestCaseListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	TestCases           []*TestCase `protobuf:"bytes,1,rep,name=test_cases,json=testCases,proto3" json:"test_cases,omitempty"`
+	TotalTestCasesCount uint64      `protobuf:"varint,2,opt,name=total_test_cases_count,json=totalTestCasesCount,proto3" json:"total_test_cases_count,omitempty"`
 }
 
-func (x *UpdateSettingRequest) Reset() {
-	*x = UpdateSettingRequest{}
+func (x *GetProblemTestCaseListResponse) Reset() {
+	*x = GetProblemTestCaseListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ojs_proto_msgTypes[46]
+		mi := &file_ojs_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateSettingRequest) String() string {
+func (x *GetProblemTestCaseListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSettingRequest) ProtoMessage() {}
+func (*GetProblemTestCaseListResponse) ProtoMessage() {}
 
-func (x *UpdateSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ojs_proto_msgTypes[46]
+func (x *GetProblemTestCaseListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProblemTestCaseListResponse.ProtoReflect.Descriptor instead.
+func (*GetProblemTestCaseListResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetProblemTestCaseListResponse) GetTestCases() []*TestCase {
+	if x != nil {
+		return x.TestCases
+	}
+	return nil
+}
+
+func (x *GetProblemTestCaseListResponse) GetTotalTestCasesCount() uint64 {
+	if x != nil {
+		return x.TotalTestCasesCount
+	}
+	return 0
+}
+
+type GetTestCaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetTestCaseRequest) Reset() {
+	*x = GetTestCaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTestCaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTestCaseRequest) ProtoMessage() {}
+
+func (x *GetTestCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UpdateSettingRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSettingRequest) Descriptor() ([]byte, []int) {
-	return file_ojs_proto_rawDescGZIP(), []int{46}
-}
-
-type UpdateSettingResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *UpdateSettingResponse) Reset() {
-	*x = UpdateSettingResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ojs_proto_msgTypes[47]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *UpdateSettingResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UpdateSettingResponse) ProtoMessage() {}
-
-func (x *UpdateSettingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ojs_proto_msgTypes[47]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTestCaseRequest.ProtoReflect.Descriptor instead.
+func (*GetTestCaseRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetTestCaseRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetTestCaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestCase *TestCase `protobuf:"bytes,1,opt,name=test_case,json=testCase,proto3" json:"test_case,omitempty"`
+}
+
+func (x *GetTestCaseResponse) Reset() {
+	*x = GetTestCaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTestCaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTestCaseResponse) ProtoMessage() {}
+
+func (x *GetTestCaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTestCaseResponse.ProtoReflect.Descriptor instead.
+func (*GetTestCaseResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetTestCaseResponse) GetTestCase() *TestCase {
+	if x != nil {
+		return x.TestCase
+	}
+	return nil
+}
+
+type UpdateTestCaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Input             string `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	Output            string `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+	IsHidden          bool   `protobuf:"varint,4,opt,name=is_hidden,json=isHidden,proto3" json:"is_hidden,omitempty"`
+	OfTestCaseGroupId uint64 `protobuf:"varint,5,opt,name=of_test_case_group_id,json=ofTestCaseGroupId,proto3" json:"of_test_case_group_id,omitempty"`
+}
+
+func (x *UpdateTestCaseRequest) Reset() {
+	*x = UpdateTestCaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTestCaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTestCaseRequest) ProtoMessage() {}
+
+func (x *UpdateTestCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTestCaseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTestCaseRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateTestCaseRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateTestCaseRequest) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+func (x *UpdateTestCaseRequest) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *UpdateTestCaseRequest) GetIsHidden() bool {
+	if x != nil {
+		return x.IsHidden
+	}
+	return false
+}
+
+func (x *UpdateTestCaseRequest) GetOfTestCaseGroupId() uint64 {
+	if x != nil {
+		return x.OfTestCaseGroupId
+	}
+	return 0
+}
+
+type UpdateTestCaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestCase *TestCase `protobuf:"bytes,1,opt,name=test_case,json=testCase,proto3" json:"test_case,omitempty"`
+}
+
+func (x *UpdateTestCaseResponse) Reset() {
+	*x = UpdateTestCaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTestCaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTestCaseResponse) ProtoMessage() {}
+
+func (x *UpdateTestCaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTestCaseResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTestCaseResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateTestCaseResponse) GetTestCase() *TestCase {
+	if x != nil {
+		return x.TestCase
+	}
+	return nil
+}
+
+type DeleteTestCaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteTestCaseRequest) Reset() {
+	*x = DeleteTestCaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTestCaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTestCaseRequest) ProtoMessage() {}
+
+func (x *DeleteTestCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTestCaseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTestCaseRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteTestCaseRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteTestCaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteTestCaseResponse) Reset() {
+	*x = DeleteTestCaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTestCaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTestCaseResponse) ProtoMessage() {}
+
+func (x *DeleteTestCaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTestCaseResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTestCaseResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{53}
+}
+
+type TestCaseGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            uint64                     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OfProblemId   uint64                     `protobuf:"varint,2,opt,name=of_problem_id,json=ofProblemId,proto3" json:"of_problem_id,omitempty"`
+	DisplayName   string                     `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Points        uint64                     `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	ScoringPolicy TestCaseGroupScoringPolicy `protobuf:"varint,5,opt,name=scoring_policy,json=scoringPolicy,proto3,enum=ojs.TestCaseGroupScoringPolicy" json:"scoring_policy,omitempty"`
+}
+
+func (x *TestCaseGroup) Reset() {
+	*x = TestCaseGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestCaseGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestCaseGroup) ProtoMessage() {}
+
+func (x *TestCaseGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestCaseGroup.ProtoReflect.Descriptor instead.
+func (*TestCaseGroup) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *TestCaseGroup) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TestCaseGroup) GetOfProblemId() uint64 {
+	if x != nil {
+		return x.OfProblemId
+	}
+	return 0
+}
+
+func (x *TestCaseGroup) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *TestCaseGroup) GetPoints() uint64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *TestCaseGroup) GetScoringPolicy() TestCaseGroupScoringPolicy {
+	if x != nil {
+		return x.ScoringPolicy
+	}
+	return TestCaseGroupScoringPolicy_UndefinedScoringPolicy
+}
+
+type CreateTestCaseGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OfProblemId   uint64                     `protobuf:"varint,1,opt,name=of_problem_id,json=ofProblemId,proto3" json:"of_problem_id,omitempty"`
+	DisplayName   string                     `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Points        uint64                     `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	ScoringPolicy TestCaseGroupScoringPolicy `protobuf:"varint,4,opt,name=scoring_policy,json=scoringPolicy,proto3,enum=ojs.TestCaseGroupScoringPolicy" json:"scoring_policy,omitempty"`
+}
+
+func (x *CreateTestCaseGroupRequest) Reset() {
+	*x = CreateTestCaseGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTestCaseGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTestCaseGroupRequest) ProtoMessage() {}
+
+func (x *CreateTestCaseGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTestCaseGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateTestCaseGroupRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CreateTestCaseGroupRequest) GetOfProblemId() uint64 {
+	if x != nil {
+		return x.OfProblemId
+	}
+	return 0
+}
+
+func (x *CreateTestCaseGroupRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *CreateTestCaseGroupRequest) GetPoints() uint64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *CreateTestCaseGroupRequest) GetScoringPolicy() TestCaseGroupScoringPolicy {
+	if x != nil {
+		return x.ScoringPolicy
+	}
+	return TestCaseGroupScoringPolicy_UndefinedScoringPolicy
+}
+
+type CreateTestCaseGroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestCaseGroup *TestCaseGroup `protobuf:"bytes,1,opt,name=test_case_group,json=testCaseGroup,proto3" json:"test_case_group,omitempty"`
+}
+
+func (x *CreateTestCaseGroupResponse) Reset() {
+	*x = CreateTestCaseGroupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTestCaseGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTestCaseGroupResponse) ProtoMessage() {}
+
+func (x *CreateTestCaseGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTestCaseGroupResponse.ProtoReflect.Descriptor instead.
+func (*CreateTestCaseGroupResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateTestCaseGroupResponse) GetTestCaseGroup() *TestCaseGroup {
+	if x != nil {
+		return x.TestCaseGroup
+	}
+	return nil
+}
+
+type GetProblemTestCaseGroupListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetProblemTestCaseGroupListRequest) Reset() {
+	*x = GetProblemTestCaseGroupListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProblemTestCaseGroupListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProblemTestCaseGroupListRequest) ProtoMessage() {}
+
+func (x *GetProblemTestCaseGroupListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProblemTestCaseGroupListRequest.ProtoReflect.Descriptor instead.
+func (*GetProblemTestCaseGroupListRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetProblemTestCaseGroupListRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetProblemTestCaseGroupListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestCaseGroups []*TestCaseGroup `protobuf:"bytes,1,rep,name=test_case_groups,json=testCaseGroups,proto3" json:"test_case_groups,omitempty"`
+}
+
+func (x *GetProblemTestCaseGroupListResponse) Reset() {
+	*x = GetProblemTestCaseGroupListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProblemTestCaseGroupListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProblemTestCaseGroupListResponse) ProtoMessage() {}
+
+func (x *GetProblemTestCaseGroupListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProblemTestCaseGroupListResponse.ProtoReflect.Descriptor instead.
+func (*GetProblemTestCaseGroupListResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetProblemTestCaseGroupListResponse) GetTestCaseGroups() []*TestCaseGroup {
+	if x != nil {
+		return x.TestCaseGroups
+	}
+	return nil
+}
+
+type UpdateTestCaseGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            uint64                     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DisplayName   string                     `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Points        uint64                     `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	ScoringPolicy TestCaseGroupScoringPolicy `protobuf:"varint,4,opt,name=scoring_policy,json=scoringPolicy,proto3,enum=ojs.TestCaseGroupScoringPolicy" json:"scoring_policy,omitempty"`
+}
+
+func (x *UpdateTestCaseGroupRequest) Reset() {
+	*x = UpdateTestCaseGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTestCaseGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTestCaseGroupRequest) ProtoMessage() {}
+
+func (x *UpdateTestCaseGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTestCaseGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTestCaseGroupRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpdateTestCaseGroupRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateTestCaseGroupRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UpdateTestCaseGroupRequest) GetPoints() uint64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *UpdateTestCaseGroupRequest) GetScoringPolicy() TestCaseGroupScoringPolicy {
+	if x != nil {
+		return x.ScoringPolicy
+	}
+	return TestCaseGroupScoringPolicy_UndefinedScoringPolicy
+}
+
+type UpdateTestCaseGroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestCaseGroup *TestCaseGroup `protobuf:"bytes,1,opt,name=test_case_group,json=testCaseGroup,proto3" json:"test_case_group,omitempty"`
+}
+
+func (x *UpdateTestCaseGroupResponse) Reset() {
+	*x = UpdateTestCaseGroupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTestCaseGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTestCaseGroupResponse) ProtoMessage() {}
+
+func (x *UpdateTestCaseGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTestCaseGroupResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTestCaseGroupResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UpdateTestCaseGroupResponse) GetTestCaseGroup() *TestCaseGroup {
+	if x != nil {
+		return x.TestCaseGroup
+	}
+	return nil
+}
+
+type DeleteTestCaseGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteTestCaseGroupRequest) Reset() {
+	*x = DeleteTestCaseGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTestCaseGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTestCaseGroupRequest) ProtoMessage() {}
+
+func (x *DeleteTestCaseGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTestCaseGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTestCaseGroupRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeleteTestCaseGroupRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteTestCaseGroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteTestCaseGroupResponse) Reset() {
+	*x = DeleteTestCaseGroupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTestCaseGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTestCaseGroupResponse) ProtoMessage() {}
+
+func (x *DeleteTestCaseGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTestCaseGroupResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTestCaseGroupResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{62}
+}
+
+type CreateSubmissionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OfProblemId uint64 `protobuf:"varint,1,opt,name=of_problem_id,json=ofProblemId,proto3" json:"of_problem_id,omitempty"`
+	Content     string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Language    string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	OfContestId uint64 `protobuf:"varint,4,opt,name=of_contest_id,json=ofContestId,proto3" json:"of_contest_id,omitempty"`
+}
+
+func (x *CreateSubmissionRequest) Reset() {
+	*x = CreateSubmissionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubmissionRequest) ProtoMessage() {}
+
+func (x *CreateSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CreateSubmissionRequest) GetOfProblemId() uint64 {
+	if x != nil {
+		return x.OfProblemId
+	}
+	return 0
+}
+
+func (x *CreateSubmissionRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateSubmissionRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *CreateSubmissionRequest) GetOfContestId() uint64 {
+	if x != nil {
+		return x.OfContestId
+	}
+	return 0
+}
+
+type Submission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            uint64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OfProblemId   uint64           `protobuf:"varint,2,opt,name=of_problem_id,json=ofProblemId,proto3" json:"of_problem_id,omitempty"`
+	AuthorId      uint64           `protobuf:"varint,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Content       string           `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Language      string           `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	Status        SubmissionStatus `protobuf:"varint,6,opt,name=status,proto3,enum=ojs.SubmissionStatus" json:"status,omitempty"`
+	Result        SubmissionResult `protobuf:"varint,7,opt,name=result,proto3,enum=ojs.SubmissionResult" json:"result,omitempty"`
+	CompileOutput string           `protobuf:"bytes,8,opt,name=compile_output,json=compileOutput,proto3" json:"compile_output,omitempty"`
+	OfContestId   uint64           `protobuf:"varint,9,opt,name=of_contest_id,json=ofContestId,proto3" json:"of_contest_id,omitempty"`
+	Score         uint64           `protobuf:"varint,10,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *Submission) Reset() {
+	*x = Submission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Submission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Submission) ProtoMessage() {}
+
+func (x *Submission) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Submission.ProtoReflect.Descriptor instead.
+func (*Submission) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Submission) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Submission) GetOfProblemId() uint64 {
+	if x != nil {
+		return x.OfProblemId
+	}
+	return 0
+}
+
+func (x *Submission) GetAuthorId() uint64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *Submission) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Submission) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *Submission) GetStatus() SubmissionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SubmissionStatus_UndefinedStatus
+}
+
+func (x *Submission) GetResult() SubmissionResult {
+	if x != nil {
+		return x.Result
+	}
+	return SubmissionResult_UndefinedResult
+}
+
+func (x *Submission) GetCompileOutput() string {
+	if x != nil {
+		return x.CompileOutput
+	}
+	return ""
+}
+
+func (x *Submission) GetOfContestId() uint64 {
+	if x != nil {
+		return x.OfContestId
+	}
+	return 0
+}
+
+func (x *Submission) GetScore() uint64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type CreateSubmissionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Submission *Submission `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+}
+
+func (x *CreateSubmissionResponse) Reset() {
+	*x = CreateSubmissionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSubmissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubmissionResponse) ProtoMessage() {}
+
+func (x *CreateSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*CreateSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CreateSubmissionResponse) GetSubmission() *Submission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+type GetSubmissionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetSubmissionRequest) Reset() {
+	*x = GetSubmissionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubmissionRequest) ProtoMessage() {}
+
+func (x *GetSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*GetSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetSubmissionRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetSubmissionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Submission *Submission `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+}
+
+func (x *GetSubmissionResponse) Reset() {
+	*x = GetSubmissionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSubmissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubmissionResponse) ProtoMessage() {}
+
+func (x *GetSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*GetSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetSubmissionResponse) GetSubmission() *Submission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+type GetSubmissionListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetSubmissionListRequest) Reset() {
+	*x = GetSubmissionListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSubmissionListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubmissionListRequest) ProtoMessage() {}
+
+func (x *GetSubmissionListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubmissionListRequest.ProtoReflect.Descriptor instead.
+func (*GetSubmissionListRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetSubmissionListRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetSubmissionListRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetSubmissionListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Submissions           []*Submission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	TotalSubmissionsCount uint64        `protobuf:"varint,2,opt,name=total_submissions_count,json=totalSubmissionsCount,proto3" json:"total_submissions_count,omitempty"`
+}
+
+func (x *GetSubmissionListResponse) Reset() {
+	*x = GetSubmissionListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSubmissionListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubmissionListResponse) ProtoMessage() {}
+
+func (x *GetSubmissionListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubmissionListResponse.ProtoReflect.Descriptor instead.
+func (*GetSubmissionListResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetSubmissionListResponse) GetSubmissions() []*Submission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+func (x *GetSubmissionListResponse) GetTotalSubmissionsCount() uint64 {
+	if x != nil {
+		return x.TotalSubmissionsCount
+	}
+	return 0
+}
+
+type SubmissionTestCaseResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             uint64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OfSubmissionId uint64           `protobuf:"varint,2,opt,name=of_submission_id,json=ofSubmissionId,proto3" json:"of_submission_id,omitempty"`
+	OfTestCaseId   uint64           `protobuf:"varint,3,opt,name=of_test_case_id,json=ofTestCaseId,proto3" json:"of_test_case_id,omitempty"`
+	Result         SubmissionResult `protobuf:"varint,4,opt,name=result,proto3,enum=ojs.SubmissionResult" json:"result,omitempty"`
+	WallTime       string           `protobuf:"bytes,5,opt,name=wall_time,json=wallTime,proto3" json:"wall_time,omitempty"`
+	CpuTime        string           `protobuf:"bytes,6,opt,name=cpu_time,json=cpuTime,proto3" json:"cpu_time,omitempty"`
+	Memory         string           `protobuf:"bytes,7,opt,name=memory,proto3" json:"memory,omitempty"`
+	ExitCode       int32            `protobuf:"varint,8,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Stdout         string           `protobuf:"bytes,9,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr         string           `protobuf:"bytes,10,opt,name=stderr,proto3" json:"stderr,omitempty"`
+}
+
+func (x *SubmissionTestCaseResult) Reset() {
+	*x = SubmissionTestCaseResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmissionTestCaseResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionTestCaseResult) ProtoMessage() {}
+
+func (x *SubmissionTestCaseResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionTestCaseResult.ProtoReflect.Descriptor instead.
+func (*SubmissionTestCaseResult) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SubmissionTestCaseResult) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SubmissionTestCaseResult) GetOfSubmissionId() uint64 {
+	if x != nil {
+		return x.OfSubmissionId
+	}
+	return 0
+}
+
+func (x *SubmissionTestCaseResult) GetOfTestCaseId() uint64 {
+	if x != nil {
+		return x.OfTestCaseId
+	}
+	return 0
+}
+
+func (x *SubmissionTestCaseResult) GetResult() SubmissionResult {
+	if x != nil {
+		return x.Result
+	}
+	return SubmissionResult_UndefinedResult
+}
+
+func (x *SubmissionTestCaseResult) GetWallTime() string {
+	if x != nil {
+		return x.WallTime
+	}
+	return ""
+}
+
+func (x *SubmissionTestCaseResult) GetCpuTime() string {
+	if x != nil {
+		return x.CpuTime
+	}
+	return ""
+}
+
+func (x *SubmissionTestCaseResult) GetMemory() string {
+	if x != nil {
+		return x.Memory
+	}
+	return ""
+}
+
+func (x *SubmissionTestCaseResult) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *SubmissionTestCaseResult) GetStdout() string {
+	if x != nil {
+		return x.Stdout
+	}
+	return ""
+}
+
+func (x *SubmissionTestCaseResult) GetStderr() string {
+	if x != nil {
+		return x.Stderr
+	}
+	return ""
+}
+
+type GetSubmissionTestCaseResultListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetSubmissionTestCaseResultListRequest) Reset() {
+	*x = GetSubmissionTestCaseResultListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSubmissionTestCaseResultListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubmissionTestCaseResultListRequest) ProtoMessage() {}
+
+func (x *GetSubmissionTestCaseResultListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubmissionTestCaseResultListRequest.ProtoReflect.Descriptor instead.
+func (*GetSubmissionTestCaseResultListRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetSubmissionTestCaseResultListRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetSubmissionTestCaseResultListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmissionTestCaseResults []*SubmissionTestCaseResult `protobuf:"bytes,1,rep,name=submission_test_case_results,json=submissionTestCaseResults,proto3" json:"submission_test_case_results,omitempty"`
+}
+
+func (x *GetSubmissionTestCaseResultListResponse) Reset() {
+	*x = GetSubmissionTestCaseResultListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSubmissionTestCaseResultListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubmissionTestCaseResultListResponse) ProtoMessage() {}
+
+func (x *GetSubmissionTestCaseResultListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubmissionTestCaseResultListResponse.ProtoReflect.Descriptor instead.
+func (*GetSubmissionTestCaseResultListResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetSubmissionTestCaseResultListResponse) GetSubmissionTestCaseResults() []*SubmissionTestCaseResult {
+	if x != nil {
+		return x.SubmissionTestCaseResults
+	}
+	return nil
+}
+
+type WatchSubmissionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *WatchSubmissionRequest) Reset() {
+	*x = WatchSubmissionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSubmissionRequest) ProtoMessage() {}
+
+func (x *WatchSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*WatchSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *WatchSubmissionRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type WatchSubmissionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  uint64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status              SubmissionStatus `protobuf:"varint,2,opt,name=status,proto3,enum=ojs.SubmissionStatus" json:"status,omitempty"`
+	Result              SubmissionResult `protobuf:"varint,3,opt,name=result,proto3,enum=ojs.SubmissionResult" json:"result,omitempty"`
+	JudgedTestCaseCount uint64           `protobuf:"varint,4,opt,name=judged_test_case_count,json=judgedTestCaseCount,proto3" json:"judged_test_case_count,omitempty"`
+	TestCaseCount       uint64           `protobuf:"varint,5,opt,name=test_case_count,json=testCaseCount,proto3" json:"test_case_count,omitempty"`
+}
+
+func (x *WatchSubmissionResponse) Reset() {
+	*x = WatchSubmissionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchSubmissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSubmissionResponse) ProtoMessage() {}
+
+func (x *WatchSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*WatchSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *WatchSubmissionResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WatchSubmissionResponse) GetStatus() SubmissionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SubmissionStatus_UndefinedStatus
+}
+
+func (x *WatchSubmissionResponse) GetResult() SubmissionResult {
+	if x != nil {
+		return x.Result
+	}
+	return SubmissionResult_UndefinedResult
+}
+
+func (x *WatchSubmissionResponse) GetJudgedTestCaseCount() uint64 {
+	if x != nil {
+		return x.JudgedTestCaseCount
+	}
+	return 0
+}
+
+func (x *WatchSubmissionResponse) GetTestCaseCount() uint64 {
+	if x != nil {
+		return x.TestCaseCount
+	}
+	return 0
+}
+
+type GetProblemSubmissionListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetProblemSubmissionListRequest) Reset() {
+	*x = GetProblemSubmissionListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProblemSubmissionListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProblemSubmissionListRequest) ProtoMessage() {}
+
+func (x *GetProblemSubmissionListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProblemSubmissionListRequest.ProtoReflect.Descriptor instead.
+func (*GetProblemSubmissionListRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetProblemSubmissionListRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetProblemSubmissionListRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetProblemSubmissionListRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetProblemSubmissionListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Submissions           []*Submission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	TotalSubmissionsCount uint64        `protobuf:"varint,2,opt,name=total_submissions_count,json=totalSubmissionsCount,proto3" json:"total_submissions_count,omitempty"`
+}
+
+func (x *GetProblemSubmissionListResponse) Reset() {
+	*x = GetProblemSubmissionListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProblemSubmissionListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProblemSubmissionListResponse) ProtoMessage() {}
+
+func (x *GetProblemSubmissionListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProblemSubmissionListResponse.ProtoReflect.Descriptor instead.
+func (*GetProblemSubmissionListResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetProblemSubmissionListResponse) GetSubmissions() []*Submission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+func (x *GetProblemSubmissionListResponse) GetTotalSubmissionsCount() uint64 {
+	if x != nil {
+		return x.TotalSubmissionsCount
+	}
+	return 0
+}
+
+type GetAccountProblemSubmissionListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	ProblemId uint64 `protobuf:"varint,2,opt,name=problem_id,json=problemId,proto3" json:"problem_id,omitempty"`
+	Offset    uint64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit     uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetAccountProblemSubmissionListRequest) Reset() {
+	*x = GetAccountProblemSubmissionListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountProblemSubmissionListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountProblemSubmissionListRequest) ProtoMessage() {}
+
+func (x *GetAccountProblemSubmissionListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountProblemSubmissionListRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountProblemSubmissionListRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetAccountProblemSubmissionListRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *GetAccountProblemSubmissionListRequest) GetProblemId() uint64 {
+	if x != nil {
+		return x.ProblemId
+	}
+	return 0
+}
+
+func (x *GetAccountProblemSubmissionListRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetAccountProblemSubmissionListRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetAccountProblemSubmissionListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Submissions           []*Submission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	TotalSubmissionsCount uint64        `protobuf:"varint,2,opt,name=total_submissions_count,json=totalSubmissionsCount,proto3" json:"total_submissions_count,omitempty"`
+}
+
+func (x *GetAccountProblemSubmissionListResponse) Reset() {
+	*x = GetAccountProblemSubmissionListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountProblemSubmissionListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountProblemSubmissionListResponse) ProtoMessage() {}
+
+func (x *GetAccountProblemSubmissionListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountProblemSubmissionListResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountProblemSubmissionListResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetAccountProblemSubmissionListResponse) GetSubmissions() []*Submission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+func (x *GetAccountProblemSubmissionListResponse) GetTotalSubmissionsCount() uint64 {
+	if x != nil {
+		return x.TotalSubmissionsCount
+	}
+	return 0
+}
+
+type ContestProblem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProblemId uint64 `protobuf:"varint,1,opt,name=problem_id,json=problemId,proto3" json:"problem_id,omitempty"`
+	Label     string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *ContestProblem) Reset() {
+	*x = ContestProblem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContestProblem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContestProblem) ProtoMessage() {}
+
+func (x *ContestProblem) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContestProblem.ProtoReflect.Descriptor instead.
+func (*ContestProblem) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ContestProblem) GetProblemId() uint64 {
+	if x != nil {
+		return x.ProblemId
+	}
+	return 0
+}
+
+func (x *ContestProblem) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type Contest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DisplayName      string             `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	AuthorId         uint64             `protobuf:"varint,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Description      string             `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	StartTime        string             `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime          string             `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	IsPublic         bool               `protobuf:"varint,7,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	Problems         []*ContestProblem  `protobuf:"bytes,8,rep,name=problems,proto3" json:"problems,omitempty"`
+	IsRegistered     bool               `protobuf:"varint,9,opt,name=is_registered,json=isRegistered,proto3" json:"is_registered,omitempty"`
+	ScoringMode      ContestScoringMode `protobuf:"varint,10,opt,name=scoring_mode,json=scoringMode,proto3,enum=ojs.ContestScoringMode" json:"scoring_mode,omitempty"`
+	FreezeScoreboard bool               `protobuf:"varint,11,opt,name=freeze_scoreboard,json=freezeScoreboard,proto3" json:"freeze_scoreboard,omitempty"`
+}
+
+func (x *Contest) Reset() {
+	*x = Contest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Contest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contest) ProtoMessage() {}
+
+func (x *Contest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Contest.ProtoReflect.Descriptor instead.
+func (*Contest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *Contest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Contest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Contest) GetAuthorId() uint64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *Contest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Contest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *Contest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *Contest) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *Contest) GetProblems() []*ContestProblem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+func (x *Contest) GetIsRegistered() bool {
+	if x != nil {
+		return x.IsRegistered
+	}
+	return false
+}
+
+func (x *Contest) GetScoringMode() ContestScoringMode {
+	if x != nil {
+		return x.ScoringMode
+	}
+	return ContestScoringMode_UndefinedScoringMode
+}
+
+func (x *Contest) GetFreezeScoreboard() bool {
+	if x != nil {
+		return x.FreezeScoreboard
+	}
+	return false
+}
+
+type CreateContestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DisplayName      string             `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Description      string             `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	StartTime        string             `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime          string             `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	IsPublic         bool               `protobuf:"varint,5,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	Problems         []*ContestProblem  `protobuf:"bytes,6,rep,name=problems,proto3" json:"problems,omitempty"`
+	ScoringMode      ContestScoringMode `protobuf:"varint,7,opt,name=scoring_mode,json=scoringMode,proto3,enum=ojs.ContestScoringMode" json:"scoring_mode,omitempty"`
+	FreezeScoreboard bool               `protobuf:"varint,8,opt,name=freeze_scoreboard,json=freezeScoreboard,proto3" json:"freeze_scoreboard,omitempty"`
+}
+
+func (x *CreateContestRequest) Reset() {
+	*x = CreateContestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateContestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContestRequest) ProtoMessage() {}
+
+func (x *CreateContestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContestRequest.ProtoReflect.Descriptor instead.
+func (*CreateContestRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CreateContestRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *CreateContestRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateContestRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *CreateContestRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *CreateContestRequest) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *CreateContestRequest) GetProblems() []*ContestProblem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+func (x *CreateContestRequest) GetScoringMode() ContestScoringMode {
+	if x != nil {
+		return x.ScoringMode
+	}
+	return ContestScoringMode_UndefinedScoringMode
+}
+
+func (x *CreateContestRequest) GetFreezeScoreboard() bool {
+	if x != nil {
+		return x.FreezeScoreboard
+	}
+	return false
+}
+
+type CreateContestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contest *Contest `protobuf:"bytes,1,opt,name=contest,proto3" json:"contest,omitempty"`
+}
+
+func (x *CreateContestResponse) Reset() {
+	*x = CreateContestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateContestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContestResponse) ProtoMessage() {}
+
+func (x *CreateContestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContestResponse.ProtoReflect.Descriptor instead.
+func (*CreateContestResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CreateContestResponse) GetContest() *Contest {
+	if x != nil {
+		return x.Contest
+	}
+	return nil
+}
+
+type GetContestListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetContestListRequest) Reset() {
+	*x = GetContestListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContestListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContestListRequest) ProtoMessage() {}
+
+func (x *GetContestListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContestListRequest.ProtoReflect.Descriptor instead.
+func (*GetContestListRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetContestListRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetContestListRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetContestListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contests          []*Contest `protobuf:"bytes,1,rep,name=contests,proto3" json:"contests,omitempty"`
+	TotalContestCount uint64     `protobuf:"varint,2,opt,name=total_contest_count,json=totalContestCount,proto3" json:"total_contest_count,omitempty"`
+}
+
+func (x *GetContestListResponse) Reset() {
+	*x = GetContestListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContestListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContestListResponse) ProtoMessage() {}
+
+func (x *GetContestListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContestListResponse.ProtoReflect.Descriptor instead.
+func (*GetContestListResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetContestListResponse) GetContests() []*Contest {
+	if x != nil {
+		return x.Contests
+	}
+	return nil
+}
+
+func (x *GetContestListResponse) GetTotalContestCount() uint64 {
+	if x != nil {
+		return x.TotalContestCount
+	}
+	return 0
+}
+
+type GetContestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetContestRequest) Reset() {
+	*x = GetContestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContestRequest) ProtoMessage() {}
+
+func (x *GetContestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContestRequest.ProtoReflect.Descriptor instead.
+func (*GetContestRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetContestRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetContestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contest *Contest `protobuf:"bytes,1,opt,name=contest,proto3" json:"contest,omitempty"`
+}
+
+func (x *GetContestResponse) Reset() {
+	*x = GetContestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContestResponse) ProtoMessage() {}
+
+func (x *GetContestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContestResponse.ProtoReflect.Descriptor instead.
+func (*GetContestResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetContestResponse) GetContest() *Contest {
+	if x != nil {
+		return x.Contest
+	}
+	return nil
+}
+
+type UpdateContestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	StartTime   string `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime     string `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// is_public and freeze_scoreboard are only changed when set.
+	IsPublic         *bool              `protobuf:"varint,6,opt,name=is_public,json=isPublic,proto3,oneof" json:"is_public,omitempty"`
+	Problems         []*ContestProblem  `protobuf:"bytes,7,rep,name=problems,proto3" json:"problems,omitempty"`
+	ScoringMode      ContestScoringMode `protobuf:"varint,8,opt,name=scoring_mode,json=scoringMode,proto3,enum=ojs.ContestScoringMode" json:"scoring_mode,omitempty"`
+	FreezeScoreboard *bool              `protobuf:"varint,9,opt,name=freeze_scoreboard,json=freezeScoreboard,proto3,oneof" json:"freeze_scoreboard,omitempty"`
+}
+
+func (x *UpdateContestRequest) Reset() {
+	*x = UpdateContestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateContestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateContestRequest) ProtoMessage() {}
+
+func (x *UpdateContestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateContestRequest.ProtoReflect.Descriptor instead.
+func (*UpdateContestRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *UpdateContestRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateContestRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UpdateContestRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateContestRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *UpdateContestRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *UpdateContestRequest) GetIsPublic() bool {
+	if x != nil && x.IsPublic != nil {
+		return *x.IsPublic
+	}
+	return false
+}
+
+func (x *UpdateContestRequest) GetProblems() []*ContestProblem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+func (x *UpdateContestRequest) GetScoringMode() ContestScoringMode {
+	if x != nil {
+		return x.ScoringMode
+	}
+	return ContestScoringMode_UndefinedScoringMode
+}
+
+func (x *UpdateContestRequest) GetFreezeScoreboard() bool {
+	if x != nil && x.FreezeScoreboard != nil {
+		return *x.FreezeScoreboard
+	}
+	return false
+}
+
+type UpdateContestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contest *Contest `protobuf:"bytes,1,opt,name=contest,proto3" json:"contest,omitempty"`
+}
+
+func (x *UpdateContestResponse) Reset() {
+	*x = UpdateContestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateContestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateContestResponse) ProtoMessage() {}
+
+func (x *UpdateContestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateContestResponse.ProtoReflect.Descriptor instead.
+func (*UpdateContestResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *UpdateContestResponse) GetContest() *Contest {
+	if x != nil {
+		return x.Contest
+	}
+	return nil
+}
+
+type DeleteContestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteContestRequest) Reset() {
+	*x = DeleteContestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteContestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContestRequest) ProtoMessage() {}
+
+func (x *DeleteContestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContestRequest.ProtoReflect.Descriptor instead.
+func (*DeleteContestRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DeleteContestRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteContestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteContestResponse) Reset() {
+	*x = DeleteContestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteContestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContestResponse) ProtoMessage() {}
+
+func (x *DeleteContestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContestResponse.ProtoReflect.Descriptor instead.
+func (*DeleteContestResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{90}
+}
+
+type RegisterContestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RegisterContestRequest) Reset() {
+	*x = RegisterContestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterContestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterContestRequest) ProtoMessage() {}
+
+func (x *RegisterContestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterContestRequest.ProtoReflect.Descriptor instead.
+func (*RegisterContestRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *RegisterContestRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RegisterContestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RegisterContestResponse) Reset() {
+	*x = RegisterContestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterContestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterContestResponse) ProtoMessage() {}
+
+func (x *RegisterContestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterContestResponse.ProtoReflect.Descriptor instead.
+func (*RegisterContestResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{92}
+}
+
+type UnregisterContestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *UnregisterContestRequest) Reset() {
+	*x = UnregisterContestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnregisterContestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterContestRequest) ProtoMessage() {}
+
+func (x *UnregisterContestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterContestRequest.ProtoReflect.Descriptor instead.
+func (*UnregisterContestRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *UnregisterContestRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UnregisterContestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnregisterContestResponse) Reset() {
+	*x = UnregisterContestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnregisterContestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterContestResponse) ProtoMessage() {}
+
+func (x *UnregisterContestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterContestResponse.ProtoReflect.Descriptor instead.
+func (*UnregisterContestResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{94}
+}
+
+type ContestScoreboardCell struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProblemId         uint64 `protobuf:"varint,1,opt,name=problem_id,json=problemId,proto3" json:"problem_id,omitempty"`
+	Label             string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	IsSolved          bool   `protobuf:"varint,3,opt,name=is_solved,json=isSolved,proto3" json:"is_solved,omitempty"`
+	WrongAttemptCount uint64 `protobuf:"varint,4,opt,name=wrong_attempt_count,json=wrongAttemptCount,proto3" json:"wrong_attempt_count,omitempty"`
+	SolvedAtMinute    uint64 `protobuf:"varint,5,opt,name=solved_at_minute,json=solvedAtMinute,proto3" json:"solved_at_minute,omitempty"`
+	Score             uint64 `protobuf:"varint,6,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *ContestScoreboardCell) Reset() {
+	*x = ContestScoreboardCell{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContestScoreboardCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContestScoreboardCell) ProtoMessage() {}
+
+func (x *ContestScoreboardCell) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContestScoreboardCell.ProtoReflect.Descriptor instead.
+func (*ContestScoreboardCell) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ContestScoreboardCell) GetProblemId() uint64 {
+	if x != nil {
+		return x.ProblemId
+	}
+	return 0
+}
+
+func (x *ContestScoreboardCell) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ContestScoreboardCell) GetIsSolved() bool {
+	if x != nil {
+		return x.IsSolved
+	}
+	return false
+}
+
+func (x *ContestScoreboardCell) GetWrongAttemptCount() uint64 {
+	if x != nil {
+		return x.WrongAttemptCount
+	}
+	return 0
+}
+
+func (x *ContestScoreboardCell) GetSolvedAtMinute() uint64 {
+	if x != nil {
+		return x.SolvedAtMinute
+	}
+	return 0
+}
+
+func (x *ContestScoreboardCell) GetScore() uint64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type ContestScoreboardRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank        uint64                   `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	AccountId   uint64                   `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AccountName string                   `protobuf:"bytes,3,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	SolvedCount uint64                   `protobuf:"varint,4,opt,name=solved_count,json=solvedCount,proto3" json:"solved_count,omitempty"`
+	Penalty     uint64                   `protobuf:"varint,5,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	TotalScore  uint64                   `protobuf:"varint,6,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"`
+	Cells       []*ContestScoreboardCell `protobuf:"bytes,7,rep,name=cells,proto3" json:"cells,omitempty"`
+}
+
+func (x *ContestScoreboardRow) Reset() {
+	*x = ContestScoreboardRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContestScoreboardRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContestScoreboardRow) ProtoMessage() {}
+
+func (x *ContestScoreboardRow) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContestScoreboardRow.ProtoReflect.Descriptor instead.
+func (*ContestScoreboardRow) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ContestScoreboardRow) GetRank() uint64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *ContestScoreboardRow) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ContestScoreboardRow) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *ContestScoreboardRow) GetSolvedCount() uint64 {
+	if x != nil {
+		return x.SolvedCount
+	}
+	return 0
+}
+
+func (x *ContestScoreboardRow) GetPenalty() uint64 {
+	if x != nil {
+		return x.Penalty
+	}
+	return 0
+}
+
+func (x *ContestScoreboardRow) GetTotalScore() uint64 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *ContestScoreboardRow) GetCells() []*ContestScoreboardCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type GetContestScoreboardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetContestScoreboardRequest) Reset() {
+	*x = GetContestScoreboardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContestScoreboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContestScoreboardRequest) ProtoMessage() {}
+
+func (x *GetContestScoreboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContestScoreboardRequest.ProtoReflect.Descriptor instead.
+func (*GetContestScoreboardRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetContestScoreboardRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetContestScoreboardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScoringMode ContestScoringMode      `protobuf:"varint,1,opt,name=scoring_mode,json=scoringMode,proto3,enum=ojs.ContestScoringMode" json:"scoring_mode,omitempty"`
+	IsFrozen    bool                    `protobuf:"varint,2,opt,name=is_frozen,json=isFrozen,proto3" json:"is_frozen,omitempty"`
+	Rows        []*ContestScoreboardRow `protobuf:"bytes,3,rep,name=rows,proto3" json:"rows,omitempty"`
+}
+
+func (x *GetContestScoreboardResponse) Reset() {
+	*x = GetContestScoreboardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContestScoreboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContestScoreboardResponse) ProtoMessage() {}
+
+func (x *GetContestScoreboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContestScoreboardResponse.ProtoReflect.Descriptor instead.
+func (*GetContestScoreboardResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetContestScoreboardResponse) GetScoringMode() ContestScoringMode {
+	if x != nil {
+		return x.ScoringMode
+	}
+	return ContestScoringMode_UndefinedScoringMode
+}
+
+func (x *GetContestScoreboardResponse) GetIsFrozen() bool {
+	if x != nil {
+		return x.IsFrozen
+	}
+	return false
+}
+
+func (x *GetContestScoreboardResponse) GetRows() []*ContestScoreboardRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+// Remote judge workers claim the oldest submitted submission, judge it on their own and report its result with
+// ReportSubmissionResult. Each claim is given its own judging lease token, which the worker passes to
+// RenewSubmissionJudgingLease more often than the judging lease duration while judging, and to
+// ReportSubmissionResult. A submission whose lease is not renewed within the judging lease duration is submitted
+// again, and the late result is rejected.
+type GetAndUpdateFirstSubmittedSubmissionToExecutingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetAndUpdateFirstSubmittedSubmissionToExecutingRequest) Reset() {
+	*x = GetAndUpdateFirstSubmittedSubmissionToExecutingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAndUpdateFirstSubmittedSubmissionToExecutingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAndUpdateFirstSubmittedSubmissionToExecutingRequest) ProtoMessage() {}
+
+func (x *GetAndUpdateFirstSubmittedSubmissionToExecutingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAndUpdateFirstSubmittedSubmissionToExecutingRequest.ProtoReflect.Descriptor instead.
+func (*GetAndUpdateFirstSubmittedSubmissionToExecutingRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{99}
+}
+
+type GetAndUpdateFirstSubmittedSubmissionToExecutingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Submission *Submission `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+	Problem    *Problem    `protobuf:"bytes,2,opt,name=problem,proto3" json:"problem,omitempty"`
+	TestCases  []*TestCase `protobuf:"bytes,3,rep,name=test_cases,json=testCases,proto3" json:"test_cases,omitempty"`
+	// Empty if the problem has no checker, or no interactor.
+	CheckerSource     string `protobuf:"bytes,4,opt,name=checker_source,json=checkerSource,proto3" json:"checker_source,omitempty"`
+	InteractorSource  string `protobuf:"bytes,5,opt,name=interactor_source,json=interactorSource,proto3" json:"interactor_source,omitempty"`
+	JudgingLeaseToken string `protobuf:"bytes,6,opt,name=judging_lease_token,json=judgingLeaseToken,proto3" json:"judging_lease_token,omitempty"`
+}
+
+func (x *GetAndUpdateFirstSubmittedSubmissionToExecutingResponse) Reset() {
+	*x = GetAndUpdateFirstSubmittedSubmissionToExecutingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAndUpdateFirstSubmittedSubmissionToExecutingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAndUpdateFirstSubmittedSubmissionToExecutingResponse) ProtoMessage() {}
+
+func (x *GetAndUpdateFirstSubmittedSubmissionToExecutingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAndUpdateFirstSubmittedSubmissionToExecutingResponse.ProtoReflect.Descriptor instead.
+func (*GetAndUpdateFirstSubmittedSubmissionToExecutingResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetAndUpdateFirstSubmittedSubmissionToExecutingResponse) GetSubmission() *Submission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+func (x *GetAndUpdateFirstSubmittedSubmissionToExecutingResponse) GetProblem() *Problem {
+	if x != nil {
+		return x.Problem
+	}
+	return nil
+}
+
+func (x *GetAndUpdateFirstSubmittedSubmissionToExecutingResponse) GetTestCases() []*TestCase {
+	if x != nil {
+		return x.TestCases
+	}
+	return nil
+}
+
+func (x *GetAndUpdateFirstSubmittedSubmissionToExecutingResponse) GetCheckerSource() string {
+	if x != nil {
+		return x.CheckerSource
+	}
+	return ""
+}
+
+func (x *GetAndUpdateFirstSubmittedSubmissionToExecutingResponse) GetInteractorSource() string {
+	if x != nil {
+		return x.InteractorSource
+	}
+	return ""
+}
+
+func (x *GetAndUpdateFirstSubmittedSubmissionToExecutingResponse) GetJudgingLeaseToken() string {
+	if x != nil {
+		return x.JudgingLeaseToken
+	}
+	return ""
+}
+
+type RenewSubmissionJudgingLeaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	JudgingLeaseToken string `protobuf:"bytes,2,opt,name=judging_lease_token,json=judgingLeaseToken,proto3" json:"judging_lease_token,omitempty"`
+}
+
+func (x *RenewSubmissionJudgingLeaseRequest) Reset() {
+	*x = RenewSubmissionJudgingLeaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenewSubmissionJudgingLeaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewSubmissionJudgingLeaseRequest) ProtoMessage() {}
+
+func (x *RenewSubmissionJudgingLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewSubmissionJudgingLeaseRequest.ProtoReflect.Descriptor instead.
+func (*RenewSubmissionJudgingLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *RenewSubmissionJudgingLeaseRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RenewSubmissionJudgingLeaseRequest) GetJudgingLeaseToken() string {
+	if x != nil {
+		return x.JudgingLeaseToken
+	}
+	return ""
+}
+
+type RenewSubmissionJudgingLeaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RenewSubmissionJudgingLeaseResponse) Reset() {
+	*x = RenewSubmissionJudgingLeaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenewSubmissionJudgingLeaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewSubmissionJudgingLeaseResponse) ProtoMessage() {}
+
+func (x *RenewSubmissionJudgingLeaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewSubmissionJudgingLeaseResponse.ProtoReflect.Descriptor instead.
+func (*RenewSubmissionJudgingLeaseResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{102}
+}
+
+type JudgedTestCaseResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OfTestCaseId uint64           `protobuf:"varint,1,opt,name=of_test_case_id,json=ofTestCaseId,proto3" json:"of_test_case_id,omitempty"`
+	Result       SubmissionResult `protobuf:"varint,2,opt,name=result,proto3,enum=ojs.SubmissionResult" json:"result,omitempty"`
+	WallTime     string           `protobuf:"bytes,3,opt,name=wall_time,json=wallTime,proto3" json:"wall_time,omitempty"`
+	CpuTime      string           `protobuf:"bytes,4,opt,name=cpu_time,json=cpuTime,proto3" json:"cpu_time,omitempty"`
+	Memory       string           `protobuf:"bytes,5,opt,name=memory,proto3" json:"memory,omitempty"`
+	ExitCode     int32            `protobuf:"varint,6,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Stdout       string           `protobuf:"bytes,7,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr       string           `protobuf:"bytes,8,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	// Fraction of the test case's points earned, given by the problem's checker. Ignored for accepted test cases.
+	Credit float64 `protobuf:"fixed64,9,opt,name=credit,proto3" json:"credit,omitempty"`
+}
+
+func (x *JudgedTestCaseResult) Reset() {
+	*x = JudgedTestCaseResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JudgedTestCaseResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JudgedTestCaseResult) ProtoMessage() {}
+
+func (x *JudgedTestCaseResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JudgedTestCaseResult.ProtoReflect.Descriptor instead.
+func (*JudgedTestCaseResult) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *JudgedTestCaseResult) GetOfTestCaseId() uint64 {
+	if x != nil {
+		return x.OfTestCaseId
+	}
+	return 0
+}
+
+func (x *JudgedTestCaseResult) GetResult() SubmissionResult {
+	if x != nil {
+		return x.Result
+	}
+	return SubmissionResult_UndefinedResult
+}
+
+func (x *JudgedTestCaseResult) GetWallTime() string {
+	if x != nil {
+		return x.WallTime
+	}
+	return ""
+}
+
+func (x *JudgedTestCaseResult) GetCpuTime() string {
+	if x != nil {
+		return x.CpuTime
+	}
+	return ""
+}
+
+func (x *JudgedTestCaseResult) GetMemory() string {
+	if x != nil {
+		return x.Memory
+	}
+	return ""
+}
+
+func (x *JudgedTestCaseResult) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *JudgedTestCaseResult) GetStdout() string {
+	if x != nil {
+		return x.Stdout
+	}
+	return ""
+}
+
+func (x *JudgedTestCaseResult) GetStderr() string {
+	if x != nil {
+		return x.Stderr
+	}
+	return ""
+}
+
+func (x *JudgedTestCaseResult) GetCredit() float64 {
+	if x != nil {
+		return x.Credit
+	}
+	return 0
+}
+
+type ReportSubmissionResultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Only CompileError and UnsupportedLanguage are reported as is, along with the compile output.
+	// Otherwise the submission's result and score are computed from the results of its test cases.
+	Result            SubmissionResult        `protobuf:"varint,2,opt,name=result,proto3,enum=ojs.SubmissionResult" json:"result,omitempty"`
+	CompileOutput     string                  `protobuf:"bytes,3,opt,name=compile_output,json=compileOutput,proto3" json:"compile_output,omitempty"`
+	TestCaseResults   []*JudgedTestCaseResult `protobuf:"bytes,4,rep,name=test_case_results,json=testCaseResults,proto3" json:"test_case_results,omitempty"`
+	JudgingLeaseToken string                  `protobuf:"bytes,5,opt,name=judging_lease_token,json=judgingLeaseToken,proto3" json:"judging_lease_token,omitempty"`
+}
+
+func (x *ReportSubmissionResultRequest) Reset() {
+	*x = ReportSubmissionResultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportSubmissionResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportSubmissionResultRequest) ProtoMessage() {}
+
+func (x *ReportSubmissionResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportSubmissionResultRequest.ProtoReflect.Descriptor instead.
+func (*ReportSubmissionResultRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ReportSubmissionResultRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReportSubmissionResultRequest) GetResult() SubmissionResult {
+	if x != nil {
+		return x.Result
+	}
+	return SubmissionResult_UndefinedResult
+}
+
+func (x *ReportSubmissionResultRequest) GetCompileOutput() string {
+	if x != nil {
+		return x.CompileOutput
+	}
+	return ""
+}
+
+func (x *ReportSubmissionResultRequest) GetTestCaseResults() []*JudgedTestCaseResult {
+	if x != nil {
+		return x.TestCaseResults
+	}
+	return nil
+}
+
+func (x *ReportSubmissionResultRequest) GetJudgingLeaseToken() string {
+	if x != nil {
+		return x.JudgingLeaseToken
+	}
+	return ""
+}
+
+type ReportSubmissionResultResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Submission *Submission `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+}
+
+func (x *ReportSubmissionResultResponse) Reset() {
+	*x = ReportSubmissionResultResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportSubmissionResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportSubmissionResultResponse) ProtoMessage() {}
+
+func (x *ReportSubmissionResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportSubmissionResultResponse.ProtoReflect.Descriptor instead.
+func (*ReportSubmissionResultResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ReportSubmissionResultResponse) GetSubmission() *Submission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+type Rejudge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AuthorId        uint64 `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	CreatedAt       string `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	SubmissionCount uint64 `protobuf:"varint,4,opt,name=submission_count,json=submissionCount,proto3" json:"submission_count,omitempty"`
+}
+
+func (x *Rejudge) Reset() {
+	*x = Rejudge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rejudge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rejudge) ProtoMessage() {}
+
+func (x *Rejudge) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rejudge.ProtoReflect.Descriptor instead.
+func (*Rejudge) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *Rejudge) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Rejudge) GetAuthorId() uint64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *Rejudge) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Rejudge) GetSubmissionCount() uint64 {
+	if x != nil {
+		return x.SubmissionCount
+	}
+	return 0
+}
+
+type RejudgedSubmission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmissionId   uint64           `protobuf:"varint,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	PreviousResult SubmissionResult `protobuf:"varint,2,opt,name=previous_result,json=previousResult,proto3,enum=ojs.SubmissionResult" json:"previous_result,omitempty"`
+	PreviousScore  uint64           `protobuf:"varint,3,opt,name=previous_score,json=previousScore,proto3" json:"previous_score,omitempty"`
+	Status         SubmissionStatus `protobuf:"varint,4,opt,name=status,proto3,enum=ojs.SubmissionStatus" json:"status,omitempty"`
+	Result         SubmissionResult `protobuf:"varint,5,opt,name=result,proto3,enum=ojs.SubmissionResult" json:"result,omitempty"`
+	Score          uint64           `protobuf:"varint,6,opt,name=score,proto3" json:"score,omitempty"`
+	IsChanged      bool             `protobuf:"varint,7,opt,name=is_changed,json=isChanged,proto3" json:"is_changed,omitempty"`
+}
+
+func (x *RejudgedSubmission) Reset() {
+	*x = RejudgedSubmission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejudgedSubmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejudgedSubmission) ProtoMessage() {}
+
+func (x *RejudgedSubmission) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejudgedSubmission.ProtoReflect.Descriptor instead.
+func (*RejudgedSubmission) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *RejudgedSubmission) GetSubmissionId() uint64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *RejudgedSubmission) GetPreviousResult() SubmissionResult {
+	if x != nil {
+		return x.PreviousResult
+	}
+	return SubmissionResult_UndefinedResult
+}
+
+func (x *RejudgedSubmission) GetPreviousScore() uint64 {
+	if x != nil {
+		return x.PreviousScore
+	}
+	return 0
+}
+
+func (x *RejudgedSubmission) GetStatus() SubmissionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SubmissionStatus_UndefinedStatus
+}
+
+func (x *RejudgedSubmission) GetResult() SubmissionResult {
+	if x != nil {
+		return x.Result
+	}
+	return SubmissionResult_UndefinedResult
+}
+
+func (x *RejudgedSubmission) GetScore() uint64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *RejudgedSubmission) GetIsChanged() bool {
+	if x != nil {
+		return x.IsChanged
+	}
+	return false
+}
+
+type RejudgeSubmissionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RejudgeSubmissionRequest) Reset() {
+	*x = RejudgeSubmissionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejudgeSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejudgeSubmissionRequest) ProtoMessage() {}
+
+func (x *RejudgeSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejudgeSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*RejudgeSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *RejudgeSubmissionRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RejudgeSubmissionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rejudge *Rejudge `protobuf:"bytes,1,opt,name=rejudge,proto3" json:"rejudge,omitempty"`
+}
+
+func (x *RejudgeSubmissionResponse) Reset() {
+	*x = RejudgeSubmissionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejudgeSubmissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejudgeSubmissionResponse) ProtoMessage() {}
+
+func (x *RejudgeSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejudgeSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*RejudgeSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *RejudgeSubmissionResponse) GetRejudge() *Rejudge {
+	if x != nil {
+		return x.Rejudge
+	}
+	return nil
+}
+
+type RejudgeProblemRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RejudgeProblemRequest) Reset() {
+	*x = RejudgeProblemRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejudgeProblemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejudgeProblemRequest) ProtoMessage() {}
+
+func (x *RejudgeProblemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejudgeProblemRequest.ProtoReflect.Descriptor instead.
+func (*RejudgeProblemRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *RejudgeProblemRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RejudgeProblemResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rejudge *Rejudge `protobuf:"bytes,1,opt,name=rejudge,proto3" json:"rejudge,omitempty"`
+}
+
+func (x *RejudgeProblemResponse) Reset() {
+	*x = RejudgeProblemResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejudgeProblemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejudgeProblemResponse) ProtoMessage() {}
+
+func (x *RejudgeProblemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejudgeProblemResponse.ProtoReflect.Descriptor instead.
+func (*RejudgeProblemResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *RejudgeProblemResponse) GetRejudge() *Rejudge {
+	if x != nil {
+		return x.Rejudge
+	}
+	return nil
+}
+
+type RejudgeContestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RejudgeContestRequest) Reset() {
+	*x = RejudgeContestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejudgeContestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejudgeContestRequest) ProtoMessage() {}
+
+func (x *RejudgeContestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejudgeContestRequest.ProtoReflect.Descriptor instead.
+func (*RejudgeContestRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *RejudgeContestRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RejudgeContestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rejudge *Rejudge `protobuf:"bytes,1,opt,name=rejudge,proto3" json:"rejudge,omitempty"`
+}
+
+func (x *RejudgeContestResponse) Reset() {
+	*x = RejudgeContestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejudgeContestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejudgeContestResponse) ProtoMessage() {}
+
+func (x *RejudgeContestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejudgeContestResponse.ProtoReflect.Descriptor instead.
+func (*RejudgeContestResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *RejudgeContestResponse) GetRejudge() *Rejudge {
+	if x != nil {
+		return x.Rejudge
+	}
+	return nil
+}
+
+type GetRejudgeReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetRejudgeReportRequest) Reset() {
+	*x = GetRejudgeReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRejudgeReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRejudgeReportRequest) ProtoMessage() {}
+
+func (x *GetRejudgeReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRejudgeReportRequest.ProtoReflect.Descriptor instead.
+func (*GetRejudgeReportRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *GetRejudgeReportRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetRejudgeReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rejudge                *Rejudge              `protobuf:"bytes,1,opt,name=rejudge,proto3" json:"rejudge,omitempty"`
+	RejudgedSubmissions    []*RejudgedSubmission `protobuf:"bytes,2,rep,name=rejudged_submissions,json=rejudgedSubmissions,proto3" json:"rejudged_submissions,omitempty"`
+	PendingSubmissionCount uint64                `protobuf:"varint,3,opt,name=pending_submission_count,json=pendingSubmissionCount,proto3" json:"pending_submission_count,omitempty"`
+	ChangedSubmissionCount uint64                `protobuf:"varint,4,opt,name=changed_submission_count,json=changedSubmissionCount,proto3" json:"changed_submission_count,omitempty"`
+}
+
+func (x *GetRejudgeReportResponse) Reset() {
+	*x = GetRejudgeReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRejudgeReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRejudgeReportResponse) ProtoMessage() {}
+
+func (x *GetRejudgeReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRejudgeReportResponse.ProtoReflect.Descriptor instead.
+func (*GetRejudgeReportResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *GetRejudgeReportResponse) GetRejudge() *Rejudge {
+	if x != nil {
+		return x.Rejudge
+	}
+	return nil
+}
+
+func (x *GetRejudgeReportResponse) GetRejudgedSubmissions() []*RejudgedSubmission {
+	if x != nil {
+		return x.RejudgedSubmissions
+	}
+	return nil
+}
+
+func (x *GetRejudgeReportResponse) GetPendingSubmissionCount() uint64 {
+	if x != nil {
+		return x.PendingSubmissionCount
+	}
+	return 0
+}
+
+func (x *GetRejudgeReportResponse) GetChangedSubmissionCount() uint64 {
+	if x != nil {
+		return x.ChangedSubmissionCount
+	}
+	return 0
+}
+
+type DeadLetteredSubmission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OfSubmissionId uint64 `protobuf:"varint,2,opt,name=of_submission_id,json=ofSubmissionId,proto3" json:"of_submission_id,omitempty"`
+	Error          string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	AttemptCount   uint32 `protobuf:"varint,4,opt,name=attempt_count,json=attemptCount,proto3" json:"attempt_count,omitempty"`
+	CreatedAt      string `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *DeadLetteredSubmission) Reset() {
+	*x = DeadLetteredSubmission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeadLetteredSubmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetteredSubmission) ProtoMessage() {}
+
+func (x *DeadLetteredSubmission) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetteredSubmission.ProtoReflect.Descriptor instead.
+func (*DeadLetteredSubmission) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *DeadLetteredSubmission) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeadLetteredSubmission) GetOfSubmissionId() uint64 {
+	if x != nil {
+		return x.OfSubmissionId
+	}
+	return 0
+}
+
+func (x *DeadLetteredSubmission) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DeadLetteredSubmission) GetAttemptCount() uint32 {
+	if x != nil {
+		return x.AttemptCount
+	}
+	return 0
+}
+
+func (x *DeadLetteredSubmission) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetDeadLetteredSubmissionListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetDeadLetteredSubmissionListRequest) Reset() {
+	*x = GetDeadLetteredSubmissionListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeadLetteredSubmissionListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadLetteredSubmissionListRequest) ProtoMessage() {}
+
+func (x *GetDeadLetteredSubmissionListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadLetteredSubmissionListRequest.ProtoReflect.Descriptor instead.
+func (*GetDeadLetteredSubmissionListRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetDeadLetteredSubmissionListRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetDeadLetteredSubmissionListRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetDeadLetteredSubmissionListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeadLetteredSubmissions          []*DeadLetteredSubmission `protobuf:"bytes,1,rep,name=dead_lettered_submissions,json=deadLetteredSubmissions,proto3" json:"dead_lettered_submissions,omitempty"`
+	TotalDeadLetteredSubmissionCount uint64                    `protobuf:"varint,2,opt,name=total_dead_lettered_submission_count,json=totalDeadLetteredSubmissionCount,proto3" json:"total_dead_lettered_submission_count,omitempty"`
+}
+
+func (x *GetDeadLetteredSubmissionListResponse) Reset() {
+	*x = GetDeadLetteredSubmissionListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeadLetteredSubmissionListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadLetteredSubmissionListResponse) ProtoMessage() {}
+
+func (x *GetDeadLetteredSubmissionListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadLetteredSubmissionListResponse.ProtoReflect.Descriptor instead.
+func (*GetDeadLetteredSubmissionListResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *GetDeadLetteredSubmissionListResponse) GetDeadLetteredSubmissions() []*DeadLetteredSubmission {
+	if x != nil {
+		return x.DeadLetteredSubmissions
+	}
+	return nil
+}
+
+func (x *GetDeadLetteredSubmissionListResponse) GetTotalDeadLetteredSubmissionCount() uint64 {
+	if x != nil {
+		return x.TotalDeadLetteredSubmissionCount
+	}
+	return 0
+}
+
+type ReplayDeadLetteredSubmissionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ReplayDeadLetteredSubmissionRequest) Reset() {
+	*x = ReplayDeadLetteredSubmissionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayDeadLetteredSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLetteredSubmissionRequest) ProtoMessage() {}
+
+func (x *ReplayDeadLetteredSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLetteredSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLetteredSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ReplayDeadLetteredSubmissionRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ReplayDeadLetteredSubmissionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeadLetteredSubmission *DeadLetteredSubmission `protobuf:"bytes,1,opt,name=dead_lettered_submission,json=deadLetteredSubmission,proto3" json:"dead_lettered_submission,omitempty"`
+	IsReplayed             bool                    `protobuf:"varint,2,opt,name=is_replayed,json=isReplayed,proto3" json:"is_replayed,omitempty"`
+}
+
+func (x *ReplayDeadLetteredSubmissionResponse) Reset() {
+	*x = ReplayDeadLetteredSubmissionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayDeadLetteredSubmissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLetteredSubmissionResponse) ProtoMessage() {}
+
+func (x *ReplayDeadLetteredSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLetteredSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLetteredSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ReplayDeadLetteredSubmissionResponse) GetDeadLetteredSubmission() *DeadLetteredSubmission {
+	if x != nil {
+		return x.DeadLetteredSubmission
+	}
+	return nil
+}
+
+func (x *ReplayDeadLetteredSubmissionResponse) GetIsReplayed() bool {
+	if x != nil {
+		return x.IsReplayed
+	}
+	return false
+}
+
+type UpdateSettingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateSettingRequest) Reset() {
+	*x = UpdateSettingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSettingRequest) ProtoMessage() {}
+
+func (x *UpdateSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSettingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSettingRequest) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{121}
+}
+
+type UpdateSettingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateSettingResponse) Reset() {
+	*x = UpdateSettingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ojs_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSettingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSettingResponse) ProtoMessage() {}
+
+func (x *UpdateSettingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ojs_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSettingResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSettingResponse) Descriptor() ([]byte, []int) {
+	return file_ojs_proto_rawDescGZIP(), []int{122}
+}
+
+var File_ojs_proto protoreflect.FileDescriptor
+
+var file_ojs_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x6f, 0x6a, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x6f, 0x6a, 0x73,
+	0x1a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x16,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x9d, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xfa, 0x42, 0x17, 0x72, 0x15, 0x32, 0x13, 0x5e,
+	0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x33, 0x2c, 0x33, 0x32,
+	0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xfa, 0x42, 0x17, 0x72,
+	0x15, 0x32, 0x13, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b,
+	0x33, 0x2c, 0x33, 0x32, 0x7d, 0x24, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x1d, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09,
+	0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22,
+	0x6d, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x6f,
+	0x6a, 0x73, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x69, 0x73, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x3f,
+	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x3c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x6a,
+	0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x64, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x18,
+	0x64, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x72, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x13,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x56, 0x0a, 0x14,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1a, 0xfa, 0x42, 0x17, 0x72, 0x15, 0x32, 0x13, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
+	0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x33, 0x2c, 0x33, 0x32, 0x7d, 0x24, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3f, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xfa, 0x42, 0x17, 0x72, 0x15, 0x32,
+	0x13, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x33, 0x2c,
+	0x33, 0x32, 0x7d, 0x24, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x15, 0x53,
+	0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x09, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x22, 0x40, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x27, 0x0a, 0x15, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x40,
+	0x0a, 0x16, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x7e, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xfa, 0x42, 0x17, 0x72, 0x15, 0x32, 0x13, 0x5e,
+	0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x33, 0x2c, 0x33, 0x32,
+	0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xfa, 0x42, 0x17, 0x72,
+	0x15, 0x32, 0x13, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b,
+	0x33, 0x2c, 0x33, 0x32, 0x7d, 0x24, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x22, 0x3f, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x6a, 0x73,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x40, 0x0a, 0x16, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x1a, 0x0a,
+	0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x70, 0x0a, 0x0a, 0x4a, 0x53, 0x4f, 0x4e, 0x57, 0x65,
+	0x62, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x74, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x6c, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x73,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x73, 0x65, 0x12, 0x0c, 0x0a, 0x01,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x6e, 0x12, 0x0c, 0x0a, 0x01, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4a,
+	0x53, 0x4f, 0x4e, 0x57, 0x65, 0x62, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x3f, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x57, 0x65,
+	0x62, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x23, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x6f, 0x6a, 0x73, 0x2e, 0x4a, 0x53, 0x4f, 0x4e, 0x57, 0x65, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x04,
+	0x6b, 0x65, 0x79, 0x73, 0x22, 0xa0, 0x02, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x4c, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x6f, 0x6a, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x69, 0x73, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02,
+	0x10, 0x01, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x33, 0x0a, 0x0d, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x65, 0x70, 0x73, 0x69,
+	0x6c, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x12, 0x09,
+	0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x0c, 0x66, 0x6c, 0x6f, 0x61, 0x74,
+	0x45, 0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x22, 0xeb, 0x03, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x62,
+	0x6c, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x61, 0x73, 0x5f,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x68,
+	0x61, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x65, 0x72, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x4c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x6f, 0x6a, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x69, 0x73, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x69, 0x73, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x6c, 0x6f, 0x61,
+	0x74, 0x5f, 0x65, 0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0c, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x45, 0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x12, 0x25, 0x0a,
+	0x0e, 0x68, 0x61, 0x73, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x68, 0x61, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x3f, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x22, 0x4e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x18, 0x64, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x72, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x28, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72,
+	0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x3c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x22, 0xb0, 0x02,
+	0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x4c, 0x0a,
+	0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x4d, 0x6f, 0x64,
+	0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x33, 0x0a, 0x0d, 0x66,
+	0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x65, 0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x12, 0x09, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x52, 0x0c, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x45, 0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e,
+	0x22, 0x3f, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x70, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x6a, 0x73,
+	0x2e, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65,
+	0x6d, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c,
+	0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x61, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x62,
+	0x6c, 0x65, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x46, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x22, 0x64, 0x0a,
+	0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x22, 0x49, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x50, 0x72,
+	0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x22, 0xb8,
+	0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x66, 0x5f, 0x70,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x6f, 0x66, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
+	0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
+	0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x30, 0x0a, 0x15, 0x6f, 0x66, 0x5f, 0x74, 0x65,
+	0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6f, 0x66, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61,
+	0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0xbb, 0x01, 0x0a, 0x08, 0x54, 0x65,
+	0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x66, 0x5f, 0x70, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f,
+	0x66, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x68,
+	0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x48,
+	0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x30, 0x0a, 0x15, 0x6f, 0x66, 0x5f, 0x74, 0x65, 0x73, 0x74,
+	0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6f, 0x66, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2a, 0x0a, 0x09, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x73, 0x65, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x22, 0x5d, 0x0a,
+	0x1d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x54, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x83, 0x01, 0x0a,
+	0x1e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x54, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2c, 0x0a, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61,
+	0x73, 0x65, 0x52, 0x09, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x12, 0x33, 0x0a,
+	0x16, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65,
+	0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x24, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x41, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54,
+	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2a, 0x0a, 0x09, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
+	0x65, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x15,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x12, 0x30, 0x0a, 0x15, 0x6f, 0x66, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65,
+	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x11, 0x6f, 0x66, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x64, 0x22, 0x44, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x09,
+	0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x08,
+	0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc6, 0x01, 0x0a, 0x0d,
+	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a,
+	0x0d, 0x6f, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x66, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x46, 0x0a, 0x0e,
+	0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x22, 0xdb, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x66, 0x50, 0x72,
+	0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa,
+	0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x52,
+	0x0a, 0x0e, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x54, 0x65, 0x73,
+	0x74, 0x43, 0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e,
+	0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01, 0x04, 0x10,
+	0x01, 0x20, 0x00, 0x52, 0x0d, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x22, 0x59, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3a, 0x0a, 0x0f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x6a, 0x73,
+	0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0d,
+	0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x34, 0x0a,
+	0x22, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x54, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x63, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65,
+	0x6d, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x10, 0x74, 0x65,
+	0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0e, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61,
+	0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0xc3, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x72, 0x03, 0x18, 0xff, 0x01, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x50, 0x0a, 0x0e,
+	0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x0d, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x59,
+	0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a,
+	0x0f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x54, 0x65, 0x73,
+	0x74, 0x43, 0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0d, 0x74, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x2c, 0x0a, 0x1a, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x97, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x66, 0x50, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0d,
+	0x6f, 0x66, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x66, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x22, 0xd2, 0x02, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x22, 0x0a, 0x0d, 0x6f, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x66, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65,
+	0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x5f,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f,
+	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6f,
+	0x66, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x6f, 0x66, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x4b, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x26, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x48, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x86,
+	0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0b,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x36, 0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc7, 0x02, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x66, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x6f, 0x66, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x25,
+	0x0a, 0x0f, 0x6f, 0x66, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6f, 0x66, 0x54, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x70, 0x75, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x70, 0x75, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64,
+	0x65, 0x72, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72,
+	0x72, 0x22, 0x38, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x89, 0x01, 0x0a, 0x27,
+	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x73,
+	0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x1c, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x6f, 0x6a, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65,
+	0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x19, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x28, 0x0a, 0x16, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0xe4, 0x01, 0x0a, 0x17, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2d, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x6f, 0x6a, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6f,
+	0x6a, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x33, 0x0a, 0x16, 0x6a,
+	0x75, 0x64, 0x67, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6a, 0x75, 0x64,
+	0x67, 0x65, 0x64, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x26, 0x0a, 0x0f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5f, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x20, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
+	0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x26, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0x94, 0x01, 0x0a, 0x27, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0b,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x36, 0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5b, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x73, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xfa, 0x42, 0x11, 0x72, 0x0f, 0x32, 0x0d,
+	0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x3f, 0x24, 0x52, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x22, 0x91, 0x03, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x69, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x2f, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x6a,
+	0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73,
+	0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x69, 0x73, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12,
+	0x3a, 0x0a, 0x0c, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0b,
+	0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x66,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x22, 0xe4, 0x02, 0x0a, 0x14, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2d, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01,
+	0x18, 0xff, 0x01, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x69, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x2f, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x6a,
+	0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x12, 0x46, 0x0a, 0x0c, 0x73, 0x63,
+	0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x53, 0x63,
+	0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01,
+	0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x0b, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x66,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x22,
+	0x3f, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74,
+	0x22, 0x4e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x1d, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x18, 0x64, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0x72, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f,
+	0x6a, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x73, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3c, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x26, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x22, 0x9e, 0x03, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x2b, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xff, 0x01,
+	0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x09, 0x69, 0x73, 0x5f,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08,
+	0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x6f, 0x6a, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c,
+	0x65, 0x6d, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x12, 0x44, 0x0a, 0x0c,
+	0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74,
+	0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0b, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x30, 0x0a, 0x11, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52,
+	0x10, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x5f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x22, 0x3f, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x26, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x0a, 0x16, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2a, 0x0a, 0x18, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x55,
+	0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd9, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x43, 0x65,
+	0x6c, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x53, 0x6f,
+	0x6c, 0x76, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x5f, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x11, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x41, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x22, 0xfc, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x6f, 0x77, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x72, 0x61, 0x6e,
+	0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x12, 0x30, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x65,
+	0x6c, 0x6c, 0x73, 0x22, 0x2d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73,
+	0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0xa6, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73,
+	0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6f, 0x6a, 0x73, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x0b, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x12, 0x2d, 0x0a, 0x04,
+	0x72, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x6a, 0x73,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x38, 0x0a, 0x36, 0x47,
+	0x65, 0x74, 0x41, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x73, 0x74,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc4, 0x02, 0x0a, 0x37, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x64,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65,
+	0x6d, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x2c, 0x0a, 0x0a, 0x74, 0x65,
+	0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x09, 0x74,
+	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x65, 0x72, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x13,
+	0x6a, 0x75, 0x64, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6a, 0x75, 0x64, 0x67, 0x69,
+	0x6e, 0x67, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6d, 0x0a, 0x22,
+	0x52, 0x65, 0x6e, 0x65, 0x77, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4a,
+	0x75, 0x64, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x37, 0x0a, 0x13, 0x6a, 0x75, 0x64, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x11, 0x6a, 0x75, 0x64, 0x67, 0x69, 0x6e,
+	0x67, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x25, 0x0a, 0x23, 0x52,
+	0x65, 0x6e, 0x65, 0x77, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4a, 0x75,
+	0x64, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xc4, 0x02, 0x0a, 0x14, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x64, 0x54, 0x65, 0x73,
+	0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x0a, 0x0f, 0x6f,
+	0x66, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6f, 0x66, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
+	0x49, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77,
+	0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x77, 0x61, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x70, 0x75, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x70, 0x75, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x65,
+	0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x6f,
+	0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x12, 0x12, 0x19,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x52, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x22, 0x8f, 0x02, 0x0a, 0x1d, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6f, 0x6a,
+	0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x5f,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f,
+	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x45, 0x0a, 0x11, 0x74,
+	0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x4a, 0x75, 0x64,
+	0x67, 0x65, 0x64, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x0f, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x12, 0x37, 0x0a, 0x13, 0x6a, 0x75, 0x64, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x11, 0x6a, 0x75, 0x64, 0x67, 0x69, 0x6e,
+	0x67, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x51, 0x0a, 0x1e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a,
+	0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x80,
+	0x01, 0x0a, 0x07, 0x52, 0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0xb3, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x64, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3e, 0x0a,
+	0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0e, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x22, 0x2a, 0x0a, 0x18, 0x52, 0x65, 0x6a, 0x75, 0x64,
+	0x67, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x43, 0x0a, 0x19, 0x52, 0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x26, 0x0a, 0x07, 0x72, 0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x52,
+	0x07, 0x72, 0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x22, 0x27, 0x0a, 0x15, 0x52, 0x65, 0x6a, 0x75,
+	0x64, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x40, 0x0a, 0x16, 0x52, 0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x62,
+	0x6c, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x72,
+	0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f,
+	0x6a, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x07, 0x72, 0x65, 0x6a, 0x75,
+	0x64, 0x67, 0x65, 0x22, 0x27, 0x0a, 0x15, 0x52, 0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x40, 0x0a, 0x16,
+	0x52, 0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x72, 0x65, 0x6a, 0x75, 0x64, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x52, 0x65,
+	0x6a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x07, 0x72, 0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x22, 0x29,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x82, 0x02, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x72, 0x65, 0x6a, 0x75, 0x64, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x52, 0x65,
+	0x6a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x07, 0x72, 0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x12, 0x4a,
+	0x0a, 0x14, 0x72, 0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f,
+	0x6a, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x72, 0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x64, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xac,
+	0x01, 0x0a, 0x16, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x66, 0x5f,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x66, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5d, 0x0a,
+	0x24, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x32, 0x02, 0x18, 0x64, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xd0, 0x01, 0x0a,
+	0x25, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x19, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x6a, 0x73, 0x2e,
+	0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x17, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x4e, 0x0a, 0x24, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x20, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x35, 0x0a, 0x23, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x9e, 0x01, 0x0a, 0x24, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x55, 0x0a, 0x18, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x16,
+	0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x17, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x52, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65,
+	0x12, 0x11, 0x0a, 0x0d, 0x55, 0x6e, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x6f, 0x6c,
+	0x65, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x10, 0x01, 0x12, 0x0d,
+	0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x10, 0x02, 0x12, 0x11, 0x0a,
+	0x0d, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x65, 0x72, 0x10, 0x03,
+	0x12, 0x0a, 0x0a, 0x06, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x10, 0x04, 0x2a, 0x87, 0x01, 0x0a,
+	0x14, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f,
+	0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x55, 0x6e, 0x64, 0x65, 0x66, 0x69, 0x6e,
+	0x65, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65,
+	0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x78, 0x61, 0x63, 0x74, 0x10, 0x01, 0x12, 0x1c, 0x0a,
+	0x18, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x57,
+	0x68, 0x69, 0x74, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x61, 0x73, 0x65, 0x49, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x46,
+	0x6c, 0x6f, 0x61, 0x74, 0x10, 0x05, 0x2a, 0x5c, 0x0a, 0x1a, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61,
+	0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x16, 0x55, 0x6e, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65,
+	0x64, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x10, 0x00,
+	0x12, 0x10, 0x0a, 0x0c, 0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67,
+	0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x10, 0x02, 0x2a, 0x53, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x6e, 0x64, 0x65,
+	0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0x00, 0x12, 0x0d, 0x0a,
+	0x09, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x46,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x10, 0x03, 0x2a, 0xad, 0x01, 0x0a, 0x10, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x13,
+	0x0a, 0x0f, 0x55, 0x6e, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x43,
+	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x02, 0x12, 0x10, 0x0a,
+	0x0c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x03, 0x12,
+	0x15, 0x0a, 0x11, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x45, 0x78, 0x63, 0x65,
+	0x65, 0x64, 0x65, 0x64, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x10, 0x05, 0x12,
+	0x0f, 0x0a, 0x0b, 0x57, 0x72, 0x6f, 0x6e, 0x67, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x10, 0x06,
+	0x12, 0x17, 0x0a, 0x13, 0x55, 0x6e, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x10, 0x07, 0x2a, 0x41, 0x0a, 0x12, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x18, 0x0a, 0x14, 0x55, 0x6e, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x43, 0x50,
+	0x43, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x4f, 0x49, 0x10, 0x02, 0x32, 0xbb, 0x32, 0x0a,
+	0x0a, 0x4f, 0x6a, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x2e, 0x6f,
+	0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x63, 0x0a, 0x0d, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x6f, 0x6a, 0x73,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x5c,
+	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x6f,
+	0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x63, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a,
+	0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6f, 0x6a, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12,
+	0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x12, 0x68, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x19, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x6f, 0x6a, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1a, 0x3a, 0x01, 0x2a, 0x1a, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x74, 0x0a, 0x0e, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x2e,
+	0x6f, 0x6a, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6f, 0x6a, 0x73, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x3a, 0x01,
+	0x2a, 0x1a, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x70, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x6f, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x1a, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72,
+	0x6f, 0x6c, 0x65, 0x12, 0x73, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x2f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x63, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x6f, 0x6a, 0x73, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x60, 0x0a,
+	0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19,
+	0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x2a, 0x10, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x6e, 0x0a, 0x0e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x6f, 0x6a, 0x73, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12,
+	0x70, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x6c, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x2a, 0x14, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6c,
+	0x6c, 0x12, 0x6f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x57, 0x65, 0x62, 0x4b,
+	0x65, 0x79, 0x53, 0x65, 0x74, 0x12, 0x1c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4a,
+	0x53, 0x4f, 0x4e, 0x57, 0x65, 0x62, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x53, 0x4f,
+	0x4e, 0x57, 0x65, 0x62, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x2e, 0x77, 0x65,
+	0x6c, 0x6c, 0x2d, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x2f, 0x6a, 0x77, 0x6b, 0x73, 0x2e, 0x6a, 0x73,
+	0x6f, 0x6e, 0x12, 0x63, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x62,
+	0x6c, 0x65, 0x6d, 0x12, 0x19, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c,
+	0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x12, 0x63, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x12, 0x5c, 0x0a, 0x0a,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x16, 0x2e, 0x6f, 0x6a, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62,
+	0x6c, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x68, 0x0a, 0x0d, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x19, 0x2e, 0x6f, 0x6a,
+	0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x1a, 0x15, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x12, 0x65, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x19, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x17, 0x2a, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72,
+	0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x85, 0x01, 0x0a, 0x14,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x22, 0x3a, 0x01, 0x2a, 0x1a, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72,
+	0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x65, 0x72, 0x12, 0x91, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x23, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x62,
+	0x6c, 0x65, 0x6d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x25, 0x3a, 0x01, 0x2a, 0x1a, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x68, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2d, 0x63, 0x61, 0x73, 0x65,
+	0x73, 0x12, 0x8b, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
+	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x6f,
+	0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x54, 0x65, 0x73,
+	0x74, 0x43, 0x61, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65,
+	0x6d, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2d, 0x63, 0x61, 0x73, 0x65, 0x73, 0x12,
+	0x61, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x17,
+	0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2d, 0x63, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x12, 0x6d, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73,
+	0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x1a, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2d, 0x63, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x12, 0x6a, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x73, 0x65, 0x12, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x19, 0x2a, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65,
+	0x73, 0x74, 0x2d, 0x63, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x7d, 0x0a,
+	0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x1f, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a,
+	0x01, 0x2a, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x73, 0x74,
+	0x2d, 0x63, 0x61, 0x73, 0x65, 0x2d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0xa0, 0x01, 0x0a,
+	0x1b, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x54, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x27, 0x2e, 0x6f,
+	0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x54, 0x65, 0x73,
+	0x74, 0x43, 0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x74,
+	0x65, 0x73, 0x74, 0x2d, 0x63, 0x61, 0x73, 0x65, 0x2d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12,
+	0x82, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61,
+	0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1f, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x22, 0x3a, 0x01, 0x2a, 0x1a, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x74,
+	0x65, 0x73, 0x74, 0x2d, 0x63, 0x61, 0x73, 0x65, 0x2d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x12, 0x7f, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65,
+	0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1f, 0x2e, 0x6f, 0x6a,
+	0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6f,
+	0x6a, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x2a, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x74, 0x65, 0x73, 0x74, 0x2d, 0x63, 0x61, 0x73, 0x65, 0x2d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x6f, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01,
+	0x2a, 0x22, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x68, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x12, 0x6f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0xb0, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2d, 0x63, 0x61, 0x73, 0x65, 0x2d, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x76, 0x0a, 0x0f, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x30, 0x01, 0x12, 0x92, 0x01, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x2e, 0x6f, 0x6a, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0xc5, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x47, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x12, 0x3f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73,
+	0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x63, 0x0a, 0x0d, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x6f, 0x6a, 0x73,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x12, 0x63,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6f,
+	0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x12, 0x12, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x73, 0x74, 0x73, 0x12, 0x5c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6f, 0x6a, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x12, 0x68, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x6f, 0x6a, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1a, 0x3a, 0x01, 0x2a, 0x1a, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x65, 0x0a, 0x0d, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x6f,
+	0x6a, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x2a, 0x15, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x12, 0x7b, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x22, 0x22, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x2f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12,
+	0x7e, 0x0a, 0x11, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x2a, 0x22, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x2f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12,
+	0x85, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x20, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6f, 0x6a, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0xae, 0x01, 0x0a, 0x2f, 0x47, 0x65, 0x74, 0x41,
+	0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x73, 0x74, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x3b, 0x2e, 0x6f, 0x6a,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69,
+	0x72, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x73, 0x74,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x1b, 0x52, 0x65, 0x6e, 0x65,
+	0x77, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4a, 0x75, 0x64, 0x67, 0x69,
+	0x6e, 0x67, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x27, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x52, 0x65,
+	0x6e, 0x65, 0x77, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4a, 0x75, 0x64,
+	0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4a, 0x75, 0x64, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x16,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x22, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6f, 0x6a, 0x73,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x7f, 0x0a, 0x11, 0x52, 0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x52, 0x65, 0x6a,
+	0x75, 0x64, 0x67, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x75,
+	0x64, 0x67, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01, 0x2a,
+	0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x6a, 0x75, 0x64,
+	0x67, 0x65, 0x12, 0x73, 0x0a, 0x0e, 0x52, 0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x50, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x12, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x75, 0x64,
+	0x67, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x50, 0x72,
+	0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f,
+	0x72, 0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x12, 0x73, 0x0a, 0x0e, 0x52, 0x65, 0x6a, 0x75, 0x64,
+	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e,
+	0x52, 0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x75,
+	0x64, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x12, 0x6e, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x1c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6a, 0x75, 0x64, 0x67,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x72,
+	0x65, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xa1, 0x01, 0x0a,
+	0x1d, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x29,
+	0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x61, 0x64, 0x2d, 0x6c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x2d, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0xad, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x28, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65,
+	0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6f, 0x6a,
+	0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x3a, 0x01,
+	0x2a, 0x22, 0x2d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x61, 0x64, 0x2d,
+	0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64, 0x2d, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x12, 0x48, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x19, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6f,
+	0x6a, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x0a, 0x5a, 0x08, 0x67, 0x72,
+	0x70, 0x63, 0x2f, 0x6f, 0x6a, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ojs_proto_rawDescOnce sync.Once
+	file_ojs_proto_rawDescData = file_ojs_proto_rawDesc
+)
+
+func file_ojs_proto_rawDescGZIP() []byte {
+	file_ojs_proto_rawDescOnce.Do(func() {
+		file_ojs_proto_rawDescData = protoimpl.X.CompressGZIP(file_ojs_proto_rawDescData)
+	})
+	return file_ojs_proto_rawDescData
+}
+
+var file_ojs_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_ojs_proto_msgTypes = make([]protoimpl.MessageInfo, 123)
+var file_ojs_proto_goTypes = []interface{}{
+	(Role)(0),                                                       // 0: ojs.Role
+	(OutputComparisonMode)(0),                                       // 1: ojs.OutputComparisonMode
+	(TestCaseGroupScoringPolicy)(0),                                 // 2: ojs.TestCaseGroupScoringPolicy
+	(SubmissionStatus)(0),                                           // 3: ojs.SubmissionStatus
+	(SubmissionResult)(0),                                           // 4: ojs.SubmissionResult
+	(ContestScoringMode)(0),                                         // 5: ojs.ContestScoringMode
+	(*GetServerInfoRequest)(nil),                                    // 6: ojs.GetServerInfoRequest
+	(*GetServerInfoResponse)(nil),                                   // 7: ojs.GetServerInfoResponse
+	(*CreateAccountRequest)(nil),                                    // 8: ojs.CreateAccountRequest
+	(*Account)(nil),                                                 // 9: ojs.Account
+	(*CreateAccountResponse)(nil),                                   // 10: ojs.CreateAccountResponse
+	(*GetAccountRequest)(nil),                                       // 11: ojs.GetAccountRequest
+	(*GetAccountResponse)(nil),                                      // 12: ojs.GetAccountResponse
+	(*GetAccountListRequest)(nil),                                   // 13: ojs.GetAccountListRequest
+	(*GetAccountListResponse)(nil),                                  // 14: ojs.GetAccountListResponse
+	(*UpdateAccountRequest)(nil),                                    // 15: ojs.UpdateAccountRequest
+	(*UpdateAccountResponse)(nil),                                   // 16: ojs.UpdateAccountResponse
+	(*ChangePasswordRequest)(nil),                                   // 17: ojs.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),                                  // 18: ojs.ChangePasswordResponse
+	(*SetAccountRoleRequest)(nil),                                   // 19: ojs.SetAccountRoleRequest
+	(*SetAccountRoleResponse)(nil),                                  // 20: ojs.SetAccountRoleResponse
+	(*DisableAccountRequest)(nil),                                   // 21: ojs.DisableAccountRequest
+	(*DisableAccountResponse)(nil),                                  // 22: ojs.DisableAccountResponse
+	(*CreateSessionRequest)(nil),                                    // 23: ojs.CreateSessionRequest
+	(*CreateSessionResponse)(nil),                                   // 24: ojs.CreateSessionResponse
+	(*DeleteSessionRequest)(nil),                                    // 25: ojs.DeleteSessionRequest
+	(*DeleteSessionResponse)(nil),                                   // 26: ojs.DeleteSessionResponse
+	(*RefreshSessionRequest)(nil),                                   // 27: ojs.RefreshSessionRequest
+	(*RefreshSessionResponse)(nil),                                  // 28: ojs.RefreshSessionResponse
+	(*DeleteAllSessionsRequest)(nil),                                // 29: ojs.DeleteAllSessionsRequest
+	(*DeleteAllSessionsResponse)(nil),                               // 30: ojs.DeleteAllSessionsResponse
+	(*JSONWebKey)(nil),                                              // 31: ojs.JSONWebKey
+	(*GetJSONWebKeySetRequest)(nil),                                 // 32: ojs.GetJSONWebKeySetRequest
+	(*GetJSONWebKeySetResponse)(nil),                                // 33: ojs.GetJSONWebKeySetResponse
+	(*CreateProblemRequest)(nil),                                    // 34: ojs.CreateProblemRequest
+	(*Problem)(nil),                                                 // 35: ojs.Problem
+	(*CreateProblemResponse)(nil),                                   // 36: ojs.CreateProblemResponse
+	(*GetProblemListRequest)(nil),                                   // 37: ojs.GetProblemListRequest
+	(*GetProblemListResponse)(nil),                                  // 38: ojs.GetProblemListResponse
+	(*GetProblemRequest)(nil),                                       // 39: ojs.GetProblemRequest
+	(*GetProblemResponse)(nil),                                      // 40: ojs.GetProblemResponse
+	(*UpdateProblemRequest)(nil),                                    // 41: ojs.UpdateProblemRequest
+	(*UpdateProblemResponse)(nil),                                   // 42: ojs.UpdateProblemResponse
+	(*DeleteProblemRequest)(nil),                                    // 43: ojs.DeleteProblemRequest
+	(*DeleteProblemResponse)(nil),                                   // 44: ojs.DeleteProblemResponse
+	(*UpdateProblemCheckerRequest)(nil),                             // 45: ojs.UpdateProblemCheckerRequest
+	(*UpdateProblemCheckerResponse)(nil),                            // 46: ojs.UpdateProblemCheckerResponse
+	(*UpdateProblemInteractorRequest)(nil),                          // 47: ojs.UpdateProblemInteractorRequest
+	(*UpdateProblemInteractorResponse)(nil),                         // 48: ojs.UpdateProblemInteractorResponse
+	(*CreateTestCaseRequest)(nil),                                   // 49: ojs.CreateTestCaseRequest
+	(*TestCase)(nil),                                                // 50: ojs.TestCase
+	(*CreateTestCaseResponse)(nil),                                  // 51: ojs.CreateTestCaseResponse
+	(*GetProblemTestCaseListRequest)(nil),                           // 52: ojs.GetProblemTestCaseListRequest
+	(*GetProblemTestCaseListResponse)(nil),                          // 53: ojs.GetProblemTestCaseListResponse
+	(*GetTestCaseRequest)(nil),                                      // 54: ojs.GetTestCaseRequest
+	(*GetTestCaseResponse)(nil),                                     // 55: ojs.GetTestCaseResponse
+	(*UpdateTestCaseRequest)(nil),                                   // 56: ojs.UpdateTestCaseRequest
+	(*UpdateTestCaseResponse)(nil),                                  // 57: ojs.UpdateTestCaseResponse
+	(*DeleteTestCaseRequest)(nil),                                   // 58: ojs.DeleteTestCaseRequest
+	(*DeleteTestCaseResponse)(nil),                                  // 59: ojs.DeleteTestCaseResponse
+	(*TestCaseGroup)(nil),                                           // 60: ojs.TestCaseGroup
+	(*CreateTestCaseGroupRequest)(nil),                              // 61: ojs.CreateTestCaseGroupRequest
+	(*CreateTestCaseGroupResponse)(nil),                             // 62: ojs.CreateTestCaseGroupResponse
+	(*GetProblemTestCaseGroupListRequest)(nil),                      // 63: ojs.GetProblemTestCaseGroupListRequest
+	(*GetProblemTestCaseGroupListResponse)(nil),                     // 64: ojs.GetProblemTestCaseGroupListResponse
+	(*UpdateTestCaseGroupRequest)(nil),                              // 65: ojs.UpdateTestCaseGroupRequest
+	(*UpdateTestCaseGroupResponse)(nil),                             // 66: ojs.UpdateTestCaseGroupResponse
+	(*DeleteTestCaseGroupRequest)(nil),                              // 67: ojs.DeleteTestCaseGroupRequest
+	(*DeleteTestCaseGroupResponse)(nil),                             // 68: ojs.DeleteTestCaseGroupResponse
+	(*CreateSubmissionRequest)(nil),                                 // 69: ojs.CreateSubmissionRequest
+	(*Submission)(nil),                                              // 70: ojs.Submission
+	(*CreateSubmissionResponse)(nil),                                // 71: ojs.CreateSubmissionResponse
+	(*GetSubmissionRequest)(nil),                                    // 72: ojs.GetSubmissionRequest
+	(*GetSubmissionResponse)(nil),                                   // 73: ojs.GetSubmissionResponse
+	(*GetSubmissionListRequest)(nil),                                // 74: ojs.GetSubmissionListRequest
+	(*GetSubmissionListResponse)(nil),                               // 75: ojs.GetSubmissionListResponse
+	(*SubmissionTestCaseResult)(nil),                                // 76: ojs.SubmissionTestCaseResult
+	(*GetSubmissionTestCaseResultListRequest)(nil),                  // 77: ojs.GetSubmissionTestCaseResultListRequest
+	(*GetSubmissionTestCaseResultListResponse)(nil),                 // 78: ojs.GetSubmissionTestCaseResultListResponse
+	(*WatchSubmissionRequest)(nil),                                  // 79: ojs.WatchSubmissionRequest
+	(*WatchSubmissionResponse)(nil),                                 // 80: ojs.WatchSubmissionResponse
+	(*GetProblemSubmissionListRequest)(nil),                         // 81: ojs.GetProblemSubmissionListRequest
+	(*GetProblemSubmissionListResponse)(nil),                        // 82: ojs.GetProblemSubmissionListResponse
+	(*GetAccountProblemSubmissionListRequest)(nil),                  // 83: ojs.GetAccountProblemSubmissionListRequest
+	(*GetAccountProblemSubmissionListResponse)(nil),                 // 84: ojs.GetAccountProblemSubmissionListResponse
+	(*ContestProblem)(nil),                                          // 85: ojs.ContestProblem
+	(*Contest)(nil),                                                 // 86: ojs.Contest
+	(*CreateContestRequest)(nil),                                    // 87: ojs.CreateContestRequest
+	(*CreateContestResponse)(nil),                                   // 88: ojs.CreateContestResponse
+	(*GetContestListRequest)(nil),                                   // 89: ojs.GetContestListRequest
+	(*GetContestListResponse)(nil),                                  // 90: ojs.GetContestListResponse
+	(*GetContestRequest)(nil),                                       // 91: ojs.GetContestRequest
+	(*GetContestResponse)(nil),                                      // 92: ojs.GetContestResponse
+	(*UpdateContestRequest)(nil),                                    // 93: ojs.UpdateContestRequest
+	(*UpdateContestResponse)(nil),                                   // 94: ojs.UpdateContestResponse
+	(*DeleteContestRequest)(nil),                                    // 95: ojs.DeleteContestRequest
+	(*DeleteContestResponse)(nil),                                   // 96: ojs.DeleteContestResponse
+	(*RegisterContestRequest)(nil),                                  // 97: ojs.RegisterContestRequest
+	(*RegisterContestResponse)(nil),                                 // 98: ojs.RegisterContestResponse
+	(*UnregisterContestRequest)(nil),                                // 99: ojs.UnregisterContestRequest
+	(*UnregisterContestResponse)(nil),                               // 100: ojs.UnregisterContestResponse
+	(*ContestScoreboardCell)(nil),                                   // 101: ojs.ContestScoreboardCell
+	(*ContestScoreboardRow)(nil),                                    // 102: ojs.ContestScoreboardRow
+	(*GetContestScoreboardRequest)(nil),                             // 103: ojs.GetContestScoreboardRequest
+	(*GetContestScoreboardResponse)(nil),                            // 104: ojs.GetContestScoreboardResponse
+	(*GetAndUpdateFirstSubmittedSubmissionToExecutingRequest)(nil),  // 105: ojs.GetAndUpdateFirstSubmittedSubmissionToExecutingRequest
+	(*GetAndUpdateFirstSubmittedSubmissionToExecutingResponse)(nil), // 106: ojs.GetAndUpdateFirstSubmittedSubmissionToExecutingResponse
+	(*RenewSubmissionJudgingLeaseRequest)(nil),                      // 107: ojs.RenewSubmissionJudgingLeaseRequest
+	(*RenewSubmissionJudgingLeaseResponse)(nil),                     // 108: ojs.RenewSubmissionJudgingLeaseResponse
+	(*JudgedTestCaseResult)(nil),                                    // 109: ojs.JudgedTestCaseResult
+	(*ReportSubmissionResultRequest)(nil),                           // 110: ojs.ReportSubmissionResultRequest
+	(*ReportSubmissionResultResponse)(nil),                          // 111: ojs.ReportSubmissionResultResponse
+	(*Rejudge)(nil),                                                 // 112: ojs.Rejudge
+	(*RejudgedSubmission)(nil),                                      // 113: ojs.RejudgedSubmission
+	(*RejudgeSubmissionRequest)(nil),                                // 114: ojs.RejudgeSubmissionRequest
+	(*RejudgeSubmissionResponse)(nil),                               // 115: ojs.RejudgeSubmissionResponse
+	(*RejudgeProblemRequest)(nil),                                   // 116: ojs.RejudgeProblemRequest
+	(*RejudgeProblemResponse)(nil),                                  // 117: ojs.RejudgeProblemResponse
+	(*RejudgeContestRequest)(nil),                                   // 118: ojs.RejudgeContestRequest
+	(*RejudgeContestResponse)(nil),                                  // 119: ojs.RejudgeContestResponse
+	(*GetRejudgeReportRequest)(nil),                                 // 120: ojs.GetRejudgeReportRequest
+	(*GetRejudgeReportResponse)(nil),                                // 121: ojs.GetRejudgeReportResponse
+	(*DeadLetteredSubmission)(nil),                                  // 122: ojs.DeadLetteredSubmission
+	(*GetDeadLetteredSubmissionListRequest)(nil),                    // 123: ojs.GetDeadLetteredSubmissionListRequest
+	(*GetDeadLetteredSubmissionListResponse)(nil),                   // 124: ojs.GetDeadLetteredSubmissionListResponse
+	(*ReplayDeadLetteredSubmissionRequest)(nil),                     // 125: ojs.ReplayDeadLetteredSubmissionRequest
+	(*ReplayDeadLetteredSubmissionResponse)(nil),                    // 126: ojs.ReplayDeadLetteredSubmissionResponse
+	(*UpdateSettingRequest)(nil),                                    // 127: ojs.UpdateSettingRequest
+	(*UpdateSettingResponse)(nil),                                   // 128: ojs.UpdateSettingResponse
+}
+var file_ojs_proto_depIdxs = []int32{
+	0,   // 0: ojs.CreateAccountRequest.role:type_name -> ojs.Role
+	0,   // 1: ojs.Account.role:type_name -> ojs.Role
+	9,   // 2: ojs.CreateAccountResponse.account:type_name -> ojs.Account
+	9,   // 3: ojs.GetAccountResponse.account:type_name -> ojs.Account
+	9,   // 4: ojs.GetAccountListResponse.accounts:type_name -> ojs.Account
+	9,   // 5: ojs.UpdateAccountResponse.account:type_name -> ojs.Account
+	0,   // 6: ojs.SetAccountRoleRequest.role:type_name -> ojs.Role
+	9,   // 7: ojs.SetAccountRoleResponse.account:type_name -> ojs.Account
+	9,   // 8: ojs.DisableAccountResponse.account:type_name -> ojs.Account
+	9,   // 9: ojs.CreateSessionResponse.account:type_name -> ojs.Account
+	9,   // 10: ojs.RefreshSessionResponse.account:type_name -> ojs.Account
+	31,  // 11: ojs.GetJSONWebKeySetResponse.keys:type_name -> ojs.JSONWebKey
+	1,   // 12: ojs.CreateProblemRequest.comparison_mode:type_name -> ojs.OutputComparisonMode
+	1,   // 13: ojs.Problem.comparison_mode:type_name -> ojs.OutputComparisonMode
+	35,  // 14: ojs.CreateProblemResponse.problem:type_name -> ojs.Problem
+	35,  // 15: ojs.GetProblemListResponse.problems:type_name -> ojs.Problem
+	35,  // 16: ojs.GetProblemResponse.problem:type_name -> ojs.Problem
+	1,   // 17: ojs.UpdateProblemRequest.comparison_mode:type_name -> ojs.OutputComparisonMode
+	35,  // 18: ojs.UpdateProblemResponse.problem:type_name -> ojs.Problem
+	35,  // 19: ojs.UpdateProblemCheckerResponse.problem:type_name -> ojs.Problem
+	35,  // 20: ojs.UpdateProblemInteractorResponse.problem:type_name -> ojs.Problem
+	50,  // 21: ojs.CreateTestCaseResponse.test_case:type_name -> ojs.TestCase
+	50,  // 22: ojs.GetProblemTestCaseListResponse.test_cases:type_name -> ojs.TestCase
+	50,  // 23: ojs.GetTestCaseResponse.test_case:type_name -> ojs.TestCase
+	50,  // 24: ojs.UpdateTestCaseResponse.test_case:type_name -> ojs.TestCase
+	2,   // 25: ojs.TestCaseGroup.scoring_policy:type_name -> ojs.TestCaseGroupScoringPolicy
+	2,   // 26: ojs.CreateTestCaseGroupRequest.scoring_policy:type_name -> ojs.TestCaseGroupScoringPolicy
+	60,  // 27: ojs.CreateTestCaseGroupResponse.test_case_group:type_name -> ojs.TestCaseGroup
+	60,  // 28: ojs.GetProblemTestCaseGroupListResponse.test_case_groups:type_name -> ojs.TestCaseGroup
+	2,   // 29: ojs.UpdateTestCaseGroupRequest.scoring_policy:type_name -> ojs.TestCaseGroupScoringPolicy
+	60,  // 30: ojs.UpdateTestCaseGroupResponse.test_case_group:type_name -> ojs.TestCaseGroup
+	3,   // 31: ojs.Submission.status:type_name -> ojs.SubmissionStatus
+	4,   // 32: ojs.Submission.result:type_name -> ojs.SubmissionResult
+	70,  // 33: ojs.CreateSubmissionResponse.submission:type_name -> ojs.Submission
+	70,  // 34: ojs.GetSubmissionResponse.submission:type_name -> ojs.Submission
+	70,  // 35: ojs.GetSubmissionListResponse.submissions:type_name -> ojs.Submission
+	4,   // 36: ojs.SubmissionTestCaseResult.result:type_name -> ojs.SubmissionResult
+	76,  // 37: ojs.GetSubmissionTestCaseResultListResponse.submission_test_case_results:type_name -> ojs.SubmissionTestCaseResult
+	3,   // 38: ojs.WatchSubmissionResponse.status:type_name -> ojs.SubmissionStatus
+	4,   // 39: ojs.WatchSubmissionResponse.result:type_name -> ojs.SubmissionResult
+	70,  // 40: ojs.GetProblemSubmissionListResponse.submissions:type_name -> ojs.Submission
+	70,  // 41: ojs.GetAccountProblemSubmissionListResponse.submissions:type_name -> ojs.Submission
+	85,  // 42: ojs.Contest.problems:type_name -> ojs.ContestProblem
+	5,   // 43: ojs.Contest.scoring_mode:type_name -> ojs.ContestScoringMode
+	85,  // 44: ojs.CreateContestRequest.problems:type_name -> ojs.ContestProblem
+	5,   // 45: ojs.CreateContestRequest.scoring_mode:type_name -> ojs.ContestScoringMode
+	86,  // 46: ojs.CreateContestResponse.contest:type_name -> ojs.Contest
+	86,  // 47: ojs.GetContestListResponse.contests:type_name -> ojs.Contest
+	86,  // 48: ojs.GetContestResponse.contest:type_name -> ojs.Contest
+	85,  // 49: ojs.UpdateContestRequest.problems:type_name -> ojs.ContestProblem
+	5,   // 50: ojs.UpdateContestRequest.scoring_mode:type_name -> ojs.ContestScoringMode
+	86,  // 51: ojs.UpdateContestResponse.contest:type_name -> ojs.Contest
+	101, // 52: ojs.ContestScoreboardRow.cells:type_name -> ojs.ContestScoreboardCell
+	5,   // 53: ojs.GetContestScoreboardResponse.scoring_mode:type_name -> ojs.ContestScoringMode
+	102, // 54: ojs.GetContestScoreboardResponse.rows:type_name -> ojs.ContestScoreboardRow
+	70,  // 55: ojs.GetAndUpdateFirstSubmittedSubmissionToExecutingResponse.submission:type_name -> ojs.Submission
+	35,  // 56: ojs.GetAndUpdateFirstSubmittedSubmissionToExecutingResponse.problem:type_name -> ojs.Problem
+	50,  // 57: ojs.GetAndUpdateFirstSubmittedSubmissionToExecutingResponse.test_cases:type_name -> ojs.TestCase
+	4,   // 58: ojs.JudgedTestCaseResult.result:type_name -> ojs.SubmissionResult
+	4,   // 59: ojs.ReportSubmissionResultRequest.result:type_name -> ojs.SubmissionResult
+	109, // 60: ojs.ReportSubmissionResultRequest.test_case_results:type_name -> ojs.JudgedTestCaseResult
+	70,  // 61: ojs.ReportSubmissionResultResponse.submission:type_name -> ojs.Submission
+	4,   // 62: ojs.RejudgedSubmission.previous_result:type_name -> ojs.SubmissionResult
+	3,   // 63: ojs.RejudgedSubmission.status:type_name -> ojs.SubmissionStatus
+	4,   // 64: ojs.RejudgedSubmission.result:type_name -> ojs.SubmissionResult
+	112, // 65: ojs.RejudgeSubmissionResponse.rejudge:type_name -> ojs.Rejudge
+	112, // 66: ojs.RejudgeProblemResponse.rejudge:type_name -> ojs.Rejudge
+	112, // 67: ojs.RejudgeContestResponse.rejudge:type_name -> ojs.Rejudge
+	112, // 68: ojs.GetRejudgeReportResponse.rejudge:type_name -> ojs.Rejudge
+	113, // 69: ojs.GetRejudgeReportResponse.rejudged_submissions:type_name -> ojs.RejudgedSubmission
+	122, // 70: ojs.GetDeadLetteredSubmissionListResponse.dead_lettered_submissions:type_name -> ojs.DeadLetteredSubmission
+	122, // 71: ojs.ReplayDeadLetteredSubmissionResponse.dead_lettered_submission:type_name -> ojs.DeadLetteredSubmission
+	6,   // 72: ojs.OjsService.GetServerInfo:input_type -> ojs.GetServerInfoRequest
+	8,   // 73: ojs.OjsService.CreateAccount:input_type -> ojs.CreateAccountRequest
+	11,  // 74: ojs.OjsService.GetAccount:input_type -> ojs.GetAccountRequest
+	13,  // 75: ojs.OjsService.GetAccountList:input_type -> ojs.GetAccountListRequest
+	15,  // 76: ojs.OjsService.UpdateAccount:input_type -> ojs.UpdateAccountRequest
+	17,  // 77: ojs.OjsService.ChangePassword:input_type -> ojs.ChangePasswordRequest
+	19,  // 78: ojs.OjsService.SetAccountRole:input_type -> ojs.SetAccountRoleRequest
+	21,  // 79: ojs.OjsService.DisableAccount:input_type -> ojs.DisableAccountRequest
+	23,  // 80: ojs.OjsService.CreateSession:input_type -> ojs.CreateSessionRequest
+	25,  // 81: ojs.OjsService.DeleteSession:input_type -> ojs.DeleteSessionRequest
+	27,  // 82: ojs.OjsService.RefreshSession:input_type -> ojs.RefreshSessionRequest
+	29,  // 83: ojs.OjsService.DeleteAllSessions:input_type -> ojs.DeleteAllSessionsRequest
+	32,  // 84: ojs.OjsService.GetJSONWebKeySet:input_type -> ojs.GetJSONWebKeySetRequest
+	34,  // 85: ojs.OjsService.CreateProblem:input_type -> ojs.CreateProblemRequest
+	37,  // 86: ojs.OjsService.GetProblemList:input_type -> ojs.GetProblemListRequest
+	39,  // 87: ojs.OjsService.GetProblem:input_type -> ojs.GetProblemRequest
+	41,  // 88: ojs.OjsService.UpdateProblem:input_type -> ojs.UpdateProblemRequest
+	43,  // 89: ojs.OjsService.DeleteProblem:input_type -> ojs.DeleteProblemRequest
+	45,  // 90: ojs.OjsService.UpdateProblemChecker:input_type -> ojs.UpdateProblemCheckerRequest
+	47,  // 91: ojs.OjsService.UpdateProblemInteractor:input_type -> ojs.UpdateProblemInteractorRequest
+	49,  // 92: ojs.OjsService.CreateTestCase:input_type -> ojs.CreateTestCaseRequest
+	52,  // 93: ojs.OjsService.GetProblemTestCaseList:input_type -> ojs.GetProblemTestCaseListRequest
+	54,  // 94: ojs.OjsService.GetTestCase:input_type -> ojs.GetTestCaseRequest
+	56,  // 95: ojs.OjsService.UpdateTestCase:input_type -> ojs.UpdateTestCaseRequest
+	58,  // 96: ojs.OjsService.DeleteTestCase:input_type -> ojs.DeleteTestCaseRequest
+	61,  // 97: ojs.OjsService.CreateTestCaseGroup:input_type -> ojs.CreateTestCaseGroupRequest
+	63,  // 98: ojs.OjsService.GetProblemTestCaseGroupList:input_type -> ojs.GetProblemTestCaseGroupListRequest
+	65,  // 99: ojs.OjsService.UpdateTestCaseGroup:input_type -> ojs.UpdateTestCaseGroupRequest
+	67,  // 100: ojs.OjsService.DeleteTestCaseGroup:input_type -> ojs.DeleteTestCaseGroupRequest
+	69,  // 101: ojs.OjsService.CreateSubmission:input_type -> ojs.CreateSubmissionRequest
+	72,  // 102: ojs.OjsService.GetSubmission:input_type -> ojs.GetSubmissionRequest
+	74,  // 103: ojs.OjsService.GetSubmissionList:input_type -> ojs.GetSubmissionListRequest
+	77,  // 104: ojs.OjsService.GetSubmissionTestCaseResultList:input_type -> ojs.GetSubmissionTestCaseResultListRequest
+	79,  // 105: ojs.OjsService.WatchSubmission:input_type -> ojs.WatchSubmissionRequest
+	81,  // 106: ojs.OjsService.GetProblemSubmissionList:input_type -> ojs.GetProblemSubmissionListRequest
+	83,  // 107: ojs.OjsService.GetAccountProblemSubmissionList:input_type -> ojs.GetAccountProblemSubmissionListRequest
+	87,  // 108: ojs.OjsService.CreateContest:input_type -> ojs.CreateContestRequest
+	89,  // 109: ojs.OjsService.GetContestList:input_type -> ojs.GetContestListRequest
+	91,  // 110: ojs.OjsService.GetContest:input_type -> ojs.GetContestRequest
+	93,  // 111: ojs.OjsService.UpdateContest:input_type -> ojs.UpdateContestRequest
+	95,  // 112: ojs.OjsService.DeleteContest:input_type -> ojs.DeleteContestRequest
+	97,  // 113: ojs.OjsService.RegisterContest:input_type -> ojs.RegisterContestRequest
+	99,  // 114: ojs.OjsService.UnregisterContest:input_type -> ojs.UnregisterContestRequest
+	103, // 115: ojs.OjsService.GetContestScoreboard:input_type -> ojs.GetContestScoreboardRequest
+	105, // 116: ojs.OjsService.GetAndUpdateFirstSubmittedSubmissionToExecuting:input_type -> ojs.GetAndUpdateFirstSubmittedSubmissionToExecutingRequest
+	107, // 117: ojs.OjsService.RenewSubmissionJudgingLease:input_type -> ojs.RenewSubmissionJudgingLeaseRequest
+	110, // 118: ojs.OjsService.ReportSubmissionResult:input_type -> ojs.ReportSubmissionResultRequest
+	114, // 119: ojs.OjsService.RejudgeSubmission:input_type -> ojs.RejudgeSubmissionRequest
+	116, // 120: ojs.OjsService.RejudgeProblem:input_type -> ojs.RejudgeProblemRequest
+	118, // 121: ojs.OjsService.RejudgeContest:input_type -> ojs.RejudgeContestRequest
+	120, // 122: ojs.OjsService.GetRejudgeReport:input_type -> ojs.GetRejudgeReportRequest
+	123, // 123: ojs.OjsService.GetDeadLetteredSubmissionList:input_type -> ojs.GetDeadLetteredSubmissionListRequest
+	125, // 124: ojs.OjsService.ReplayDeadLetteredSubmission:input_type -> ojs.ReplayDeadLetteredSubmissionRequest
+	127, // 125: ojs.OjsService.UpdateSetting:input_type -> ojs.UpdateSettingRequest
+	7,   // 126: ojs.OjsService.GetServerInfo:output_type -> ojs.GetServerInfoResponse
+	10,  // 127: ojs.OjsService.CreateAccount:output_type -> ojs.CreateAccountResponse
+	12,  // 128: ojs.OjsService.GetAccount:output_type -> ojs.GetAccountResponse
+	14,  // 129: ojs.OjsService.GetAccountList:output_type -> ojs.GetAccountListResponse
+	16,  // 130: ojs.OjsService.UpdateAccount:output_type -> ojs.UpdateAccountResponse
+	18,  // 131: ojs.OjsService.ChangePassword:output_type -> ojs.ChangePasswordResponse
+	20,  // 132: ojs.OjsService.SetAccountRole:output_type -> ojs.SetAccountRoleResponse
+	22,  // 133: ojs.OjsService.DisableAccount:output_type -> ojs.DisableAccountResponse
+	24,  // 134: ojs.OjsService.CreateSession:output_type -> ojs.CreateSessionResponse
+	26,  // 135: ojs.OjsService.DeleteSession:output_type -> ojs.DeleteSessionResponse
+	28,  // 136: ojs.OjsService.RefreshSession:output_type -> ojs.RefreshSessionResponse
+	30,  // 137: ojs.OjsService.DeleteAllSessions:output_type -> ojs.DeleteAllSessionsResponse
+	33,  // 138: ojs.OjsService.GetJSONWebKeySet:output_type -> ojs.GetJSONWebKeySetResponse
+	36,  // 139: ojs.OjsService.CreateProblem:output_type -> ojs.CreateProblemResponse
+	38,  // 140: ojs.OjsService.GetProblemList:output_type -> ojs.GetProblemListResponse
+	40,  // 141: ojs.OjsService.GetProblem:output_type -> ojs.GetProblemResponse
+	42,  // 142: ojs.OjsService.UpdateProblem:output_type -> ojs.UpdateProblemResponse
+	44,  // 143: ojs.OjsService.DeleteProblem:output_type -> ojs.DeleteProblemResponse
+	46,  // 144: ojs.OjsService.UpdateProblemChecker:output_type -> ojs.UpdateProblemCheckerResponse
+	48,  // 145: ojs.OjsService.UpdateProblemInteractor:output_type -> ojs.UpdateProblemInteractorResponse
+	51,  // 146: ojs.OjsService.CreateTestCase:output_type -> ojs.CreateTestCaseResponse
+	53,  // 147: ojs.OjsService.GetProblemTestCaseList:output_type -> ojs.GetProblemTestCaseListResponse
+	55,  // 148: ojs.OjsService.GetTestCase:output_type -> ojs.GetTestCaseResponse
+	57,  // 149: ojs.OjsService.UpdateTestCase:output_type -> ojs.UpdateTestCaseResponse
+	59,  // 150: ojs.OjsService.DeleteTestCase:output_type -> ojs.DeleteTestCaseResponse
+	62,  // 151: ojs.OjsService.CreateTestCaseGroup:output_type -> ojs.CreateTestCaseGroupResponse
+	64,  // 152: ojs.OjsService.GetProblemTestCaseGroupList:output_type -> ojs.GetProblemTestCaseGroupListResponse
+	66,  // 153: ojs.OjsService.UpdateTestCaseGroup:output_type -> ojs.UpdateTestCaseGroupResponse
+	68,  // 154: ojs.OjsService.DeleteTestCaseGroup:output_type -> ojs.DeleteTestCaseGroupResponse
+	71,  // 155: ojs.OjsService.CreateSubmission:output_type -> ojs.CreateSubmissionResponse
+	73,  // 156: ojs.OjsService.GetSubmission:output_type -> ojs.GetSubmissionResponse
+	75,  // 157: ojs.OjsService.GetSubmissionList:output_type -> ojs.GetSubmissionListResponse
+	78,  // 158: ojs.OjsService.GetSubmissionTestCaseResultList:output_type -> ojs.GetSubmissionTestCaseResultListResponse
+	80,  // 159: ojs.OjsService.WatchSubmission:output_type -> ojs.WatchSubmissionResponse
+	82,  // 160: ojs.OjsService.GetProblemSubmissionList:output_type -> ojs.GetProblemSubmissionListResponse
+	84,  // 161: ojs.OjsService.GetAccountProblemSubmissionList:output_type -> ojs.GetAccountProblemSubmissionListResponse
+	88,  // 162: ojs.OjsService.CreateContest:output_type -> ojs.CreateContestResponse
+	90,  // 163: ojs.OjsService.GetContestList:output_type -> ojs.GetContestListResponse
+	92,  // 164: ojs.OjsService.GetContest:output_type -> ojs.GetContestResponse
+	94,  // 165: ojs.OjsService.UpdateContest:output_type -> ojs.UpdateContestResponse
+	96,  // 166: ojs.OjsService.DeleteContest:output_type -> ojs.DeleteContestResponse
+	98,  // 167: ojs.OjsService.RegisterContest:output_type -> ojs.RegisterContestResponse
+	100, // 168: ojs.OjsService.UnregisterContest:output_type -> ojs.UnregisterContestResponse
+	104, // 169: ojs.OjsService.GetContestScoreboard:output_type -> ojs.GetContestScoreboardResponse
+	106, // 170: ojs.OjsService.GetAndUpdateFirstSubmittedSubmissionToExecuting:output_type -> ojs.GetAndUpdateFirstSubmittedSubmissionToExecutingResponse
+	108, // 171: ojs.OjsService.RenewSubmissionJudgingLease:output_type -> ojs.RenewSubmissionJudgingLeaseResponse
+	111, // 172: ojs.OjsService.ReportSubmissionResult:output_type -> ojs.ReportSubmissionResultResponse
+	115, // 173: ojs.OjsService.RejudgeSubmission:output_type -> ojs.RejudgeSubmissionResponse
+	117, // 174: ojs.OjsService.RejudgeProblem:output_type -> ojs.RejudgeProblemResponse
+	119, // 175: ojs.OjsService.RejudgeContest:output_type -> ojs.RejudgeContestResponse
+	121, // 176: ojs.OjsService.GetRejudgeReport:output_type -> ojs.GetRejudgeReportResponse
+	124, // 177: ojs.OjsService.GetDeadLetteredSubmissionList:output_type -> ojs.GetDeadLetteredSubmissionListResponse
+	126, // 178: ojs.OjsService.ReplayDeadLetteredSubmission:output_type -> ojs.ReplayDeadLetteredSubmissionResponse
+	128, // 179: ojs.OjsService.UpdateSetting:output_type -> ojs.UpdateSettingResponse
+	126, // [126:180] is the sub-list for method output_type
+	72,  // [72:126] is the sub-list for method input_type
+	72,  // [72:72] is the sub-list for extension type_name
+	72,  // [72:72] is the sub-list for extension extendee
+	0,   // [0:72] is the sub-list for field type_name
+}
+
+func init() { file_ojs_proto_init() }
+func file_ojs_proto_init() {
+	if File_ojs_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ojs_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServerInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServerInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Account); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangePasswordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangePasswordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAccountRoleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAccountRoleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSessionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSessionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshSessionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAllSessionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAllSessionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JSONWebKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJSONWebKeySetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJSONWebKeySetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProblemRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Problem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProblemResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProblemListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProblemListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProblemRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProblemResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProblemRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProblemResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProblemRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProblemResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProblemCheckerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProblemCheckerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProblemInteractorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProblemInteractorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTestCaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestCase); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTestCaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProblemTestCaseListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProblemTestCaseListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTestCaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTestCaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTestCaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTestCaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTestCaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTestCaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestCaseGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTestCaseGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTestCaseGroupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProblemTestCaseGroupListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProblemTestCaseGroupListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTestCaseGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTestCaseGroupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTestCaseGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTestCaseGroupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSubmissionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Submission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSubmissionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubmissionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubmissionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubmissionListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubmissionListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionTestCaseResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubmissionTestCaseResultListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubmissionTestCaseResultListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchSubmissionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ojs_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchSubmissionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UpdateSettingResponse.ProtoReflect.Descriptor instead.
-func (*UpdateSettingResponse) Descriptor() ([]byte, []int) {
-	return file_ojs_proto_rawDescGZIP(), []int{47}
-}
-
-var File_ojs_proto protoreflect.FileDescriptor
-
-var file_ojs_proto_rawDesc = []byte{
-	0x0a, 0x09, 0x6f, 0x6a, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x6f, 0x6a, 0x73,
-	0x1a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x16,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x9d, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xfa, 0x42, 0x17, 0x72, 0x15, 0x32, 0x13, 0x5e,
-	0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x33, 0x2c, 0x33, 0x32,
-	0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xfa, 0x42, 0x17, 0x72,
-	0x15, 0x32, 0x13, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b,
-	0x33, 0x2c, 0x33, 0x32, 0x7d, 0x24, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x12, 0x1d, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09,
-	0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22,
-	0x4c, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
-	0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x6f,
-	0x6a, 0x73, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x3f, 0x0a,
-	0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x23,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x3c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x6a, 0x73,
-	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x22, 0x7e, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xfa, 0x42, 0x17, 0x72, 0x15, 0x32, 0x13,
-	0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x33, 0x2c, 0x33,
-	0x32, 0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xfa, 0x42, 0x17,
-	0x72, 0x15, 0x32, 0x13, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d,
-	0x7b, 0x33, 0x2c, 0x33, 0x32, 0x7d, 0x24, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x22, 0x3f, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x6a,
-	0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
-	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x22, 0xde, 0x01, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12,
-	0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x22, 0x3f, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
-	0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x07, 0x70, 0x72,
-	0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x22, 0x4e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62,
-	0x6c, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x18, 0x64, 0x52, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x72, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62,
-	0x6c, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x28, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52,
-	0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x6f,
-	0x62, 0x6c, 0x65, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74,
-	0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3c,
-	0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x62,
-	0x6c, 0x65, 0x6d, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x22, 0xad, 0x01, 0x0a,
-	0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73,
-	0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69,
-	0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x3f, 0x0a, 0x15,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x50, 0x72, 0x6f,
-	0x62, 0x6c, 0x65, 0x6d, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x22, 0x26, 0x0a,
-	0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x86,
-	0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x66, 0x5f, 0x70,
-	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0b, 0x6f, 0x66, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x70,
-	0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
-	0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
-	0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22, 0x89, 0x01, 0x0a, 0x08, 0x54, 0x65, 0x73, 0x74,
-	0x43, 0x61, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x6c,
-	0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x66, 0x50,
-	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x68, 0x69, 0x64,
-	0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x48, 0x69, 0x64,
-	0x64, 0x65, 0x6e, 0x22, 0x44, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73,
-	0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a,
-	0x09, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0d, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52,
-	0x08, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x22, 0x5d, 0x0a, 0x1d, 0x47, 0x65, 0x74,
-	0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74,
-	0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x0a, 0x74,
-	0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x09,
-	0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x24,
-	0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x41, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x74,
-	0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
-	0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x08, 0x74,
-	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x22, 0x72, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x69, 0x73, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x69, 0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22, 0x44, 0x0a, 0x16, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61,
-	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x54,
-	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
-	0x65, 0x22, 0x27, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x73, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x22, 0x0a, 0x0d, 0x6f, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x66, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65,
-	0x6d, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0xf1, 0x01, 0x0a, 0x0a, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x66, 0x5f, 0x70,
-	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0b, 0x6f, 0x66, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12,
-	0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x15, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d,
-	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
-	0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x4b, 0x0a,
-	0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x73, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x6f, 0x6a, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
-	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x26, 0x0a, 0x14, 0x47, 0x65,
-	0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x48, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x73,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x48, 0x0a, 0x18,
-	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x6a, 0x73, 0x2e,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x5f, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x22, 0x8d, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x6a, 0x73,
-	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0x94, 0x01, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50,
-	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72,
-	0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
-	0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
-	0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x27, 0x47, 0x65, 0x74, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
-	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x38,
-	0x0a, 0x36, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69,
-	0x72, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x39, 0x0a, 0x37, 0x47, 0x65, 0x74, 0x41,
-	0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x73, 0x74, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x54, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x52, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x11, 0x0a, 0x0d,
-	0x55, 0x6e, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x10, 0x00, 0x12,
-	0x09, 0x0a, 0x05, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x72, 0x6f,
-	0x62, 0x6c, 0x65, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x65, 0x72, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06,
-	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x10, 0x04, 0x2a, 0x53, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x13, 0x0a, 0x0f,
-	0x55, 0x6e, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10,
-	0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x10, 0x01,
-	0x12, 0x0d, 0x0a, 0x09, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x12,
-	0x0c, 0x0a, 0x08, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x10, 0x03, 0x2a, 0xad, 0x01,
-	0x0a, 0x10, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x6e, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x01, 0x12,
-	0x10, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10,
-	0x02, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65,
-	0x64, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x57, 0x72, 0x6f, 0x6e, 0x67, 0x41, 0x6e, 0x73, 0x77,
-	0x65, 0x72, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x6e, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
-	0x74, 0x65, 0x64, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x10, 0x07, 0x32, 0xc7, 0x13,
-	0x0a, 0x0a, 0x4f, 0x6a, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x2e,
-	0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x63, 0x0a, 0x0d, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x6f, 0x6a,
-	0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12,
-	0x5c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x2e,
-	0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x63, 0x0a,
-	0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19,
-	0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a,
-	0x22, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x60, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
-	0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x12, 0x2a, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x63, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x19, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
-	0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x12, 0x63, 0x0a, 0x0e, 0x47, 0x65, 0x74,
-	0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x6f, 0x6a,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x12, 0x5c,
-	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x16, 0x2e, 0x6f,
-	0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70,
-	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x68, 0x0a, 0x0d,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x19, 0x2e,
-	0x6f, 0x6a, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65,
-	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x1a,
-	0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
-	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x65, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x19, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x2a, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x68, 0x0a,
-	0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12,
-	0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74,
-	0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6f, 0x6a,
-	0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17,
-	0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x73,
-	0x74, 0x2d, 0x63, 0x61, 0x73, 0x65, 0x73, 0x12, 0x8b, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50,
-	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x22, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62,
-	0x6c, 0x65, 0x6d, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f,
-	0x62, 0x6c, 0x65, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2d,
-	0x63, 0x61, 0x73, 0x65, 0x73, 0x12, 0x61, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x65, 0x73, 0x74,
-	0x43, 0x61, 0x73, 0x65, 0x12, 0x17, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65,
-	0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
-	0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12,
-	0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2d, 0x63, 0x61,
-	0x73, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x6d, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x2e, 0x6f, 0x6a, 0x73,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x1a, 0x17,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2d, 0x63, 0x61, 0x73,
-	0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x6a, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x2a, 0x17, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2d, 0x63, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x7b,
-	0x69, 0x64, 0x7d, 0x12, 0x6f, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22,
-	0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x68, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75,
-	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x6f,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x92, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x2e, 0x6f,
-	0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62,
-	0x6c, 0x65, 0x6d, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x23, 0x12, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x62, 0x6c,
-	0x65, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0xc5, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x47, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x12, 0x3f, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x62, 0x6c,
-	0x65, 0x6d, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x7d,
-	0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xae, 0x01, 0x0a,
-	0x2f, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72,
-	0x73, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67,
-	0x12, 0x3b, 0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x64, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65,
-	0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e,
-	0x6f, 0x6a, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x46, 0x69, 0x72, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a,
-	0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x19,
-	0x2e, 0x6f, 0x6a, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6f, 0x6a, 0x73, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x0a, 0x5a, 0x08, 0x67, 0x72, 0x70, 0x63, 0x2f,
-	0x6f, 0x6a, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_ojs_proto_rawDescOnce sync.Once
-	file_ojs_proto_rawDescData = file_ojs_proto_rawDesc
-)
-
-func file_ojs_proto_rawDescGZIP() []byte {
-	file_ojs_proto_rawDescOnce.Do(func() {
-		file_ojs_proto_rawDescData = protoimpl.X.CompressGZIP(file_ojs_proto_rawDescData)
-	})
-	return file_ojs_proto_rawDescData
-}
-
-var file_ojs_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_ojs_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
-var file_ojs_proto_goTypes = []interface{}{
-	(Role)(0),                                                       // 0: ojs.Role
-	(SubmissionStatus)(0),                                           // 1: ojs.SubmissionStatus
-	(SubmissionResult)(0),                                           // 2: ojs.SubmissionResult
-	(*GetServerInfoRequest)(nil),                                    // 3: ojs.GetServerInfoRequest
-	(*GetServerInfoResponse)(nil),                                   // 4: ojs.GetServerInfoResponse
-	(*CreateAccountRequest)(nil),                                    // 5: ojs.CreateAccountRequest
-	(*Account)(nil),                                                 // 6: ojs.Account
-	(*CreateAccountResponse)(nil),                                   // 7: ojs.CreateAccountResponse
-	(*GetAccountRequest)(nil),                                       // 8: ojs.GetAccountRequest
-	(*GetAccountResponse)(nil),                                      // 9: ojs.GetAccountResponse
-	(*CreateSessionRequest)(nil),                                    // 10: ojs.CreateSessionRequest
-	(*CreateSessionResponse)(nil),                                   // 11: ojs.CreateSessionResponse
-	(*DeleteSessionRequest)(nil),                                    // 12: ojs.DeleteSessionRequest
-	(*DeleteSessionResponse)(nil),                                   // 13: ojs.DeleteSessionResponse
-	(*CreateProblemRequest)(nil),                                    // 14: ojs.CreateProblemRequest
-	(*Problem)(nil),                                                 // 15: ojs.Problem
-	(*CreateProblemResponse)(nil),                                   // 16: ojs.CreateProblemResponse
-	(*GetProblemListRequest)(nil),                                   // 17: ojs.GetProblemListRequest
-	(*GetProblemListResponse)(nil),                                  // 18: ojs.GetProblemListResponse
-	(*GetProblemRequest)(nil),                                       // 19: ojs.GetProblemRequest
-	(*GetProblemResponse)(nil),                                      // 20: ojs.GetProblemResponse
-	(*UpdateProblemRequest)(nil),                                    // 21: ojs.UpdateProblemRequest
-	(*UpdateProblemResponse)(nil),                                   // 22: ojs.UpdateProblemResponse
-	(*DeleteProblemRequest)(nil),                                    // 23: ojs.DeleteProblemRequest
-	(*DeleteProblemResponse)(nil),                                   // 24: ojs.DeleteProblemResponse
-	(*CreateTestCaseRequest)(nil),                                   // 25: ojs.CreateTestCaseRequest
-	(*TestCase)(nil),                                                // 26: ojs.TestCase
-	(*CreateTestCaseResponse)(nil),                                  // 27: ojs.CreateTestCaseResponse
-	(*GetProblemTestCaseListRequest)(nil),                           // 28: ojs.GetProblemTestCaseListRequest
-	(*GetProblemTestCaseListResponse)(nil),                          // 29: ojs.GetProblemTestCaseListResponse
-	(*GetTestCaseRequest)(nil),                                      // 30: ojs.GetTestCaseRequest
-	(*GetTestCaseResponse)(nil),                                     // 31: ojs.GetTestCaseResponse
-	(*UpdateTestCaseRequest)(nil),                                   // 32: ojs.UpdateTestCaseRequest
-	(*UpdateTestCaseResponse)(nil),                                  // 33: ojs.UpdateTestCaseResponse
-	(*DeleteTestCaseRequest)(nil),                                   // 34: ojs.DeleteTestCaseRequest
-	(*DeleteTestCaseResponse)(nil),                                  // 35: ojs.DeleteTestCaseResponse
-	(*CreateSubmissionRequest)(nil),                                 // 36: ojs.CreateSubmissionRequest
-	(*Submission)(nil),                                              // 37: ojs.Submission
-	(*CreateSubmissionResponse)(nil),                                // 38: ojs.CreateSubmissionResponse
-	(*GetSubmissionRequest)(nil),                                    // 39: ojs.GetSubmissionRequest
-	(*GetSubmissionResponse)(nil),                                   // 40: ojs.GetSubmissionResponse
-	(*GetSubmissionListRequest)(nil),                                // 41: ojs.GetSubmissionListRequest
-	(*GetSubmissionListResponse)(nil),                               // 42: ojs.GetSubmissionListResponse
-	(*GetProblemSubmissionListRequest)(nil),                         // 43: ojs.GetProblemSubmissionListRequest
-	(*GetProblemSubmissionListResponse)(nil),                        // 44: ojs.GetProblemSubmissionListResponse
-	(*GetAccountProblemSubmissionListRequest)(nil),                  // 45: ojs.GetAccountProblemSubmissionListRequest
-	(*GetAccountProblemSubmissionListResponse)(nil),                 // 46: ojs.GetAccountProblemSubmissionListResponse
-	(*GetAndUpdateFirstSubmittedSubmissionToExecutingRequest)(nil),  // 47: ojs.GetAndUpdateFirstSubmittedSubmissionToExecutingRequest
-	(*GetAndUpdateFirstSubmittedSubmissionToExecutingResponse)(nil), // 48: ojs.GetAndUpdateFirstSubmittedSubmissionToExecutingResponse
-	(*UpdateSettingRequest)(nil),                                    // 49: ojs.UpdateSettingRequest
-	(*UpdateSettingResponse)(nil),                                   // 50: ojs.UpdateSettingResponse
-}
-var file_ojs_proto_depIdxs = []int32{
-	0,  // 0: ojs.CreateAccountRequest.role:type_name -> ojs.Role
-	0,  // 1: ojs.Account.role:type_name -> ojs.Role
-	6,  // 2: ojs.CreateAccountResponse.account:type_name -> ojs.Account
-	6,  // 3: ojs.GetAccountResponse.account:type_name -> ojs.Account
-	6,  // 4: ojs.CreateSessionResponse.account:type_name -> ojs.Account
-	15, // 5: ojs.CreateProblemResponse.problem:type_name -> ojs.Problem
-	15, // 6: ojs.GetProblemListResponse.problems:type_name -> ojs.Problem
-	15, // 7: ojs.GetProblemResponse.problem:type_name -> ojs.Problem
-	15, // 8: ojs.UpdateProblemResponse.problem:type_name -> ojs.Problem
-	26, // 9: ojs.CreateTestCaseResponse.test_case:type_name -> ojs.TestCase
-	26, // 10: ojs.GetProblemTestCaseListResponse.test_cases:type_name -> ojs.TestCase
-	26, // 11: ojs.GetTestCaseResponse.test_case:type_name -> ojs.TestCase
-	26, // 12: ojs.UpdateTestCaseResponse.test_case:type_name -> ojs.TestCase
-	1,  // 13: ojs.Submission.status:type_name -> ojs.SubmissionStatus
-	2,  // 14: ojs.Submission.result:type_name -> ojs.SubmissionResult
-	37, // 15: ojs.CreateSubmissionResponse.submission:type_name -> ojs.Submission
-	37, // 16: ojs.GetSubmissionResponse.submission:type_name -> ojs.Submission
-	37, // 17: ojs.GetSubmissionListResponse.submissions:type_name -> ojs.Submission
-	37, // 18: ojs.GetProblemSubmissionListResponse.submissions:type_name -> ojs.Submission
-	37, // 19: ojs.GetAccountProblemSubmissionListResponse.submissions:type_name -> ojs.Submission
-	3,  // 20: ojs.OjsService.GetServerInfo:input_type -> ojs.GetServerInfoRequest
-	5,  // 21: ojs.OjsService.CreateAccount:input_type -> ojs.CreateAccountRequest
-	8,  // 22: ojs.OjsService.GetAccount:input_type -> ojs.GetAccountRequest
-	10, // 23: ojs.OjsService.CreateSession:input_type -> ojs.CreateSessionRequest
-	12, // 24: ojs.OjsService.DeleteSession:input_type -> ojs.DeleteSessionRequest
-	14, // 25: ojs.OjsService.CreateProblem:input_type -> ojs.CreateProblemRequest
-	17, // 26: ojs.OjsService.GetProblemList:input_type -> ojs.GetProblemListRequest
-	19, // 27: ojs.OjsService.GetProblem:input_type -> ojs.GetProblemRequest
-	21, // 28: ojs.OjsService.UpdateProblem:input_type -> ojs.UpdateProblemRequest
-	23, // 29: ojs.OjsService.DeleteProblem:input_type -> ojs.DeleteProblemRequest
-	25, // 30: ojs.OjsService.CreateTestCase:input_type -> ojs.CreateTestCaseRequest
-	28, // 31: ojs.OjsService.GetProblemTestCaseList:input_type -> ojs.GetProblemTestCaseListRequest
-	30, // 32: ojs.OjsService.GetTestCase:input_type -> ojs.GetTestCaseRequest
-	32, // 33: ojs.OjsService.UpdateTestCase:input_type -> ojs.UpdateTestCaseRequest
-	34, // 34: ojs.OjsService.DeleteTestCase:input_type -> ojs.DeleteTestCaseRequest
-	36, // 35: ojs.OjsService.CreateSubmission:input_type -> ojs.CreateSubmissionRequest
-	39, // 36: ojs.OjsService.GetSubmission:input_type -> ojs.GetSubmissionRequest
-	41, // 37: ojs.OjsService.GetSubmissionList:input_type -> ojs.GetSubmissionListRequest
-	43, // 38: ojs.OjsService.GetProblemSubmissionList:input_type -> ojs.GetProblemSubmissionListRequest
-	45, // 39: ojs.OjsService.GetAccountProblemSubmissionList:input_type -> ojs.GetAccountProblemSubmissionListRequest
-	47, // 40: ojs.OjsService.GetAndUpdateFirstSubmittedSubmissionToExecuting:input_type -> ojs.GetAndUpdateFirstSubmittedSubmissionToExecutingRequest
-	49, // 41: ojs.OjsService.UpdateSetting:input_type -> ojs.UpdateSettingRequest
-	4,  // 42: ojs.OjsService.GetServerInfo:output_type -> ojs.GetServerInfoResponse
-	7,  // 43: ojs.OjsService.CreateAccount:output_type -> ojs.CreateAccountResponse
-	9,  // 44: ojs.OjsService.GetAccount:output_type -> ojs.GetAccountResponse
-	11, // 45: ojs.OjsService.CreateSession:output_type -> ojs.CreateSessionResponse
-	13, // 46: ojs.OjsService.DeleteSession:output_type -> ojs.DeleteSessionResponse
-	16, // 47: ojs.OjsService.CreateProblem:output_type -> ojs.CreateProblemResponse
-	18, // 48: ojs.OjsService.GetProblemList:output_type -> ojs.GetProblemListResponse
-	20, // 49: ojs.OjsService.GetProblem:output_type -> ojs.GetProblemResponse
-	22, // 50: ojs.OjsService.UpdateProblem:output_type -> ojs.UpdateProblemResponse
-	24, // 51: ojs.OjsService.DeleteProblem:output_type -> ojs.DeleteProblemResponse
-	27, // 52: ojs.OjsService.CreateTestCase:output_type -> ojs.CreateTestCaseResponse
-	29, // 53: ojs.OjsService.GetProblemTestCaseList:output_type -> ojs.GetProblemTestCaseListResponse
-	31, // 54: ojs.OjsService.GetTestCase:output_type -> ojs.GetTestCaseResponse
-	33, // 55: ojs.OjsService.UpdateTestCase:output_type -> ojs.UpdateTestCaseResponse
-	35, // 56: ojs.OjsService.DeleteTestCase:output_type -> ojs.DeleteTestCaseResponse
-	38, // 57: ojs.OjsService.CreateSubmission:output_type -> ojs.CreateSubmissionResponse
-	40, // 58: ojs.OjsService.GetSubmission:output_type -> ojs.GetSubmissionResponse
-	42, // 59: ojs.OjsService.GetSubmissionList:output_type -> ojs.GetSubmissionListResponse
-	44, // 60: ojs.OjsService.GetProblemSubmissionList:output_type -> ojs.GetProblemSubmissionListResponse
-	46, // 61: ojs.OjsService.GetAccountProblemSubmissionList:output_type -> ojs.GetAccountProblemSubmissionListResponse
-	48, // 62: ojs.OjsService.GetAndUpdateFirstSubmittedSubmissionToExecuting:output_type -> ojs.GetAndUpdateFirstSubmittedSubmissionToExecutingResponse
-	50, // 63: ojs.OjsService.UpdateSetting:output_type -> ojs.UpdateSettingResponse
-	42, // [42:64] is the sub-list for method output_type
-	20, // [20:42] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
-}
-
-func init() { file_ojs_proto_init() }
-func file_ojs_proto_init() {
-	if File_ojs_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_ojs_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetServerInfoRequest); i {
+		file_ojs_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProblemSubmissionListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3364,8 +10021,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetServerInfoResponse); i {
+		file_ojs_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProblemSubmissionListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3376,8 +10033,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAccountRequest); i {
+		file_ojs_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountProblemSubmissionListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3388,8 +10045,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Account); i {
+		file_ojs_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountProblemSubmissionListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3400,8 +10057,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAccountResponse); i {
+		file_ojs_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContestProblem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3412,8 +10069,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAccountRequest); i {
+		file_ojs_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Contest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3424,8 +10081,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAccountResponse); i {
+		file_ojs_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateContestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3436,8 +10093,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSessionRequest); i {
+		file_ojs_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateContestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3448,8 +10105,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSessionResponse); i {
+		file_ojs_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContestListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3460,8 +10117,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSessionRequest); i {
+		file_ojs_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContestListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3472,8 +10129,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSessionResponse); i {
+		file_ojs_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3484,8 +10141,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateProblemRequest); i {
+		file_ojs_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3496,8 +10153,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Problem); i {
+		file_ojs_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateContestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3508,8 +10165,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateProblemResponse); i {
+		file_ojs_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateContestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3520,8 +10177,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProblemListRequest); i {
+		file_ojs_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteContestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3532,8 +10189,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProblemListResponse); i {
+		file_ojs_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteContestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3544,8 +10201,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProblemRequest); i {
+		file_ojs_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterContestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3556,8 +10213,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProblemResponse); i {
+		file_ojs_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterContestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3568,8 +10225,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateProblemRequest); i {
+		file_ojs_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnregisterContestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3580,8 +10237,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateProblemResponse); i {
+		file_ojs_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnregisterContestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3592,8 +10249,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteProblemRequest); i {
+		file_ojs_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContestScoreboardCell); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3604,8 +10261,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteProblemResponse); i {
+		file_ojs_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContestScoreboardRow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3616,8 +10273,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTestCaseRequest); i {
+		file_ojs_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContestScoreboardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3628,8 +10285,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestCase); i {
+		file_ojs_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContestScoreboardResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3640,8 +10297,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTestCaseResponse); i {
+		file_ojs_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAndUpdateFirstSubmittedSubmissionToExecutingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3652,8 +10309,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProblemTestCaseListRequest); i {
+		file_ojs_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAndUpdateFirstSubmittedSubmissionToExecutingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3664,8 +10321,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProblemTestCaseListResponse); i {
+		file_ojs_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewSubmissionJudgingLeaseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3676,8 +10333,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTestCaseRequest); i {
+		file_ojs_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewSubmissionJudgingLeaseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3688,8 +10345,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTestCaseResponse); i {
+		file_ojs_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JudgedTestCaseResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3700,8 +10357,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateTestCaseRequest); i {
+		file_ojs_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportSubmissionResultRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3712,8 +10369,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateTestCaseResponse); i {
+		file_ojs_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportSubmissionResultResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3724,8 +10381,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteTestCaseRequest); i {
+		file_ojs_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rejudge); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3736,8 +10393,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteTestCaseResponse); i {
+		file_ojs_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejudgedSubmission); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3748,8 +10405,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSubmissionRequest); i {
+		file_ojs_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejudgeSubmissionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3760,8 +10417,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Submission); i {
+		file_ojs_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejudgeSubmissionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3772,8 +10429,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSubmissionResponse); i {
+		file_ojs_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejudgeProblemRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3784,8 +10441,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSubmissionRequest); i {
+		file_ojs_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejudgeProblemResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3796,8 +10453,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSubmissionResponse); i {
+		file_ojs_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejudgeContestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3808,8 +10465,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSubmissionListRequest); i {
+		file_ojs_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejudgeContestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3820,8 +10477,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSubmissionListResponse); i {
+		file_ojs_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRejudgeReportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3832,8 +10489,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProblemSubmissionListRequest); i {
+		file_ojs_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRejudgeReportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3844,8 +10501,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProblemSubmissionListResponse); i {
+		file_ojs_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadLetteredSubmission); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3856,8 +10513,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAccountProblemSubmissionListRequest); i {
+		file_ojs_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeadLetteredSubmissionListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3868,8 +10525,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAccountProblemSubmissionListResponse); i {
+		file_ojs_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeadLetteredSubmissionListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3880,8 +10537,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAndUpdateFirstSubmittedSubmissionToExecutingRequest); i {
+		file_ojs_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayDeadLetteredSubmissionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3892,8 +10549,8 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAndUpdateFirstSubmittedSubmissionToExecutingResponse); i {
+		file_ojs_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayDeadLetteredSubmissionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3904,7 +10561,7 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_ojs_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateSettingRequest); i {
 			case 0:
 				return &v.state
@@ -3916,7 +10573,7 @@ func file_ojs_proto_init() {
 				return nil
 			}
 		}
-		file_ojs_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_ojs_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateSettingResponse); i {
 			case 0:
 				return &v.state
@@ -3929,13 +10586,14 @@ func file_ojs_proto_init() {
 			}
 		}
 	}
+	file_ojs_proto_msgTypes[87].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ojs_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   48,
+			NumEnums:      6,
+			NumMessages:   123,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -396,6 +396,8 @@ func (m *Account) validate(all bool) error {
 
 	// no validation rules for Role
 
+	// no validation rules for IsDisabled
+
 	if len(errors) > 0 {
 		return AccountMultiError(errors)
 	}
@@ -839,43 +841,36 @@ var _ interface {
 	ErrorName() string
 } = GetAccountResponseValidationError{}
 
-// Validate checks the field values on CreateSessionRequest with the rules
+// Validate checks the field values on GetAccountListRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateSessionRequest) Validate() error {
+func (m *GetAccountListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateSessionRequest with the rules
+// ValidateAll checks the field values on GetAccountListRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateSessionRequestMultiError, or nil if none found.
-func (m *CreateSessionRequest) ValidateAll() error {
+// GetAccountListRequestMultiError, or nil if none found.
+func (m *GetAccountListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateSessionRequest) validate(all bool) error {
+func (m *GetAccountListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_CreateSessionRequest_Name_Pattern.MatchString(m.GetName()) {
-		err := CreateSessionRequestValidationError{
-			field:  "Name",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{3,32}$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Query
 
-	if !_CreateSessionRequest_Password_Pattern.MatchString(m.GetPassword()) {
-		err := CreateSessionRequestValidationError{
-			field:  "Password",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{3,32}$\"",
+	// no validation rules for Offset
+
+	if m.GetLimit() > 100 {
+		err := GetAccountListRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 100",
 		}
 		if !all {
 			return err
@@ -884,19 +879,19 @@ func (m *CreateSessionRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateSessionRequestMultiError(errors)
+		return GetAccountListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateSessionRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateSessionRequest.ValidateAll() if the designated
+// GetAccountListRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAccountListRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateSessionRequestMultiError []error
+type GetAccountListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateSessionRequestMultiError) Error() string {
+func (m GetAccountListRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -905,11 +900,11 @@ func (m CreateSessionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateSessionRequestMultiError) AllErrors() []error { return m }
+func (m GetAccountListRequestMultiError) AllErrors() []error { return m }
 
-// CreateSessionRequestValidationError is the validation error returned by
-// CreateSessionRequest.Validate if the designated constraints aren't met.
-type CreateSessionRequestValidationError struct {
+// GetAccountListRequestValidationError is the validation error returned by
+// GetAccountListRequest.Validate if the designated constraints aren't met.
+type GetAccountListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -917,24 +912,24 @@ type CreateSessionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateSessionRequestValidationError) Field() string { return e.field }
+func (e GetAccountListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateSessionRequestValidationError) Reason() string { return e.reason }
+func (e GetAccountListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateSessionRequestValidationError) Cause() error { return e.cause }
+func (e GetAccountListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateSessionRequestValidationError) Key() bool { return e.key }
+func (e GetAccountListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateSessionRequestValidationError) ErrorName() string {
-	return "CreateSessionRequestValidationError"
+func (e GetAccountListRequestValidationError) ErrorName() string {
+	return "GetAccountListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateSessionRequestValidationError) Error() string {
+func (e GetAccountListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -946,14 +941,14 @@ func (e CreateSessionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateSessionRequest.%s: %s%s",
+		"invalid %sGetAccountListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateSessionRequestValidationError{}
+var _ error = GetAccountListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -961,77 +956,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateSessionRequestValidationError{}
-
-var _CreateSessionRequest_Name_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{3,32}$")
-
-var _CreateSessionRequest_Password_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{3,32}$")
+} = GetAccountListRequestValidationError{}
 
-// Validate checks the field values on CreateSessionResponse with the rules
+// Validate checks the field values on GetAccountListResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateSessionResponse) Validate() error {
+func (m *GetAccountListResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateSessionResponse with the rules
+// ValidateAll checks the field values on GetAccountListResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateSessionResponseMultiError, or nil if none found.
-func (m *CreateSessionResponse) ValidateAll() error {
+// GetAccountListResponseMultiError, or nil if none found.
+func (m *GetAccountListResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateSessionResponse) validate(all bool) error {
+func (m *GetAccountListResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetAccount()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateSessionResponseValidationError{
-					field:  "Account",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetAccounts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAccountListResponseValidationError{
+						field:  fmt.Sprintf("Accounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAccountListResponseValidationError{
+						field:  fmt.Sprintf("Accounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateSessionResponseValidationError{
-					field:  "Account",
+				return GetAccountListResponseValidationError{
+					field:  fmt.Sprintf("Accounts[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateSessionResponseValidationError{
-				field:  "Account",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for TotalAccountCount
+
 	if len(errors) > 0 {
-		return CreateSessionResponseMultiError(errors)
+		return GetAccountListResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateSessionResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateSessionResponse.ValidateAll() if the designated
+// GetAccountListResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAccountListResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreateSessionResponseMultiError []error
+type GetAccountListResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateSessionResponseMultiError) Error() string {
+func (m GetAccountListResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1040,11 +1038,11 @@ func (m CreateSessionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateSessionResponseMultiError) AllErrors() []error { return m }
+func (m GetAccountListResponseMultiError) AllErrors() []error { return m }
 
-// CreateSessionResponseValidationError is the validation error returned by
-// CreateSessionResponse.Validate if the designated constraints aren't met.
-type CreateSessionResponseValidationError struct {
+// GetAccountListResponseValidationError is the validation error returned by
+// GetAccountListResponse.Validate if the designated constraints aren't met.
+type GetAccountListResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1052,24 +1050,24 @@ type CreateSessionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateSessionResponseValidationError) Field() string { return e.field }
+func (e GetAccountListResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateSessionResponseValidationError) Reason() string { return e.reason }
+func (e GetAccountListResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateSessionResponseValidationError) Cause() error { return e.cause }
+func (e GetAccountListResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateSessionResponseValidationError) Key() bool { return e.key }
+func (e GetAccountListResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateSessionResponseValidationError) ErrorName() string {
-	return "CreateSessionResponseValidationError"
+func (e GetAccountListResponseValidationError) ErrorName() string {
+	return "GetAccountListResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateSessionResponseValidationError) Error() string {
+func (e GetAccountListResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1081,14 +1079,14 @@ func (e CreateSessionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateSessionResponse.%s: %s%s",
+		"invalid %sGetAccountListResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateSessionResponseValidationError{}
+var _ error = GetAccountListResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1096,44 +1094,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateSessionResponseValidationError{}
+} = GetAccountListResponseValidationError{}
 
-// Validate checks the field values on DeleteSessionRequest with the rules
+// Validate checks the field values on UpdateAccountRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteSessionRequest) Validate() error {
+func (m *UpdateAccountRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteSessionRequest with the rules
+// ValidateAll checks the field values on UpdateAccountRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteSessionRequestMultiError, or nil if none found.
-func (m *DeleteSessionRequest) ValidateAll() error {
+// UpdateAccountRequestMultiError, or nil if none found.
+func (m *UpdateAccountRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteSessionRequest) validate(all bool) error {
+func (m *UpdateAccountRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	if !_UpdateAccountRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := UpdateAccountRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{3,32}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return DeleteSessionRequestMultiError(errors)
+		return UpdateAccountRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteSessionRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteSessionRequest.ValidateAll() if the designated
+// UpdateAccountRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateAccountRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteSessionRequestMultiError []error
+type UpdateAccountRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteSessionRequestMultiError) Error() string {
+func (m UpdateAccountRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1142,11 +1153,11 @@ func (m DeleteSessionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteSessionRequestMultiError) AllErrors() []error { return m }
+func (m UpdateAccountRequestMultiError) AllErrors() []error { return m }
 
-// DeleteSessionRequestValidationError is the validation error returned by
-// DeleteSessionRequest.Validate if the designated constraints aren't met.
-type DeleteSessionRequestValidationError struct {
+// UpdateAccountRequestValidationError is the validation error returned by
+// UpdateAccountRequest.Validate if the designated constraints aren't met.
+type UpdateAccountRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1154,24 +1165,24 @@ type DeleteSessionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteSessionRequestValidationError) Field() string { return e.field }
+func (e UpdateAccountRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteSessionRequestValidationError) Reason() string { return e.reason }
+func (e UpdateAccountRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteSessionRequestValidationError) Cause() error { return e.cause }
+func (e UpdateAccountRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteSessionRequestValidationError) Key() bool { return e.key }
+func (e UpdateAccountRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteSessionRequestValidationError) ErrorName() string {
-	return "DeleteSessionRequestValidationError"
+func (e UpdateAccountRequestValidationError) ErrorName() string {
+	return "UpdateAccountRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteSessionRequestValidationError) Error() string {
+func (e UpdateAccountRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1183,14 +1194,14 @@ func (e DeleteSessionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteSessionRequest.%s: %s%s",
+		"invalid %sUpdateAccountRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteSessionRequestValidationError{}
+var _ error = UpdateAccountRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1198,44 +1209,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteSessionRequestValidationError{}
+} = UpdateAccountRequestValidationError{}
 
-// Validate checks the field values on DeleteSessionResponse with the rules
+var _UpdateAccountRequest_Name_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{3,32}$")
+
+// Validate checks the field values on UpdateAccountResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteSessionResponse) Validate() error {
+func (m *UpdateAccountResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteSessionResponse with the rules
+// ValidateAll checks the field values on UpdateAccountResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteSessionResponseMultiError, or nil if none found.
-func (m *DeleteSessionResponse) ValidateAll() error {
+// UpdateAccountResponseMultiError, or nil if none found.
+func (m *UpdateAccountResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteSessionResponse) validate(all bool) error {
+func (m *UpdateAccountResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateAccountResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateAccountResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateAccountResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return DeleteSessionResponseMultiError(errors)
+		return UpdateAccountResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteSessionResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteSessionResponse.ValidateAll() if the designated
+// UpdateAccountResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateAccountResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteSessionResponseMultiError []error
+type UpdateAccountResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteSessionResponseMultiError) Error() string {
+func (m UpdateAccountResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1244,11 +1286,11 @@ func (m DeleteSessionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteSessionResponseMultiError) AllErrors() []error { return m }
+func (m UpdateAccountResponseMultiError) AllErrors() []error { return m }
 
-// DeleteSessionResponseValidationError is the validation error returned by
-// DeleteSessionResponse.Validate if the designated constraints aren't met.
-type DeleteSessionResponseValidationError struct {
+// UpdateAccountResponseValidationError is the validation error returned by
+// UpdateAccountResponse.Validate if the designated constraints aren't met.
+type UpdateAccountResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1256,24 +1298,24 @@ type DeleteSessionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteSessionResponseValidationError) Field() string { return e.field }
+func (e UpdateAccountResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteSessionResponseValidationError) Reason() string { return e.reason }
+func (e UpdateAccountResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteSessionResponseValidationError) Cause() error { return e.cause }
+func (e UpdateAccountResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteSessionResponseValidationError) Key() bool { return e.key }
+func (e UpdateAccountResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteSessionResponseValidationError) ErrorName() string {
-	return "DeleteSessionResponseValidationError"
+func (e UpdateAccountResponseValidationError) ErrorName() string {
+	return "UpdateAccountResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteSessionResponseValidationError) Error() string {
+func (e UpdateAccountResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1285,14 +1327,14 @@ func (e DeleteSessionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteSessionResponse.%s: %s%s",
+		"invalid %sUpdateAccountResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteSessionResponseValidationError{}
+var _ error = UpdateAccountResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1300,52 +1342,59 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteSessionResponseValidationError{}
+} = UpdateAccountResponseValidationError{}
 
-// Validate checks the field values on CreateProblemRequest with the rules
+// Validate checks the field values on ChangePasswordRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateProblemRequest) Validate() error {
+func (m *ChangePasswordRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateProblemRequest with the rules
+// ValidateAll checks the field values on ChangePasswordRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateProblemRequestMultiError, or nil if none found.
-func (m *CreateProblemRequest) ValidateAll() error {
+// ChangePasswordRequestMultiError, or nil if none found.
+func (m *ChangePasswordRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateProblemRequest) validate(all bool) error {
+func (m *ChangePasswordRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DisplayName
-
-	// no validation rules for Description
+	// no validation rules for Id
 
-	// no validation rules for TimeLimit
+	// no validation rules for OldPassword
 
-	// no validation rules for MemoryLimit
+	if !_ChangePasswordRequest_NewPassword_Pattern.MatchString(m.GetNewPassword()) {
+		err := ChangePasswordRequestValidationError{
+			field:  "NewPassword",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{3,32}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return CreateProblemRequestMultiError(errors)
+		return ChangePasswordRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateProblemRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateProblemRequest.ValidateAll() if the designated
+// ChangePasswordRequestMultiError is an error wrapping multiple validation
+// errors returned by ChangePasswordRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateProblemRequestMultiError []error
+type ChangePasswordRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateProblemRequestMultiError) Error() string {
+func (m ChangePasswordRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1354,11 +1403,11 @@ func (m CreateProblemRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateProblemRequestMultiError) AllErrors() []error { return m }
+func (m ChangePasswordRequestMultiError) AllErrors() []error { return m }
 
-// CreateProblemRequestValidationError is the validation error returned by
-// CreateProblemRequest.Validate if the designated constraints aren't met.
-type CreateProblemRequestValidationError struct {
+// ChangePasswordRequestValidationError is the validation error returned by
+// ChangePasswordRequest.Validate if the designated constraints aren't met.
+type ChangePasswordRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1366,24 +1415,24 @@ type CreateProblemRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateProblemRequestValidationError) Field() string { return e.field }
+func (e ChangePasswordRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateProblemRequestValidationError) Reason() string { return e.reason }
+func (e ChangePasswordRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateProblemRequestValidationError) Cause() error { return e.cause }
+func (e ChangePasswordRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateProblemRequestValidationError) Key() bool { return e.key }
+func (e ChangePasswordRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateProblemRequestValidationError) ErrorName() string {
-	return "CreateProblemRequestValidationError"
+func (e ChangePasswordRequestValidationError) ErrorName() string {
+	return "ChangePasswordRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateProblemRequestValidationError) Error() string {
+func (e ChangePasswordRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1395,14 +1444,14 @@ func (e CreateProblemRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateProblemRequest.%s: %s%s",
+		"invalid %sChangePasswordRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateProblemRequestValidationError{}
+var _ error = ChangePasswordRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1410,56 +1459,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateProblemRequestValidationError{}
+} = ChangePasswordRequestValidationError{}
 
-// Validate checks the field values on Problem with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Problem) Validate() error {
+var _ChangePasswordRequest_NewPassword_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{3,32}$")
+
+// Validate checks the field values on ChangePasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangePasswordResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Problem with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ProblemMultiError, or nil if none found.
-func (m *Problem) ValidateAll() error {
+// ValidateAll checks the field values on ChangePasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangePasswordResponseMultiError, or nil if none found.
+func (m *ChangePasswordResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Problem) validate(all bool) error {
+func (m *ChangePasswordResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for DisplayName
-
-	// no validation rules for AuthorId
-
-	// no validation rules for AuthorName
-
-	// no validation rules for Description
-
-	// no validation rules for TimeLimit
-
-	// no validation rules for MemoryLimit
-
 	if len(errors) > 0 {
-		return ProblemMultiError(errors)
+		return ChangePasswordResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProblemMultiError is an error wrapping multiple validation errors returned
-// by Problem.ValidateAll() if the designated constraints aren't met.
-type ProblemMultiError []error
+// ChangePasswordResponseMultiError is an error wrapping multiple validation
+// errors returned by ChangePasswordResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ChangePasswordResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProblemMultiError) Error() string {
+func (m ChangePasswordResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1468,11 +1507,11 @@ func (m ProblemMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProblemMultiError) AllErrors() []error { return m }
+func (m ChangePasswordResponseMultiError) AllErrors() []error { return m }
 
-// ProblemValidationError is the validation error returned by Problem.Validate
-// if the designated constraints aren't met.
-type ProblemValidationError struct {
+// ChangePasswordResponseValidationError is the validation error returned by
+// ChangePasswordResponse.Validate if the designated constraints aren't met.
+type ChangePasswordResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1480,22 +1519,24 @@ type ProblemValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProblemValidationError) Field() string { return e.field }
+func (e ChangePasswordResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProblemValidationError) Reason() string { return e.reason }
+func (e ChangePasswordResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProblemValidationError) Cause() error { return e.cause }
+func (e ChangePasswordResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProblemValidationError) Key() bool { return e.key }
+func (e ChangePasswordResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProblemValidationError) ErrorName() string { return "ProblemValidationError" }
+func (e ChangePasswordResponseValidationError) ErrorName() string {
+	return "ChangePasswordResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ProblemValidationError) Error() string {
+func (e ChangePasswordResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1507,14 +1548,14 @@ func (e ProblemValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProblem.%s: %s%s",
+		"invalid %sChangePasswordResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProblemValidationError{}
+var _ error = ChangePasswordResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1522,73 +1563,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProblemValidationError{}
+} = ChangePasswordResponseValidationError{}
 
-// Validate checks the field values on CreateProblemResponse with the rules
+// Validate checks the field values on SetAccountRoleRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateProblemResponse) Validate() error {
+func (m *SetAccountRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateProblemResponse with the rules
+// ValidateAll checks the field values on SetAccountRoleRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateProblemResponseMultiError, or nil if none found.
-func (m *CreateProblemResponse) ValidateAll() error {
+// SetAccountRoleRequestMultiError, or nil if none found.
+func (m *SetAccountRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateProblemResponse) validate(all bool) error {
+func (m *SetAccountRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetProblem()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateProblemResponseValidationError{
-					field:  "Problem",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateProblemResponseValidationError{
-					field:  "Problem",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetProblem()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateProblemResponseValidationError{
-				field:  "Problem",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Id
+
+	// no validation rules for Role
 
 	if len(errors) > 0 {
-		return CreateProblemResponseMultiError(errors)
+		return SetAccountRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateProblemResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateProblemResponse.ValidateAll() if the designated
+// SetAccountRoleRequestMultiError is an error wrapping multiple validation
+// errors returned by SetAccountRoleRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateProblemResponseMultiError []error
+type SetAccountRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateProblemResponseMultiError) Error() string {
+func (m SetAccountRoleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1597,11 +1613,11 @@ func (m CreateProblemResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateProblemResponseMultiError) AllErrors() []error { return m }
+func (m SetAccountRoleRequestMultiError) AllErrors() []error { return m }
 
-// CreateProblemResponseValidationError is the validation error returned by
-// CreateProblemResponse.Validate if the designated constraints aren't met.
-type CreateProblemResponseValidationError struct {
+// SetAccountRoleRequestValidationError is the validation error returned by
+// SetAccountRoleRequest.Validate if the designated constraints aren't met.
+type SetAccountRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1609,24 +1625,24 @@ type CreateProblemResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateProblemResponseValidationError) Field() string { return e.field }
+func (e SetAccountRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateProblemResponseValidationError) Reason() string { return e.reason }
+func (e SetAccountRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateProblemResponseValidationError) Cause() error { return e.cause }
+func (e SetAccountRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateProblemResponseValidationError) Key() bool { return e.key }
+func (e SetAccountRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateProblemResponseValidationError) ErrorName() string {
-	return "CreateProblemResponseValidationError"
+func (e SetAccountRoleRequestValidationError) ErrorName() string {
+	return "SetAccountRoleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateProblemResponseValidationError) Error() string {
+func (e SetAccountRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1638,14 +1654,14 @@ func (e CreateProblemResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateProblemResponse.%s: %s%s",
+		"invalid %sSetAccountRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateProblemResponseValidationError{}
+var _ error = SetAccountRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1653,57 +1669,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateProblemResponseValidationError{}
+} = SetAccountRoleRequestValidationError{}
 
-// Validate checks the field values on GetProblemListRequest with the rules
+// Validate checks the field values on SetAccountRoleResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetProblemListRequest) Validate() error {
+func (m *SetAccountRoleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProblemListRequest with the rules
+// ValidateAll checks the field values on SetAccountRoleResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetProblemListRequestMultiError, or nil if none found.
-func (m *GetProblemListRequest) ValidateAll() error {
+// SetAccountRoleResponseMultiError, or nil if none found.
+func (m *SetAccountRoleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProblemListRequest) validate(all bool) error {
+func (m *SetAccountRoleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Offset
-
-	if m.GetLimit() > 100 {
-		err := GetProblemListRequestValidationError{
-			field:  "Limit",
-			reason: "value must be less than or equal to 100",
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetAccountRoleResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetAccountRoleResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetAccountRoleResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetProblemListRequestMultiError(errors)
+		return SetAccountRoleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProblemListRequestMultiError is an error wrapping multiple validation
-// errors returned by GetProblemListRequest.ValidateAll() if the designated
+// SetAccountRoleResponseMultiError is an error wrapping multiple validation
+// errors returned by SetAccountRoleResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetProblemListRequestMultiError []error
+type SetAccountRoleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProblemListRequestMultiError) Error() string {
+func (m SetAccountRoleResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1712,11 +1744,11 @@ func (m GetProblemListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProblemListRequestMultiError) AllErrors() []error { return m }
+func (m SetAccountRoleResponseMultiError) AllErrors() []error { return m }
 
-// GetProblemListRequestValidationError is the validation error returned by
-// GetProblemListRequest.Validate if the designated constraints aren't met.
-type GetProblemListRequestValidationError struct {
+// SetAccountRoleResponseValidationError is the validation error returned by
+// SetAccountRoleResponse.Validate if the designated constraints aren't met.
+type SetAccountRoleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1724,24 +1756,24 @@ type GetProblemListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProblemListRequestValidationError) Field() string { return e.field }
+func (e SetAccountRoleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProblemListRequestValidationError) Reason() string { return e.reason }
+func (e SetAccountRoleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProblemListRequestValidationError) Cause() error { return e.cause }
+func (e SetAccountRoleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProblemListRequestValidationError) Key() bool { return e.key }
+func (e SetAccountRoleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProblemListRequestValidationError) ErrorName() string {
-	return "GetProblemListRequestValidationError"
+func (e SetAccountRoleResponseValidationError) ErrorName() string {
+	return "SetAccountRoleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetProblemListRequestValidationError) Error() string {
+func (e SetAccountRoleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1753,14 +1785,14 @@ func (e GetProblemListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProblemListRequest.%s: %s%s",
+		"invalid %sSetAccountRoleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProblemListRequestValidationError{}
+var _ error = SetAccountRoleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1768,80 +1800,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProblemListRequestValidationError{}
+} = SetAccountRoleResponseValidationError{}
 
-// Validate checks the field values on GetProblemListResponse with the rules
+// Validate checks the field values on DisableAccountRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetProblemListResponse) Validate() error {
+func (m *DisableAccountRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProblemListResponse with the rules
+// ValidateAll checks the field values on DisableAccountRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetProblemListResponseMultiError, or nil if none found.
-func (m *GetProblemListResponse) ValidateAll() error {
+// DisableAccountRequestMultiError, or nil if none found.
+func (m *DisableAccountRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProblemListResponse) validate(all bool) error {
+func (m *DisableAccountRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetProblems() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetProblemListResponseValidationError{
-						field:  fmt.Sprintf("Problems[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetProblemListResponseValidationError{
-						field:  fmt.Sprintf("Problems[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetProblemListResponseValidationError{
-					field:  fmt.Sprintf("Problems[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for TotalProblemCount
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return GetProblemListResponseMultiError(errors)
+		return DisableAccountRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProblemListResponseMultiError is an error wrapping multiple validation
-// errors returned by GetProblemListResponse.ValidateAll() if the designated
+// DisableAccountRequestMultiError is an error wrapping multiple validation
+// errors returned by DisableAccountRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetProblemListResponseMultiError []error
+type DisableAccountRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProblemListResponseMultiError) Error() string {
+func (m DisableAccountRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1850,11 +1848,11 @@ func (m GetProblemListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProblemListResponseMultiError) AllErrors() []error { return m }
+func (m DisableAccountRequestMultiError) AllErrors() []error { return m }
 
-// GetProblemListResponseValidationError is the validation error returned by
-// GetProblemListResponse.Validate if the designated constraints aren't met.
-type GetProblemListResponseValidationError struct {
+// DisableAccountRequestValidationError is the validation error returned by
+// DisableAccountRequest.Validate if the designated constraints aren't met.
+type DisableAccountRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1862,24 +1860,24 @@ type GetProblemListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProblemListResponseValidationError) Field() string { return e.field }
+func (e DisableAccountRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProblemListResponseValidationError) Reason() string { return e.reason }
+func (e DisableAccountRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProblemListResponseValidationError) Cause() error { return e.cause }
+func (e DisableAccountRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProblemListResponseValidationError) Key() bool { return e.key }
+func (e DisableAccountRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProblemListResponseValidationError) ErrorName() string {
-	return "GetProblemListResponseValidationError"
+func (e DisableAccountRequestValidationError) ErrorName() string {
+	return "DisableAccountRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetProblemListResponseValidationError) Error() string {
+func (e DisableAccountRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1891,14 +1889,14 @@ func (e GetProblemListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProblemListResponse.%s: %s%s",
+		"invalid %sDisableAccountRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProblemListResponseValidationError{}
+var _ error = DisableAccountRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1906,46 +1904,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProblemListResponseValidationError{}
+} = DisableAccountRequestValidationError{}
 
-// Validate checks the field values on GetProblemRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetProblemRequest) Validate() error {
+// Validate checks the field values on DisableAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableAccountResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProblemRequest with the rules
+// ValidateAll checks the field values on DisableAccountResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetProblemRequestMultiError, or nil if none found.
-func (m *GetProblemRequest) ValidateAll() error {
+// DisableAccountResponseMultiError, or nil if none found.
+func (m *DisableAccountResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProblemRequest) validate(all bool) error {
+func (m *DisableAccountResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DisableAccountResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DisableAccountResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DisableAccountResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetProblemRequestMultiError(errors)
+		return DisableAccountResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProblemRequestMultiError is an error wrapping multiple validation errors
-// returned by GetProblemRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetProblemRequestMultiError []error
+// DisableAccountResponseMultiError is an error wrapping multiple validation
+// errors returned by DisableAccountResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DisableAccountResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProblemRequestMultiError) Error() string {
+func (m DisableAccountResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1954,11 +1979,11 @@ func (m GetProblemRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProblemRequestMultiError) AllErrors() []error { return m }
+func (m DisableAccountResponseMultiError) AllErrors() []error { return m }
 
-// GetProblemRequestValidationError is the validation error returned by
-// GetProblemRequest.Validate if the designated constraints aren't met.
-type GetProblemRequestValidationError struct {
+// DisableAccountResponseValidationError is the validation error returned by
+// DisableAccountResponse.Validate if the designated constraints aren't met.
+type DisableAccountResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1966,24 +1991,24 @@ type GetProblemRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProblemRequestValidationError) Field() string { return e.field }
+func (e DisableAccountResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProblemRequestValidationError) Reason() string { return e.reason }
+func (e DisableAccountResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProblemRequestValidationError) Cause() error { return e.cause }
+func (e DisableAccountResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProblemRequestValidationError) Key() bool { return e.key }
+func (e DisableAccountResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProblemRequestValidationError) ErrorName() string {
-	return "GetProblemRequestValidationError"
+func (e DisableAccountResponseValidationError) ErrorName() string {
+	return "DisableAccountResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetProblemRequestValidationError) Error() string {
+func (e DisableAccountResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1995,14 +2020,14 @@ func (e GetProblemRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProblemRequest.%s: %s%s",
+		"invalid %sDisableAccountResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProblemRequestValidationError{}
+var _ error = DisableAccountResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2010,24 +2035,152 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProblemRequestValidationError{}
+} = DisableAccountResponseValidationError{}
 
-// Validate checks the field values on GetProblemResponse with the rules
+// Validate checks the field values on CreateSessionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetProblemResponse) Validate() error {
+func (m *CreateSessionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProblemResponse with the rules
+// ValidateAll checks the field values on CreateSessionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetProblemResponseMultiError, or nil if none found.
-func (m *GetProblemResponse) ValidateAll() error {
+// CreateSessionRequestMultiError, or nil if none found.
+func (m *CreateSessionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProblemResponse) validate(all bool) error {
+func (m *CreateSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_CreateSessionRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := CreateSessionRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{3,32}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateSessionRequest_Password_Pattern.MatchString(m.GetPassword()) {
+		err := CreateSessionRequestValidationError{
+			field:  "Password",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{3,32}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSessionRequestMultiError) AllErrors() []error { return m }
+
+// CreateSessionRequestValidationError is the validation error returned by
+// CreateSessionRequest.Validate if the designated constraints aren't met.
+type CreateSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSessionRequestValidationError) ErrorName() string {
+	return "CreateSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSessionRequestValidationError{}
+
+var _CreateSessionRequest_Name_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{3,32}$")
+
+var _CreateSessionRequest_Password_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{3,32}$")
+
+// Validate checks the field values on CreateSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSessionResponseMultiError, or nil if none found.
+func (m *CreateSessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSessionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2035,28 +2188,28 @@ func (m *GetProblemResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetProblem()).(type) {
+		switch v := interface{}(m.GetAccount()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetProblemResponseValidationError{
-					field:  "Problem",
+				errors = append(errors, CreateSessionResponseValidationError{
+					field:  "Account",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetProblemResponseValidationError{
-					field:  "Problem",
+				errors = append(errors, CreateSessionResponseValidationError{
+					field:  "Account",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetProblem()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetProblemResponseValidationError{
-				field:  "Problem",
+			return CreateSessionResponseValidationError{
+				field:  "Account",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2064,19 +2217,19 @@ func (m *GetProblemResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetProblemResponseMultiError(errors)
+		return CreateSessionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProblemResponseMultiError is an error wrapping multiple validation errors
-// returned by GetProblemResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetProblemResponseMultiError []error
+// CreateSessionResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateSessionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSessionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProblemResponseMultiError) Error() string {
+func (m CreateSessionResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2085,11 +2238,11 @@ func (m GetProblemResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProblemResponseMultiError) AllErrors() []error { return m }
+func (m CreateSessionResponseMultiError) AllErrors() []error { return m }
 
-// GetProblemResponseValidationError is the validation error returned by
-// GetProblemResponse.Validate if the designated constraints aren't met.
-type GetProblemResponseValidationError struct {
+// CreateSessionResponseValidationError is the validation error returned by
+// CreateSessionResponse.Validate if the designated constraints aren't met.
+type CreateSessionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2097,24 +2250,24 @@ type GetProblemResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProblemResponseValidationError) Field() string { return e.field }
+func (e CreateSessionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProblemResponseValidationError) Reason() string { return e.reason }
+func (e CreateSessionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProblemResponseValidationError) Cause() error { return e.cause }
+func (e CreateSessionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProblemResponseValidationError) Key() bool { return e.key }
+func (e CreateSessionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProblemResponseValidationError) ErrorName() string {
-	return "GetProblemResponseValidationError"
+func (e CreateSessionResponseValidationError) ErrorName() string {
+	return "CreateSessionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetProblemResponseValidationError) Error() string {
+func (e CreateSessionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2126,14 +2279,14 @@ func (e GetProblemResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProblemResponse.%s: %s%s",
+		"invalid %sCreateSessionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProblemResponseValidationError{}
+var _ error = CreateSessionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2141,54 +2294,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProblemResponseValidationError{}
+} = CreateSessionResponseValidationError{}
 
-// Validate checks the field values on UpdateProblemRequest with the rules
+// Validate checks the field values on DeleteSessionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateProblemRequest) Validate() error {
+func (m *DeleteSessionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateProblemRequest with the rules
+// ValidateAll checks the field values on DeleteSessionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateProblemRequestMultiError, or nil if none found.
-func (m *UpdateProblemRequest) ValidateAll() error {
+// DeleteSessionRequestMultiError, or nil if none found.
+func (m *DeleteSessionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateProblemRequest) validate(all bool) error {
+func (m *DeleteSessionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for DisplayName
-
-	// no validation rules for Description
-
-	// no validation rules for TimeLimit
-
-	// no validation rules for MemoryLimit
-
 	if len(errors) > 0 {
-		return UpdateProblemRequestMultiError(errors)
+		return DeleteSessionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateProblemRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateProblemRequest.ValidateAll() if the designated
+// DeleteSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSessionRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateProblemRequestMultiError []error
+type DeleteSessionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateProblemRequestMultiError) Error() string {
+func (m DeleteSessionRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2197,11 +2340,11 @@ func (m UpdateProblemRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateProblemRequestMultiError) AllErrors() []error { return m }
+func (m DeleteSessionRequestMultiError) AllErrors() []error { return m }
 
-// UpdateProblemRequestValidationError is the validation error returned by
-// UpdateProblemRequest.Validate if the designated constraints aren't met.
-type UpdateProblemRequestValidationError struct {
+// DeleteSessionRequestValidationError is the validation error returned by
+// DeleteSessionRequest.Validate if the designated constraints aren't met.
+type DeleteSessionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2209,24 +2352,8841 @@ type UpdateProblemRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateProblemRequestValidationError) Field() string { return e.field }
+func (e DeleteSessionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateProblemRequestValidationError) Reason() string { return e.reason }
+func (e DeleteSessionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateProblemRequestValidationError) Cause() error { return e.cause }
+func (e DeleteSessionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateProblemRequestValidationError) Key() bool { return e.key }
+func (e DeleteSessionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateProblemRequestValidationError) ErrorName() string {
-	return "UpdateProblemRequestValidationError"
+func (e DeleteSessionRequestValidationError) ErrorName() string {
+	return "DeleteSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSessionRequestValidationError{}
+
+// Validate checks the field values on DeleteSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSessionResponseMultiError, or nil if none found.
+func (m *DeleteSessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteSessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSessionResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteSessionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSessionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSessionResponseMultiError) AllErrors() []error { return m }
+
+// DeleteSessionResponseValidationError is the validation error returned by
+// DeleteSessionResponse.Validate if the designated constraints aren't met.
+type DeleteSessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSessionResponseValidationError) ErrorName() string {
+	return "DeleteSessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSessionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSessionResponseValidationError{}
+
+// Validate checks the field values on RefreshSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshSessionRequestMultiError, or nil if none found.
+func (m *RefreshSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefreshSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RefreshSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefreshSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by RefreshSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefreshSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefreshSessionRequestMultiError) AllErrors() []error { return m }
+
+// RefreshSessionRequestValidationError is the validation error returned by
+// RefreshSessionRequest.Validate if the designated constraints aren't met.
+type RefreshSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshSessionRequestValidationError) ErrorName() string {
+	return "RefreshSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefreshSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshSessionRequestValidationError{}
+
+// Validate checks the field values on RefreshSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshSessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshSessionResponseMultiError, or nil if none found.
+func (m *RefreshSessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefreshSessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RefreshSessionResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RefreshSessionResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RefreshSessionResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RefreshSessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefreshSessionResponseMultiError is an error wrapping multiple validation
+// errors returned by RefreshSessionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshSessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefreshSessionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefreshSessionResponseMultiError) AllErrors() []error { return m }
+
+// RefreshSessionResponseValidationError is the validation error returned by
+// RefreshSessionResponse.Validate if the designated constraints aren't met.
+type RefreshSessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshSessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshSessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshSessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshSessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshSessionResponseValidationError) ErrorName() string {
+	return "RefreshSessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefreshSessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshSessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshSessionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshSessionResponseValidationError{}
+
+// Validate checks the field values on DeleteAllSessionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAllSessionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAllSessionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAllSessionsRequestMultiError, or nil if none found.
+func (m *DeleteAllSessionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAllSessionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteAllSessionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAllSessionsRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteAllSessionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAllSessionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAllSessionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAllSessionsRequestMultiError) AllErrors() []error { return m }
+
+// DeleteAllSessionsRequestValidationError is the validation error returned by
+// DeleteAllSessionsRequest.Validate if the designated constraints aren't met.
+type DeleteAllSessionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAllSessionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAllSessionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAllSessionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAllSessionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAllSessionsRequestValidationError) ErrorName() string {
+	return "DeleteAllSessionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAllSessionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAllSessionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAllSessionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAllSessionsRequestValidationError{}
+
+// Validate checks the field values on DeleteAllSessionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAllSessionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAllSessionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAllSessionsResponseMultiError, or nil if none found.
+func (m *DeleteAllSessionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAllSessionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteAllSessionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAllSessionsResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteAllSessionsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteAllSessionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAllSessionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAllSessionsResponseMultiError) AllErrors() []error { return m }
+
+// DeleteAllSessionsResponseValidationError is the validation error returned by
+// DeleteAllSessionsResponse.Validate if the designated constraints aren't met.
+type DeleteAllSessionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAllSessionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAllSessionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAllSessionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAllSessionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAllSessionsResponseValidationError) ErrorName() string {
+	return "DeleteAllSessionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAllSessionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAllSessionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAllSessionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAllSessionsResponseValidationError{}
+
+// Validate checks the field values on JSONWebKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JSONWebKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JSONWebKey with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JSONWebKeyMultiError, or
+// nil if none found.
+func (m *JSONWebKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JSONWebKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kid
+
+	// no validation rules for Kty
+
+	// no validation rules for Alg
+
+	// no validation rules for Use
+
+	// no validation rules for N
+
+	// no validation rules for E
+
+	if len(errors) > 0 {
+		return JSONWebKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// JSONWebKeyMultiError is an error wrapping multiple validation errors
+// returned by JSONWebKey.ValidateAll() if the designated constraints aren't met.
+type JSONWebKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JSONWebKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JSONWebKeyMultiError) AllErrors() []error { return m }
+
+// JSONWebKeyValidationError is the validation error returned by
+// JSONWebKey.Validate if the designated constraints aren't met.
+type JSONWebKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JSONWebKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JSONWebKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JSONWebKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JSONWebKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JSONWebKeyValidationError) ErrorName() string { return "JSONWebKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JSONWebKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJSONWebKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JSONWebKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JSONWebKeyValidationError{}
+
+// Validate checks the field values on GetJSONWebKeySetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJSONWebKeySetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJSONWebKeySetRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJSONWebKeySetRequestMultiError, or nil if none found.
+func (m *GetJSONWebKeySetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJSONWebKeySetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetJSONWebKeySetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJSONWebKeySetRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJSONWebKeySetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetJSONWebKeySetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJSONWebKeySetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJSONWebKeySetRequestMultiError) AllErrors() []error { return m }
+
+// GetJSONWebKeySetRequestValidationError is the validation error returned by
+// GetJSONWebKeySetRequest.Validate if the designated constraints aren't met.
+type GetJSONWebKeySetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJSONWebKeySetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJSONWebKeySetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJSONWebKeySetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJSONWebKeySetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJSONWebKeySetRequestValidationError) ErrorName() string {
+	return "GetJSONWebKeySetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJSONWebKeySetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJSONWebKeySetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJSONWebKeySetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJSONWebKeySetRequestValidationError{}
+
+// Validate checks the field values on GetJSONWebKeySetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJSONWebKeySetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJSONWebKeySetResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJSONWebKeySetResponseMultiError, or nil if none found.
+func (m *GetJSONWebKeySetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJSONWebKeySetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJSONWebKeySetResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJSONWebKeySetResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJSONWebKeySetResponseValidationError{
+					field:  fmt.Sprintf("Keys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetJSONWebKeySetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJSONWebKeySetResponseMultiError is an error wrapping multiple validation
+// errors returned by GetJSONWebKeySetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetJSONWebKeySetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJSONWebKeySetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJSONWebKeySetResponseMultiError) AllErrors() []error { return m }
+
+// GetJSONWebKeySetResponseValidationError is the validation error returned by
+// GetJSONWebKeySetResponse.Validate if the designated constraints aren't met.
+type GetJSONWebKeySetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJSONWebKeySetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJSONWebKeySetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJSONWebKeySetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJSONWebKeySetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJSONWebKeySetResponseValidationError) ErrorName() string {
+	return "GetJSONWebKeySetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJSONWebKeySetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJSONWebKeySetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJSONWebKeySetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJSONWebKeySetResponseValidationError{}
+
+// Validate checks the field values on CreateProblemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateProblemRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateProblemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateProblemRequestMultiError, or nil if none found.
+func (m *CreateProblemRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateProblemRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Description
+
+	// no validation rules for TimeLimit
+
+	// no validation rules for MemoryLimit
+
+	if _, ok := OutputComparisonMode_name[int32(m.GetComparisonMode())]; !ok {
+		err := CreateProblemRequestValidationError{
+			field:  "ComparisonMode",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFloatEpsilon() < 0 {
+		err := CreateProblemRequestValidationError{
+			field:  "FloatEpsilon",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateProblemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateProblemRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateProblemRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateProblemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateProblemRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateProblemRequestMultiError) AllErrors() []error { return m }
+
+// CreateProblemRequestValidationError is the validation error returned by
+// CreateProblemRequest.Validate if the designated constraints aren't met.
+type CreateProblemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateProblemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateProblemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateProblemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateProblemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateProblemRequestValidationError) ErrorName() string {
+	return "CreateProblemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateProblemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateProblemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateProblemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateProblemRequestValidationError{}
+
+// Validate checks the field values on Problem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Problem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Problem with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ProblemMultiError, or nil if none found.
+func (m *Problem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Problem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for DisplayName
+
+	// no validation rules for AuthorId
+
+	// no validation rules for AuthorName
+
+	// no validation rules for Description
+
+	// no validation rules for TimeLimit
+
+	// no validation rules for MemoryLimit
+
+	// no validation rules for HasChecker
+
+	// no validation rules for CheckerLanguage
+
+	// no validation rules for ComparisonMode
+
+	// no validation rules for FloatEpsilon
+
+	// no validation rules for HasInteractor
+
+	// no validation rules for InteractorLanguage
+
+	if len(errors) > 0 {
+		return ProblemMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProblemMultiError is an error wrapping multiple validation errors returned
+// by Problem.ValidateAll() if the designated constraints aren't met.
+type ProblemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProblemMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProblemMultiError) AllErrors() []error { return m }
+
+// ProblemValidationError is the validation error returned by Problem.Validate
+// if the designated constraints aren't met.
+type ProblemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProblemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProblemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProblemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProblemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProblemValidationError) ErrorName() string { return "ProblemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProblemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProblem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProblemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProblemValidationError{}
+
+// Validate checks the field values on CreateProblemResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateProblemResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateProblemResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateProblemResponseMultiError, or nil if none found.
+func (m *CreateProblemResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateProblemResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProblem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateProblemResponseValidationError{
+					field:  "Problem",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateProblemResponseValidationError{
+					field:  "Problem",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProblem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateProblemResponseValidationError{
+				field:  "Problem",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateProblemResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateProblemResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateProblemResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateProblemResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateProblemResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateProblemResponseMultiError) AllErrors() []error { return m }
+
+// CreateProblemResponseValidationError is the validation error returned by
+// CreateProblemResponse.Validate if the designated constraints aren't met.
+type CreateProblemResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateProblemResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateProblemResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateProblemResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateProblemResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateProblemResponseValidationError) ErrorName() string {
+	return "CreateProblemResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateProblemResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateProblemResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateProblemResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateProblemResponseValidationError{}
+
+// Validate checks the field values on GetProblemListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProblemListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProblemListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProblemListRequestMultiError, or nil if none found.
+func (m *GetProblemListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProblemListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Offset
+
+	if m.GetLimit() > 100 {
+		err := GetProblemListRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetProblemListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProblemListRequestMultiError is an error wrapping multiple validation
+// errors returned by GetProblemListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetProblemListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProblemListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProblemListRequestMultiError) AllErrors() []error { return m }
+
+// GetProblemListRequestValidationError is the validation error returned by
+// GetProblemListRequest.Validate if the designated constraints aren't met.
+type GetProblemListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProblemListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProblemListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProblemListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProblemListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProblemListRequestValidationError) ErrorName() string {
+	return "GetProblemListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProblemListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProblemListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProblemListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProblemListRequestValidationError{}
+
+// Validate checks the field values on GetProblemListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProblemListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProblemListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProblemListResponseMultiError, or nil if none found.
+func (m *GetProblemListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProblemListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetProblems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetProblemListResponseValidationError{
+						field:  fmt.Sprintf("Problems[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetProblemListResponseValidationError{
+						field:  fmt.Sprintf("Problems[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetProblemListResponseValidationError{
+					field:  fmt.Sprintf("Problems[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalProblemCount
+
+	if len(errors) > 0 {
+		return GetProblemListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProblemListResponseMultiError is an error wrapping multiple validation
+// errors returned by GetProblemListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetProblemListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProblemListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProblemListResponseMultiError) AllErrors() []error { return m }
+
+// GetProblemListResponseValidationError is the validation error returned by
+// GetProblemListResponse.Validate if the designated constraints aren't met.
+type GetProblemListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProblemListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProblemListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProblemListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProblemListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProblemListResponseValidationError) ErrorName() string {
+	return "GetProblemListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProblemListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProblemListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProblemListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProblemListResponseValidationError{}
+
+// Validate checks the field values on GetProblemRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetProblemRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProblemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProblemRequestMultiError, or nil if none found.
+func (m *GetProblemRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProblemRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetProblemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProblemRequestMultiError is an error wrapping multiple validation errors
+// returned by GetProblemRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetProblemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProblemRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProblemRequestMultiError) AllErrors() []error { return m }
+
+// GetProblemRequestValidationError is the validation error returned by
+// GetProblemRequest.Validate if the designated constraints aren't met.
+type GetProblemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProblemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProblemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProblemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProblemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProblemRequestValidationError) ErrorName() string {
+	return "GetProblemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProblemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProblemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProblemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProblemRequestValidationError{}
+
+// Validate checks the field values on GetProblemResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProblemResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProblemResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProblemResponseMultiError, or nil if none found.
+func (m *GetProblemResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProblemResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProblem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetProblemResponseValidationError{
+					field:  "Problem",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetProblemResponseValidationError{
+					field:  "Problem",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProblem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetProblemResponseValidationError{
+				field:  "Problem",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetProblemResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProblemResponseMultiError is an error wrapping multiple validation errors
+// returned by GetProblemResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetProblemResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProblemResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProblemResponseMultiError) AllErrors() []error { return m }
+
+// GetProblemResponseValidationError is the validation error returned by
+// GetProblemResponse.Validate if the designated constraints aren't met.
+type GetProblemResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProblemResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProblemResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProblemResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProblemResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProblemResponseValidationError) ErrorName() string {
+	return "GetProblemResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProblemResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProblemResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProblemResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProblemResponseValidationError{}
+
+// Validate checks the field values on UpdateProblemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateProblemRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateProblemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateProblemRequestMultiError, or nil if none found.
+func (m *UpdateProblemRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateProblemRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Description
+
+	// no validation rules for TimeLimit
+
+	// no validation rules for MemoryLimit
+
+	if _, ok := OutputComparisonMode_name[int32(m.GetComparisonMode())]; !ok {
+		err := UpdateProblemRequestValidationError{
+			field:  "ComparisonMode",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFloatEpsilon() < 0 {
+		err := UpdateProblemRequestValidationError{
+			field:  "FloatEpsilon",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateProblemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateProblemRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateProblemRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateProblemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateProblemRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateProblemRequestMultiError) AllErrors() []error { return m }
+
+// UpdateProblemRequestValidationError is the validation error returned by
+// UpdateProblemRequest.Validate if the designated constraints aren't met.
+type UpdateProblemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateProblemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateProblemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateProblemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateProblemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateProblemRequestValidationError) ErrorName() string {
+	return "UpdateProblemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateProblemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateProblemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateProblemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateProblemRequestValidationError{}
+
+// Validate checks the field values on UpdateProblemResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateProblemResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateProblemResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateProblemResponseMultiError, or nil if none found.
+func (m *UpdateProblemResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateProblemResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProblem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateProblemResponseValidationError{
+					field:  "Problem",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateProblemResponseValidationError{
+					field:  "Problem",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProblem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateProblemResponseValidationError{
+				field:  "Problem",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateProblemResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateProblemResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateProblemResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateProblemResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateProblemResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateProblemResponseMultiError) AllErrors() []error { return m }
+
+// UpdateProblemResponseValidationError is the validation error returned by
+// UpdateProblemResponse.Validate if the designated constraints aren't met.
+type UpdateProblemResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateProblemResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateProblemResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateProblemResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateProblemResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateProblemResponseValidationError) ErrorName() string {
+	return "UpdateProblemResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateProblemResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateProblemResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateProblemResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateProblemResponseValidationError{}
+
+// Validate checks the field values on DeleteProblemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteProblemRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteProblemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteProblemRequestMultiError, or nil if none found.
+func (m *DeleteProblemRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteProblemRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteProblemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteProblemRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteProblemRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteProblemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteProblemRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteProblemRequestMultiError) AllErrors() []error { return m }
+
+// DeleteProblemRequestValidationError is the validation error returned by
+// DeleteProblemRequest.Validate if the designated constraints aren't met.
+type DeleteProblemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteProblemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteProblemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteProblemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteProblemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteProblemRequestValidationError) ErrorName() string {
+	return "DeleteProblemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteProblemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteProblemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteProblemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteProblemRequestValidationError{}
+
+// Validate checks the field values on DeleteProblemResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteProblemResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteProblemResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteProblemResponseMultiError, or nil if none found.
+func (m *DeleteProblemResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteProblemResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteProblemResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteProblemResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteProblemResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteProblemResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteProblemResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteProblemResponseMultiError) AllErrors() []error { return m }
+
+// DeleteProblemResponseValidationError is the validation error returned by
+// DeleteProblemResponse.Validate if the designated constraints aren't met.
+type DeleteProblemResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteProblemResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteProblemResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteProblemResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteProblemResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteProblemResponseValidationError) ErrorName() string {
+	return "DeleteProblemResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteProblemResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteProblemResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteProblemResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteProblemResponseValidationError{}
+
+// Validate checks the field values on UpdateProblemCheckerRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateProblemCheckerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateProblemCheckerRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateProblemCheckerRequestMultiError, or nil if none found.
+func (m *UpdateProblemCheckerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateProblemCheckerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Language
+
+	// no validation rules for Source
+
+	if len(errors) > 0 {
+		return UpdateProblemCheckerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateProblemCheckerRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateProblemCheckerRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateProblemCheckerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateProblemCheckerRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateProblemCheckerRequestMultiError) AllErrors() []error { return m }
+
+// UpdateProblemCheckerRequestValidationError is the validation error returned
+// by UpdateProblemCheckerRequest.Validate if the designated constraints
+// aren't met.
+type UpdateProblemCheckerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateProblemCheckerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateProblemCheckerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateProblemCheckerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateProblemCheckerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateProblemCheckerRequestValidationError) ErrorName() string {
+	return "UpdateProblemCheckerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateProblemCheckerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateProblemCheckerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateProblemCheckerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateProblemCheckerRequestValidationError{}
+
+// Validate checks the field values on UpdateProblemCheckerResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateProblemCheckerResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateProblemCheckerResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateProblemCheckerResponseMultiError, or nil if none found.
+func (m *UpdateProblemCheckerResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateProblemCheckerResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProblem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateProblemCheckerResponseValidationError{
+					field:  "Problem",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateProblemCheckerResponseValidationError{
+					field:  "Problem",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProblem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateProblemCheckerResponseValidationError{
+				field:  "Problem",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateProblemCheckerResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateProblemCheckerResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateProblemCheckerResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateProblemCheckerResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateProblemCheckerResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateProblemCheckerResponseMultiError) AllErrors() []error { return m }
+
+// UpdateProblemCheckerResponseValidationError is the validation error returned
+// by UpdateProblemCheckerResponse.Validate if the designated constraints
+// aren't met.
+type UpdateProblemCheckerResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateProblemCheckerResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateProblemCheckerResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateProblemCheckerResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateProblemCheckerResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateProblemCheckerResponseValidationError) ErrorName() string {
+	return "UpdateProblemCheckerResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateProblemCheckerResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateProblemCheckerResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateProblemCheckerResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateProblemCheckerResponseValidationError{}
+
+// Validate checks the field values on UpdateProblemInteractorRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateProblemInteractorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateProblemInteractorRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateProblemInteractorRequestMultiError, or nil if none found.
+func (m *UpdateProblemInteractorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateProblemInteractorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Language
+
+	// no validation rules for Source
+
+	if len(errors) > 0 {
+		return UpdateProblemInteractorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateProblemInteractorRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateProblemInteractorRequest.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateProblemInteractorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateProblemInteractorRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateProblemInteractorRequestMultiError) AllErrors() []error { return m }
+
+// UpdateProblemInteractorRequestValidationError is the validation error
+// returned by UpdateProblemInteractorRequest.Validate if the designated
+// constraints aren't met.
+type UpdateProblemInteractorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateProblemInteractorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateProblemInteractorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateProblemInteractorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateProblemInteractorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateProblemInteractorRequestValidationError) ErrorName() string {
+	return "UpdateProblemInteractorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateProblemInteractorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateProblemInteractorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateProblemInteractorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateProblemInteractorRequestValidationError{}
+
+// Validate checks the field values on UpdateProblemInteractorResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateProblemInteractorResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateProblemInteractorResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateProblemInteractorResponseMultiError, or nil if none found.
+func (m *UpdateProblemInteractorResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateProblemInteractorResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProblem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateProblemInteractorResponseValidationError{
+					field:  "Problem",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateProblemInteractorResponseValidationError{
+					field:  "Problem",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProblem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateProblemInteractorResponseValidationError{
+				field:  "Problem",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateProblemInteractorResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateProblemInteractorResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateProblemInteractorResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateProblemInteractorResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateProblemInteractorResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateProblemInteractorResponseMultiError) AllErrors() []error { return m }
+
+// UpdateProblemInteractorResponseValidationError is the validation error
+// returned by UpdateProblemInteractorResponse.Validate if the designated
+// constraints aren't met.
+type UpdateProblemInteractorResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateProblemInteractorResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateProblemInteractorResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateProblemInteractorResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateProblemInteractorResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateProblemInteractorResponseValidationError) ErrorName() string {
+	return "UpdateProblemInteractorResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateProblemInteractorResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateProblemInteractorResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateProblemInteractorResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateProblemInteractorResponseValidationError{}
+
+// Validate checks the field values on CreateTestCaseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTestCaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTestCaseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTestCaseRequestMultiError, or nil if none found.
+func (m *CreateTestCaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTestCaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OfProblemId
+
+	// no validation rules for Input
+
+	// no validation rules for Output
+
+	// no validation rules for IsHidden
+
+	// no validation rules for OfTestCaseGroupId
+
+	if len(errors) > 0 {
+		return CreateTestCaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTestCaseRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateTestCaseRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTestCaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTestCaseRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTestCaseRequestMultiError) AllErrors() []error { return m }
+
+// CreateTestCaseRequestValidationError is the validation error returned by
+// CreateTestCaseRequest.Validate if the designated constraints aren't met.
+type CreateTestCaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTestCaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTestCaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTestCaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTestCaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTestCaseRequestValidationError) ErrorName() string {
+	return "CreateTestCaseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTestCaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTestCaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTestCaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTestCaseRequestValidationError{}
+
+// Validate checks the field values on TestCase with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TestCase) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TestCase with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TestCaseMultiError, or nil
+// if none found.
+func (m *TestCase) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TestCase) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OfProblemId
+
+	// no validation rules for Input
+
+	// no validation rules for Output
+
+	// no validation rules for IsHidden
+
+	// no validation rules for OfTestCaseGroupId
+
+	if len(errors) > 0 {
+		return TestCaseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TestCaseMultiError is an error wrapping multiple validation errors returned
+// by TestCase.ValidateAll() if the designated constraints aren't met.
+type TestCaseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TestCaseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TestCaseMultiError) AllErrors() []error { return m }
+
+// TestCaseValidationError is the validation error returned by
+// TestCase.Validate if the designated constraints aren't met.
+type TestCaseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestCaseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestCaseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestCaseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestCaseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestCaseValidationError) ErrorName() string { return "TestCaseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TestCaseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestCase.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestCaseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestCaseValidationError{}
+
+// Validate checks the field values on CreateTestCaseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTestCaseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTestCaseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTestCaseResponseMultiError, or nil if none found.
+func (m *CreateTestCaseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTestCaseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTestCase()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTestCaseResponseValidationError{
+					field:  "TestCase",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTestCaseResponseValidationError{
+					field:  "TestCase",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTestCase()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTestCaseResponseValidationError{
+				field:  "TestCase",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateTestCaseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTestCaseResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateTestCaseResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTestCaseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTestCaseResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTestCaseResponseMultiError) AllErrors() []error { return m }
+
+// CreateTestCaseResponseValidationError is the validation error returned by
+// CreateTestCaseResponse.Validate if the designated constraints aren't met.
+type CreateTestCaseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTestCaseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTestCaseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTestCaseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTestCaseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTestCaseResponseValidationError) ErrorName() string {
+	return "CreateTestCaseResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTestCaseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTestCaseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTestCaseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTestCaseResponseValidationError{}
+
+// Validate checks the field values on GetProblemTestCaseListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProblemTestCaseListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProblemTestCaseListRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetProblemTestCaseListRequestMultiError, or nil if none found.
+func (m *GetProblemTestCaseListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProblemTestCaseListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Offset
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return GetProblemTestCaseListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProblemTestCaseListRequestMultiError is an error wrapping multiple
+// validation errors returned by GetProblemTestCaseListRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetProblemTestCaseListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProblemTestCaseListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProblemTestCaseListRequestMultiError) AllErrors() []error { return m }
+
+// GetProblemTestCaseListRequestValidationError is the validation error
+// returned by GetProblemTestCaseListRequest.Validate if the designated
+// constraints aren't met.
+type GetProblemTestCaseListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProblemTestCaseListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProblemTestCaseListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProblemTestCaseListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProblemTestCaseListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProblemTestCaseListRequestValidationError) ErrorName() string {
+	return "GetProblemTestCaseListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProblemTestCaseListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProblemTestCaseListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProblemTestCaseListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProblemTestCaseListRequestValidationError{}
+
+// Validate checks the field values on GetProblemTestCaseListResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProblemTestCaseListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProblemTestCaseListResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetProblemTestCaseListResponseMultiError, or nil if none found.
+func (m *GetProblemTestCaseListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProblemTestCaseListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTestCases() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetProblemTestCaseListResponseValidationError{
+						field:  fmt.Sprintf("TestCases[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetProblemTestCaseListResponseValidationError{
+						field:  fmt.Sprintf("TestCases[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetProblemTestCaseListResponseValidationError{
+					field:  fmt.Sprintf("TestCases[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalTestCasesCount
+
+	if len(errors) > 0 {
+		return GetProblemTestCaseListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProblemTestCaseListResponseMultiError is an error wrapping multiple
+// validation errors returned by GetProblemTestCaseListResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetProblemTestCaseListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProblemTestCaseListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProblemTestCaseListResponseMultiError) AllErrors() []error { return m }
+
+// GetProblemTestCaseListResponseValidationError is the validation error
+// returned by GetProblemTestCaseListResponse.Validate if the designated
+// constraints aren't met.
+type GetProblemTestCaseListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProblemTestCaseListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProblemTestCaseListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProblemTestCaseListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProblemTestCaseListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProblemTestCaseListResponseValidationError) ErrorName() string {
+	return "GetProblemTestCaseListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProblemTestCaseListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProblemTestCaseListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProblemTestCaseListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProblemTestCaseListResponseValidationError{}
+
+// Validate checks the field values on GetTestCaseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTestCaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTestCaseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTestCaseRequestMultiError, or nil if none found.
+func (m *GetTestCaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTestCaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetTestCaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTestCaseRequestMultiError is an error wrapping multiple validation errors
+// returned by GetTestCaseRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetTestCaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTestCaseRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTestCaseRequestMultiError) AllErrors() []error { return m }
+
+// GetTestCaseRequestValidationError is the validation error returned by
+// GetTestCaseRequest.Validate if the designated constraints aren't met.
+type GetTestCaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTestCaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTestCaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTestCaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTestCaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTestCaseRequestValidationError) ErrorName() string {
+	return "GetTestCaseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTestCaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTestCaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTestCaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTestCaseRequestValidationError{}
+
+// Validate checks the field values on GetTestCaseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTestCaseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTestCaseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTestCaseResponseMultiError, or nil if none found.
+func (m *GetTestCaseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTestCaseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTestCase()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTestCaseResponseValidationError{
+					field:  "TestCase",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTestCaseResponseValidationError{
+					field:  "TestCase",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTestCase()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTestCaseResponseValidationError{
+				field:  "TestCase",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTestCaseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTestCaseResponseMultiError is an error wrapping multiple validation
+// errors returned by GetTestCaseResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetTestCaseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTestCaseResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTestCaseResponseMultiError) AllErrors() []error { return m }
+
+// GetTestCaseResponseValidationError is the validation error returned by
+// GetTestCaseResponse.Validate if the designated constraints aren't met.
+type GetTestCaseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTestCaseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTestCaseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTestCaseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTestCaseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTestCaseResponseValidationError) ErrorName() string {
+	return "GetTestCaseResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTestCaseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTestCaseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTestCaseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTestCaseResponseValidationError{}
+
+// Validate checks the field values on UpdateTestCaseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTestCaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTestCaseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTestCaseRequestMultiError, or nil if none found.
+func (m *UpdateTestCaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTestCaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Input
+
+	// no validation rules for Output
+
+	// no validation rules for IsHidden
+
+	// no validation rules for OfTestCaseGroupId
+
+	if len(errors) > 0 {
+		return UpdateTestCaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTestCaseRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateTestCaseRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateTestCaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTestCaseRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTestCaseRequestMultiError) AllErrors() []error { return m }
+
+// UpdateTestCaseRequestValidationError is the validation error returned by
+// UpdateTestCaseRequest.Validate if the designated constraints aren't met.
+type UpdateTestCaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTestCaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTestCaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTestCaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTestCaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTestCaseRequestValidationError) ErrorName() string {
+	return "UpdateTestCaseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTestCaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTestCaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTestCaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTestCaseRequestValidationError{}
+
+// Validate checks the field values on UpdateTestCaseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTestCaseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTestCaseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTestCaseResponseMultiError, or nil if none found.
+func (m *UpdateTestCaseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTestCaseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTestCase()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTestCaseResponseValidationError{
+					field:  "TestCase",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTestCaseResponseValidationError{
+					field:  "TestCase",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTestCase()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTestCaseResponseValidationError{
+				field:  "TestCase",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateTestCaseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTestCaseResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateTestCaseResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateTestCaseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTestCaseResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTestCaseResponseMultiError) AllErrors() []error { return m }
+
+// UpdateTestCaseResponseValidationError is the validation error returned by
+// UpdateTestCaseResponse.Validate if the designated constraints aren't met.
+type UpdateTestCaseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTestCaseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTestCaseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTestCaseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTestCaseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTestCaseResponseValidationError) ErrorName() string {
+	return "UpdateTestCaseResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTestCaseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTestCaseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTestCaseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTestCaseResponseValidationError{}
+
+// Validate checks the field values on DeleteTestCaseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTestCaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTestCaseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTestCaseRequestMultiError, or nil if none found.
+func (m *DeleteTestCaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTestCaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteTestCaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTestCaseRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteTestCaseRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTestCaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTestCaseRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTestCaseRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTestCaseRequestValidationError is the validation error returned by
+// DeleteTestCaseRequest.Validate if the designated constraints aren't met.
+type DeleteTestCaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTestCaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTestCaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTestCaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTestCaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTestCaseRequestValidationError) ErrorName() string {
+	return "DeleteTestCaseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTestCaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTestCaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTestCaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTestCaseRequestValidationError{}
+
+// Validate checks the field values on DeleteTestCaseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTestCaseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTestCaseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTestCaseResponseMultiError, or nil if none found.
+func (m *DeleteTestCaseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTestCaseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteTestCaseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTestCaseResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteTestCaseResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTestCaseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTestCaseResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTestCaseResponseMultiError) AllErrors() []error { return m }
+
+// DeleteTestCaseResponseValidationError is the validation error returned by
+// DeleteTestCaseResponse.Validate if the designated constraints aren't met.
+type DeleteTestCaseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTestCaseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTestCaseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTestCaseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTestCaseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTestCaseResponseValidationError) ErrorName() string {
+	return "DeleteTestCaseResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTestCaseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTestCaseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTestCaseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTestCaseResponseValidationError{}
+
+// Validate checks the field values on TestCaseGroup with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TestCaseGroup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TestCaseGroup with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TestCaseGroupMultiError, or
+// nil if none found.
+func (m *TestCaseGroup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TestCaseGroup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OfProblemId
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Points
+
+	// no validation rules for ScoringPolicy
+
+	if len(errors) > 0 {
+		return TestCaseGroupMultiError(errors)
+	}
+
+	return nil
+}
+
+// TestCaseGroupMultiError is an error wrapping multiple validation errors
+// returned by TestCaseGroup.ValidateAll() if the designated constraints
+// aren't met.
+type TestCaseGroupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TestCaseGroupMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TestCaseGroupMultiError) AllErrors() []error { return m }
+
+// TestCaseGroupValidationError is the validation error returned by
+// TestCaseGroup.Validate if the designated constraints aren't met.
+type TestCaseGroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestCaseGroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestCaseGroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestCaseGroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestCaseGroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestCaseGroupValidationError) ErrorName() string { return "TestCaseGroupValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TestCaseGroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestCaseGroup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestCaseGroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestCaseGroupValidationError{}
+
+// Validate checks the field values on CreateTestCaseGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTestCaseGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTestCaseGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTestCaseGroupRequestMultiError, or nil if none found.
+func (m *CreateTestCaseGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTestCaseGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OfProblemId
+
+	if l := utf8.RuneCountInString(m.GetDisplayName()); l < 1 || l > 255 {
+		err := CreateTestCaseGroupRequestValidationError{
+			field:  "DisplayName",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Points
+
+	if _, ok := _CreateTestCaseGroupRequest_ScoringPolicy_NotInLookup[m.GetScoringPolicy()]; ok {
+		err := CreateTestCaseGroupRequestValidationError{
+			field:  "ScoringPolicy",
+			reason: "value must not be in list [UndefinedScoringPolicy]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := TestCaseGroupScoringPolicy_name[int32(m.GetScoringPolicy())]; !ok {
+		err := CreateTestCaseGroupRequestValidationError{
+			field:  "ScoringPolicy",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateTestCaseGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTestCaseGroupRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateTestCaseGroupRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateTestCaseGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTestCaseGroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTestCaseGroupRequestMultiError) AllErrors() []error { return m }
+
+// CreateTestCaseGroupRequestValidationError is the validation error returned
+// by CreateTestCaseGroupRequest.Validate if the designated constraints aren't met.
+type CreateTestCaseGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTestCaseGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTestCaseGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTestCaseGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTestCaseGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTestCaseGroupRequestValidationError) ErrorName() string {
+	return "CreateTestCaseGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTestCaseGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTestCaseGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTestCaseGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTestCaseGroupRequestValidationError{}
+
+var _CreateTestCaseGroupRequest_ScoringPolicy_NotInLookup = map[TestCaseGroupScoringPolicy]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on CreateTestCaseGroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTestCaseGroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTestCaseGroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTestCaseGroupResponseMultiError, or nil if none found.
+func (m *CreateTestCaseGroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTestCaseGroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTestCaseGroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTestCaseGroupResponseValidationError{
+					field:  "TestCaseGroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTestCaseGroupResponseValidationError{
+					field:  "TestCaseGroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTestCaseGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTestCaseGroupResponseValidationError{
+				field:  "TestCaseGroup",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateTestCaseGroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTestCaseGroupResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateTestCaseGroupResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateTestCaseGroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTestCaseGroupResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTestCaseGroupResponseMultiError) AllErrors() []error { return m }
+
+// CreateTestCaseGroupResponseValidationError is the validation error returned
+// by CreateTestCaseGroupResponse.Validate if the designated constraints
+// aren't met.
+type CreateTestCaseGroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTestCaseGroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTestCaseGroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTestCaseGroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTestCaseGroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTestCaseGroupResponseValidationError) ErrorName() string {
+	return "CreateTestCaseGroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTestCaseGroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTestCaseGroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTestCaseGroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTestCaseGroupResponseValidationError{}
+
+// Validate checks the field values on GetProblemTestCaseGroupListRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetProblemTestCaseGroupListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProblemTestCaseGroupListRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetProblemTestCaseGroupListRequestMultiError, or nil if none found.
+func (m *GetProblemTestCaseGroupListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProblemTestCaseGroupListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetProblemTestCaseGroupListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProblemTestCaseGroupListRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetProblemTestCaseGroupListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetProblemTestCaseGroupListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProblemTestCaseGroupListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProblemTestCaseGroupListRequestMultiError) AllErrors() []error { return m }
+
+// GetProblemTestCaseGroupListRequestValidationError is the validation error
+// returned by GetProblemTestCaseGroupListRequest.Validate if the designated
+// constraints aren't met.
+type GetProblemTestCaseGroupListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProblemTestCaseGroupListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProblemTestCaseGroupListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProblemTestCaseGroupListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProblemTestCaseGroupListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProblemTestCaseGroupListRequestValidationError) ErrorName() string {
+	return "GetProblemTestCaseGroupListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProblemTestCaseGroupListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProblemTestCaseGroupListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProblemTestCaseGroupListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProblemTestCaseGroupListRequestValidationError{}
+
+// Validate checks the field values on GetProblemTestCaseGroupListResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetProblemTestCaseGroupListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProblemTestCaseGroupListResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetProblemTestCaseGroupListResponseMultiError, or nil if none found.
+func (m *GetProblemTestCaseGroupListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProblemTestCaseGroupListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTestCaseGroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetProblemTestCaseGroupListResponseValidationError{
+						field:  fmt.Sprintf("TestCaseGroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetProblemTestCaseGroupListResponseValidationError{
+						field:  fmt.Sprintf("TestCaseGroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetProblemTestCaseGroupListResponseValidationError{
+					field:  fmt.Sprintf("TestCaseGroups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetProblemTestCaseGroupListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProblemTestCaseGroupListResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetProblemTestCaseGroupListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetProblemTestCaseGroupListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProblemTestCaseGroupListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProblemTestCaseGroupListResponseMultiError) AllErrors() []error { return m }
+
+// GetProblemTestCaseGroupListResponseValidationError is the validation error
+// returned by GetProblemTestCaseGroupListResponse.Validate if the designated
+// constraints aren't met.
+type GetProblemTestCaseGroupListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProblemTestCaseGroupListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProblemTestCaseGroupListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProblemTestCaseGroupListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProblemTestCaseGroupListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProblemTestCaseGroupListResponseValidationError) ErrorName() string {
+	return "GetProblemTestCaseGroupListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProblemTestCaseGroupListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProblemTestCaseGroupListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProblemTestCaseGroupListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProblemTestCaseGroupListResponseValidationError{}
+
+// Validate checks the field values on UpdateTestCaseGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTestCaseGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTestCaseGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTestCaseGroupRequestMultiError, or nil if none found.
+func (m *UpdateTestCaseGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTestCaseGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if utf8.RuneCountInString(m.GetDisplayName()) > 255 {
+		err := UpdateTestCaseGroupRequestValidationError{
+			field:  "DisplayName",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Points
+
+	if _, ok := TestCaseGroupScoringPolicy_name[int32(m.GetScoringPolicy())]; !ok {
+		err := UpdateTestCaseGroupRequestValidationError{
+			field:  "ScoringPolicy",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateTestCaseGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTestCaseGroupRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateTestCaseGroupRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateTestCaseGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTestCaseGroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTestCaseGroupRequestMultiError) AllErrors() []error { return m }
+
+// UpdateTestCaseGroupRequestValidationError is the validation error returned
+// by UpdateTestCaseGroupRequest.Validate if the designated constraints aren't met.
+type UpdateTestCaseGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTestCaseGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTestCaseGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTestCaseGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTestCaseGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTestCaseGroupRequestValidationError) ErrorName() string {
+	return "UpdateTestCaseGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTestCaseGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTestCaseGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTestCaseGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTestCaseGroupRequestValidationError{}
+
+// Validate checks the field values on UpdateTestCaseGroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTestCaseGroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTestCaseGroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTestCaseGroupResponseMultiError, or nil if none found.
+func (m *UpdateTestCaseGroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTestCaseGroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTestCaseGroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTestCaseGroupResponseValidationError{
+					field:  "TestCaseGroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTestCaseGroupResponseValidationError{
+					field:  "TestCaseGroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTestCaseGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTestCaseGroupResponseValidationError{
+				field:  "TestCaseGroup",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateTestCaseGroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTestCaseGroupResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateTestCaseGroupResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateTestCaseGroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTestCaseGroupResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTestCaseGroupResponseMultiError) AllErrors() []error { return m }
+
+// UpdateTestCaseGroupResponseValidationError is the validation error returned
+// by UpdateTestCaseGroupResponse.Validate if the designated constraints
+// aren't met.
+type UpdateTestCaseGroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTestCaseGroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTestCaseGroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTestCaseGroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTestCaseGroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTestCaseGroupResponseValidationError) ErrorName() string {
+	return "UpdateTestCaseGroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTestCaseGroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTestCaseGroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTestCaseGroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTestCaseGroupResponseValidationError{}
+
+// Validate checks the field values on DeleteTestCaseGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTestCaseGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTestCaseGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTestCaseGroupRequestMultiError, or nil if none found.
+func (m *DeleteTestCaseGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTestCaseGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteTestCaseGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTestCaseGroupRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteTestCaseGroupRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteTestCaseGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTestCaseGroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTestCaseGroupRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTestCaseGroupRequestValidationError is the validation error returned
+// by DeleteTestCaseGroupRequest.Validate if the designated constraints aren't met.
+type DeleteTestCaseGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTestCaseGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTestCaseGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTestCaseGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTestCaseGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTestCaseGroupRequestValidationError) ErrorName() string {
+	return "DeleteTestCaseGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTestCaseGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTestCaseGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTestCaseGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTestCaseGroupRequestValidationError{}
+
+// Validate checks the field values on DeleteTestCaseGroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTestCaseGroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTestCaseGroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTestCaseGroupResponseMultiError, or nil if none found.
+func (m *DeleteTestCaseGroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTestCaseGroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteTestCaseGroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTestCaseGroupResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteTestCaseGroupResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteTestCaseGroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTestCaseGroupResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTestCaseGroupResponseMultiError) AllErrors() []error { return m }
+
+// DeleteTestCaseGroupResponseValidationError is the validation error returned
+// by DeleteTestCaseGroupResponse.Validate if the designated constraints
+// aren't met.
+type DeleteTestCaseGroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTestCaseGroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTestCaseGroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTestCaseGroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTestCaseGroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTestCaseGroupResponseValidationError) ErrorName() string {
+	return "DeleteTestCaseGroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTestCaseGroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTestCaseGroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTestCaseGroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTestCaseGroupResponseValidationError{}
+
+// Validate checks the field values on CreateSubmissionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSubmissionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSubmissionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSubmissionRequestMultiError, or nil if none found.
+func (m *CreateSubmissionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSubmissionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OfProblemId
+
+	// no validation rules for Content
+
+	// no validation rules for Language
+
+	// no validation rules for OfContestId
+
+	if len(errors) > 0 {
+		return CreateSubmissionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSubmissionRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateSubmissionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSubmissionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSubmissionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSubmissionRequestMultiError) AllErrors() []error { return m }
+
+// CreateSubmissionRequestValidationError is the validation error returned by
+// CreateSubmissionRequest.Validate if the designated constraints aren't met.
+type CreateSubmissionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSubmissionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSubmissionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSubmissionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSubmissionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSubmissionRequestValidationError) ErrorName() string {
+	return "CreateSubmissionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSubmissionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSubmissionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSubmissionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSubmissionRequestValidationError{}
+
+// Validate checks the field values on Submission with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Submission) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Submission with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SubmissionMultiError, or
+// nil if none found.
+func (m *Submission) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Submission) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OfProblemId
+
+	// no validation rules for AuthorId
+
+	// no validation rules for Content
+
+	// no validation rules for Language
+
+	// no validation rules for Status
+
+	// no validation rules for Result
+
+	// no validation rules for CompileOutput
+
+	// no validation rules for OfContestId
+
+	// no validation rules for Score
+
+	if len(errors) > 0 {
+		return SubmissionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmissionMultiError is an error wrapping multiple validation errors
+// returned by Submission.ValidateAll() if the designated constraints aren't met.
+type SubmissionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmissionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmissionMultiError) AllErrors() []error { return m }
+
+// SubmissionValidationError is the validation error returned by
+// Submission.Validate if the designated constraints aren't met.
+type SubmissionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmissionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmissionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmissionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmissionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmissionValidationError) ErrorName() string { return "SubmissionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubmissionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmission.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmissionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmissionValidationError{}
+
+// Validate checks the field values on CreateSubmissionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSubmissionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSubmissionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSubmissionResponseMultiError, or nil if none found.
+func (m *CreateSubmissionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSubmissionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSubmission()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSubmissionResponseValidationError{
+					field:  "Submission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSubmissionResponseValidationError{
+					field:  "Submission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubmission()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSubmissionResponseValidationError{
+				field:  "Submission",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateSubmissionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSubmissionResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateSubmissionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSubmissionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSubmissionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSubmissionResponseMultiError) AllErrors() []error { return m }
+
+// CreateSubmissionResponseValidationError is the validation error returned by
+// CreateSubmissionResponse.Validate if the designated constraints aren't met.
+type CreateSubmissionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSubmissionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSubmissionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSubmissionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSubmissionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSubmissionResponseValidationError) ErrorName() string {
+	return "CreateSubmissionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSubmissionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSubmissionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSubmissionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSubmissionResponseValidationError{}
+
+// Validate checks the field values on GetSubmissionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSubmissionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSubmissionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSubmissionRequestMultiError, or nil if none found.
+func (m *GetSubmissionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSubmissionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetSubmissionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSubmissionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSubmissionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetSubmissionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSubmissionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSubmissionRequestMultiError) AllErrors() []error { return m }
+
+// GetSubmissionRequestValidationError is the validation error returned by
+// GetSubmissionRequest.Validate if the designated constraints aren't met.
+type GetSubmissionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSubmissionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSubmissionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSubmissionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSubmissionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSubmissionRequestValidationError) ErrorName() string {
+	return "GetSubmissionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSubmissionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSubmissionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSubmissionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSubmissionRequestValidationError{}
+
+// Validate checks the field values on GetSubmissionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSubmissionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSubmissionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSubmissionResponseMultiError, or nil if none found.
+func (m *GetSubmissionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSubmissionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSubmission()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSubmissionResponseValidationError{
+					field:  "Submission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSubmissionResponseValidationError{
+					field:  "Submission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubmission()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSubmissionResponseValidationError{
+				field:  "Submission",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetSubmissionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSubmissionResponseMultiError is an error wrapping multiple validation
+// errors returned by GetSubmissionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetSubmissionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSubmissionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSubmissionResponseMultiError) AllErrors() []error { return m }
+
+// GetSubmissionResponseValidationError is the validation error returned by
+// GetSubmissionResponse.Validate if the designated constraints aren't met.
+type GetSubmissionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSubmissionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSubmissionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSubmissionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSubmissionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSubmissionResponseValidationError) ErrorName() string {
+	return "GetSubmissionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSubmissionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSubmissionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSubmissionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSubmissionResponseValidationError{}
+
+// Validate checks the field values on GetSubmissionListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSubmissionListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSubmissionListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSubmissionListRequestMultiError, or nil if none found.
+func (m *GetSubmissionListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSubmissionListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Offset
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return GetSubmissionListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSubmissionListRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSubmissionListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetSubmissionListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSubmissionListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSubmissionListRequestMultiError) AllErrors() []error { return m }
+
+// GetSubmissionListRequestValidationError is the validation error returned by
+// GetSubmissionListRequest.Validate if the designated constraints aren't met.
+type GetSubmissionListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSubmissionListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSubmissionListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSubmissionListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSubmissionListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSubmissionListRequestValidationError) ErrorName() string {
+	return "GetSubmissionListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSubmissionListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSubmissionListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSubmissionListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSubmissionListRequestValidationError{}
+
+// Validate checks the field values on GetSubmissionListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSubmissionListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSubmissionListResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSubmissionListResponseMultiError, or nil if none found.
+func (m *GetSubmissionListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSubmissionListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSubmissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSubmissionListResponseValidationError{
+						field:  fmt.Sprintf("Submissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSubmissionListResponseValidationError{
+						field:  fmt.Sprintf("Submissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSubmissionListResponseValidationError{
+					field:  fmt.Sprintf("Submissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalSubmissionsCount
+
+	if len(errors) > 0 {
+		return GetSubmissionListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSubmissionListResponseMultiError is an error wrapping multiple validation
+// errors returned by GetSubmissionListResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetSubmissionListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSubmissionListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSubmissionListResponseMultiError) AllErrors() []error { return m }
+
+// GetSubmissionListResponseValidationError is the validation error returned by
+// GetSubmissionListResponse.Validate if the designated constraints aren't met.
+type GetSubmissionListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSubmissionListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSubmissionListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSubmissionListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSubmissionListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSubmissionListResponseValidationError) ErrorName() string {
+	return "GetSubmissionListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSubmissionListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSubmissionListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSubmissionListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSubmissionListResponseValidationError{}
+
+// Validate checks the field values on SubmissionTestCaseResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmissionTestCaseResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmissionTestCaseResult with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmissionTestCaseResultMultiError, or nil if none found.
+func (m *SubmissionTestCaseResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmissionTestCaseResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OfSubmissionId
+
+	// no validation rules for OfTestCaseId
+
+	// no validation rules for Result
+
+	// no validation rules for WallTime
+
+	// no validation rules for CpuTime
+
+	// no validation rules for Memory
+
+	// no validation rules for ExitCode
+
+	// no validation rules for Stdout
+
+	// no validation rules for Stderr
+
+	if len(errors) > 0 {
+		return SubmissionTestCaseResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmissionTestCaseResultMultiError is an error wrapping multiple validation
+// errors returned by SubmissionTestCaseResult.ValidateAll() if the designated
+// constraints aren't met.
+type SubmissionTestCaseResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmissionTestCaseResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmissionTestCaseResultMultiError) AllErrors() []error { return m }
+
+// SubmissionTestCaseResultValidationError is the validation error returned by
+// SubmissionTestCaseResult.Validate if the designated constraints aren't met.
+type SubmissionTestCaseResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmissionTestCaseResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmissionTestCaseResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmissionTestCaseResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmissionTestCaseResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmissionTestCaseResultValidationError) ErrorName() string {
+	return "SubmissionTestCaseResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmissionTestCaseResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmissionTestCaseResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmissionTestCaseResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmissionTestCaseResultValidationError{}
+
+// Validate checks the field values on GetSubmissionTestCaseResultListRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetSubmissionTestCaseResultListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// GetSubmissionTestCaseResultListRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// GetSubmissionTestCaseResultListRequestMultiError, or nil if none found.
+func (m *GetSubmissionTestCaseResultListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSubmissionTestCaseResultListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetSubmissionTestCaseResultListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSubmissionTestCaseResultListRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// GetSubmissionTestCaseResultListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetSubmissionTestCaseResultListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSubmissionTestCaseResultListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSubmissionTestCaseResultListRequestMultiError) AllErrors() []error { return m }
+
+// GetSubmissionTestCaseResultListRequestValidationError is the validation
+// error returned by GetSubmissionTestCaseResultListRequest.Validate if the
+// designated constraints aren't met.
+type GetSubmissionTestCaseResultListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSubmissionTestCaseResultListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSubmissionTestCaseResultListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSubmissionTestCaseResultListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSubmissionTestCaseResultListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSubmissionTestCaseResultListRequestValidationError) ErrorName() string {
+	return "GetSubmissionTestCaseResultListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSubmissionTestCaseResultListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSubmissionTestCaseResultListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSubmissionTestCaseResultListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSubmissionTestCaseResultListRequestValidationError{}
+
+// Validate checks the field values on GetSubmissionTestCaseResultListResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetSubmissionTestCaseResultListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// GetSubmissionTestCaseResultListResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// GetSubmissionTestCaseResultListResponseMultiError, or nil if none found.
+func (m *GetSubmissionTestCaseResultListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSubmissionTestCaseResultListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSubmissionTestCaseResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSubmissionTestCaseResultListResponseValidationError{
+						field:  fmt.Sprintf("SubmissionTestCaseResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSubmissionTestCaseResultListResponseValidationError{
+						field:  fmt.Sprintf("SubmissionTestCaseResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSubmissionTestCaseResultListResponseValidationError{
+					field:  fmt.Sprintf("SubmissionTestCaseResults[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetSubmissionTestCaseResultListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSubmissionTestCaseResultListResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// GetSubmissionTestCaseResultListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetSubmissionTestCaseResultListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSubmissionTestCaseResultListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSubmissionTestCaseResultListResponseMultiError) AllErrors() []error { return m }
+
+// GetSubmissionTestCaseResultListResponseValidationError is the validation
+// error returned by GetSubmissionTestCaseResultListResponse.Validate if the
+// designated constraints aren't met.
+type GetSubmissionTestCaseResultListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSubmissionTestCaseResultListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSubmissionTestCaseResultListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSubmissionTestCaseResultListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSubmissionTestCaseResultListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSubmissionTestCaseResultListResponseValidationError) ErrorName() string {
+	return "GetSubmissionTestCaseResultListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSubmissionTestCaseResultListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSubmissionTestCaseResultListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSubmissionTestCaseResultListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSubmissionTestCaseResultListResponseValidationError{}
+
+// Validate checks the field values on WatchSubmissionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchSubmissionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchSubmissionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchSubmissionRequestMultiError, or nil if none found.
+func (m *WatchSubmissionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchSubmissionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return WatchSubmissionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchSubmissionRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchSubmissionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchSubmissionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchSubmissionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchSubmissionRequestMultiError) AllErrors() []error { return m }
+
+// WatchSubmissionRequestValidationError is the validation error returned by
+// WatchSubmissionRequest.Validate if the designated constraints aren't met.
+type WatchSubmissionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchSubmissionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchSubmissionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchSubmissionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchSubmissionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchSubmissionRequestValidationError) ErrorName() string {
+	return "WatchSubmissionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchSubmissionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchSubmissionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchSubmissionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchSubmissionRequestValidationError{}
+
+// Validate checks the field values on WatchSubmissionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchSubmissionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchSubmissionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchSubmissionResponseMultiError, or nil if none found.
+func (m *WatchSubmissionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchSubmissionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	// no validation rules for Result
+
+	// no validation rules for JudgedTestCaseCount
+
+	// no validation rules for TestCaseCount
+
+	if len(errors) > 0 {
+		return WatchSubmissionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchSubmissionResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchSubmissionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WatchSubmissionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchSubmissionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchSubmissionResponseMultiError) AllErrors() []error { return m }
+
+// WatchSubmissionResponseValidationError is the validation error returned by
+// WatchSubmissionResponse.Validate if the designated constraints aren't met.
+type WatchSubmissionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchSubmissionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchSubmissionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchSubmissionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchSubmissionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchSubmissionResponseValidationError) ErrorName() string {
+	return "WatchSubmissionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchSubmissionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchSubmissionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchSubmissionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchSubmissionResponseValidationError{}
+
+// Validate checks the field values on GetProblemSubmissionListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProblemSubmissionListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProblemSubmissionListRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetProblemSubmissionListRequestMultiError, or nil if none found.
+func (m *GetProblemSubmissionListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProblemSubmissionListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Offset
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return GetProblemSubmissionListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProblemSubmissionListRequestMultiError is an error wrapping multiple
+// validation errors returned by GetProblemSubmissionListRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetProblemSubmissionListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProblemSubmissionListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProblemSubmissionListRequestMultiError) AllErrors() []error { return m }
+
+// GetProblemSubmissionListRequestValidationError is the validation error
+// returned by GetProblemSubmissionListRequest.Validate if the designated
+// constraints aren't met.
+type GetProblemSubmissionListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProblemSubmissionListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProblemSubmissionListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProblemSubmissionListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProblemSubmissionListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProblemSubmissionListRequestValidationError) ErrorName() string {
+	return "GetProblemSubmissionListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProblemSubmissionListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProblemSubmissionListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProblemSubmissionListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProblemSubmissionListRequestValidationError{}
+
+// Validate checks the field values on GetProblemSubmissionListResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetProblemSubmissionListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProblemSubmissionListResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetProblemSubmissionListResponseMultiError, or nil if none found.
+func (m *GetProblemSubmissionListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProblemSubmissionListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSubmissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetProblemSubmissionListResponseValidationError{
+						field:  fmt.Sprintf("Submissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetProblemSubmissionListResponseValidationError{
+						field:  fmt.Sprintf("Submissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetProblemSubmissionListResponseValidationError{
+					field:  fmt.Sprintf("Submissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalSubmissionsCount
+
+	if len(errors) > 0 {
+		return GetProblemSubmissionListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProblemSubmissionListResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetProblemSubmissionListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetProblemSubmissionListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProblemSubmissionListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProblemSubmissionListResponseMultiError) AllErrors() []error { return m }
+
+// GetProblemSubmissionListResponseValidationError is the validation error
+// returned by GetProblemSubmissionListResponse.Validate if the designated
+// constraints aren't met.
+type GetProblemSubmissionListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProblemSubmissionListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProblemSubmissionListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProblemSubmissionListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProblemSubmissionListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProblemSubmissionListResponseValidationError) ErrorName() string {
+	return "GetProblemSubmissionListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProblemSubmissionListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProblemSubmissionListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProblemSubmissionListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProblemSubmissionListResponseValidationError{}
+
+// Validate checks the field values on GetAccountProblemSubmissionListRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetAccountProblemSubmissionListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// GetAccountProblemSubmissionListRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// GetAccountProblemSubmissionListRequestMultiError, or nil if none found.
+func (m *GetAccountProblemSubmissionListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccountProblemSubmissionListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	// no validation rules for ProblemId
+
+	// no validation rules for Offset
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return GetAccountProblemSubmissionListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccountProblemSubmissionListRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// GetAccountProblemSubmissionListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAccountProblemSubmissionListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccountProblemSubmissionListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccountProblemSubmissionListRequestMultiError) AllErrors() []error { return m }
+
+// GetAccountProblemSubmissionListRequestValidationError is the validation
+// error returned by GetAccountProblemSubmissionListRequest.Validate if the
+// designated constraints aren't met.
+type GetAccountProblemSubmissionListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccountProblemSubmissionListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccountProblemSubmissionListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccountProblemSubmissionListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccountProblemSubmissionListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccountProblemSubmissionListRequestValidationError) ErrorName() string {
+	return "GetAccountProblemSubmissionListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccountProblemSubmissionListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccountProblemSubmissionListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccountProblemSubmissionListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccountProblemSubmissionListRequestValidationError{}
+
+// Validate checks the field values on GetAccountProblemSubmissionListResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetAccountProblemSubmissionListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// GetAccountProblemSubmissionListResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// GetAccountProblemSubmissionListResponseMultiError, or nil if none found.
+func (m *GetAccountProblemSubmissionListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccountProblemSubmissionListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSubmissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAccountProblemSubmissionListResponseValidationError{
+						field:  fmt.Sprintf("Submissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAccountProblemSubmissionListResponseValidationError{
+						field:  fmt.Sprintf("Submissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAccountProblemSubmissionListResponseValidationError{
+					field:  fmt.Sprintf("Submissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalSubmissionsCount
+
+	if len(errors) > 0 {
+		return GetAccountProblemSubmissionListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccountProblemSubmissionListResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// GetAccountProblemSubmissionListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAccountProblemSubmissionListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccountProblemSubmissionListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccountProblemSubmissionListResponseMultiError) AllErrors() []error { return m }
+
+// GetAccountProblemSubmissionListResponseValidationError is the validation
+// error returned by GetAccountProblemSubmissionListResponse.Validate if the
+// designated constraints aren't met.
+type GetAccountProblemSubmissionListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccountProblemSubmissionListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccountProblemSubmissionListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccountProblemSubmissionListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccountProblemSubmissionListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccountProblemSubmissionListResponseValidationError) ErrorName() string {
+	return "GetAccountProblemSubmissionListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccountProblemSubmissionListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccountProblemSubmissionListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccountProblemSubmissionListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccountProblemSubmissionListResponseValidationError{}
+
+// Validate checks the field values on ContestProblem with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ContestProblem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ContestProblem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ContestProblemMultiError,
+// or nil if none found.
+func (m *ContestProblem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ContestProblem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProblemId
+
+	if !_ContestProblem_Label_Pattern.MatchString(m.GetLabel()) {
+		err := ContestProblemValidationError{
+			field:  "Label",
+			reason: "value does not match regex pattern \"^[A-Z][0-9]?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ContestProblemMultiError(errors)
+	}
+
+	return nil
+}
+
+// ContestProblemMultiError is an error wrapping multiple validation errors
+// returned by ContestProblem.ValidateAll() if the designated constraints
+// aren't met.
+type ContestProblemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ContestProblemMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ContestProblemMultiError) AllErrors() []error { return m }
+
+// ContestProblemValidationError is the validation error returned by
+// ContestProblem.Validate if the designated constraints aren't met.
+type ContestProblemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ContestProblemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ContestProblemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ContestProblemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ContestProblemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ContestProblemValidationError) ErrorName() string { return "ContestProblemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ContestProblemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sContestProblem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ContestProblemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ContestProblemValidationError{}
+
+var _ContestProblem_Label_Pattern = regexp.MustCompile("^[A-Z][0-9]?$")
+
+// Validate checks the field values on Contest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Contest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Contest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ContestMultiError, or nil if none found.
+func (m *Contest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Contest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for DisplayName
+
+	// no validation rules for AuthorId
+
+	// no validation rules for Description
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	// no validation rules for IsPublic
+
+	for idx, item := range m.GetProblems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ContestValidationError{
+						field:  fmt.Sprintf("Problems[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ContestValidationError{
+						field:  fmt.Sprintf("Problems[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ContestValidationError{
+					field:  fmt.Sprintf("Problems[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for IsRegistered
+
+	// no validation rules for ScoringMode
+
+	// no validation rules for FreezeScoreboard
+
+	if len(errors) > 0 {
+		return ContestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ContestMultiError is an error wrapping multiple validation errors returned
+// by Contest.ValidateAll() if the designated constraints aren't met.
+type ContestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ContestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ContestMultiError) AllErrors() []error { return m }
+
+// ContestValidationError is the validation error returned by Contest.Validate
+// if the designated constraints aren't met.
+type ContestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ContestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ContestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ContestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ContestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ContestValidationError) ErrorName() string { return "ContestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ContestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sContest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ContestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ContestValidationError{}
+
+// Validate checks the field values on CreateContestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateContestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateContestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateContestRequestMultiError, or nil if none found.
+func (m *CreateContestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateContestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetDisplayName()); l < 1 || l > 255 {
+		err := CreateContestRequestValidationError{
+			field:  "DisplayName",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	// no validation rules for IsPublic
+
+	for idx, item := range m.GetProblems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateContestRequestValidationError{
+						field:  fmt.Sprintf("Problems[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateContestRequestValidationError{
+						field:  fmt.Sprintf("Problems[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateContestRequestValidationError{
+					field:  fmt.Sprintf("Problems[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if _, ok := _CreateContestRequest_ScoringMode_NotInLookup[m.GetScoringMode()]; ok {
+		err := CreateContestRequestValidationError{
+			field:  "ScoringMode",
+			reason: "value must not be in list [UndefinedScoringMode]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ContestScoringMode_name[int32(m.GetScoringMode())]; !ok {
+		err := CreateContestRequestValidationError{
+			field:  "ScoringMode",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for FreezeScoreboard
+
+	if len(errors) > 0 {
+		return CreateContestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateContestRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateContestRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateContestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateContestRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateContestRequestMultiError) AllErrors() []error { return m }
+
+// CreateContestRequestValidationError is the validation error returned by
+// CreateContestRequest.Validate if the designated constraints aren't met.
+type CreateContestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateContestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateContestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateContestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateContestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateContestRequestValidationError) ErrorName() string {
+	return "CreateContestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateContestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateContestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateContestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateContestRequestValidationError{}
+
+var _CreateContestRequest_ScoringMode_NotInLookup = map[ContestScoringMode]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on CreateContestResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateContestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateContestResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateContestResponseMultiError, or nil if none found.
+func (m *CreateContestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateContestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetContest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateContestResponseValidationError{
+					field:  "Contest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateContestResponseValidationError{
+					field:  "Contest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateContestResponseValidationError{
+				field:  "Contest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateContestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateContestResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateContestResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateContestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateContestResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateContestResponseMultiError) AllErrors() []error { return m }
+
+// CreateContestResponseValidationError is the validation error returned by
+// CreateContestResponse.Validate if the designated constraints aren't met.
+type CreateContestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateContestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateContestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateContestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateContestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateContestResponseValidationError) ErrorName() string {
+	return "CreateContestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateContestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateContestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateContestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateContestResponseValidationError{}
+
+// Validate checks the field values on GetContestListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetContestListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetContestListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetContestListRequestMultiError, or nil if none found.
+func (m *GetContestListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetContestListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Offset
+
+	if m.GetLimit() > 100 {
+		err := GetContestListRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetContestListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetContestListRequestMultiError is an error wrapping multiple validation
+// errors returned by GetContestListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetContestListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetContestListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetContestListRequestMultiError) AllErrors() []error { return m }
+
+// GetContestListRequestValidationError is the validation error returned by
+// GetContestListRequest.Validate if the designated constraints aren't met.
+type GetContestListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetContestListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetContestListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetContestListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetContestListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetContestListRequestValidationError) ErrorName() string {
+	return "GetContestListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetContestListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetContestListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetContestListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetContestListRequestValidationError{}
+
+// Validate checks the field values on GetContestListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetContestListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetContestListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetContestListResponseMultiError, or nil if none found.
+func (m *GetContestListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetContestListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetContests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetContestListResponseValidationError{
+						field:  fmt.Sprintf("Contests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetContestListResponseValidationError{
+						field:  fmt.Sprintf("Contests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetContestListResponseValidationError{
+					field:  fmt.Sprintf("Contests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalContestCount
+
+	if len(errors) > 0 {
+		return GetContestListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetContestListResponseMultiError is an error wrapping multiple validation
+// errors returned by GetContestListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetContestListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetContestListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetContestListResponseMultiError) AllErrors() []error { return m }
+
+// GetContestListResponseValidationError is the validation error returned by
+// GetContestListResponse.Validate if the designated constraints aren't met.
+type GetContestListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetContestListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetContestListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetContestListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetContestListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetContestListResponseValidationError) ErrorName() string {
+	return "GetContestListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetContestListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetContestListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetContestListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetContestListResponseValidationError{}
+
+// Validate checks the field values on GetContestRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetContestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetContestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetContestRequestMultiError, or nil if none found.
+func (m *GetContestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetContestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetContestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetContestRequestMultiError is an error wrapping multiple validation errors
+// returned by GetContestRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetContestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetContestRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetContestRequestMultiError) AllErrors() []error { return m }
+
+// GetContestRequestValidationError is the validation error returned by
+// GetContestRequest.Validate if the designated constraints aren't met.
+type GetContestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetContestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetContestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetContestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetContestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetContestRequestValidationError) ErrorName() string {
+	return "GetContestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetContestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetContestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetContestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetContestRequestValidationError{}
+
+// Validate checks the field values on GetContestResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetContestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetContestResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetContestResponseMultiError, or nil if none found.
+func (m *GetContestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetContestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetContest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetContestResponseValidationError{
+					field:  "Contest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetContestResponseValidationError{
+					field:  "Contest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetContestResponseValidationError{
+				field:  "Contest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetContestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetContestResponseMultiError is an error wrapping multiple validation errors
+// returned by GetContestResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetContestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetContestResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetContestResponseMultiError) AllErrors() []error { return m }
+
+// GetContestResponseValidationError is the validation error returned by
+// GetContestResponse.Validate if the designated constraints aren't met.
+type GetContestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetContestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetContestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetContestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetContestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetContestResponseValidationError) ErrorName() string {
+	return "GetContestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetContestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetContestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetContestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetContestResponseValidationError{}
+
+// Validate checks the field values on UpdateContestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateContestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateContestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateContestRequestMultiError, or nil if none found.
+func (m *UpdateContestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateContestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if utf8.RuneCountInString(m.GetDisplayName()) > 255 {
+		err := UpdateContestRequestValidationError{
+			field:  "DisplayName",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	for idx, item := range m.GetProblems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateContestRequestValidationError{
+						field:  fmt.Sprintf("Problems[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateContestRequestValidationError{
+						field:  fmt.Sprintf("Problems[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateContestRequestValidationError{
+					field:  fmt.Sprintf("Problems[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if _, ok := ContestScoringMode_name[int32(m.GetScoringMode())]; !ok {
+		err := UpdateContestRequestValidationError{
+			field:  "ScoringMode",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.IsPublic != nil {
+		// no validation rules for IsPublic
+	}
+
+	if m.FreezeScoreboard != nil {
+		// no validation rules for FreezeScoreboard
+	}
+
+	if len(errors) > 0 {
+		return UpdateContestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateContestRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateContestRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateContestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateContestRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateContestRequestMultiError) AllErrors() []error { return m }
+
+// UpdateContestRequestValidationError is the validation error returned by
+// UpdateContestRequest.Validate if the designated constraints aren't met.
+type UpdateContestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateContestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateContestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateContestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateContestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateContestRequestValidationError) ErrorName() string {
+	return "UpdateContestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateContestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateContestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateContestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateContestRequestValidationError{}
+
+// Validate checks the field values on UpdateContestResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateContestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateContestResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateContestResponseMultiError, or nil if none found.
+func (m *UpdateContestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateContestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetContest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateContestResponseValidationError{
+					field:  "Contest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateContestResponseValidationError{
+					field:  "Contest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateContestResponseValidationError{
+				field:  "Contest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateContestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateContestResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateContestResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateContestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateContestResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateContestResponseMultiError) AllErrors() []error { return m }
+
+// UpdateContestResponseValidationError is the validation error returned by
+// UpdateContestResponse.Validate if the designated constraints aren't met.
+type UpdateContestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateContestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateContestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateContestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateContestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateContestResponseValidationError) ErrorName() string {
+	return "UpdateContestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateContestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateContestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateContestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateContestResponseValidationError{}
+
+// Validate checks the field values on DeleteContestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteContestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteContestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteContestRequestMultiError, or nil if none found.
+func (m *DeleteContestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteContestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteContestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteContestRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteContestRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteContestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteContestRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteContestRequestMultiError) AllErrors() []error { return m }
+
+// DeleteContestRequestValidationError is the validation error returned by
+// DeleteContestRequest.Validate if the designated constraints aren't met.
+type DeleteContestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteContestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteContestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteContestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteContestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteContestRequestValidationError) ErrorName() string {
+	return "DeleteContestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteContestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteContestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteContestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteContestRequestValidationError{}
+
+// Validate checks the field values on DeleteContestResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteContestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteContestResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteContestResponseMultiError, or nil if none found.
+func (m *DeleteContestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteContestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteContestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteContestResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteContestResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteContestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteContestResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteContestResponseMultiError) AllErrors() []error { return m }
+
+// DeleteContestResponseValidationError is the validation error returned by
+// DeleteContestResponse.Validate if the designated constraints aren't met.
+type DeleteContestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteContestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteContestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteContestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteContestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteContestResponseValidationError) ErrorName() string {
+	return "DeleteContestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteContestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteContestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteContestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteContestResponseValidationError{}
+
+// Validate checks the field values on RegisterContestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RegisterContestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegisterContestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegisterContestRequestMultiError, or nil if none found.
+func (m *RegisterContestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegisterContestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return RegisterContestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegisterContestRequestMultiError is an error wrapping multiple validation
+// errors returned by RegisterContestRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RegisterContestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegisterContestRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegisterContestRequestMultiError) AllErrors() []error { return m }
+
+// RegisterContestRequestValidationError is the validation error returned by
+// RegisterContestRequest.Validate if the designated constraints aren't met.
+type RegisterContestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegisterContestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegisterContestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegisterContestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegisterContestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegisterContestRequestValidationError) ErrorName() string {
+	return "RegisterContestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegisterContestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegisterContestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegisterContestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegisterContestRequestValidationError{}
+
+// Validate checks the field values on RegisterContestResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RegisterContestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegisterContestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegisterContestResponseMultiError, or nil if none found.
+func (m *RegisterContestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegisterContestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RegisterContestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegisterContestResponseMultiError is an error wrapping multiple validation
+// errors returned by RegisterContestResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RegisterContestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegisterContestResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegisterContestResponseMultiError) AllErrors() []error { return m }
+
+// RegisterContestResponseValidationError is the validation error returned by
+// RegisterContestResponse.Validate if the designated constraints aren't met.
+type RegisterContestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegisterContestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegisterContestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegisterContestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegisterContestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegisterContestResponseValidationError) ErrorName() string {
+	return "RegisterContestResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateProblemRequestValidationError) Error() string {
+func (e RegisterContestResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2238,14 +11198,14 @@ func (e UpdateProblemRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateProblemRequest.%s: %s%s",
+		"invalid %sRegisterContestResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateProblemRequestValidationError{}
+var _ error = RegisterContestResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2253,73 +11213,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateProblemRequestValidationError{}
+} = RegisterContestResponseValidationError{}
 
-// Validate checks the field values on UpdateProblemResponse with the rules
+// Validate checks the field values on UnregisterContestRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateProblemResponse) Validate() error {
+func (m *UnregisterContestRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateProblemResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UnregisterContestRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateProblemResponseMultiError, or nil if none found.
-func (m *UpdateProblemResponse) ValidateAll() error {
+// UnregisterContestRequestMultiError, or nil if none found.
+func (m *UnregisterContestRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateProblemResponse) validate(all bool) error {
+func (m *UnregisterContestRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetProblem()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateProblemResponseValidationError{
-					field:  "Problem",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateProblemResponseValidationError{
-					field:  "Problem",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetProblem()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateProblemResponseValidationError{
-				field:  "Problem",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return UpdateProblemResponseMultiError(errors)
+		return UnregisterContestRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateProblemResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateProblemResponse.ValidateAll() if the designated
+// UnregisterContestRequestMultiError is an error wrapping multiple validation
+// errors returned by UnregisterContestRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateProblemResponseMultiError []error
+type UnregisterContestRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateProblemResponseMultiError) Error() string {
+func (m UnregisterContestRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2328,11 +11261,11 @@ func (m UpdateProblemResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateProblemResponseMultiError) AllErrors() []error { return m }
+func (m UnregisterContestRequestMultiError) AllErrors() []error { return m }
 
-// UpdateProblemResponseValidationError is the validation error returned by
-// UpdateProblemResponse.Validate if the designated constraints aren't met.
-type UpdateProblemResponseValidationError struct {
+// UnregisterContestRequestValidationError is the validation error returned by
+// UnregisterContestRequest.Validate if the designated constraints aren't met.
+type UnregisterContestRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2340,24 +11273,24 @@ type UpdateProblemResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateProblemResponseValidationError) Field() string { return e.field }
+func (e UnregisterContestRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateProblemResponseValidationError) Reason() string { return e.reason }
+func (e UnregisterContestRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateProblemResponseValidationError) Cause() error { return e.cause }
+func (e UnregisterContestRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateProblemResponseValidationError) Key() bool { return e.key }
+func (e UnregisterContestRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateProblemResponseValidationError) ErrorName() string {
-	return "UpdateProblemResponseValidationError"
+func (e UnregisterContestRequestValidationError) ErrorName() string {
+	return "UnregisterContestRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateProblemResponseValidationError) Error() string {
+func (e UnregisterContestRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2369,14 +11302,14 @@ func (e UpdateProblemResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateProblemResponse.%s: %s%s",
+		"invalid %sUnregisterContestRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateProblemResponseValidationError{}
+var _ error = UnregisterContestRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2384,46 +11317,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateProblemResponseValidationError{}
+} = UnregisterContestRequestValidationError{}
 
-// Validate checks the field values on DeleteProblemRequest with the rules
+// Validate checks the field values on UnregisterContestResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteProblemRequest) Validate() error {
+func (m *UnregisterContestResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteProblemRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UnregisterContestResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteProblemRequestMultiError, or nil if none found.
-func (m *DeleteProblemRequest) ValidateAll() error {
+// UnregisterContestResponseMultiError, or nil if none found.
+func (m *UnregisterContestResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteProblemRequest) validate(all bool) error {
+func (m *UnregisterContestResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
 	if len(errors) > 0 {
-		return DeleteProblemRequestMultiError(errors)
+		return UnregisterContestResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteProblemRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteProblemRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteProblemRequestMultiError []error
+// UnregisterContestResponseMultiError is an error wrapping multiple validation
+// errors returned by UnregisterContestResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UnregisterContestResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteProblemRequestMultiError) Error() string {
+func (m UnregisterContestResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2432,11 +11363,11 @@ func (m DeleteProblemRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteProblemRequestMultiError) AllErrors() []error { return m }
+func (m UnregisterContestResponseMultiError) AllErrors() []error { return m }
 
-// DeleteProblemRequestValidationError is the validation error returned by
-// DeleteProblemRequest.Validate if the designated constraints aren't met.
-type DeleteProblemRequestValidationError struct {
+// UnregisterContestResponseValidationError is the validation error returned by
+// UnregisterContestResponse.Validate if the designated constraints aren't met.
+type UnregisterContestResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2444,24 +11375,24 @@ type DeleteProblemRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteProblemRequestValidationError) Field() string { return e.field }
+func (e UnregisterContestResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteProblemRequestValidationError) Reason() string { return e.reason }
+func (e UnregisterContestResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteProblemRequestValidationError) Cause() error { return e.cause }
+func (e UnregisterContestResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteProblemRequestValidationError) Key() bool { return e.key }
+func (e UnregisterContestResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteProblemRequestValidationError) ErrorName() string {
-	return "DeleteProblemRequestValidationError"
+func (e UnregisterContestResponseValidationError) ErrorName() string {
+	return "UnregisterContestResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteProblemRequestValidationError) Error() string {
+func (e UnregisterContestResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2473,14 +11404,14 @@ func (e DeleteProblemRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteProblemRequest.%s: %s%s",
+		"invalid %sUnregisterContestResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteProblemRequestValidationError{}
+var _ error = UnregisterContestResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2488,44 +11419,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteProblemRequestValidationError{}
+} = UnregisterContestResponseValidationError{}
 
-// Validate checks the field values on DeleteProblemResponse with the rules
+// Validate checks the field values on ContestScoreboardCell with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteProblemResponse) Validate() error {
+func (m *ContestScoreboardCell) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteProblemResponse with the rules
+// ValidateAll checks the field values on ContestScoreboardCell with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteProblemResponseMultiError, or nil if none found.
-func (m *DeleteProblemResponse) ValidateAll() error {
+// ContestScoreboardCellMultiError, or nil if none found.
+func (m *ContestScoreboardCell) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteProblemResponse) validate(all bool) error {
+func (m *ContestScoreboardCell) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for ProblemId
+
+	// no validation rules for Label
+
+	// no validation rules for IsSolved
+
+	// no validation rules for WrongAttemptCount
+
+	// no validation rules for SolvedAtMinute
+
+	// no validation rules for Score
+
 	if len(errors) > 0 {
-		return DeleteProblemResponseMultiError(errors)
+		return ContestScoreboardCellMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteProblemResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteProblemResponse.ValidateAll() if the designated
+// ContestScoreboardCellMultiError is an error wrapping multiple validation
+// errors returned by ContestScoreboardCell.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteProblemResponseMultiError []error
+type ContestScoreboardCellMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteProblemResponseMultiError) Error() string {
+func (m ContestScoreboardCellMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2534,11 +11477,11 @@ func (m DeleteProblemResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteProblemResponseMultiError) AllErrors() []error { return m }
+func (m ContestScoreboardCellMultiError) AllErrors() []error { return m }
 
-// DeleteProblemResponseValidationError is the validation error returned by
-// DeleteProblemResponse.Validate if the designated constraints aren't met.
-type DeleteProblemResponseValidationError struct {
+// ContestScoreboardCellValidationError is the validation error returned by
+// ContestScoreboardCell.Validate if the designated constraints aren't met.
+type ContestScoreboardCellValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2546,24 +11489,24 @@ type DeleteProblemResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteProblemResponseValidationError) Field() string { return e.field }
+func (e ContestScoreboardCellValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteProblemResponseValidationError) Reason() string { return e.reason }
+func (e ContestScoreboardCellValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteProblemResponseValidationError) Cause() error { return e.cause }
+func (e ContestScoreboardCellValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteProblemResponseValidationError) Key() bool { return e.key }
+func (e ContestScoreboardCellValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteProblemResponseValidationError) ErrorName() string {
-	return "DeleteProblemResponseValidationError"
+func (e ContestScoreboardCellValidationError) ErrorName() string {
+	return "ContestScoreboardCellValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteProblemResponseValidationError) Error() string {
+func (e ContestScoreboardCellValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2575,14 +11518,14 @@ func (e DeleteProblemResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteProblemResponse.%s: %s%s",
+		"invalid %sContestScoreboardCell.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteProblemResponseValidationError{}
+var _ error = ContestScoreboardCellValidationError{}
 
 var _ interface {
 	Field() string
@@ -2590,52 +11533,90 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteProblemResponseValidationError{}
+} = ContestScoreboardCellValidationError{}
 
-// Validate checks the field values on CreateTestCaseRequest with the rules
+// Validate checks the field values on ContestScoreboardRow with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateTestCaseRequest) Validate() error {
+func (m *ContestScoreboardRow) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateTestCaseRequest with the rules
+// ValidateAll checks the field values on ContestScoreboardRow with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateTestCaseRequestMultiError, or nil if none found.
-func (m *CreateTestCaseRequest) ValidateAll() error {
+// ContestScoreboardRowMultiError, or nil if none found.
+func (m *ContestScoreboardRow) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateTestCaseRequest) validate(all bool) error {
+func (m *ContestScoreboardRow) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OfProblemId
+	// no validation rules for Rank
 
-	// no validation rules for Input
+	// no validation rules for AccountId
 
-	// no validation rules for Output
+	// no validation rules for AccountName
 
-	// no validation rules for IsHidden
+	// no validation rules for SolvedCount
+
+	// no validation rules for Penalty
+
+	// no validation rules for TotalScore
+
+	for idx, item := range m.GetCells() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ContestScoreboardRowValidationError{
+						field:  fmt.Sprintf("Cells[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ContestScoreboardRowValidationError{
+						field:  fmt.Sprintf("Cells[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ContestScoreboardRowValidationError{
+					field:  fmt.Sprintf("Cells[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return CreateTestCaseRequestMultiError(errors)
+		return ContestScoreboardRowMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateTestCaseRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateTestCaseRequest.ValidateAll() if the designated
+// ContestScoreboardRowMultiError is an error wrapping multiple validation
+// errors returned by ContestScoreboardRow.ValidateAll() if the designated
 // constraints aren't met.
-type CreateTestCaseRequestMultiError []error
+type ContestScoreboardRowMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateTestCaseRequestMultiError) Error() string {
+func (m ContestScoreboardRowMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2644,11 +11625,11 @@ func (m CreateTestCaseRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateTestCaseRequestMultiError) AllErrors() []error { return m }
+func (m ContestScoreboardRowMultiError) AllErrors() []error { return m }
 
-// CreateTestCaseRequestValidationError is the validation error returned by
-// CreateTestCaseRequest.Validate if the designated constraints aren't met.
-type CreateTestCaseRequestValidationError struct {
+// ContestScoreboardRowValidationError is the validation error returned by
+// ContestScoreboardRow.Validate if the designated constraints aren't met.
+type ContestScoreboardRowValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2656,24 +11637,24 @@ type CreateTestCaseRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateTestCaseRequestValidationError) Field() string { return e.field }
+func (e ContestScoreboardRowValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateTestCaseRequestValidationError) Reason() string { return e.reason }
+func (e ContestScoreboardRowValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateTestCaseRequestValidationError) Cause() error { return e.cause }
+func (e ContestScoreboardRowValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateTestCaseRequestValidationError) Key() bool { return e.key }
+func (e ContestScoreboardRowValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateTestCaseRequestValidationError) ErrorName() string {
-	return "CreateTestCaseRequestValidationError"
+func (e ContestScoreboardRowValidationError) ErrorName() string {
+	return "ContestScoreboardRowValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateTestCaseRequestValidationError) Error() string {
+func (e ContestScoreboardRowValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2685,14 +11666,14 @@ func (e CreateTestCaseRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateTestCaseRequest.%s: %s%s",
+		"invalid %sContestScoreboardRow.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateTestCaseRequestValidationError{}
+var _ error = ContestScoreboardRowValidationError{}
 
 var _ interface {
 	Field() string
@@ -2700,24 +11681,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateTestCaseRequestValidationError{}
+} = ContestScoreboardRowValidationError{}
 
-// Validate checks the field values on TestCase with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *TestCase) Validate() error {
+// Validate checks the field values on GetContestScoreboardRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetContestScoreboardRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TestCase with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TestCaseMultiError, or nil
-// if none found.
-func (m *TestCase) ValidateAll() error {
+// ValidateAll checks the field values on GetContestScoreboardRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetContestScoreboardRequestMultiError, or nil if none found.
+func (m *GetContestScoreboardRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TestCase) validate(all bool) error {
+func (m *GetContestScoreboardRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2726,27 +11707,20 @@ func (m *TestCase) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for OfProblemId
-
-	// no validation rules for Input
-
-	// no validation rules for Output
-
-	// no validation rules for IsHidden
-
 	if len(errors) > 0 {
-		return TestCaseMultiError(errors)
+		return GetContestScoreboardRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TestCaseMultiError is an error wrapping multiple validation errors returned
-// by TestCase.ValidateAll() if the designated constraints aren't met.
-type TestCaseMultiError []error
+// GetContestScoreboardRequestMultiError is an error wrapping multiple
+// validation errors returned by GetContestScoreboardRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetContestScoreboardRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TestCaseMultiError) Error() string {
+func (m GetContestScoreboardRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2755,11 +11729,12 @@ func (m TestCaseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TestCaseMultiError) AllErrors() []error { return m }
+func (m GetContestScoreboardRequestMultiError) AllErrors() []error { return m }
 
-// TestCaseValidationError is the validation error returned by
-// TestCase.Validate if the designated constraints aren't met.
-type TestCaseValidationError struct {
+// GetContestScoreboardRequestValidationError is the validation error returned
+// by GetContestScoreboardRequest.Validate if the designated constraints
+// aren't met.
+type GetContestScoreboardRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2767,22 +11742,24 @@ type TestCaseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TestCaseValidationError) Field() string { return e.field }
+func (e GetContestScoreboardRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TestCaseValidationError) Reason() string { return e.reason }
+func (e GetContestScoreboardRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TestCaseValidationError) Cause() error { return e.cause }
+func (e GetContestScoreboardRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TestCaseValidationError) Key() bool { return e.key }
+func (e GetContestScoreboardRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TestCaseValidationError) ErrorName() string { return "TestCaseValidationError" }
+func (e GetContestScoreboardRequestValidationError) ErrorName() string {
+	return "GetContestScoreboardRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TestCaseValidationError) Error() string {
+func (e GetContestScoreboardRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2794,14 +11771,14 @@ func (e TestCaseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTestCase.%s: %s%s",
+		"invalid %sGetContestScoreboardRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TestCaseValidationError{}
+var _ error = GetContestScoreboardRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2809,73 +11786,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TestCaseValidationError{}
+} = GetContestScoreboardRequestValidationError{}
 
-// Validate checks the field values on CreateTestCaseResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetContestScoreboardResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateTestCaseResponse) Validate() error {
+func (m *GetContestScoreboardResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateTestCaseResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetContestScoreboardResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateTestCaseResponseMultiError, or nil if none found.
-func (m *CreateTestCaseResponse) ValidateAll() error {
+// GetContestScoreboardResponseMultiError, or nil if none found.
+func (m *GetContestScoreboardResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateTestCaseResponse) validate(all bool) error {
+func (m *GetContestScoreboardResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTestCase()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateTestCaseResponseValidationError{
-					field:  "TestCase",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for ScoringMode
+
+	// no validation rules for IsFrozen
+
+	for idx, item := range m.GetRows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetContestScoreboardResponseValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetContestScoreboardResponseValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateTestCaseResponseValidationError{
-					field:  "TestCase",
+				return GetContestScoreboardResponseValidationError{
+					field:  fmt.Sprintf("Rows[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTestCase()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateTestCaseResponseValidationError{
-				field:  "TestCase",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return CreateTestCaseResponseMultiError(errors)
+		return GetContestScoreboardResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateTestCaseResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateTestCaseResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateTestCaseResponseMultiError []error
+// GetContestScoreboardResponseMultiError is an error wrapping multiple
+// validation errors returned by GetContestScoreboardResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetContestScoreboardResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateTestCaseResponseMultiError) Error() string {
+func (m GetContestScoreboardResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2884,11 +11870,12 @@ func (m CreateTestCaseResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateTestCaseResponseMultiError) AllErrors() []error { return m }
+func (m GetContestScoreboardResponseMultiError) AllErrors() []error { return m }
 
-// CreateTestCaseResponseValidationError is the validation error returned by
-// CreateTestCaseResponse.Validate if the designated constraints aren't met.
-type CreateTestCaseResponseValidationError struct {
+// GetContestScoreboardResponseValidationError is the validation error returned
+// by GetContestScoreboardResponse.Validate if the designated constraints
+// aren't met.
+type GetContestScoreboardResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2896,24 +11883,24 @@ type CreateTestCaseResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateTestCaseResponseValidationError) Field() string { return e.field }
+func (e GetContestScoreboardResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateTestCaseResponseValidationError) Reason() string { return e.reason }
+func (e GetContestScoreboardResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateTestCaseResponseValidationError) Cause() error { return e.cause }
+func (e GetContestScoreboardResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateTestCaseResponseValidationError) Key() bool { return e.key }
+func (e GetContestScoreboardResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateTestCaseResponseValidationError) ErrorName() string {
-	return "CreateTestCaseResponseValidationError"
+func (e GetContestScoreboardResponseValidationError) ErrorName() string {
+	return "GetContestScoreboardResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateTestCaseResponseValidationError) Error() string {
+func (e GetContestScoreboardResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2925,14 +11912,14 @@ func (e CreateTestCaseResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateTestCaseResponse.%s: %s%s",
+		"invalid %sGetContestScoreboardResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateTestCaseResponseValidationError{}
+var _ error = GetContestScoreboardResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2940,50 +11927,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateTestCaseResponseValidationError{}
+} = GetContestScoreboardResponseValidationError{}
 
-// Validate checks the field values on GetProblemTestCaseListRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on
+// GetAndUpdateFirstSubmittedSubmissionToExecutingRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetProblemTestCaseListRequest) Validate() error {
+func (m *GetAndUpdateFirstSubmittedSubmissionToExecutingRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProblemTestCaseListRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetProblemTestCaseListRequestMultiError, or nil if none found.
-func (m *GetProblemTestCaseListRequest) ValidateAll() error {
+// ValidateAll checks the field values on
+// GetAndUpdateFirstSubmittedSubmissionToExecutingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAndUpdateFirstSubmittedSubmissionToExecutingRequestMultiError, or nil if
+// none found.
+func (m *GetAndUpdateFirstSubmittedSubmissionToExecutingRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProblemTestCaseListRequest) validate(all bool) error {
+func (m *GetAndUpdateFirstSubmittedSubmissionToExecutingRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Offset
-
-	// no validation rules for Limit
-
 	if len(errors) > 0 {
-		return GetProblemTestCaseListRequestMultiError(errors)
+		return GetAndUpdateFirstSubmittedSubmissionToExecutingRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProblemTestCaseListRequestMultiError is an error wrapping multiple
-// validation errors returned by GetProblemTestCaseListRequest.ValidateAll()
-// if the designated constraints aren't met.
-type GetProblemTestCaseListRequestMultiError []error
+// GetAndUpdateFirstSubmittedSubmissionToExecutingRequestMultiError is an error
+// wrapping multiple validation errors returned by
+// GetAndUpdateFirstSubmittedSubmissionToExecutingRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetAndUpdateFirstSubmittedSubmissionToExecutingRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProblemTestCaseListRequestMultiError) Error() string {
+func (m GetAndUpdateFirstSubmittedSubmissionToExecutingRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2992,12 +11977,15 @@ func (m GetProblemTestCaseListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProblemTestCaseListRequestMultiError) AllErrors() []error { return m }
+func (m GetAndUpdateFirstSubmittedSubmissionToExecutingRequestMultiError) AllErrors() []error {
+	return m
+}
 
-// GetProblemTestCaseListRequestValidationError is the validation error
-// returned by GetProblemTestCaseListRequest.Validate if the designated
-// constraints aren't met.
-type GetProblemTestCaseListRequestValidationError struct {
+// GetAndUpdateFirstSubmittedSubmissionToExecutingRequestValidationError is the
+// validation error returned by
+// GetAndUpdateFirstSubmittedSubmissionToExecutingRequest.Validate if the
+// designated constraints aren't met.
+type GetAndUpdateFirstSubmittedSubmissionToExecutingRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3005,24 +11993,32 @@ type GetProblemTestCaseListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProblemTestCaseListRequestValidationError) Field() string { return e.field }
+func (e GetAndUpdateFirstSubmittedSubmissionToExecutingRequestValidationError) Field() string {
+	return e.field
+}
 
 // Reason function returns reason value.
-func (e GetProblemTestCaseListRequestValidationError) Reason() string { return e.reason }
+func (e GetAndUpdateFirstSubmittedSubmissionToExecutingRequestValidationError) Reason() string {
+	return e.reason
+}
 
 // Cause function returns cause value.
-func (e GetProblemTestCaseListRequestValidationError) Cause() error { return e.cause }
+func (e GetAndUpdateFirstSubmittedSubmissionToExecutingRequestValidationError) Cause() error {
+	return e.cause
+}
 
 // Key function returns key value.
-func (e GetProblemTestCaseListRequestValidationError) Key() bool { return e.key }
+func (e GetAndUpdateFirstSubmittedSubmissionToExecutingRequestValidationError) Key() bool {
+	return e.key
+}
 
 // ErrorName returns error name.
-func (e GetProblemTestCaseListRequestValidationError) ErrorName() string {
-	return "GetProblemTestCaseListRequestValidationError"
+func (e GetAndUpdateFirstSubmittedSubmissionToExecutingRequestValidationError) ErrorName() string {
+	return "GetAndUpdateFirstSubmittedSubmissionToExecutingRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetProblemTestCaseListRequestValidationError) Error() string {
+func (e GetAndUpdateFirstSubmittedSubmissionToExecutingRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3034,14 +12030,14 @@ func (e GetProblemTestCaseListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProblemTestCaseListRequest.%s: %s%s",
+		"invalid %sGetAndUpdateFirstSubmittedSubmissionToExecutingRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProblemTestCaseListRequestValidationError{}
+var _ error = GetAndUpdateFirstSubmittedSubmissionToExecutingRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3049,30 +12045,91 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProblemTestCaseListRequestValidationError{}
+} = GetAndUpdateFirstSubmittedSubmissionToExecutingRequestValidationError{}
 
-// Validate checks the field values on GetProblemTestCaseListResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on
+// GetAndUpdateFirstSubmittedSubmissionToExecutingResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetProblemTestCaseListResponse) Validate() error {
+func (m *GetAndUpdateFirstSubmittedSubmissionToExecutingResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProblemTestCaseListResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetProblemTestCaseListResponseMultiError, or nil if none found.
-func (m *GetProblemTestCaseListResponse) ValidateAll() error {
+// ValidateAll checks the field values on
+// GetAndUpdateFirstSubmittedSubmissionToExecutingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAndUpdateFirstSubmittedSubmissionToExecutingResponseMultiError, or nil
+// if none found.
+func (m *GetAndUpdateFirstSubmittedSubmissionToExecutingResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProblemTestCaseListResponse) validate(all bool) error {
+func (m *GetAndUpdateFirstSubmittedSubmissionToExecutingResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetSubmission()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError{
+					field:  "Submission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError{
+					field:  "Submission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubmission()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError{
+				field:  "Submission",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetProblem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError{
+					field:  "Problem",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError{
+					field:  "Problem",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProblem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError{
+				field:  "Problem",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	for idx, item := range m.GetTestCases() {
 		_, _ = idx, item
 
@@ -3080,7 +12137,7 @@ func (m *GetProblemTestCaseListResponse) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetProblemTestCaseListResponseValidationError{
+					errors = append(errors, GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError{
 						field:  fmt.Sprintf("TestCases[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -3088,7 +12145,7 @@ func (m *GetProblemTestCaseListResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetProblemTestCaseListResponseValidationError{
+					errors = append(errors, GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError{
 						field:  fmt.Sprintf("TestCases[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -3097,7 +12154,7 @@ func (m *GetProblemTestCaseListResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetProblemTestCaseListResponseValidationError{
+				return GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError{
 					field:  fmt.Sprintf("TestCases[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3107,22 +12164,27 @@ func (m *GetProblemTestCaseListResponse) validate(all bool) error {
 
 	}
 
-	// no validation rules for TotalTestCasesCount
+	// no validation rules for CheckerSource
+
+	// no validation rules for InteractorSource
+
+	// no validation rules for JudgingLeaseToken
 
 	if len(errors) > 0 {
-		return GetProblemTestCaseListResponseMultiError(errors)
+		return GetAndUpdateFirstSubmittedSubmissionToExecutingResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProblemTestCaseListResponseMultiError is an error wrapping multiple
-// validation errors returned by GetProblemTestCaseListResponse.ValidateAll()
-// if the designated constraints aren't met.
-type GetProblemTestCaseListResponseMultiError []error
+// GetAndUpdateFirstSubmittedSubmissionToExecutingResponseMultiError is an
+// error wrapping multiple validation errors returned by
+// GetAndUpdateFirstSubmittedSubmissionToExecutingResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetAndUpdateFirstSubmittedSubmissionToExecutingResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProblemTestCaseListResponseMultiError) Error() string {
+func (m GetAndUpdateFirstSubmittedSubmissionToExecutingResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3131,12 +12193,15 @@ func (m GetProblemTestCaseListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProblemTestCaseListResponseMultiError) AllErrors() []error { return m }
+func (m GetAndUpdateFirstSubmittedSubmissionToExecutingResponseMultiError) AllErrors() []error {
+	return m
+}
 
-// GetProblemTestCaseListResponseValidationError is the validation error
-// returned by GetProblemTestCaseListResponse.Validate if the designated
-// constraints aren't met.
-type GetProblemTestCaseListResponseValidationError struct {
+// GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError is
+// the validation error returned by
+// GetAndUpdateFirstSubmittedSubmissionToExecutingResponse.Validate if the
+// designated constraints aren't met.
+type GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3144,24 +12209,32 @@ type GetProblemTestCaseListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProblemTestCaseListResponseValidationError) Field() string { return e.field }
+func (e GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError) Field() string {
+	return e.field
+}
 
 // Reason function returns reason value.
-func (e GetProblemTestCaseListResponseValidationError) Reason() string { return e.reason }
+func (e GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError) Reason() string {
+	return e.reason
+}
 
 // Cause function returns cause value.
-func (e GetProblemTestCaseListResponseValidationError) Cause() error { return e.cause }
+func (e GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError) Cause() error {
+	return e.cause
+}
 
 // Key function returns key value.
-func (e GetProblemTestCaseListResponseValidationError) Key() bool { return e.key }
+func (e GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError) Key() bool {
+	return e.key
+}
 
 // ErrorName returns error name.
-func (e GetProblemTestCaseListResponseValidationError) ErrorName() string {
-	return "GetProblemTestCaseListResponseValidationError"
+func (e GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError) ErrorName() string {
+	return "GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetProblemTestCaseListResponseValidationError) Error() string {
+func (e GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3173,14 +12246,14 @@ func (e GetProblemTestCaseListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProblemTestCaseListResponse.%s: %s%s",
+		"invalid %sGetAndUpdateFirstSubmittedSubmissionToExecutingResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProblemTestCaseListResponseValidationError{}
+var _ error = GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3188,24 +12261,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProblemTestCaseListResponseValidationError{}
+} = GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError{}
 
-// Validate checks the field values on GetTestCaseRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetTestCaseRequest) Validate() error {
+// Validate checks the field values on RenewSubmissionJudgingLeaseRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RenewSubmissionJudgingLeaseRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetTestCaseRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetTestCaseRequestMultiError, or nil if none found.
-func (m *GetTestCaseRequest) ValidateAll() error {
+// ValidateAll checks the field values on RenewSubmissionJudgingLeaseRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RenewSubmissionJudgingLeaseRequestMultiError, or nil if none found.
+func (m *RenewSubmissionJudgingLeaseRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetTestCaseRequest) validate(all bool) error {
+func (m *RenewSubmissionJudgingLeaseRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3214,20 +12288,32 @@ func (m *GetTestCaseRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
+	if utf8.RuneCountInString(m.GetJudgingLeaseToken()) < 1 {
+		err := RenewSubmissionJudgingLeaseRequestValidationError{
+			field:  "JudgingLeaseToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return GetTestCaseRequestMultiError(errors)
+		return RenewSubmissionJudgingLeaseRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetTestCaseRequestMultiError is an error wrapping multiple validation errors
-// returned by GetTestCaseRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetTestCaseRequestMultiError []error
+// RenewSubmissionJudgingLeaseRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// RenewSubmissionJudgingLeaseRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RenewSubmissionJudgingLeaseRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetTestCaseRequestMultiError) Error() string {
+func (m RenewSubmissionJudgingLeaseRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3236,11 +12322,12 @@ func (m GetTestCaseRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetTestCaseRequestMultiError) AllErrors() []error { return m }
+func (m RenewSubmissionJudgingLeaseRequestMultiError) AllErrors() []error { return m }
 
-// GetTestCaseRequestValidationError is the validation error returned by
-// GetTestCaseRequest.Validate if the designated constraints aren't met.
-type GetTestCaseRequestValidationError struct {
+// RenewSubmissionJudgingLeaseRequestValidationError is the validation error
+// returned by RenewSubmissionJudgingLeaseRequest.Validate if the designated
+// constraints aren't met.
+type RenewSubmissionJudgingLeaseRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3248,24 +12335,24 @@ type GetTestCaseRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetTestCaseRequestValidationError) Field() string { return e.field }
+func (e RenewSubmissionJudgingLeaseRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetTestCaseRequestValidationError) Reason() string { return e.reason }
+func (e RenewSubmissionJudgingLeaseRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetTestCaseRequestValidationError) Cause() error { return e.cause }
+func (e RenewSubmissionJudgingLeaseRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetTestCaseRequestValidationError) Key() bool { return e.key }
+func (e RenewSubmissionJudgingLeaseRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetTestCaseRequestValidationError) ErrorName() string {
-	return "GetTestCaseRequestValidationError"
+func (e RenewSubmissionJudgingLeaseRequestValidationError) ErrorName() string {
+	return "RenewSubmissionJudgingLeaseRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetTestCaseRequestValidationError) Error() string {
+func (e RenewSubmissionJudgingLeaseRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3277,14 +12364,14 @@ func (e GetTestCaseRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetTestCaseRequest.%s: %s%s",
+		"invalid %sRenewSubmissionJudgingLeaseRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetTestCaseRequestValidationError{}
+var _ error = RenewSubmissionJudgingLeaseRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3292,73 +12379,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetTestCaseRequestValidationError{}
+} = RenewSubmissionJudgingLeaseRequestValidationError{}
 
-// Validate checks the field values on GetTestCaseResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetTestCaseResponse) Validate() error {
+// Validate checks the field values on RenewSubmissionJudgingLeaseResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RenewSubmissionJudgingLeaseResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetTestCaseResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetTestCaseResponseMultiError, or nil if none found.
-func (m *GetTestCaseResponse) ValidateAll() error {
+// ValidateAll checks the field values on RenewSubmissionJudgingLeaseResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RenewSubmissionJudgingLeaseResponseMultiError, or nil if none found.
+func (m *RenewSubmissionJudgingLeaseResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetTestCaseResponse) validate(all bool) error {
+func (m *RenewSubmissionJudgingLeaseResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTestCase()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetTestCaseResponseValidationError{
-					field:  "TestCase",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetTestCaseResponseValidationError{
-					field:  "TestCase",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTestCase()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetTestCaseResponseValidationError{
-				field:  "TestCase",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return GetTestCaseResponseMultiError(errors)
+		return RenewSubmissionJudgingLeaseResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetTestCaseResponseMultiError is an error wrapping multiple validation
-// errors returned by GetTestCaseResponse.ValidateAll() if the designated
+// RenewSubmissionJudgingLeaseResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// RenewSubmissionJudgingLeaseResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetTestCaseResponseMultiError []error
+type RenewSubmissionJudgingLeaseResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetTestCaseResponseMultiError) Error() string {
+func (m RenewSubmissionJudgingLeaseResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3367,11 +12427,12 @@ func (m GetTestCaseResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetTestCaseResponseMultiError) AllErrors() []error { return m }
+func (m RenewSubmissionJudgingLeaseResponseMultiError) AllErrors() []error { return m }
 
-// GetTestCaseResponseValidationError is the validation error returned by
-// GetTestCaseResponse.Validate if the designated constraints aren't met.
-type GetTestCaseResponseValidationError struct {
+// RenewSubmissionJudgingLeaseResponseValidationError is the validation error
+// returned by RenewSubmissionJudgingLeaseResponse.Validate if the designated
+// constraints aren't met.
+type RenewSubmissionJudgingLeaseResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3379,24 +12440,24 @@ type GetTestCaseResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetTestCaseResponseValidationError) Field() string { return e.field }
+func (e RenewSubmissionJudgingLeaseResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetTestCaseResponseValidationError) Reason() string { return e.reason }
+func (e RenewSubmissionJudgingLeaseResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetTestCaseResponseValidationError) Cause() error { return e.cause }
+func (e RenewSubmissionJudgingLeaseResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetTestCaseResponseValidationError) Key() bool { return e.key }
+func (e RenewSubmissionJudgingLeaseResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetTestCaseResponseValidationError) ErrorName() string {
-	return "GetTestCaseResponseValidationError"
+func (e RenewSubmissionJudgingLeaseResponseValidationError) ErrorName() string {
+	return "RenewSubmissionJudgingLeaseResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetTestCaseResponseValidationError) Error() string {
+func (e RenewSubmissionJudgingLeaseResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3408,14 +12469,14 @@ func (e GetTestCaseResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetTestCaseResponse.%s: %s%s",
+		"invalid %sRenewSubmissionJudgingLeaseResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetTestCaseResponseValidationError{}
+var _ error = RenewSubmissionJudgingLeaseResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3423,52 +12484,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetTestCaseResponseValidationError{}
+} = RenewSubmissionJudgingLeaseResponseValidationError{}
 
-// Validate checks the field values on UpdateTestCaseRequest with the rules
+// Validate checks the field values on JudgedTestCaseResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateTestCaseRequest) Validate() error {
+func (m *JudgedTestCaseResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateTestCaseRequest with the rules
+// ValidateAll checks the field values on JudgedTestCaseResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateTestCaseRequestMultiError, or nil if none found.
-func (m *UpdateTestCaseRequest) ValidateAll() error {
+// JudgedTestCaseResultMultiError, or nil if none found.
+func (m *JudgedTestCaseResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateTestCaseRequest) validate(all bool) error {
+func (m *JudgedTestCaseResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for OfTestCaseId
 
-	// no validation rules for Input
+	if _, ok := SubmissionResult_name[int32(m.GetResult())]; !ok {
+		err := JudgedTestCaseResultValidationError{
+			field:  "Result",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Output
+	// no validation rules for WallTime
 
-	// no validation rules for IsHidden
+	// no validation rules for CpuTime
+
+	// no validation rules for Memory
+
+	// no validation rules for ExitCode
+
+	// no validation rules for Stdout
+
+	// no validation rules for Stderr
+
+	if val := m.GetCredit(); val < 0 || val > 1 {
+		err := JudgedTestCaseResultValidationError{
+			field:  "Credit",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return UpdateTestCaseRequestMultiError(errors)
+		return JudgedTestCaseResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateTestCaseRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateTestCaseRequest.ValidateAll() if the designated
+// JudgedTestCaseResultMultiError is an error wrapping multiple validation
+// errors returned by JudgedTestCaseResult.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateTestCaseRequestMultiError []error
+type JudgedTestCaseResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateTestCaseRequestMultiError) Error() string {
+func (m JudgedTestCaseResultMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3477,11 +12566,11 @@ func (m UpdateTestCaseRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateTestCaseRequestMultiError) AllErrors() []error { return m }
+func (m JudgedTestCaseResultMultiError) AllErrors() []error { return m }
 
-// UpdateTestCaseRequestValidationError is the validation error returned by
-// UpdateTestCaseRequest.Validate if the designated constraints aren't met.
-type UpdateTestCaseRequestValidationError struct {
+// JudgedTestCaseResultValidationError is the validation error returned by
+// JudgedTestCaseResult.Validate if the designated constraints aren't met.
+type JudgedTestCaseResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3489,24 +12578,24 @@ type UpdateTestCaseRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateTestCaseRequestValidationError) Field() string { return e.field }
+func (e JudgedTestCaseResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateTestCaseRequestValidationError) Reason() string { return e.reason }
+func (e JudgedTestCaseResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateTestCaseRequestValidationError) Cause() error { return e.cause }
+func (e JudgedTestCaseResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateTestCaseRequestValidationError) Key() bool { return e.key }
+func (e JudgedTestCaseResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateTestCaseRequestValidationError) ErrorName() string {
-	return "UpdateTestCaseRequestValidationError"
+func (e JudgedTestCaseResultValidationError) ErrorName() string {
+	return "JudgedTestCaseResultValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateTestCaseRequestValidationError) Error() string {
+func (e JudgedTestCaseResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3518,14 +12607,14 @@ func (e UpdateTestCaseRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateTestCaseRequest.%s: %s%s",
+		"invalid %sJudgedTestCaseResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateTestCaseRequestValidationError{}
+var _ error = JudgedTestCaseResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -3533,73 +12622,104 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateTestCaseRequestValidationError{}
+} = JudgedTestCaseResultValidationError{}
 
-// Validate checks the field values on UpdateTestCaseResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ReportSubmissionResultRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateTestCaseResponse) Validate() error {
+func (m *ReportSubmissionResultRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateTestCaseResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateTestCaseResponseMultiError, or nil if none found.
-func (m *UpdateTestCaseResponse) ValidateAll() error {
+// ValidateAll checks the field values on ReportSubmissionResultRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ReportSubmissionResultRequestMultiError, or nil if none found.
+func (m *ReportSubmissionResultRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateTestCaseResponse) validate(all bool) error {
+func (m *ReportSubmissionResultRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTestCase()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateTestCaseResponseValidationError{
-					field:  "TestCase",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for Id
+
+	if _, ok := SubmissionResult_name[int32(m.GetResult())]; !ok {
+		err := ReportSubmissionResultRequestValidationError{
+			field:  "Result",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for CompileOutput
+
+	for idx, item := range m.GetTestCaseResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReportSubmissionResultRequestValidationError{
+						field:  fmt.Sprintf("TestCaseResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReportSubmissionResultRequestValidationError{
+						field:  fmt.Sprintf("TestCaseResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateTestCaseResponseValidationError{
-					field:  "TestCase",
+				return ReportSubmissionResultRequestValidationError{
+					field:  fmt.Sprintf("TestCaseResults[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
+				}
 			}
 		}
-	} else if v, ok := interface{}(m.GetTestCase()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateTestCaseResponseValidationError{
-				field:  "TestCase",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+
+	}
+
+	if utf8.RuneCountInString(m.GetJudgingLeaseToken()) < 1 {
+		err := ReportSubmissionResultRequestValidationError{
+			field:  "JudgingLeaseToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return UpdateTestCaseResponseMultiError(errors)
+		return ReportSubmissionResultRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateTestCaseResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateTestCaseResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateTestCaseResponseMultiError []error
+// ReportSubmissionResultRequestMultiError is an error wrapping multiple
+// validation errors returned by ReportSubmissionResultRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ReportSubmissionResultRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateTestCaseResponseMultiError) Error() string {
+func (m ReportSubmissionResultRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3608,11 +12728,12 @@ func (m UpdateTestCaseResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateTestCaseResponseMultiError) AllErrors() []error { return m }
+func (m ReportSubmissionResultRequestMultiError) AllErrors() []error { return m }
 
-// UpdateTestCaseResponseValidationError is the validation error returned by
-// UpdateTestCaseResponse.Validate if the designated constraints aren't met.
-type UpdateTestCaseResponseValidationError struct {
+// ReportSubmissionResultRequestValidationError is the validation error
+// returned by ReportSubmissionResultRequest.Validate if the designated
+// constraints aren't met.
+type ReportSubmissionResultRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3620,24 +12741,24 @@ type UpdateTestCaseResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateTestCaseResponseValidationError) Field() string { return e.field }
+func (e ReportSubmissionResultRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateTestCaseResponseValidationError) Reason() string { return e.reason }
+func (e ReportSubmissionResultRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateTestCaseResponseValidationError) Cause() error { return e.cause }
+func (e ReportSubmissionResultRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateTestCaseResponseValidationError) Key() bool { return e.key }
+func (e ReportSubmissionResultRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateTestCaseResponseValidationError) ErrorName() string {
-	return "UpdateTestCaseResponseValidationError"
+func (e ReportSubmissionResultRequestValidationError) ErrorName() string {
+	return "ReportSubmissionResultRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateTestCaseResponseValidationError) Error() string {
+func (e ReportSubmissionResultRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3649,14 +12770,14 @@ func (e UpdateTestCaseResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateTestCaseResponse.%s: %s%s",
+		"invalid %sReportSubmissionResultRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateTestCaseResponseValidationError{}
+var _ error = ReportSubmissionResultRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3664,46 +12785,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateTestCaseResponseValidationError{}
+} = ReportSubmissionResultRequestValidationError{}
 
-// Validate checks the field values on DeleteTestCaseRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ReportSubmissionResultResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteTestCaseRequest) Validate() error {
+func (m *ReportSubmissionResultResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteTestCaseRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteTestCaseRequestMultiError, or nil if none found.
-func (m *DeleteTestCaseRequest) ValidateAll() error {
+// ValidateAll checks the field values on ReportSubmissionResultResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ReportSubmissionResultResponseMultiError, or nil if none found.
+func (m *ReportSubmissionResultResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteTestCaseRequest) validate(all bool) error {
+func (m *ReportSubmissionResultResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if all {
+		switch v := interface{}(m.GetSubmission()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportSubmissionResultResponseValidationError{
+					field:  "Submission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportSubmissionResultResponseValidationError{
+					field:  "Submission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubmission()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportSubmissionResultResponseValidationError{
+				field:  "Submission",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteTestCaseRequestMultiError(errors)
+		return ReportSubmissionResultResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteTestCaseRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteTestCaseRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteTestCaseRequestMultiError []error
+// ReportSubmissionResultResponseMultiError is an error wrapping multiple
+// validation errors returned by ReportSubmissionResultResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ReportSubmissionResultResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteTestCaseRequestMultiError) Error() string {
+func (m ReportSubmissionResultResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3712,11 +12860,12 @@ func (m DeleteTestCaseRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteTestCaseRequestMultiError) AllErrors() []error { return m }
+func (m ReportSubmissionResultResponseMultiError) AllErrors() []error { return m }
 
-// DeleteTestCaseRequestValidationError is the validation error returned by
-// DeleteTestCaseRequest.Validate if the designated constraints aren't met.
-type DeleteTestCaseRequestValidationError struct {
+// ReportSubmissionResultResponseValidationError is the validation error
+// returned by ReportSubmissionResultResponse.Validate if the designated
+// constraints aren't met.
+type ReportSubmissionResultResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3724,24 +12873,24 @@ type DeleteTestCaseRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteTestCaseRequestValidationError) Field() string { return e.field }
+func (e ReportSubmissionResultResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteTestCaseRequestValidationError) Reason() string { return e.reason }
+func (e ReportSubmissionResultResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteTestCaseRequestValidationError) Cause() error { return e.cause }
+func (e ReportSubmissionResultResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteTestCaseRequestValidationError) Key() bool { return e.key }
+func (e ReportSubmissionResultResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteTestCaseRequestValidationError) ErrorName() string {
-	return "DeleteTestCaseRequestValidationError"
+func (e ReportSubmissionResultResponseValidationError) ErrorName() string {
+	return "ReportSubmissionResultResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteTestCaseRequestValidationError) Error() string {
+func (e ReportSubmissionResultResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3753,14 +12902,14 @@ func (e DeleteTestCaseRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteTestCaseRequest.%s: %s%s",
+		"invalid %sReportSubmissionResultResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteTestCaseRequestValidationError{}
+var _ error = ReportSubmissionResultResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3768,44 +12917,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteTestCaseRequestValidationError{}
+} = ReportSubmissionResultResponseValidationError{}
 
-// Validate checks the field values on DeleteTestCaseResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteTestCaseResponse) Validate() error {
+// Validate checks the field values on Rejudge with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Rejudge) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteTestCaseResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteTestCaseResponseMultiError, or nil if none found.
-func (m *DeleteTestCaseResponse) ValidateAll() error {
+// ValidateAll checks the field values on Rejudge with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RejudgeMultiError, or nil if none found.
+func (m *Rejudge) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteTestCaseResponse) validate(all bool) error {
+func (m *Rejudge) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for AuthorId
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for SubmissionCount
+
 	if len(errors) > 0 {
-		return DeleteTestCaseResponseMultiError(errors)
+		return RejudgeMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteTestCaseResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteTestCaseResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteTestCaseResponseMultiError []error
+// RejudgeMultiError is an error wrapping multiple validation errors returned
+// by Rejudge.ValidateAll() if the designated constraints aren't met.
+type RejudgeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteTestCaseResponseMultiError) Error() string {
+func (m RejudgeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3814,11 +12969,11 @@ func (m DeleteTestCaseResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteTestCaseResponseMultiError) AllErrors() []error { return m }
+func (m RejudgeMultiError) AllErrors() []error { return m }
 
-// DeleteTestCaseResponseValidationError is the validation error returned by
-// DeleteTestCaseResponse.Validate if the designated constraints aren't met.
-type DeleteTestCaseResponseValidationError struct {
+// RejudgeValidationError is the validation error returned by Rejudge.Validate
+// if the designated constraints aren't met.
+type RejudgeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3826,24 +12981,22 @@ type DeleteTestCaseResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteTestCaseResponseValidationError) Field() string { return e.field }
+func (e RejudgeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteTestCaseResponseValidationError) Reason() string { return e.reason }
+func (e RejudgeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteTestCaseResponseValidationError) Cause() error { return e.cause }
+func (e RejudgeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteTestCaseResponseValidationError) Key() bool { return e.key }
+func (e RejudgeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteTestCaseResponseValidationError) ErrorName() string {
-	return "DeleteTestCaseResponseValidationError"
-}
+func (e RejudgeValidationError) ErrorName() string { return "RejudgeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteTestCaseResponseValidationError) Error() string {
+func (e RejudgeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3855,14 +13008,14 @@ func (e DeleteTestCaseResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteTestCaseResponse.%s: %s%s",
+		"invalid %sRejudge.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteTestCaseResponseValidationError{}
+var _ error = RejudgeValidationError{}
 
 var _ interface {
 	Field() string
@@ -3870,50 +13023,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteTestCaseResponseValidationError{}
+} = RejudgeValidationError{}
 
-// Validate checks the field values on CreateSubmissionRequest with the rules
+// Validate checks the field values on RejudgedSubmission with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateSubmissionRequest) Validate() error {
+func (m *RejudgedSubmission) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateSubmissionRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RejudgedSubmission with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateSubmissionRequestMultiError, or nil if none found.
-func (m *CreateSubmissionRequest) ValidateAll() error {
+// RejudgedSubmissionMultiError, or nil if none found.
+func (m *RejudgedSubmission) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateSubmissionRequest) validate(all bool) error {
+func (m *RejudgedSubmission) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OfProblemId
+	// no validation rules for SubmissionId
 
-	// no validation rules for Content
+	// no validation rules for PreviousResult
 
-	// no validation rules for Language
+	// no validation rules for PreviousScore
+
+	// no validation rules for Status
+
+	// no validation rules for Result
+
+	// no validation rules for Score
+
+	// no validation rules for IsChanged
 
 	if len(errors) > 0 {
-		return CreateSubmissionRequestMultiError(errors)
+		return RejudgedSubmissionMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateSubmissionRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateSubmissionRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateSubmissionRequestMultiError []error
+// RejudgedSubmissionMultiError is an error wrapping multiple validation errors
+// returned by RejudgedSubmission.ValidateAll() if the designated constraints
+// aren't met.
+type RejudgedSubmissionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateSubmissionRequestMultiError) Error() string {
+func (m RejudgedSubmissionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3922,11 +13083,11 @@ func (m CreateSubmissionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateSubmissionRequestMultiError) AllErrors() []error { return m }
+func (m RejudgedSubmissionMultiError) AllErrors() []error { return m }
 
-// CreateSubmissionRequestValidationError is the validation error returned by
-// CreateSubmissionRequest.Validate if the designated constraints aren't met.
-type CreateSubmissionRequestValidationError struct {
+// RejudgedSubmissionValidationError is the validation error returned by
+// RejudgedSubmission.Validate if the designated constraints aren't met.
+type RejudgedSubmissionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3934,24 +13095,24 @@ type CreateSubmissionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateSubmissionRequestValidationError) Field() string { return e.field }
+func (e RejudgedSubmissionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateSubmissionRequestValidationError) Reason() string { return e.reason }
+func (e RejudgedSubmissionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateSubmissionRequestValidationError) Cause() error { return e.cause }
+func (e RejudgedSubmissionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateSubmissionRequestValidationError) Key() bool { return e.key }
+func (e RejudgedSubmissionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateSubmissionRequestValidationError) ErrorName() string {
-	return "CreateSubmissionRequestValidationError"
+func (e RejudgedSubmissionValidationError) ErrorName() string {
+	return "RejudgedSubmissionValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateSubmissionRequestValidationError) Error() string {
+func (e RejudgedSubmissionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3963,14 +13124,14 @@ func (e CreateSubmissionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateSubmissionRequest.%s: %s%s",
+		"invalid %sRejudgedSubmission.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateSubmissionRequestValidationError{}
+var _ error = RejudgedSubmissionValidationError{}
 
 var _ interface {
 	Field() string
@@ -3978,24 +13139,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateSubmissionRequestValidationError{}
+} = RejudgedSubmissionValidationError{}
 
-// Validate checks the field values on Submission with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Submission) Validate() error {
+// Validate checks the field values on RejudgeSubmissionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejudgeSubmissionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Submission with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SubmissionMultiError, or
-// nil if none found.
-func (m *Submission) ValidateAll() error {
+// ValidateAll checks the field values on RejudgeSubmissionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejudgeSubmissionRequestMultiError, or nil if none found.
+func (m *RejudgeSubmissionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Submission) validate(all bool) error {
+func (m *RejudgeSubmissionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4004,31 +13165,20 @@ func (m *Submission) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for OfProblemId
-
-	// no validation rules for AuthorId
-
-	// no validation rules for Content
-
-	// no validation rules for Language
-
-	// no validation rules for Status
-
-	// no validation rules for Result
-
 	if len(errors) > 0 {
-		return SubmissionMultiError(errors)
+		return RejudgeSubmissionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SubmissionMultiError is an error wrapping multiple validation errors
-// returned by Submission.ValidateAll() if the designated constraints aren't met.
-type SubmissionMultiError []error
+// RejudgeSubmissionRequestMultiError is an error wrapping multiple validation
+// errors returned by RejudgeSubmissionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RejudgeSubmissionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SubmissionMultiError) Error() string {
+func (m RejudgeSubmissionRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4037,11 +13187,11 @@ func (m SubmissionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SubmissionMultiError) AllErrors() []error { return m }
+func (m RejudgeSubmissionRequestMultiError) AllErrors() []error { return m }
 
-// SubmissionValidationError is the validation error returned by
-// Submission.Validate if the designated constraints aren't met.
-type SubmissionValidationError struct {
+// RejudgeSubmissionRequestValidationError is the validation error returned by
+// RejudgeSubmissionRequest.Validate if the designated constraints aren't met.
+type RejudgeSubmissionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4049,22 +13199,24 @@ type SubmissionValidationError struct {
 }
 
 // Field function returns field value.
-func (e SubmissionValidationError) Field() string { return e.field }
+func (e RejudgeSubmissionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SubmissionValidationError) Reason() string { return e.reason }
+func (e RejudgeSubmissionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SubmissionValidationError) Cause() error { return e.cause }
+func (e RejudgeSubmissionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SubmissionValidationError) Key() bool { return e.key }
+func (e RejudgeSubmissionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SubmissionValidationError) ErrorName() string { return "SubmissionValidationError" }
+func (e RejudgeSubmissionRequestValidationError) ErrorName() string {
+	return "RejudgeSubmissionRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SubmissionValidationError) Error() string {
+func (e RejudgeSubmissionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4076,14 +13228,14 @@ func (e SubmissionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSubmission.%s: %s%s",
+		"invalid %sRejudgeSubmissionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SubmissionValidationError{}
+var _ error = RejudgeSubmissionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4091,24 +13243,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SubmissionValidationError{}
+} = RejudgeSubmissionRequestValidationError{}
 
-// Validate checks the field values on CreateSubmissionResponse with the rules
+// Validate checks the field values on RejudgeSubmissionResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateSubmissionResponse) Validate() error {
+func (m *RejudgeSubmissionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateSubmissionResponse with the
+// ValidateAll checks the field values on RejudgeSubmissionResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateSubmissionResponseMultiError, or nil if none found.
-func (m *CreateSubmissionResponse) ValidateAll() error {
+// RejudgeSubmissionResponseMultiError, or nil if none found.
+func (m *RejudgeSubmissionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateSubmissionResponse) validate(all bool) error {
+func (m *RejudgeSubmissionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4116,28 +13268,28 @@ func (m *CreateSubmissionResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetSubmission()).(type) {
+		switch v := interface{}(m.GetRejudge()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateSubmissionResponseValidationError{
-					field:  "Submission",
+				errors = append(errors, RejudgeSubmissionResponseValidationError{
+					field:  "Rejudge",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateSubmissionResponseValidationError{
-					field:  "Submission",
+				errors = append(errors, RejudgeSubmissionResponseValidationError{
+					field:  "Rejudge",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSubmission()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRejudge()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateSubmissionResponseValidationError{
-				field:  "Submission",
+			return RejudgeSubmissionResponseValidationError{
+				field:  "Rejudge",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -4145,19 +13297,19 @@ func (m *CreateSubmissionResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateSubmissionResponseMultiError(errors)
+		return RejudgeSubmissionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateSubmissionResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateSubmissionResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateSubmissionResponseMultiError []error
+// RejudgeSubmissionResponseMultiError is an error wrapping multiple validation
+// errors returned by RejudgeSubmissionResponse.ValidateAll() if the
+// designated constraints aren't met.
+type RejudgeSubmissionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateSubmissionResponseMultiError) Error() string {
+func (m RejudgeSubmissionResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4166,11 +13318,11 @@ func (m CreateSubmissionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateSubmissionResponseMultiError) AllErrors() []error { return m }
+func (m RejudgeSubmissionResponseMultiError) AllErrors() []error { return m }
 
-// CreateSubmissionResponseValidationError is the validation error returned by
-// CreateSubmissionResponse.Validate if the designated constraints aren't met.
-type CreateSubmissionResponseValidationError struct {
+// RejudgeSubmissionResponseValidationError is the validation error returned by
+// RejudgeSubmissionResponse.Validate if the designated constraints aren't met.
+type RejudgeSubmissionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4178,24 +13330,24 @@ type CreateSubmissionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateSubmissionResponseValidationError) Field() string { return e.field }
+func (e RejudgeSubmissionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateSubmissionResponseValidationError) Reason() string { return e.reason }
+func (e RejudgeSubmissionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateSubmissionResponseValidationError) Cause() error { return e.cause }
+func (e RejudgeSubmissionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateSubmissionResponseValidationError) Key() bool { return e.key }
+func (e RejudgeSubmissionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateSubmissionResponseValidationError) ErrorName() string {
-	return "CreateSubmissionResponseValidationError"
+func (e RejudgeSubmissionResponseValidationError) ErrorName() string {
+	return "RejudgeSubmissionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateSubmissionResponseValidationError) Error() string {
+func (e RejudgeSubmissionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4207,14 +13359,14 @@ func (e CreateSubmissionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateSubmissionResponse.%s: %s%s",
+		"invalid %sRejudgeSubmissionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateSubmissionResponseValidationError{}
+var _ error = RejudgeSubmissionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4222,24 +13374,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateSubmissionResponseValidationError{}
+} = RejudgeSubmissionResponseValidationError{}
 
-// Validate checks the field values on GetSubmissionRequest with the rules
+// Validate checks the field values on RejudgeProblemRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetSubmissionRequest) Validate() error {
+func (m *RejudgeProblemRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSubmissionRequest with the rules
+// ValidateAll checks the field values on RejudgeProblemRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSubmissionRequestMultiError, or nil if none found.
-func (m *GetSubmissionRequest) ValidateAll() error {
+// RejudgeProblemRequestMultiError, or nil if none found.
+func (m *RejudgeProblemRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSubmissionRequest) validate(all bool) error {
+func (m *RejudgeProblemRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4249,19 +13401,19 @@ func (m *GetSubmissionRequest) validate(all bool) error {
 	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return GetSubmissionRequestMultiError(errors)
+		return RejudgeProblemRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSubmissionRequestMultiError is an error wrapping multiple validation
-// errors returned by GetSubmissionRequest.ValidateAll() if the designated
+// RejudgeProblemRequestMultiError is an error wrapping multiple validation
+// errors returned by RejudgeProblemRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetSubmissionRequestMultiError []error
+type RejudgeProblemRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSubmissionRequestMultiError) Error() string {
+func (m RejudgeProblemRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4270,11 +13422,11 @@ func (m GetSubmissionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSubmissionRequestMultiError) AllErrors() []error { return m }
+func (m RejudgeProblemRequestMultiError) AllErrors() []error { return m }
 
-// GetSubmissionRequestValidationError is the validation error returned by
-// GetSubmissionRequest.Validate if the designated constraints aren't met.
-type GetSubmissionRequestValidationError struct {
+// RejudgeProblemRequestValidationError is the validation error returned by
+// RejudgeProblemRequest.Validate if the designated constraints aren't met.
+type RejudgeProblemRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4282,24 +13434,24 @@ type GetSubmissionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSubmissionRequestValidationError) Field() string { return e.field }
+func (e RejudgeProblemRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSubmissionRequestValidationError) Reason() string { return e.reason }
+func (e RejudgeProblemRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSubmissionRequestValidationError) Cause() error { return e.cause }
+func (e RejudgeProblemRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSubmissionRequestValidationError) Key() bool { return e.key }
+func (e RejudgeProblemRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSubmissionRequestValidationError) ErrorName() string {
-	return "GetSubmissionRequestValidationError"
+func (e RejudgeProblemRequestValidationError) ErrorName() string {
+	return "RejudgeProblemRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetSubmissionRequestValidationError) Error() string {
+func (e RejudgeProblemRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4311,14 +13463,14 @@ func (e GetSubmissionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSubmissionRequest.%s: %s%s",
+		"invalid %sRejudgeProblemRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSubmissionRequestValidationError{}
+var _ error = RejudgeProblemRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4326,24 +13478,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSubmissionRequestValidationError{}
+} = RejudgeProblemRequestValidationError{}
 
-// Validate checks the field values on GetSubmissionResponse with the rules
+// Validate checks the field values on RejudgeProblemResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetSubmissionResponse) Validate() error {
+func (m *RejudgeProblemResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSubmissionResponse with the rules
+// ValidateAll checks the field values on RejudgeProblemResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSubmissionResponseMultiError, or nil if none found.
-func (m *GetSubmissionResponse) ValidateAll() error {
+// RejudgeProblemResponseMultiError, or nil if none found.
+func (m *RejudgeProblemResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSubmissionResponse) validate(all bool) error {
+func (m *RejudgeProblemResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4351,28 +13503,28 @@ func (m *GetSubmissionResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetSubmission()).(type) {
+		switch v := interface{}(m.GetRejudge()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetSubmissionResponseValidationError{
-					field:  "Submission",
+				errors = append(errors, RejudgeProblemResponseValidationError{
+					field:  "Rejudge",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetSubmissionResponseValidationError{
-					field:  "Submission",
+				errors = append(errors, RejudgeProblemResponseValidationError{
+					field:  "Rejudge",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSubmission()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRejudge()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetSubmissionResponseValidationError{
-				field:  "Submission",
+			return RejudgeProblemResponseValidationError{
+				field:  "Rejudge",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -4380,19 +13532,19 @@ func (m *GetSubmissionResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetSubmissionResponseMultiError(errors)
+		return RejudgeProblemResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSubmissionResponseMultiError is an error wrapping multiple validation
-// errors returned by GetSubmissionResponse.ValidateAll() if the designated
+// RejudgeProblemResponseMultiError is an error wrapping multiple validation
+// errors returned by RejudgeProblemResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetSubmissionResponseMultiError []error
+type RejudgeProblemResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSubmissionResponseMultiError) Error() string {
+func (m RejudgeProblemResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4401,11 +13553,11 @@ func (m GetSubmissionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSubmissionResponseMultiError) AllErrors() []error { return m }
+func (m RejudgeProblemResponseMultiError) AllErrors() []error { return m }
 
-// GetSubmissionResponseValidationError is the validation error returned by
-// GetSubmissionResponse.Validate if the designated constraints aren't met.
-type GetSubmissionResponseValidationError struct {
+// RejudgeProblemResponseValidationError is the validation error returned by
+// RejudgeProblemResponse.Validate if the designated constraints aren't met.
+type RejudgeProblemResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4413,24 +13565,24 @@ type GetSubmissionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSubmissionResponseValidationError) Field() string { return e.field }
+func (e RejudgeProblemResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSubmissionResponseValidationError) Reason() string { return e.reason }
+func (e RejudgeProblemResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSubmissionResponseValidationError) Cause() error { return e.cause }
+func (e RejudgeProblemResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSubmissionResponseValidationError) Key() bool { return e.key }
+func (e RejudgeProblemResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSubmissionResponseValidationError) ErrorName() string {
-	return "GetSubmissionResponseValidationError"
+func (e RejudgeProblemResponseValidationError) ErrorName() string {
+	return "RejudgeProblemResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetSubmissionResponseValidationError) Error() string {
+func (e RejudgeProblemResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4442,14 +13594,14 @@ func (e GetSubmissionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSubmissionResponse.%s: %s%s",
+		"invalid %sRejudgeProblemResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSubmissionResponseValidationError{}
+var _ error = RejudgeProblemResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4457,48 +13609,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSubmissionResponseValidationError{}
+} = RejudgeProblemResponseValidationError{}
 
-// Validate checks the field values on GetSubmissionListRequest with the rules
+// Validate checks the field values on RejudgeContestRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetSubmissionListRequest) Validate() error {
+func (m *RejudgeContestRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSubmissionListRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RejudgeContestRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSubmissionListRequestMultiError, or nil if none found.
-func (m *GetSubmissionListRequest) ValidateAll() error {
+// RejudgeContestRequestMultiError, or nil if none found.
+func (m *RejudgeContestRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSubmissionListRequest) validate(all bool) error {
+func (m *RejudgeContestRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Offset
-
-	// no validation rules for Limit
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return GetSubmissionListRequestMultiError(errors)
+		return RejudgeContestRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSubmissionListRequestMultiError is an error wrapping multiple validation
-// errors returned by GetSubmissionListRequest.ValidateAll() if the designated
+// RejudgeContestRequestMultiError is an error wrapping multiple validation
+// errors returned by RejudgeContestRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetSubmissionListRequestMultiError []error
+type RejudgeContestRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSubmissionListRequestMultiError) Error() string {
+func (m RejudgeContestRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4507,11 +13657,11 @@ func (m GetSubmissionListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSubmissionListRequestMultiError) AllErrors() []error { return m }
+func (m RejudgeContestRequestMultiError) AllErrors() []error { return m }
 
-// GetSubmissionListRequestValidationError is the validation error returned by
-// GetSubmissionListRequest.Validate if the designated constraints aren't met.
-type GetSubmissionListRequestValidationError struct {
+// RejudgeContestRequestValidationError is the validation error returned by
+// RejudgeContestRequest.Validate if the designated constraints aren't met.
+type RejudgeContestRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4519,24 +13669,24 @@ type GetSubmissionListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSubmissionListRequestValidationError) Field() string { return e.field }
+func (e RejudgeContestRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSubmissionListRequestValidationError) Reason() string { return e.reason }
+func (e RejudgeContestRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSubmissionListRequestValidationError) Cause() error { return e.cause }
+func (e RejudgeContestRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSubmissionListRequestValidationError) Key() bool { return e.key }
+func (e RejudgeContestRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSubmissionListRequestValidationError) ErrorName() string {
-	return "GetSubmissionListRequestValidationError"
+func (e RejudgeContestRequestValidationError) ErrorName() string {
+	return "RejudgeContestRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetSubmissionListRequestValidationError) Error() string {
+func (e RejudgeContestRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4548,14 +13698,14 @@ func (e GetSubmissionListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSubmissionListRequest.%s: %s%s",
+		"invalid %sRejudgeContestRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSubmissionListRequestValidationError{}
+var _ error = RejudgeContestRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4563,80 +13713,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSubmissionListRequestValidationError{}
+} = RejudgeContestRequestValidationError{}
 
-// Validate checks the field values on GetSubmissionListResponse with the rules
+// Validate checks the field values on RejudgeContestResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetSubmissionListResponse) Validate() error {
+func (m *RejudgeContestResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSubmissionListResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RejudgeContestResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSubmissionListResponseMultiError, or nil if none found.
-func (m *GetSubmissionListResponse) ValidateAll() error {
+// RejudgeContestResponseMultiError, or nil if none found.
+func (m *RejudgeContestResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSubmissionListResponse) validate(all bool) error {
+func (m *RejudgeContestResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetSubmissions() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetSubmissionListResponseValidationError{
-						field:  fmt.Sprintf("Submissions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetSubmissionListResponseValidationError{
-						field:  fmt.Sprintf("Submissions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetRejudge()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RejudgeContestResponseValidationError{
+					field:  "Rejudge",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return GetSubmissionListResponseValidationError{
-					field:  fmt.Sprintf("Submissions[%v]", idx),
+				errors = append(errors, RejudgeContestResponseValidationError{
+					field:  "Rejudge",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRejudge()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RejudgeContestResponseValidationError{
+				field:  "Rejudge",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for TotalSubmissionsCount
-
 	if len(errors) > 0 {
-		return GetSubmissionListResponseMultiError(errors)
+		return RejudgeContestResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSubmissionListResponseMultiError is an error wrapping multiple validation
-// errors returned by GetSubmissionListResponse.ValidateAll() if the
-// designated constraints aren't met.
-type GetSubmissionListResponseMultiError []error
+// RejudgeContestResponseMultiError is an error wrapping multiple validation
+// errors returned by RejudgeContestResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RejudgeContestResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSubmissionListResponseMultiError) Error() string {
+func (m RejudgeContestResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4645,11 +13788,11 @@ func (m GetSubmissionListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSubmissionListResponseMultiError) AllErrors() []error { return m }
+func (m RejudgeContestResponseMultiError) AllErrors() []error { return m }
 
-// GetSubmissionListResponseValidationError is the validation error returned by
-// GetSubmissionListResponse.Validate if the designated constraints aren't met.
-type GetSubmissionListResponseValidationError struct {
+// RejudgeContestResponseValidationError is the validation error returned by
+// RejudgeContestResponse.Validate if the designated constraints aren't met.
+type RejudgeContestResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4657,24 +13800,24 @@ type GetSubmissionListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSubmissionListResponseValidationError) Field() string { return e.field }
+func (e RejudgeContestResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSubmissionListResponseValidationError) Reason() string { return e.reason }
+func (e RejudgeContestResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSubmissionListResponseValidationError) Cause() error { return e.cause }
+func (e RejudgeContestResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSubmissionListResponseValidationError) Key() bool { return e.key }
+func (e RejudgeContestResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSubmissionListResponseValidationError) ErrorName() string {
-	return "GetSubmissionListResponseValidationError"
+func (e RejudgeContestResponseValidationError) ErrorName() string {
+	return "RejudgeContestResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetSubmissionListResponseValidationError) Error() string {
+func (e RejudgeContestResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4686,14 +13829,14 @@ func (e GetSubmissionListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSubmissionListResponse.%s: %s%s",
+		"invalid %sRejudgeContestResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSubmissionListResponseValidationError{}
+var _ error = RejudgeContestResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4701,24 +13844,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSubmissionListResponseValidationError{}
+} = RejudgeContestResponseValidationError{}
 
-// Validate checks the field values on GetProblemSubmissionListRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetRejudgeReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetProblemSubmissionListRequest) Validate() error {
+func (m *GetRejudgeReportRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProblemSubmissionListRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetProblemSubmissionListRequestMultiError, or nil if none found.
-func (m *GetProblemSubmissionListRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetRejudgeReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRejudgeReportRequestMultiError, or nil if none found.
+func (m *GetRejudgeReportRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProblemSubmissionListRequest) validate(all bool) error {
+func (m *GetRejudgeReportRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4727,24 +13870,20 @@ func (m *GetProblemSubmissionListRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Offset
-
-	// no validation rules for Limit
-
 	if len(errors) > 0 {
-		return GetProblemSubmissionListRequestMultiError(errors)
+		return GetRejudgeReportRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProblemSubmissionListRequestMultiError is an error wrapping multiple
-// validation errors returned by GetProblemSubmissionListRequest.ValidateAll()
-// if the designated constraints aren't met.
-type GetProblemSubmissionListRequestMultiError []error
+// GetRejudgeReportRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRejudgeReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRejudgeReportRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProblemSubmissionListRequestMultiError) Error() string {
+func (m GetRejudgeReportRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4753,12 +13892,11 @@ func (m GetProblemSubmissionListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProblemSubmissionListRequestMultiError) AllErrors() []error { return m }
+func (m GetRejudgeReportRequestMultiError) AllErrors() []error { return m }
 
-// GetProblemSubmissionListRequestValidationError is the validation error
-// returned by GetProblemSubmissionListRequest.Validate if the designated
-// constraints aren't met.
-type GetProblemSubmissionListRequestValidationError struct {
+// GetRejudgeReportRequestValidationError is the validation error returned by
+// GetRejudgeReportRequest.Validate if the designated constraints aren't met.
+type GetRejudgeReportRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4766,24 +13904,24 @@ type GetProblemSubmissionListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProblemSubmissionListRequestValidationError) Field() string { return e.field }
+func (e GetRejudgeReportRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProblemSubmissionListRequestValidationError) Reason() string { return e.reason }
+func (e GetRejudgeReportRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProblemSubmissionListRequestValidationError) Cause() error { return e.cause }
+func (e GetRejudgeReportRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProblemSubmissionListRequestValidationError) Key() bool { return e.key }
+func (e GetRejudgeReportRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProblemSubmissionListRequestValidationError) ErrorName() string {
-	return "GetProblemSubmissionListRequestValidationError"
+func (e GetRejudgeReportRequestValidationError) ErrorName() string {
+	return "GetRejudgeReportRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetProblemSubmissionListRequestValidationError) Error() string {
+func (e GetRejudgeReportRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4795,14 +13933,14 @@ func (e GetProblemSubmissionListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProblemSubmissionListRequest.%s: %s%s",
+		"invalid %sGetRejudgeReportRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProblemSubmissionListRequestValidationError{}
+var _ error = GetRejudgeReportRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4810,48 +13948,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProblemSubmissionListRequestValidationError{}
+} = GetRejudgeReportRequestValidationError{}
 
-// Validate checks the field values on GetProblemSubmissionListResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *GetProblemSubmissionListResponse) Validate() error {
+// Validate checks the field values on GetRejudgeReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRejudgeReportResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetProblemSubmissionListResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetProblemSubmissionListResponseMultiError, or nil if none found.
-func (m *GetProblemSubmissionListResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetRejudgeReportResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRejudgeReportResponseMultiError, or nil if none found.
+func (m *GetRejudgeReportResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetProblemSubmissionListResponse) validate(all bool) error {
+func (m *GetRejudgeReportResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetSubmissions() {
+	if all {
+		switch v := interface{}(m.GetRejudge()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRejudgeReportResponseValidationError{
+					field:  "Rejudge",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRejudgeReportResponseValidationError{
+					field:  "Rejudge",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRejudge()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRejudgeReportResponseValidationError{
+				field:  "Rejudge",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetRejudgedSubmissions() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetProblemSubmissionListResponseValidationError{
-						field:  fmt.Sprintf("Submissions[%v]", idx),
+					errors = append(errors, GetRejudgeReportResponseValidationError{
+						field:  fmt.Sprintf("RejudgedSubmissions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetProblemSubmissionListResponseValidationError{
-						field:  fmt.Sprintf("Submissions[%v]", idx),
+					errors = append(errors, GetRejudgeReportResponseValidationError{
+						field:  fmt.Sprintf("RejudgedSubmissions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4859,8 +14025,8 @@ func (m *GetProblemSubmissionListResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetProblemSubmissionListResponseValidationError{
-					field:  fmt.Sprintf("Submissions[%v]", idx),
+				return GetRejudgeReportResponseValidationError{
+					field:  fmt.Sprintf("RejudgedSubmissions[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -4869,23 +14035,136 @@ func (m *GetProblemSubmissionListResponse) validate(all bool) error {
 
 	}
 
-	// no validation rules for TotalSubmissionsCount
+	// no validation rules for PendingSubmissionCount
+
+	// no validation rules for ChangedSubmissionCount
+
+	if len(errors) > 0 {
+		return GetRejudgeReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRejudgeReportResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRejudgeReportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetRejudgeReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRejudgeReportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRejudgeReportResponseMultiError) AllErrors() []error { return m }
+
+// GetRejudgeReportResponseValidationError is the validation error returned by
+// GetRejudgeReportResponse.Validate if the designated constraints aren't met.
+type GetRejudgeReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRejudgeReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRejudgeReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRejudgeReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRejudgeReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRejudgeReportResponseValidationError) ErrorName() string {
+	return "GetRejudgeReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRejudgeReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRejudgeReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRejudgeReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRejudgeReportResponseValidationError{}
+
+// Validate checks the field values on DeadLetteredSubmission with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeadLetteredSubmission) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeadLetteredSubmission with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeadLetteredSubmissionMultiError, or nil if none found.
+func (m *DeadLetteredSubmission) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeadLetteredSubmission) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OfSubmissionId
+
+	// no validation rules for Error
+
+	// no validation rules for AttemptCount
+
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return GetProblemSubmissionListResponseMultiError(errors)
+		return DeadLetteredSubmissionMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetProblemSubmissionListResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// GetProblemSubmissionListResponse.ValidateAll() if the designated
+// DeadLetteredSubmissionMultiError is an error wrapping multiple validation
+// errors returned by DeadLetteredSubmission.ValidateAll() if the designated
 // constraints aren't met.
-type GetProblemSubmissionListResponseMultiError []error
+type DeadLetteredSubmissionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetProblemSubmissionListResponseMultiError) Error() string {
+func (m DeadLetteredSubmissionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4894,12 +14173,11 @@ func (m GetProblemSubmissionListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetProblemSubmissionListResponseMultiError) AllErrors() []error { return m }
+func (m DeadLetteredSubmissionMultiError) AllErrors() []error { return m }
 
-// GetProblemSubmissionListResponseValidationError is the validation error
-// returned by GetProblemSubmissionListResponse.Validate if the designated
-// constraints aren't met.
-type GetProblemSubmissionListResponseValidationError struct {
+// DeadLetteredSubmissionValidationError is the validation error returned by
+// DeadLetteredSubmission.Validate if the designated constraints aren't met.
+type DeadLetteredSubmissionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4907,24 +14185,24 @@ type GetProblemSubmissionListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetProblemSubmissionListResponseValidationError) Field() string { return e.field }
+func (e DeadLetteredSubmissionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetProblemSubmissionListResponseValidationError) Reason() string { return e.reason }
+func (e DeadLetteredSubmissionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetProblemSubmissionListResponseValidationError) Cause() error { return e.cause }
+func (e DeadLetteredSubmissionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetProblemSubmissionListResponseValidationError) Key() bool { return e.key }
+func (e DeadLetteredSubmissionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetProblemSubmissionListResponseValidationError) ErrorName() string {
-	return "GetProblemSubmissionListResponseValidationError"
+func (e DeadLetteredSubmissionValidationError) ErrorName() string {
+	return "DeadLetteredSubmissionValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetProblemSubmissionListResponseValidationError) Error() string {
+func (e DeadLetteredSubmissionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4936,14 +14214,14 @@ func (e GetProblemSubmissionListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetProblemSubmissionListResponse.%s: %s%s",
+		"invalid %sDeadLetteredSubmission.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetProblemSubmissionListResponseValidationError{}
+var _ error = DeadLetteredSubmissionValidationError{}
 
 var _ interface {
 	Field() string
@@ -4951,55 +14229,59 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetProblemSubmissionListResponseValidationError{}
+} = DeadLetteredSubmissionValidationError{}
 
-// Validate checks the field values on GetAccountProblemSubmissionListRequest
+// Validate checks the field values on GetDeadLetteredSubmissionListRequest
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the first error encountered is returned, or nil if
 // there are no violations.
-func (m *GetAccountProblemSubmissionListRequest) Validate() error {
+func (m *GetDeadLetteredSubmissionListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// GetAccountProblemSubmissionListRequest with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// GetAccountProblemSubmissionListRequestMultiError, or nil if none found.
-func (m *GetAccountProblemSubmissionListRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetDeadLetteredSubmissionListRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetDeadLetteredSubmissionListRequestMultiError, or nil if none found.
+func (m *GetDeadLetteredSubmissionListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAccountProblemSubmissionListRequest) validate(all bool) error {
+func (m *GetDeadLetteredSubmissionListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AccountId
-
-	// no validation rules for ProblemId
-
 	// no validation rules for Offset
 
-	// no validation rules for Limit
+	if m.GetLimit() > 100 {
+		err := GetDeadLetteredSubmissionListRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetAccountProblemSubmissionListRequestMultiError(errors)
+		return GetDeadLetteredSubmissionListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAccountProblemSubmissionListRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// GetAccountProblemSubmissionListRequest.ValidateAll() if the designated
+// GetDeadLetteredSubmissionListRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetDeadLetteredSubmissionListRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetAccountProblemSubmissionListRequestMultiError []error
+type GetDeadLetteredSubmissionListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAccountProblemSubmissionListRequestMultiError) Error() string {
+func (m GetDeadLetteredSubmissionListRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5008,12 +14290,12 @@ func (m GetAccountProblemSubmissionListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAccountProblemSubmissionListRequestMultiError) AllErrors() []error { return m }
+func (m GetDeadLetteredSubmissionListRequestMultiError) AllErrors() []error { return m }
 
-// GetAccountProblemSubmissionListRequestValidationError is the validation
-// error returned by GetAccountProblemSubmissionListRequest.Validate if the
-// designated constraints aren't met.
-type GetAccountProblemSubmissionListRequestValidationError struct {
+// GetDeadLetteredSubmissionListRequestValidationError is the validation error
+// returned by GetDeadLetteredSubmissionListRequest.Validate if the designated
+// constraints aren't met.
+type GetDeadLetteredSubmissionListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5021,24 +14303,24 @@ type GetAccountProblemSubmissionListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAccountProblemSubmissionListRequestValidationError) Field() string { return e.field }
+func (e GetDeadLetteredSubmissionListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAccountProblemSubmissionListRequestValidationError) Reason() string { return e.reason }
+func (e GetDeadLetteredSubmissionListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAccountProblemSubmissionListRequestValidationError) Cause() error { return e.cause }
+func (e GetDeadLetteredSubmissionListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAccountProblemSubmissionListRequestValidationError) Key() bool { return e.key }
+func (e GetDeadLetteredSubmissionListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAccountProblemSubmissionListRequestValidationError) ErrorName() string {
-	return "GetAccountProblemSubmissionListRequestValidationError"
+func (e GetDeadLetteredSubmissionListRequestValidationError) ErrorName() string {
+	return "GetDeadLetteredSubmissionListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAccountProblemSubmissionListRequestValidationError) Error() string {
+func (e GetDeadLetteredSubmissionListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5050,14 +14332,14 @@ func (e GetAccountProblemSubmissionListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAccountProblemSubmissionListRequest.%s: %s%s",
+		"invalid %sGetDeadLetteredSubmissionListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAccountProblemSubmissionListRequestValidationError{}
+var _ error = GetDeadLetteredSubmissionListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5065,49 +14347,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAccountProblemSubmissionListRequestValidationError{}
+} = GetDeadLetteredSubmissionListRequestValidationError{}
 
-// Validate checks the field values on GetAccountProblemSubmissionListResponse
+// Validate checks the field values on GetDeadLetteredSubmissionListResponse
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the first error encountered is returned, or nil if
 // there are no violations.
-func (m *GetAccountProblemSubmissionListResponse) Validate() error {
+func (m *GetDeadLetteredSubmissionListResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// GetAccountProblemSubmissionListResponse with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// GetAccountProblemSubmissionListResponseMultiError, or nil if none found.
-func (m *GetAccountProblemSubmissionListResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetDeadLetteredSubmissionListResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetDeadLetteredSubmissionListResponseMultiError, or nil if none found.
+func (m *GetDeadLetteredSubmissionListResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAccountProblemSubmissionListResponse) validate(all bool) error {
+func (m *GetDeadLetteredSubmissionListResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetSubmissions() {
+	for idx, item := range m.GetDeadLetteredSubmissions() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAccountProblemSubmissionListResponseValidationError{
-						field:  fmt.Sprintf("Submissions[%v]", idx),
+					errors = append(errors, GetDeadLetteredSubmissionListResponseValidationError{
+						field:  fmt.Sprintf("DeadLetteredSubmissions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAccountProblemSubmissionListResponseValidationError{
-						field:  fmt.Sprintf("Submissions[%v]", idx),
+					errors = append(errors, GetDeadLetteredSubmissionListResponseValidationError{
+						field:  fmt.Sprintf("DeadLetteredSubmissions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -5115,8 +14396,8 @@ func (m *GetAccountProblemSubmissionListResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetAccountProblemSubmissionListResponseValidationError{
-					field:  fmt.Sprintf("Submissions[%v]", idx),
+				return GetDeadLetteredSubmissionListResponseValidationError{
+					field:  fmt.Sprintf("DeadLetteredSubmissions[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -5125,23 +14406,23 @@ func (m *GetAccountProblemSubmissionListResponse) validate(all bool) error {
 
 	}
 
-	// no validation rules for TotalSubmissionsCount
+	// no validation rules for TotalDeadLetteredSubmissionCount
 
 	if len(errors) > 0 {
-		return GetAccountProblemSubmissionListResponseMultiError(errors)
+		return GetDeadLetteredSubmissionListResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAccountProblemSubmissionListResponseMultiError is an error wrapping
+// GetDeadLetteredSubmissionListResponseMultiError is an error wrapping
 // multiple validation errors returned by
-// GetAccountProblemSubmissionListResponse.ValidateAll() if the designated
+// GetDeadLetteredSubmissionListResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetAccountProblemSubmissionListResponseMultiError []error
+type GetDeadLetteredSubmissionListResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAccountProblemSubmissionListResponseMultiError) Error() string {
+func (m GetDeadLetteredSubmissionListResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5150,12 +14431,12 @@ func (m GetAccountProblemSubmissionListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAccountProblemSubmissionListResponseMultiError) AllErrors() []error { return m }
+func (m GetDeadLetteredSubmissionListResponseMultiError) AllErrors() []error { return m }
 
-// GetAccountProblemSubmissionListResponseValidationError is the validation
-// error returned by GetAccountProblemSubmissionListResponse.Validate if the
+// GetDeadLetteredSubmissionListResponseValidationError is the validation error
+// returned by GetDeadLetteredSubmissionListResponse.Validate if the
 // designated constraints aren't met.
-type GetAccountProblemSubmissionListResponseValidationError struct {
+type GetDeadLetteredSubmissionListResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5163,24 +14444,24 @@ type GetAccountProblemSubmissionListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAccountProblemSubmissionListResponseValidationError) Field() string { return e.field }
+func (e GetDeadLetteredSubmissionListResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAccountProblemSubmissionListResponseValidationError) Reason() string { return e.reason }
+func (e GetDeadLetteredSubmissionListResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAccountProblemSubmissionListResponseValidationError) Cause() error { return e.cause }
+func (e GetDeadLetteredSubmissionListResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAccountProblemSubmissionListResponseValidationError) Key() bool { return e.key }
+func (e GetDeadLetteredSubmissionListResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAccountProblemSubmissionListResponseValidationError) ErrorName() string {
-	return "GetAccountProblemSubmissionListResponseValidationError"
+func (e GetDeadLetteredSubmissionListResponseValidationError) ErrorName() string {
+	return "GetDeadLetteredSubmissionListResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAccountProblemSubmissionListResponseValidationError) Error() string {
+func (e GetDeadLetteredSubmissionListResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5192,14 +14473,14 @@ func (e GetAccountProblemSubmissionListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAccountProblemSubmissionListResponse.%s: %s%s",
+		"invalid %sGetDeadLetteredSubmissionListResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAccountProblemSubmissionListResponseValidationError{}
+var _ error = GetDeadLetteredSubmissionListResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5207,48 +14488,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAccountProblemSubmissionListResponseValidationError{}
+} = GetDeadLetteredSubmissionListResponseValidationError{}
 
-// Validate checks the field values on
-// GetAndUpdateFirstSubmittedSubmissionToExecutingRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAndUpdateFirstSubmittedSubmissionToExecutingRequest) Validate() error {
+// Validate checks the field values on ReplayDeadLetteredSubmissionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ReplayDeadLetteredSubmissionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// GetAndUpdateFirstSubmittedSubmissionToExecutingRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetAndUpdateFirstSubmittedSubmissionToExecutingRequestMultiError, or nil if
-// none found.
-func (m *GetAndUpdateFirstSubmittedSubmissionToExecutingRequest) ValidateAll() error {
+// ValidateAll checks the field values on ReplayDeadLetteredSubmissionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ReplayDeadLetteredSubmissionRequestMultiError, or nil if none found.
+func (m *ReplayDeadLetteredSubmissionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAndUpdateFirstSubmittedSubmissionToExecutingRequest) validate(all bool) error {
+func (m *ReplayDeadLetteredSubmissionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
 	if len(errors) > 0 {
-		return GetAndUpdateFirstSubmittedSubmissionToExecutingRequestMultiError(errors)
+		return ReplayDeadLetteredSubmissionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAndUpdateFirstSubmittedSubmissionToExecutingRequestMultiError is an error
-// wrapping multiple validation errors returned by
-// GetAndUpdateFirstSubmittedSubmissionToExecutingRequest.ValidateAll() if the
-// designated constraints aren't met.
-type GetAndUpdateFirstSubmittedSubmissionToExecutingRequestMultiError []error
+// ReplayDeadLetteredSubmissionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ReplayDeadLetteredSubmissionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReplayDeadLetteredSubmissionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAndUpdateFirstSubmittedSubmissionToExecutingRequestMultiError) Error() string {
+func (m ReplayDeadLetteredSubmissionRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5257,15 +14538,12 @@ func (m GetAndUpdateFirstSubmittedSubmissionToExecutingRequestMultiError) Error(
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAndUpdateFirstSubmittedSubmissionToExecutingRequestMultiError) AllErrors() []error {
-	return m
-}
+func (m ReplayDeadLetteredSubmissionRequestMultiError) AllErrors() []error { return m }
 
-// GetAndUpdateFirstSubmittedSubmissionToExecutingRequestValidationError is the
-// validation error returned by
-// GetAndUpdateFirstSubmittedSubmissionToExecutingRequest.Validate if the
-// designated constraints aren't met.
-type GetAndUpdateFirstSubmittedSubmissionToExecutingRequestValidationError struct {
+// ReplayDeadLetteredSubmissionRequestValidationError is the validation error
+// returned by ReplayDeadLetteredSubmissionRequest.Validate if the designated
+// constraints aren't met.
+type ReplayDeadLetteredSubmissionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5273,32 +14551,24 @@ type GetAndUpdateFirstSubmittedSubmissionToExecutingRequestValidationError struc
 }
 
 // Field function returns field value.
-func (e GetAndUpdateFirstSubmittedSubmissionToExecutingRequestValidationError) Field() string {
-	return e.field
-}
+func (e ReplayDeadLetteredSubmissionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAndUpdateFirstSubmittedSubmissionToExecutingRequestValidationError) Reason() string {
-	return e.reason
-}
+func (e ReplayDeadLetteredSubmissionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAndUpdateFirstSubmittedSubmissionToExecutingRequestValidationError) Cause() error {
-	return e.cause
-}
+func (e ReplayDeadLetteredSubmissionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAndUpdateFirstSubmittedSubmissionToExecutingRequestValidationError) Key() bool {
-	return e.key
-}
+func (e ReplayDeadLetteredSubmissionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAndUpdateFirstSubmittedSubmissionToExecutingRequestValidationError) ErrorName() string {
-	return "GetAndUpdateFirstSubmittedSubmissionToExecutingRequestValidationError"
+func (e ReplayDeadLetteredSubmissionRequestValidationError) ErrorName() string {
+	return "ReplayDeadLetteredSubmissionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAndUpdateFirstSubmittedSubmissionToExecutingRequestValidationError) Error() string {
+func (e ReplayDeadLetteredSubmissionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5310,14 +14580,14 @@ func (e GetAndUpdateFirstSubmittedSubmissionToExecutingRequestValidationError) E
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAndUpdateFirstSubmittedSubmissionToExecutingRequest.%s: %s%s",
+		"invalid %sReplayDeadLetteredSubmissionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAndUpdateFirstSubmittedSubmissionToExecutingRequestValidationError{}
+var _ error = ReplayDeadLetteredSubmissionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5325,48 +14595,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAndUpdateFirstSubmittedSubmissionToExecutingRequestValidationError{}
+} = ReplayDeadLetteredSubmissionRequestValidationError{}
 
-// Validate checks the field values on
-// GetAndUpdateFirstSubmittedSubmissionToExecutingResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAndUpdateFirstSubmittedSubmissionToExecutingResponse) Validate() error {
+// Validate checks the field values on ReplayDeadLetteredSubmissionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *ReplayDeadLetteredSubmissionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// GetAndUpdateFirstSubmittedSubmissionToExecutingResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetAndUpdateFirstSubmittedSubmissionToExecutingResponseMultiError, or nil
-// if none found.
-func (m *GetAndUpdateFirstSubmittedSubmissionToExecutingResponse) ValidateAll() error {
+// ValidateAll checks the field values on ReplayDeadLetteredSubmissionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ReplayDeadLetteredSubmissionResponseMultiError, or nil if none found.
+func (m *ReplayDeadLetteredSubmissionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAndUpdateFirstSubmittedSubmissionToExecutingResponse) validate(all bool) error {
+func (m *ReplayDeadLetteredSubmissionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetDeadLetteredSubmission()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReplayDeadLetteredSubmissionResponseValidationError{
+					field:  "DeadLetteredSubmission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReplayDeadLetteredSubmissionResponseValidationError{
+					field:  "DeadLetteredSubmission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeadLetteredSubmission()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReplayDeadLetteredSubmissionResponseValidationError{
+				field:  "DeadLetteredSubmission",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IsReplayed
+
 	if len(errors) > 0 {
-		return GetAndUpdateFirstSubmittedSubmissionToExecutingResponseMultiError(errors)
+		return ReplayDeadLetteredSubmissionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAndUpdateFirstSubmittedSubmissionToExecutingResponseMultiError is an
-// error wrapping multiple validation errors returned by
-// GetAndUpdateFirstSubmittedSubmissionToExecutingResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetAndUpdateFirstSubmittedSubmissionToExecutingResponseMultiError []error
+// ReplayDeadLetteredSubmissionResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ReplayDeadLetteredSubmissionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReplayDeadLetteredSubmissionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAndUpdateFirstSubmittedSubmissionToExecutingResponseMultiError) Error() string {
+func (m ReplayDeadLetteredSubmissionResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5375,15 +14674,12 @@ func (m GetAndUpdateFirstSubmittedSubmissionToExecutingResponseMultiError) Error
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAndUpdateFirstSubmittedSubmissionToExecutingResponseMultiError) AllErrors() []error {
-	return m
-}
+func (m ReplayDeadLetteredSubmissionResponseMultiError) AllErrors() []error { return m }
 
-// GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError is
-// the validation error returned by
-// GetAndUpdateFirstSubmittedSubmissionToExecutingResponse.Validate if the
-// designated constraints aren't met.
-type GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError struct {
+// ReplayDeadLetteredSubmissionResponseValidationError is the validation error
+// returned by ReplayDeadLetteredSubmissionResponse.Validate if the designated
+// constraints aren't met.
+type ReplayDeadLetteredSubmissionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5391,32 +14687,24 @@ type GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError stru
 }
 
 // Field function returns field value.
-func (e GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError) Field() string {
-	return e.field
-}
+func (e ReplayDeadLetteredSubmissionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError) Reason() string {
-	return e.reason
-}
+func (e ReplayDeadLetteredSubmissionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError) Cause() error {
-	return e.cause
-}
+func (e ReplayDeadLetteredSubmissionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError) Key() bool {
-	return e.key
-}
+func (e ReplayDeadLetteredSubmissionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError) ErrorName() string {
-	return "GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError"
+func (e ReplayDeadLetteredSubmissionResponseValidationError) ErrorName() string {
+	return "ReplayDeadLetteredSubmissionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError) Error() string {
+func (e ReplayDeadLetteredSubmissionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5428,14 +14716,14 @@ func (e GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError)
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAndUpdateFirstSubmittedSubmissionToExecutingResponse.%s: %s%s",
+		"invalid %sReplayDeadLetteredSubmissionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError{}
+var _ error = ReplayDeadLetteredSubmissionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5443,7 +14731,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAndUpdateFirstSubmittedSubmissionToExecutingResponseValidationError{}
+} = ReplayDeadLetteredSubmissionResponseValidationError{}
 
 // Validate checks the field values on UpdateSettingRequest with the rules
 // defined in the proto definition for this message. If any rules are
@@ -22,24 +22,56 @@ const (
 	OjsService_GetServerInfo_FullMethodName                                   = "/ojs.OjsService/GetServerInfo"
 	OjsService_CreateAccount_FullMethodName                                   = "/ojs.OjsService/CreateAccount"
 	OjsService_GetAccount_FullMethodName                                      = "/ojs.OjsService/GetAccount"
+	OjsService_GetAccountList_FullMethodName                                  = "/ojs.OjsService/GetAccountList"
+	OjsService_UpdateAccount_FullMethodName                                   = "/ojs.OjsService/UpdateAccount"
+	OjsService_ChangePassword_FullMethodName                                  = "/ojs.OjsService/ChangePassword"
+	OjsService_SetAccountRole_FullMethodName                                  = "/ojs.OjsService/SetAccountRole"
+	OjsService_DisableAccount_FullMethodName                                  = "/ojs.OjsService/DisableAccount"
 	OjsService_CreateSession_FullMethodName                                   = "/ojs.OjsService/CreateSession"
 	OjsService_DeleteSession_FullMethodName                                   = "/ojs.OjsService/DeleteSession"
+	OjsService_RefreshSession_FullMethodName                                  = "/ojs.OjsService/RefreshSession"
+	OjsService_DeleteAllSessions_FullMethodName                               = "/ojs.OjsService/DeleteAllSessions"
+	OjsService_GetJSONWebKeySet_FullMethodName                                = "/ojs.OjsService/GetJSONWebKeySet"
 	OjsService_CreateProblem_FullMethodName                                   = "/ojs.OjsService/CreateProblem"
 	OjsService_GetProblemList_FullMethodName                                  = "/ojs.OjsService/GetProblemList"
 	OjsService_GetProblem_FullMethodName                                      = "/ojs.OjsService/GetProblem"
 	OjsService_UpdateProblem_FullMethodName                                   = "/ojs.OjsService/UpdateProblem"
 	OjsService_DeleteProblem_FullMethodName                                   = "/ojs.OjsService/DeleteProblem"
+	OjsService_UpdateProblemChecker_FullMethodName                            = "/ojs.OjsService/UpdateProblemChecker"
+	OjsService_UpdateProblemInteractor_FullMethodName                         = "/ojs.OjsService/UpdateProblemInteractor"
 	OjsService_CreateTestCase_FullMethodName                                  = "/ojs.OjsService/CreateTestCase"
 	OjsService_GetProblemTestCaseList_FullMethodName                          = "/ojs.OjsService/GetProblemTestCaseList"
 	OjsService_GetTestCase_FullMethodName                                     = "/ojs.OjsService/GetTestCase"
 	OjsService_UpdateTestCase_FullMethodName                                  = "/ojs.OjsService/UpdateTestCase"
 	OjsService_DeleteTestCase_FullMethodName                                  = "/ojs.OjsService/DeleteTestCase"
+	OjsService_CreateTestCaseGroup_FullMethodName                             = "/ojs.OjsService/CreateTestCaseGroup"
+	OjsService_GetProblemTestCaseGroupList_FullMethodName                     = "/ojs.OjsService/GetProblemTestCaseGroupList"
+	OjsService_UpdateTestCaseGroup_FullMethodName                             = "/ojs.OjsService/UpdateTestCaseGroup"
+	OjsService_DeleteTestCaseGroup_FullMethodName                             = "/ojs.OjsService/DeleteTestCaseGroup"
 	OjsService_CreateSubmission_FullMethodName                                = "/ojs.OjsService/CreateSubmission"
 	OjsService_GetSubmission_FullMethodName                                   = "/ojs.OjsService/GetSubmission"
 	OjsService_GetSubmissionList_FullMethodName                               = "/ojs.OjsService/GetSubmissionList"
+	OjsService_GetSubmissionTestCaseResultList_FullMethodName                 = "/ojs.OjsService/GetSubmissionTestCaseResultList"
+	OjsService_WatchSubmission_FullMethodName                                 = "/ojs.OjsService/WatchSubmission"
 	OjsService_GetProblemSubmissionList_FullMethodName                        = "/ojs.OjsService/GetProblemSubmissionList"
 	OjsService_GetAccountProblemSubmissionList_FullMethodName                 = "/ojs.OjsService/GetAccountProblemSubmissionList"
+	OjsService_CreateContest_FullMethodName                                   = "/ojs.OjsService/CreateContest"
+	OjsService_GetContestList_FullMethodName                                  = "/ojs.OjsService/GetContestList"
+	OjsService_GetContest_FullMethodName                                      = "/ojs.OjsService/GetContest"
+	OjsService_UpdateContest_FullMethodName                                   = "/ojs.OjsService/UpdateContest"
+	OjsService_DeleteContest_FullMethodName                                   = "/ojs.OjsService/DeleteContest"
+	OjsService_RegisterContest_FullMethodName                                 = "/ojs.OjsService/RegisterContest"
+	OjsService_UnregisterContest_FullMethodName                               = "/ojs.OjsService/UnregisterContest"
+	OjsService_GetContestScoreboard_FullMethodName                            = "/ojs.OjsService/GetContestScoreboard"
 	OjsService_GetAndUpdateFirstSubmittedSubmissionToExecuting_FullMethodName = "/ojs.OjsService/GetAndUpdateFirstSubmittedSubmissionToExecuting"
+	OjsService_RenewSubmissionJudgingLease_FullMethodName                     = "/ojs.OjsService/RenewSubmissionJudgingLease"
+	OjsService_ReportSubmissionResult_FullMethodName                          = "/ojs.OjsService/ReportSubmissionResult"
+	OjsService_RejudgeSubmission_FullMethodName                               = "/ojs.OjsService/RejudgeSubmission"
+	OjsService_RejudgeProblem_FullMethodName                                  = "/ojs.OjsService/RejudgeProblem"
+	OjsService_RejudgeContest_FullMethodName                                  = "/ojs.OjsService/RejudgeContest"
+	OjsService_GetRejudgeReport_FullMethodName                                = "/ojs.OjsService/GetRejudgeReport"
+	OjsService_GetDeadLetteredSubmissionList_FullMethodName                   = "/ojs.OjsService/GetDeadLetteredSubmissionList"
+	OjsService_ReplayDeadLetteredSubmission_FullMethodName                    = "/ojs.OjsService/ReplayDeadLetteredSubmission"
 	OjsService_UpdateSetting_FullMethodName                                   = "/ojs.OjsService/UpdateSetting"
 )
 
@@ -50,24 +82,56 @@ type OjsServiceClient interface {
 	GetServerInfo(ctx context.Context, in *GetServerInfoRequest, opts ...grpc.CallOption) (*GetServerInfoResponse, error)
 	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
 	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error)
+	GetAccountList(ctx context.Context, in *GetAccountListRequest, opts ...grpc.CallOption) (*GetAccountListResponse, error)
+	UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*UpdateAccountResponse, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	SetAccountRole(ctx context.Context, in *SetAccountRoleRequest, opts ...grpc.CallOption) (*SetAccountRoleResponse, error)
+	DisableAccount(ctx context.Context, in *DisableAccountRequest, opts ...grpc.CallOption) (*DisableAccountResponse, error)
 	CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*CreateSessionResponse, error)
 	DeleteSession(ctx context.Context, in *DeleteSessionRequest, opts ...grpc.CallOption) (*DeleteSessionResponse, error)
+	RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*RefreshSessionResponse, error)
+	DeleteAllSessions(ctx context.Context, in *DeleteAllSessionsRequest, opts ...grpc.CallOption) (*DeleteAllSessionsResponse, error)
+	GetJSONWebKeySet(ctx context.Context, in *GetJSONWebKeySetRequest, opts ...grpc.CallOption) (*GetJSONWebKeySetResponse, error)
 	CreateProblem(ctx context.Context, in *CreateProblemRequest, opts ...grpc.CallOption) (*CreateProblemResponse, error)
 	GetProblemList(ctx context.Context, in *GetProblemListRequest, opts ...grpc.CallOption) (*GetProblemListResponse, error)
 	GetProblem(ctx context.Context, in *GetProblemRequest, opts ...grpc.CallOption) (*GetProblemResponse, error)
 	UpdateProblem(ctx context.Context, in *UpdateProblemRequest, opts ...grpc.CallOption) (*UpdateProblemResponse, error)
 	DeleteProblem(ctx context.Context, in *DeleteProblemRequest, opts ...grpc.CallOption) (*DeleteProblemResponse, error)
+	UpdateProblemChecker(ctx context.Context, in *UpdateProblemCheckerRequest, opts ...grpc.CallOption) (*UpdateProblemCheckerResponse, error)
+	UpdateProblemInteractor(ctx context.Context, in *UpdateProblemInteractorRequest, opts ...grpc.CallOption) (*UpdateProblemInteractorResponse, error)
 	CreateTestCase(ctx context.Context, in *CreateTestCaseRequest, opts ...grpc.CallOption) (*CreateTestCaseResponse, error)
 	GetProblemTestCaseList(ctx context.Context, in *GetProblemTestCaseListRequest, opts ...grpc.CallOption) (*GetProblemTestCaseListResponse, error)
 	GetTestCase(ctx context.Context, in *GetTestCaseRequest, opts ...grpc.CallOption) (*GetTestCaseResponse, error)
 	UpdateTestCase(ctx context.Context, in *UpdateTestCaseRequest, opts ...grpc.CallOption) (*UpdateTestCaseResponse, error)
 	DeleteTestCase(ctx context.Context, in *DeleteTestCaseRequest, opts ...grpc.CallOption) (*DeleteTestCaseResponse, error)
+	CreateTestCaseGroup(ctx context.Context, in *CreateTestCaseGroupRequest, opts ...grpc.CallOption) (*CreateTestCaseGroupResponse, error)
+	GetProblemTestCaseGroupList(ctx context.Context, in *GetProblemTestCaseGroupListRequest, opts ...grpc.CallOption) (*GetProblemTestCaseGroupListResponse, error)
+	UpdateTestCaseGroup(ctx context.Context, in *UpdateTestCaseGroupRequest, opts ...grpc.CallOption) (*UpdateTestCaseGroupResponse, error)
+	DeleteTestCaseGroup(ctx context.Context, in *DeleteTestCaseGroupRequest, opts ...grpc.CallOption) (*DeleteTestCaseGroupResponse, error)
 	CreateSubmission(ctx context.Context, in *CreateSubmissionRequest, opts ...grpc.CallOption) (*CreateSubmissionResponse, error)
 	GetSubmission(ctx context.Context, in *GetSubmissionRequest, opts ...grpc.CallOption) (*GetSubmissionResponse, error)
 	GetSubmissionList(ctx context.Context, in *GetSubmissionListRequest, opts ...grpc.CallOption) (*GetSubmissionListResponse, error)
+	GetSubmissionTestCaseResultList(ctx context.Context, in *GetSubmissionTestCaseResultListRequest, opts ...grpc.CallOption) (*GetSubmissionTestCaseResultListResponse, error)
+	WatchSubmission(ctx context.Context, in *WatchSubmissionRequest, opts ...grpc.CallOption) (OjsService_WatchSubmissionClient, error)
 	GetProblemSubmissionList(ctx context.Context, in *GetProblemSubmissionListRequest, opts ...grpc.CallOption) (*GetProblemSubmissionListResponse, error)
 	GetAccountProblemSubmissionList(ctx context.Context, in *GetAccountProblemSubmissionListRequest, opts ...grpc.CallOption) (*GetAccountProblemSubmissionListResponse, error)
+	CreateContest(ctx context.Context, in *CreateContestRequest, opts ...grpc.CallOption) (*CreateContestResponse, error)
+	GetContestList(ctx context.Context, in *GetContestListRequest, opts ...grpc.CallOption) (*GetContestListResponse, error)
+	GetContest(ctx context.Context, in *GetContestRequest, opts ...grpc.CallOption) (*GetContestResponse, error)
+	UpdateContest(ctx context.Context, in *UpdateContestRequest, opts ...grpc.CallOption) (*UpdateContestResponse, error)
+	DeleteContest(ctx context.Context, in *DeleteContestRequest, opts ...grpc.CallOption) (*DeleteContestResponse, error)
+	RegisterContest(ctx context.Context, in *RegisterContestRequest, opts ...grpc.CallOption) (*RegisterContestResponse, error)
+	UnregisterContest(ctx context.Context, in *UnregisterContestRequest, opts ...grpc.CallOption) (*UnregisterContestResponse, error)
+	GetContestScoreboard(ctx context.Context, in *GetContestScoreboardRequest, opts ...grpc.CallOption) (*GetContestScoreboardResponse, error)
 	GetAndUpdateFirstSubmittedSubmissionToExecuting(ctx context.Context, in *GetAndUpdateFirstSubmittedSubmissionToExecutingRequest, opts ...grpc.CallOption) (*GetAndUpdateFirstSubmittedSubmissionToExecutingResponse, error)
+	RenewSubmissionJudgingLease(ctx context.Context, in *RenewSubmissionJudgingLeaseRequest, opts ...grpc.CallOption) (*RenewSubmissionJudgingLeaseResponse, error)
+	ReportSubmissionResult(ctx context.Context, in *ReportSubmissionResultRequest, opts ...grpc.CallOption) (*ReportSubmissionResultResponse, error)
+	RejudgeSubmission(ctx context.Context, in *RejudgeSubmissionRequest, opts ...grpc.CallOption) (*RejudgeSubmissionResponse, error)
+	RejudgeProblem(ctx context.Context, in *RejudgeProblemRequest, opts ...grpc.CallOption) (*RejudgeProblemResponse, error)
+	RejudgeContest(ctx context.Context, in *RejudgeContestRequest, opts ...grpc.CallOption) (*RejudgeContestResponse, error)
+	GetRejudgeReport(ctx context.Context, in *GetRejudgeReportRequest, opts ...grpc.CallOption) (*GetRejudgeReportResponse, error)
+	GetDeadLetteredSubmissionList(ctx context.Context, in *GetDeadLetteredSubmissionListRequest, opts ...grpc.CallOption) (*GetDeadLetteredSubmissionListResponse, error)
+	ReplayDeadLetteredSubmission(ctx context.Context, in *ReplayDeadLetteredSubmissionRequest, opts ...grpc.CallOption) (*ReplayDeadLetteredSubmissionResponse, error)
 	UpdateSetting(ctx context.Context, in *UpdateSettingRequest, opts ...grpc.CallOption) (*UpdateSettingResponse, error)
 }
 
@@ -106,6 +170,51 @@ func (c *ojsServiceClient) GetAccount(ctx context.Context, in *GetAccountRequest
 	return out, nil
 }
 
+func (c *ojsServiceClient) GetAccountList(ctx context.Context, in *GetAccountListRequest, opts ...grpc.CallOption) (*GetAccountListResponse, error) {
+	out := new(GetAccountListResponse)
+	err := c.cc.Invoke(ctx, OjsService_GetAccountList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ojsServiceClient) UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*UpdateAccountResponse, error) {
+	out := new(UpdateAccountResponse)
+	err := c.cc.Invoke(ctx, OjsService_UpdateAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ojsServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	out := new(ChangePasswordResponse)
+	err := c.cc.Invoke(ctx, OjsService_ChangePassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ojsServiceClient) SetAccountRole(ctx context.Context, in *SetAccountRoleRequest, opts ...grpc.CallOption) (*SetAccountRoleResponse, error) {
+	out := new(SetAccountRoleResponse)
+	err := c.cc.Invoke(ctx, OjsService_SetAccountRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ojsServiceClient) DisableAccount(ctx context.Context, in *DisableAccountRequest, opts ...grpc.CallOption) (*DisableAccountResponse, error) {
+	out := new(DisableAccountResponse)
+	err := c.cc.Invoke(ctx, OjsService_DisableAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ojsServiceClient) CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*CreateSessionResponse, error) {
 	out := new(CreateSessionResponse)
 	err := c.cc.Invoke(ctx, OjsService_CreateSession_FullMethodName, in, out, opts...)
@@ -124,6 +233,33 @@ func (c *ojsServiceClient) DeleteSession(ctx context.Context, in *DeleteSessionR
 	return out, nil
 }
 
+func (c *ojsServiceClient) RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*RefreshSessionResponse, error) {
+	out := new(RefreshSessionResponse)
+	err := c.cc.Invoke(ctx, OjsService_RefreshSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ojsServiceClient) DeleteAllSessions(ctx context.Context, in *DeleteAllSessionsRequest, opts ...grpc.CallOption) (*DeleteAllSessionsResponse, error) {
+	out := new(DeleteAllSessionsResponse)
+	err := c.cc.Invoke(ctx, OjsService_DeleteAllSessions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ojsServiceClient) GetJSONWebKeySet(ctx context.Context, in *GetJSONWebKeySetRequest, opts ...grpc.CallOption) (*GetJSONWebKeySetResponse, error) {
+	out := new(GetJSONWebKeySetResponse)
+	err := c.cc.Invoke(ctx, OjsService_GetJSONWebKeySet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ojsServiceClient) CreateProblem(ctx context.Context, in *CreateProblemRequest, opts ...grpc.CallOption) (*CreateProblemResponse, error) {
 	out := new(CreateProblemResponse)
 	err := c.cc.Invoke(ctx, OjsService_CreateProblem_FullMethodName, in, out, opts...)
@@ -169,6 +305,24 @@ func (c *ojsServiceClient) DeleteProblem(ctx context.Context, in *DeleteProblemR
 	return out, nil
 }
 
+func (c *ojsServiceClient) UpdateProblemChecker(ctx context.Context, in *UpdateProblemCheckerRequest, opts ...grpc.CallOption) (*UpdateProblemCheckerResponse, error) {
+	out := new(UpdateProblemCheckerResponse)
+	err := c.cc.Invoke(ctx, OjsService_UpdateProblemChecker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ojsServiceClient) UpdateProblemInteractor(ctx context.Context, in *UpdateProblemInteractorRequest, opts ...grpc.CallOption) (*UpdateProblemInteractorResponse, error) {
+	out := new(UpdateProblemInteractorResponse)
+	err := c.cc.Invoke(ctx, OjsService_UpdateProblemInteractor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ojsServiceClient) CreateTestCase(ctx context.Context, in *CreateTestCaseRequest, opts ...grpc.CallOption) (*CreateTestCaseResponse, error) {
 	out := new(CreateTestCaseResponse)
 	err := c.cc.Invoke(ctx, OjsService_CreateTestCase_FullMethodName, in, out, opts...)
@@ -214,6 +368,42 @@ func (c *ojsServiceClient) DeleteTestCase(ctx context.Context, in *DeleteTestCas
 	return out, nil
 }
 
+func (c *ojsServiceClient) CreateTestCaseGroup(ctx context.Context, in *CreateTestCaseGroupRequest, opts ...grpc.CallOption) (*CreateTestCaseGroupResponse, error) {
+	out := new(CreateTestCaseGroupResponse)
+	err := c.cc.Invoke(ctx, OjsService_CreateTestCaseGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ojsServiceClient) GetProblemTestCaseGroupList(ctx context.Context, in *GetProblemTestCaseGroupListRequest, opts ...grpc.CallOption) (*GetProblemTestCaseGroupListResponse, error) {
+	out := new(GetProblemTestCaseGroupListResponse)
+	err := c.cc.Invoke(ctx, OjsService_GetProblemTestCaseGroupList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ojsServiceClient) UpdateTestCaseGroup(ctx context.Context, in *UpdateTestCaseGroupRequest, opts ...grpc.CallOption) (*UpdateTestCaseGroupResponse, error) {
+	out := new(UpdateTestCaseGroupResponse)
+	err := c.cc.Invoke(ctx, OjsService_UpdateTestCaseGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ojsServiceClient) DeleteTestCaseGroup(ctx context.Context, in *DeleteTestCaseGroupRequest, opts ...grpc.CallOption) (*DeleteTestCaseGroupResponse, error) {
+	out := new(DeleteTestCaseGroupResponse)
+	err := c.cc.Invoke(ctx, OjsService_DeleteTestCaseGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ojsServiceClient) CreateSubmission(ctx context.Context, in *CreateSubmissionRequest, opts ...grpc.CallOption) (*CreateSubmissionResponse, error) {
 	out := new(CreateSubmissionResponse)
 	err := c.cc.Invoke(ctx, OjsService_CreateSubmission_FullMethodName, in, out, opts...)
@@ -241,6 +431,47 @@ func (c *ojsServiceClient) GetSubmissionList(ctx context.Context, in *GetSubmiss
 	return out, nil
 }
 
+func (c *ojsServiceClient) GetSubmissionTestCaseResultList(ctx context.Context, in *GetSubmissionTestCaseResultListRequest, opts ...grpc.CallOption) (*GetSubmissionTestCaseResultListResponse, error) {
+	out := new(GetSubmissionTestCaseResultListResponse)
+	err := c.cc.Invoke(ctx, OjsService_GetSubmissionTestCaseResultList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ojsServiceClient) WatchSubmission(ctx context.Context, in *WatchSubmissionRequest, opts ...grpc.CallOption) (OjsService_WatchSubmissionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OjsService_ServiceDesc.Streams[0], OjsService_WatchSubmission_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ojsServiceWatchSubmissionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OjsService_WatchSubmissionClient interface {
+	Recv() (*WatchSubmissionResponse, error)
+	grpc.ClientStream
+}
+
+type ojsServiceWatchSubmissionClient struct {
+	grpc.ClientStream
+}
+
+func (x *ojsServiceWatchSubmissionClient) Recv() (*WatchSubmissionResponse, error) {
+	m := new(WatchSubmissionResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *ojsServiceClient) GetProblemSubmissionList(ctx context.Context, in *GetProblemSubmissionListRequest, opts ...grpc.CallOption) (*GetProblemSubmissionListResponse, error) {
 	out := new(GetProblemSubmissionListResponse)
 	err := c.cc.Invoke(ctx, OjsService_GetProblemSubmissionList_FullMethodName, in, out, opts...)
@@ -259,6 +490,78 @@ func (c *ojsServiceClient) GetAccountProblemSubmissionList(ctx context.Context,
 	return out, nil
 }
 
+func (c *ojsServiceClient) CreateContest(ctx context.Context, in *CreateContestRequest, opts ...grpc.CallOption) (*CreateContestResponse, error) {
+	out := new(CreateContestResponse)
+	err := c.cc.Invoke(ctx, OjsService_CreateContest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ojsServiceClient) GetContestList(ctx context.Context, in *GetContestListRequest, opts ...grpc.CallOption) (*GetContestListResponse, error) {
+	out := new(GetContestListResponse)
+	err := c.cc.Invoke(ctx, OjsService_GetContestList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ojsServiceClient) GetContest(ctx context.Context, in *GetContestRequest, opts ...grpc.CallOption) (*GetContestResponse, error) {
+	out := new(GetContestResponse)
+	err := c.cc.Invoke(ctx, OjsService_GetContest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ojsServiceClient) UpdateContest(ctx context.Context, in *UpdateContestRequest, opts ...grpc.CallOption) (*UpdateContestResponse, error) {
+	out := new(UpdateContestResponse)
+	err := c.cc.Invoke(ctx, OjsService_UpdateContest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ojsServiceClient) DeleteContest(ctx context.Context, in *DeleteContestRequest, opts ...grpc.CallOption) (*DeleteContestResponse, error) {
+	out := new(DeleteContestResponse)
+	err := c.cc.Invoke(ctx, OjsService_DeleteContest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ojsServiceClient) RegisterContest(ctx context.Context, in *RegisterContestRequest, opts ...grpc.CallOption) (*RegisterContestResponse, error) {
+	out := new(RegisterContestResponse)
+	err := c.cc.Invoke(ctx, OjsService_RegisterContest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ojsServiceClient) UnregisterContest(ctx context.Context, in *UnregisterContestRequest, opts ...grpc.CallOption) (*UnregisterContestResponse, error) {
+	out := new(UnregisterContestResponse)
+	err := c.cc.Invoke(ctx, OjsService_UnregisterContest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ojsServiceClient) GetContestScoreboard(ctx context.Context, in *GetContestScoreboardRequest, opts ...grpc.CallOption) (*GetContestScoreboardResponse, error) {
+	out := new(GetContestScoreboardResponse)
+	err := c.cc.Invoke(ctx, OjsService_GetContestScoreboard_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ojsServiceClient) GetAndUpdateFirstSubmittedSubmissionToExecuting(ctx context.Context, in *GetAndUpdateFirstSubmittedSubmissionToExecutingRequest, opts ...grpc.CallOption) (*GetAndUpdateFirstSubmittedSubmissionToExecutingResponse, error) {
 	out := new(GetAndUpdateFirstSubmittedSubmissionToExecutingResponse)
 	err := c.cc.Invoke(ctx, OjsService_GetAndUpdateFirstSubmittedSubmissionToExecuting_FullMethodName, in, out, opts...)
@@ -268,6 +571,78 @@ func (c *ojsServiceClient) GetAndUpdateFirstSubmittedSubmissionToExecuting(ctx c
 	return out, nil
 }
 
+func (c *ojsServiceClient) RenewSubmissionJudgingLease(ctx context.Context, in *RenewSubmissionJudgingLeaseRequest, opts ...grpc.CallOption) (*RenewSubmissionJudgingLeaseResponse, error) {
+	out := new(RenewSubmissionJudgingLeaseResponse)
+	err := c.cc.Invoke(ctx, OjsService_RenewSubmissionJudgingLease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ojsServiceClient) ReportSubmissionResult(ctx context.Context, in *ReportSubmissionResultRequest, opts ...grpc.CallOption) (*ReportSubmissionResultResponse, error) {
+	out := new(ReportSubmissionResultResponse)
+	err := c.cc.Invoke(ctx, OjsService_ReportSubmissionResult_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ojsServiceClient) RejudgeSubmission(ctx context.Context, in *RejudgeSubmissionRequest, opts ...grpc.CallOption) (*RejudgeSubmissionResponse, error) {
+	out := new(RejudgeSubmissionResponse)
+	err := c.cc.Invoke(ctx, OjsService_RejudgeSubmission_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ojsServiceClient) RejudgeProblem(ctx context.Context, in *RejudgeProblemRequest, opts ...grpc.CallOption) (*RejudgeProblemResponse, error) {
+	out := new(RejudgeProblemResponse)
+	err := c.cc.Invoke(ctx, OjsService_RejudgeProblem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ojsServiceClient) RejudgeContest(ctx context.Context, in *RejudgeContestRequest, opts ...grpc.CallOption) (*RejudgeContestResponse, error) {
+	out := new(RejudgeContestResponse)
+	err := c.cc.Invoke(ctx, OjsService_RejudgeContest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ojsServiceClient) GetRejudgeReport(ctx context.Context, in *GetRejudgeReportRequest, opts ...grpc.CallOption) (*GetRejudgeReportResponse, error) {
+	out := new(GetRejudgeReportResponse)
+	err := c.cc.Invoke(ctx, OjsService_GetRejudgeReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ojsServiceClient) GetDeadLetteredSubmissionList(ctx context.Context, in *GetDeadLetteredSubmissionListRequest, opts ...grpc.CallOption) (*GetDeadLetteredSubmissionListResponse, error) {
+	out := new(GetDeadLetteredSubmissionListResponse)
+	err := c.cc.Invoke(ctx, OjsService_GetDeadLetteredSubmissionList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ojsServiceClient) ReplayDeadLetteredSubmission(ctx context.Context, in *ReplayDeadLetteredSubmissionRequest, opts ...grpc.CallOption) (*ReplayDeadLetteredSubmissionResponse, error) {
+	out := new(ReplayDeadLetteredSubmissionResponse)
+	err := c.cc.Invoke(ctx, OjsService_ReplayDeadLetteredSubmission_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ojsServiceClient) UpdateSetting(ctx context.Context, in *UpdateSettingRequest, opts ...grpc.CallOption) (*UpdateSettingResponse, error) {
 	out := new(UpdateSettingResponse)
 	err := c.cc.Invoke(ctx, OjsService_UpdateSetting_FullMethodName, in, out, opts...)
@@ -284,24 +659,56 @@ type OjsServiceServer interface {
 	GetServerInfo(context.Context, *GetServerInfoRequest) (*GetServerInfoResponse, error)
 	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
 	GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error)
+	GetAccountList(context.Context, *GetAccountListRequest) (*GetAccountListResponse, error)
+	UpdateAccount(context.Context, *UpdateAccountRequest) (*UpdateAccountResponse, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	SetAccountRole(context.Context, *SetAccountRoleRequest) (*SetAccountRoleResponse, error)
+	DisableAccount(context.Context, *DisableAccountRequest) (*DisableAccountResponse, error)
 	CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error)
 	DeleteSession(context.Context, *DeleteSessionRequest) (*DeleteSessionResponse, error)
+	RefreshSession(context.Context, *RefreshSessionRequest) (*RefreshSessionResponse, error)
+	DeleteAllSessions(context.Context, *DeleteAllSessionsRequest) (*DeleteAllSessionsResponse, error)
+	GetJSONWebKeySet(context.Context, *GetJSONWebKeySetRequest) (*GetJSONWebKeySetResponse, error)
 	CreateProblem(context.Context, *CreateProblemRequest) (*CreateProblemResponse, error)
 	GetProblemList(context.Context, *GetProblemListRequest) (*GetProblemListResponse, error)
 	GetProblem(context.Context, *GetProblemRequest) (*GetProblemResponse, error)
 	UpdateProblem(context.Context, *UpdateProblemRequest) (*UpdateProblemResponse, error)
 	DeleteProblem(context.Context, *DeleteProblemRequest) (*DeleteProblemResponse, error)
+	UpdateProblemChecker(context.Context, *UpdateProblemCheckerRequest) (*UpdateProblemCheckerResponse, error)
+	UpdateProblemInteractor(context.Context, *UpdateProblemInteractorRequest) (*UpdateProblemInteractorResponse, error)
 	CreateTestCase(context.Context, *CreateTestCaseRequest) (*CreateTestCaseResponse, error)
 	GetProblemTestCaseList(context.Context, *GetProblemTestCaseListRequest) (*GetProblemTestCaseListResponse, error)
 	GetTestCase(context.Context, *GetTestCaseRequest) (*GetTestCaseResponse, error)
 	UpdateTestCase(context.Context, *UpdateTestCaseRequest) (*UpdateTestCaseResponse, error)
 	DeleteTestCase(context.Context, *DeleteTestCaseRequest) (*DeleteTestCaseResponse, error)
+	CreateTestCaseGroup(context.Context, *CreateTestCaseGroupRequest) (*CreateTestCaseGroupResponse, error)
+	GetProblemTestCaseGroupList(context.Context, *GetProblemTestCaseGroupListRequest) (*GetProblemTestCaseGroupListResponse, error)
+	UpdateTestCaseGroup(context.Context, *UpdateTestCaseGroupRequest) (*UpdateTestCaseGroupResponse, error)
+	DeleteTestCaseGroup(context.Context, *DeleteTestCaseGroupRequest) (*DeleteTestCaseGroupResponse, error)
 	CreateSubmission(context.Context, *CreateSubmissionRequest) (*CreateSubmissionResponse, error)
 	GetSubmission(context.Context, *GetSubmissionRequest) (*GetSubmissionResponse, error)
 	GetSubmissionList(context.Context, *GetSubmissionListRequest) (*GetSubmissionListResponse, error)
+	GetSubmissionTestCaseResultList(context.Context, *GetSubmissionTestCaseResultListRequest) (*GetSubmissionTestCaseResultListResponse, error)
+	WatchSubmission(*WatchSubmissionRequest, OjsService_WatchSubmissionServer) error
 	GetProblemSubmissionList(context.Context, *GetProblemSubmissionListRequest) (*GetProblemSubmissionListResponse, error)
 	GetAccountProblemSubmissionList(context.Context, *GetAccountProblemSubmissionListRequest) (*GetAccountProblemSubmissionListResponse, error)
+	CreateContest(context.Context, *CreateContestRequest) (*CreateContestResponse, error)
+	GetContestList(context.Context, *GetContestListRequest) (*GetContestListResponse, error)
+	GetContest(context.Context, *GetContestRequest) (*GetContestResponse, error)
+	UpdateContest(context.Context, *UpdateContestRequest) (*UpdateContestResponse, error)
+	DeleteContest(context.Context, *DeleteContestRequest) (*DeleteContestResponse, error)
+	RegisterContest(context.Context, *RegisterContestRequest) (*RegisterContestResponse, error)
+	UnregisterContest(context.Context, *UnregisterContestRequest) (*UnregisterContestResponse, error)
+	GetContestScoreboard(context.Context, *GetContestScoreboardRequest) (*GetContestScoreboardResponse, error)
 	GetAndUpdateFirstSubmittedSubmissionToExecuting(context.Context, *GetAndUpdateFirstSubmittedSubmissionToExecutingRequest) (*GetAndUpdateFirstSubmittedSubmissionToExecutingResponse, error)
+	RenewSubmissionJudgingLease(context.Context, *RenewSubmissionJudgingLeaseRequest) (*RenewSubmissionJudgingLeaseResponse, error)
+	ReportSubmissionResult(context.Context, *ReportSubmissionResultRequest) (*ReportSubmissionResultResponse, error)
+	RejudgeSubmission(context.Context, *RejudgeSubmissionRequest) (*RejudgeSubmissionResponse, error)
+	RejudgeProblem(context.Context, *RejudgeProblemRequest) (*RejudgeProblemResponse, error)
+	RejudgeContest(context.Context, *RejudgeContestRequest) (*RejudgeContestResponse, error)
+	GetRejudgeReport(context.Context, *GetRejudgeReportRequest) (*GetRejudgeReportResponse, error)
+	GetDeadLetteredSubmissionList(context.Context, *GetDeadLetteredSubmissionListRequest) (*GetDeadLetteredSubmissionListResponse, error)
+	ReplayDeadLetteredSubmission(context.Context, *ReplayDeadLetteredSubmissionRequest) (*ReplayDeadLetteredSubmissionResponse, error)
 	UpdateSetting(context.Context, *UpdateSettingRequest) (*UpdateSettingResponse, error)
 	mustEmbedUnimplementedOjsServiceServer()
 }
@@ -319,12 +726,36 @@ func (UnimplementedOjsServiceServer) CreateAccount(context.Context, *CreateAccou
 func (UnimplementedOjsServiceServer) GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
 }
+func (UnimplementedOjsServiceServer) GetAccountList(context.Context, *GetAccountListRequest) (*GetAccountListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountList not implemented")
+}
+func (UnimplementedOjsServiceServer) UpdateAccount(context.Context, *UpdateAccountRequest) (*UpdateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccount not implemented")
+}
+func (UnimplementedOjsServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedOjsServiceServer) SetAccountRole(context.Context, *SetAccountRoleRequest) (*SetAccountRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccountRole not implemented")
+}
+func (UnimplementedOjsServiceServer) DisableAccount(context.Context, *DisableAccountRequest) (*DisableAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableAccount not implemented")
+}
 func (UnimplementedOjsServiceServer) CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSession not implemented")
 }
 func (UnimplementedOjsServiceServer) DeleteSession(context.Context, *DeleteSessionRequest) (*DeleteSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSession not implemented")
 }
+func (UnimplementedOjsServiceServer) RefreshSession(context.Context, *RefreshSessionRequest) (*RefreshSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshSession not implemented")
+}
+func (UnimplementedOjsServiceServer) DeleteAllSessions(context.Context, *DeleteAllSessionsRequest) (*DeleteAllSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAllSessions not implemented")
+}
+func (UnimplementedOjsServiceServer) GetJSONWebKeySet(context.Context, *GetJSONWebKeySetRequest) (*GetJSONWebKeySetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJSONWebKeySet not implemented")
+}
 func (UnimplementedOjsServiceServer) CreateProblem(context.Context, *CreateProblemRequest) (*CreateProblemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateProblem not implemented")
 }
@@ -340,6 +771,12 @@ func (UnimplementedOjsServiceServer) UpdateProblem(context.Context, *UpdateProbl
 func (UnimplementedOjsServiceServer) DeleteProblem(context.Context, *DeleteProblemRequest) (*DeleteProblemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProblem not implemented")
 }
+func (UnimplementedOjsServiceServer) UpdateProblemChecker(context.Context, *UpdateProblemCheckerRequest) (*UpdateProblemCheckerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProblemChecker not implemented")
+}
+func (UnimplementedOjsServiceServer) UpdateProblemInteractor(context.Context, *UpdateProblemInteractorRequest) (*UpdateProblemInteractorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProblemInteractor not implemented")
+}
 func (UnimplementedOjsServiceServer) CreateTestCase(context.Context, *CreateTestCaseRequest) (*CreateTestCaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTestCase not implemented")
 }
@@ -355,6 +792,18 @@ func (UnimplementedOjsServiceServer) UpdateTestCase(context.Context, *UpdateTest
 func (UnimplementedOjsServiceServer) DeleteTestCase(context.Context, *DeleteTestCaseRequest) (*DeleteTestCaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTestCase not implemented")
 }
+func (UnimplementedOjsServiceServer) CreateTestCaseGroup(context.Context, *CreateTestCaseGroupRequest) (*CreateTestCaseGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTestCaseGroup not implemented")
+}
+func (UnimplementedOjsServiceServer) GetProblemTestCaseGroupList(context.Context, *GetProblemTestCaseGroupListRequest) (*GetProblemTestCaseGroupListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProblemTestCaseGroupList not implemented")
+}
+func (UnimplementedOjsServiceServer) UpdateTestCaseGroup(context.Context, *UpdateTestCaseGroupRequest) (*UpdateTestCaseGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTestCaseGroup not implemented")
+}
+func (UnimplementedOjsServiceServer) DeleteTestCaseGroup(context.Context, *DeleteTestCaseGroupRequest) (*DeleteTestCaseGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTestCaseGroup not implemented")
+}
 func (UnimplementedOjsServiceServer) CreateSubmission(context.Context, *CreateSubmissionRequest) (*CreateSubmissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSubmission not implemented")
 }
@@ -364,405 +813,1038 @@ func (UnimplementedOjsServiceServer) GetSubmission(context.Context, *GetSubmissi
 func (UnimplementedOjsServiceServer) GetSubmissionList(context.Context, *GetSubmissionListRequest) (*GetSubmissionListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissionList not implemented")
 }
+func (UnimplementedOjsServiceServer) GetSubmissionTestCaseResultList(context.Context, *GetSubmissionTestCaseResultListRequest) (*GetSubmissionTestCaseResultListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissionTestCaseResultList not implemented")
+}
+func (UnimplementedOjsServiceServer) WatchSubmission(*WatchSubmissionRequest, OjsService_WatchSubmissionServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSubmission not implemented")
+}
 func (UnimplementedOjsServiceServer) GetProblemSubmissionList(context.Context, *GetProblemSubmissionListRequest) (*GetProblemSubmissionListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProblemSubmissionList not implemented")
 }
 func (UnimplementedOjsServiceServer) GetAccountProblemSubmissionList(context.Context, *GetAccountProblemSubmissionListRequest) (*GetAccountProblemSubmissionListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccountProblemSubmissionList not implemented")
 }
+func (UnimplementedOjsServiceServer) CreateContest(context.Context, *CreateContestRequest) (*CreateContestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateContest not implemented")
+}
+func (UnimplementedOjsServiceServer) GetContestList(context.Context, *GetContestListRequest) (*GetContestListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContestList not implemented")
+}
+func (UnimplementedOjsServiceServer) GetContest(context.Context, *GetContestRequest) (*GetContestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContest not implemented")
+}
+func (UnimplementedOjsServiceServer) UpdateContest(context.Context, *UpdateContestRequest) (*UpdateContestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateContest not implemented")
+}
+func (UnimplementedOjsServiceServer) DeleteContest(context.Context, *DeleteContestRequest) (*DeleteContestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteContest not implemented")
+}
+func (UnimplementedOjsServiceServer) RegisterContest(context.Context, *RegisterContestRequest) (*RegisterContestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterContest not implemented")
+}
+func (UnimplementedOjsServiceServer) UnregisterContest(context.Context, *UnregisterContestRequest) (*UnregisterContestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterContest not implemented")
+}
+func (UnimplementedOjsServiceServer) GetContestScoreboard(context.Context, *GetContestScoreboardRequest) (*GetContestScoreboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContestScoreboard not implemented")
+}
 func (UnimplementedOjsServiceServer) GetAndUpdateFirstSubmittedSubmissionToExecuting(context.Context, *GetAndUpdateFirstSubmittedSubmissionToExecutingRequest) (*GetAndUpdateFirstSubmittedSubmissionToExecutingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAndUpdateFirstSubmittedSubmissionToExecuting not implemented")
 }
-func (UnimplementedOjsServiceServer) UpdateSetting(context.Context, *UpdateSettingRequest) (*UpdateSettingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSetting not implemented")
+func (UnimplementedOjsServiceServer) RenewSubmissionJudgingLease(context.Context, *RenewSubmissionJudgingLeaseRequest) (*RenewSubmissionJudgingLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewSubmissionJudgingLease not implemented")
+}
+func (UnimplementedOjsServiceServer) ReportSubmissionResult(context.Context, *ReportSubmissionResultRequest) (*ReportSubmissionResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportSubmissionResult not implemented")
+}
+func (UnimplementedOjsServiceServer) RejudgeSubmission(context.Context, *RejudgeSubmissionRequest) (*RejudgeSubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejudgeSubmission not implemented")
+}
+func (UnimplementedOjsServiceServer) RejudgeProblem(context.Context, *RejudgeProblemRequest) (*RejudgeProblemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejudgeProblem not implemented")
+}
+func (UnimplementedOjsServiceServer) RejudgeContest(context.Context, *RejudgeContestRequest) (*RejudgeContestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejudgeContest not implemented")
+}
+func (UnimplementedOjsServiceServer) GetRejudgeReport(context.Context, *GetRejudgeReportRequest) (*GetRejudgeReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRejudgeReport not implemented")
+}
+func (UnimplementedOjsServiceServer) GetDeadLetteredSubmissionList(context.Context, *GetDeadLetteredSubmissionListRequest) (*GetDeadLetteredSubmissionListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeadLetteredSubmissionList not implemented")
+}
+func (UnimplementedOjsServiceServer) ReplayDeadLetteredSubmission(context.Context, *ReplayDeadLetteredSubmissionRequest) (*ReplayDeadLetteredSubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetteredSubmission not implemented")
+}
+func (UnimplementedOjsServiceServer) UpdateSetting(context.Context, *UpdateSettingRequest) (*UpdateSettingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSetting not implemented")
+}
+func (UnimplementedOjsServiceServer) mustEmbedUnimplementedOjsServiceServer() {}
+
+// UnsafeOjsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OjsServiceServer will
+// result in compilation errors.
+type UnsafeOjsServiceServer interface {
+	mustEmbedUnimplementedOjsServiceServer()
+}
+
+func RegisterOjsServiceServer(s grpc.ServiceRegistrar, srv OjsServiceServer) {
+	s.RegisterService(&OjsService_ServiceDesc, srv)
+}
+
+func _OjsService_GetServerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServerInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).GetServerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_GetServerInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).GetServerInfo(ctx, req.(*GetServerInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_CreateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_GetAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).GetAccount(ctx, req.(*GetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_GetAccountList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).GetAccountList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_GetAccountList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).GetAccountList(ctx, req.(*GetAccountListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_UpdateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).UpdateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_UpdateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).UpdateAccount(ctx, req.(*UpdateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_SetAccountRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAccountRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).SetAccountRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_SetAccountRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).SetAccountRole(ctx, req.(*SetAccountRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_DisableAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).DisableAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_DisableAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).DisableAccount(ctx, req.(*DisableAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_CreateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).CreateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_CreateSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).CreateSession(ctx, req.(*CreateSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_DeleteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).DeleteSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_DeleteSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).DeleteSession(ctx, req.(*DeleteSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_RefreshSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).RefreshSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_RefreshSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).RefreshSession(ctx, req.(*RefreshSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_DeleteAllSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAllSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).DeleteAllSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_DeleteAllSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).DeleteAllSessions(ctx, req.(*DeleteAllSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_GetJSONWebKeySet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJSONWebKeySetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).GetJSONWebKeySet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_GetJSONWebKeySet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).GetJSONWebKeySet(ctx, req.(*GetJSONWebKeySetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_CreateProblem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProblemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).CreateProblem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_CreateProblem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).CreateProblem(ctx, req.(*CreateProblemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_GetProblemList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProblemListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).GetProblemList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_GetProblemList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).GetProblemList(ctx, req.(*GetProblemListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_GetProblem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProblemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).GetProblem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_GetProblem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).GetProblem(ctx, req.(*GetProblemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_UpdateProblem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProblemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).UpdateProblem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_UpdateProblem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).UpdateProblem(ctx, req.(*UpdateProblemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_DeleteProblem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProblemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).DeleteProblem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_DeleteProblem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).DeleteProblem(ctx, req.(*DeleteProblemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_UpdateProblemChecker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProblemCheckerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).UpdateProblemChecker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_UpdateProblemChecker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).UpdateProblemChecker(ctx, req.(*UpdateProblemCheckerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_UpdateProblemInteractor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProblemInteractorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).UpdateProblemInteractor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_UpdateProblemInteractor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).UpdateProblemInteractor(ctx, req.(*UpdateProblemInteractorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_CreateTestCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTestCaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).CreateTestCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_CreateTestCase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).CreateTestCase(ctx, req.(*CreateTestCaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_GetProblemTestCaseList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProblemTestCaseListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).GetProblemTestCaseList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_GetProblemTestCaseList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).GetProblemTestCaseList(ctx, req.(*GetProblemTestCaseListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_GetTestCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTestCaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).GetTestCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_GetTestCase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).GetTestCase(ctx, req.(*GetTestCaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_UpdateTestCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTestCaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).UpdateTestCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_UpdateTestCase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).UpdateTestCase(ctx, req.(*UpdateTestCaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_DeleteTestCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTestCaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).DeleteTestCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_DeleteTestCase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).DeleteTestCase(ctx, req.(*DeleteTestCaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_CreateTestCaseGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTestCaseGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).CreateTestCaseGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_CreateTestCaseGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).CreateTestCaseGroup(ctx, req.(*CreateTestCaseGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_GetProblemTestCaseGroupList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProblemTestCaseGroupListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).GetProblemTestCaseGroupList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_GetProblemTestCaseGroupList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).GetProblemTestCaseGroupList(ctx, req.(*GetProblemTestCaseGroupListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_UpdateTestCaseGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTestCaseGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).UpdateTestCaseGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_UpdateTestCaseGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).UpdateTestCaseGroup(ctx, req.(*UpdateTestCaseGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OjsService_DeleteTestCaseGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTestCaseGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).DeleteTestCaseGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_DeleteTestCaseGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).DeleteTestCaseGroup(ctx, req.(*DeleteTestCaseGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedOjsServiceServer) mustEmbedUnimplementedOjsServiceServer() {}
 
-// UnsafeOjsServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to OjsServiceServer will
-// result in compilation errors.
-type UnsafeOjsServiceServer interface {
-	mustEmbedUnimplementedOjsServiceServer()
+func _OjsService_CreateSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).CreateSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_CreateSubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).CreateSubmission(ctx, req.(*CreateSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterOjsServiceServer(s grpc.ServiceRegistrar, srv OjsServiceServer) {
-	s.RegisterService(&OjsService_ServiceDesc, srv)
+func _OjsService_GetSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OjsServiceServer).GetSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OjsService_GetSubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OjsServiceServer).GetSubmission(ctx, req.(*GetSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _OjsService_GetServerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetServerInfoRequest)
+func _OjsService_GetSubmissionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubmissionListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OjsServiceServer).GetServerInfo(ctx, in)
+		return srv.(OjsServiceServer).GetSubmissionList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OjsService_GetServerInfo_FullMethodName,
+		FullMethod: OjsService_GetSubmissionList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OjsServiceServer).GetServerInfo(ctx, req.(*GetServerInfoRequest))
+		return srv.(OjsServiceServer).GetSubmissionList(ctx, req.(*GetSubmissionListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OjsService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAccountRequest)
+func _OjsService_GetSubmissionTestCaseResultList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubmissionTestCaseResultListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OjsServiceServer).CreateAccount(ctx, in)
+		return srv.(OjsServiceServer).GetSubmissionTestCaseResultList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OjsService_CreateAccount_FullMethodName,
+		FullMethod: OjsService_GetSubmissionTestCaseResultList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OjsServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+		return srv.(OjsServiceServer).GetSubmissionTestCaseResultList(ctx, req.(*GetSubmissionTestCaseResultListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OjsService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountRequest)
+func _OjsService_WatchSubmission_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSubmissionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OjsServiceServer).WatchSubmission(m, &ojsServiceWatchSubmissionServer{stream})
+}
+
+type OjsService_WatchSubmissionServer interface {
+	Send(*WatchSubmissionResponse) error
+	grpc.ServerStream
+}
+
+type ojsServiceWatchSubmissionServer struct {
+	grpc.ServerStream
+}
+
+func (x *ojsServiceWatchSubmissionServer) Send(m *WatchSubmissionResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _OjsService_GetProblemSubmissionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProblemSubmissionListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OjsServiceServer).GetAccount(ctx, in)
+		return srv.(OjsServiceServer).GetProblemSubmissionList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OjsService_GetAccount_FullMethodName,
+		FullMethod: OjsService_GetProblemSubmissionList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OjsServiceServer).GetAccount(ctx, req.(*GetAccountRequest))
+		return srv.(OjsServiceServer).GetProblemSubmissionList(ctx, req.(*GetProblemSubmissionListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OjsService_CreateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSessionRequest)
+func _OjsService_GetAccountProblemSubmissionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountProblemSubmissionListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OjsServiceServer).CreateSession(ctx, in)
+		return srv.(OjsServiceServer).GetAccountProblemSubmissionList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OjsService_CreateSession_FullMethodName,
+		FullMethod: OjsService_GetAccountProblemSubmissionList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OjsServiceServer).CreateSession(ctx, req.(*CreateSessionRequest))
+		return srv.(OjsServiceServer).GetAccountProblemSubmissionList(ctx, req.(*GetAccountProblemSubmissionListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OjsService_DeleteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteSessionRequest)
+func _OjsService_CreateContest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateContestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OjsServiceServer).DeleteSession(ctx, in)
+		return srv.(OjsServiceServer).CreateContest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OjsService_DeleteSession_FullMethodName,
+		FullMethod: OjsService_CreateContest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OjsServiceServer).DeleteSession(ctx, req.(*DeleteSessionRequest))
+		return srv.(OjsServiceServer).CreateContest(ctx, req.(*CreateContestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OjsService_CreateProblem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProblemRequest)
+func _OjsService_GetContestList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContestListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OjsServiceServer).CreateProblem(ctx, in)
+		return srv.(OjsServiceServer).GetContestList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OjsService_CreateProblem_FullMethodName,
+		FullMethod: OjsService_GetContestList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OjsServiceServer).CreateProblem(ctx, req.(*CreateProblemRequest))
+		return srv.(OjsServiceServer).GetContestList(ctx, req.(*GetContestListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OjsService_GetProblemList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProblemListRequest)
+func _OjsService_GetContest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OjsServiceServer).GetProblemList(ctx, in)
+		return srv.(OjsServiceServer).GetContest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OjsService_GetProblemList_FullMethodName,
+		FullMethod: OjsService_GetContest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OjsServiceServer).GetProblemList(ctx, req.(*GetProblemListRequest))
+		return srv.(OjsServiceServer).GetContest(ctx, req.(*GetContestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OjsService_GetProblem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProblemRequest)
+func _OjsService_UpdateContest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateContestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OjsServiceServer).GetProblem(ctx, in)
+		return srv.(OjsServiceServer).UpdateContest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OjsService_GetProblem_FullMethodName,
+		FullMethod: OjsService_UpdateContest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OjsServiceServer).GetProblem(ctx, req.(*GetProblemRequest))
+		return srv.(OjsServiceServer).UpdateContest(ctx, req.(*UpdateContestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OjsService_UpdateProblem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProblemRequest)
+func _OjsService_DeleteContest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteContestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OjsServiceServer).UpdateProblem(ctx, in)
+		return srv.(OjsServiceServer).DeleteContest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OjsService_UpdateProblem_FullMethodName,
+		FullMethod: OjsService_DeleteContest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OjsServiceServer).UpdateProblem(ctx, req.(*UpdateProblemRequest))
+		return srv.(OjsServiceServer).DeleteContest(ctx, req.(*DeleteContestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OjsService_DeleteProblem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteProblemRequest)
+func _OjsService_RegisterContest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterContestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OjsServiceServer).DeleteProblem(ctx, in)
+		return srv.(OjsServiceServer).RegisterContest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OjsService_DeleteProblem_FullMethodName,
+		FullMethod: OjsService_RegisterContest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OjsServiceServer).DeleteProblem(ctx, req.(*DeleteProblemRequest))
+		return srv.(OjsServiceServer).RegisterContest(ctx, req.(*RegisterContestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OjsService_CreateTestCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTestCaseRequest)
+func _OjsService_UnregisterContest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterContestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OjsServiceServer).CreateTestCase(ctx, in)
+		return srv.(OjsServiceServer).UnregisterContest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OjsService_CreateTestCase_FullMethodName,
+		FullMethod: OjsService_UnregisterContest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OjsServiceServer).CreateTestCase(ctx, req.(*CreateTestCaseRequest))
+		return srv.(OjsServiceServer).UnregisterContest(ctx, req.(*UnregisterContestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OjsService_GetProblemTestCaseList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProblemTestCaseListRequest)
+func _OjsService_GetContestScoreboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContestScoreboardRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OjsServiceServer).GetProblemTestCaseList(ctx, in)
+		return srv.(OjsServiceServer).GetContestScoreboard(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OjsService_GetProblemTestCaseList_FullMethodName,
+		FullMethod: OjsService_GetContestScoreboard_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OjsServiceServer).GetProblemTestCaseList(ctx, req.(*GetProblemTestCaseListRequest))
+		return srv.(OjsServiceServer).GetContestScoreboard(ctx, req.(*GetContestScoreboardRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OjsService_GetTestCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTestCaseRequest)
+func _OjsService_GetAndUpdateFirstSubmittedSubmissionToExecuting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAndUpdateFirstSubmittedSubmissionToExecutingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OjsServiceServer).GetTestCase(ctx, in)
+		return srv.(OjsServiceServer).GetAndUpdateFirstSubmittedSubmissionToExecuting(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OjsService_GetTestCase_FullMethodName,
+		FullMethod: OjsService_GetAndUpdateFirstSubmittedSubmissionToExecuting_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OjsServiceServer).GetTestCase(ctx, req.(*GetTestCaseRequest))
+		return srv.(OjsServiceServer).GetAndUpdateFirstSubmittedSubmissionToExecuting(ctx, req.(*GetAndUpdateFirstSubmittedSubmissionToExecutingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OjsService_UpdateTestCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTestCaseRequest)
+func _OjsService_RenewSubmissionJudgingLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewSubmissionJudgingLeaseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OjsServiceServer).UpdateTestCase(ctx, in)
+		return srv.(OjsServiceServer).RenewSubmissionJudgingLease(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OjsService_UpdateTestCase_FullMethodName,
+		FullMethod: OjsService_RenewSubmissionJudgingLease_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OjsServiceServer).UpdateTestCase(ctx, req.(*UpdateTestCaseRequest))
+		return srv.(OjsServiceServer).RenewSubmissionJudgingLease(ctx, req.(*RenewSubmissionJudgingLeaseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OjsService_DeleteTestCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTestCaseRequest)
+func _OjsService_ReportSubmissionResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportSubmissionResultRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OjsServiceServer).DeleteTestCase(ctx, in)
+		return srv.(OjsServiceServer).ReportSubmissionResult(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OjsService_DeleteTestCase_FullMethodName,
+		FullMethod: OjsService_ReportSubmissionResult_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OjsServiceServer).DeleteTestCase(ctx, req.(*DeleteTestCaseRequest))
+		return srv.(OjsServiceServer).ReportSubmissionResult(ctx, req.(*ReportSubmissionResultRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OjsService_CreateSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSubmissionRequest)
+func _OjsService_RejudgeSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejudgeSubmissionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OjsServiceServer).CreateSubmission(ctx, in)
+		return srv.(OjsServiceServer).RejudgeSubmission(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OjsService_CreateSubmission_FullMethodName,
+		FullMethod: OjsService_RejudgeSubmission_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OjsServiceServer).CreateSubmission(ctx, req.(*CreateSubmissionRequest))
+		return srv.(OjsServiceServer).RejudgeSubmission(ctx, req.(*RejudgeSubmissionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OjsService_GetSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSubmissionRequest)
+func _OjsService_RejudgeProblem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejudgeProblemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OjsServiceServer).GetSubmission(ctx, in)
+		return srv.(OjsServiceServer).RejudgeProblem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OjsService_GetSubmission_FullMethodName,
+		FullMethod: OjsService_RejudgeProblem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OjsServiceServer).GetSubmission(ctx, req.(*GetSubmissionRequest))
+		return srv.(OjsServiceServer).RejudgeProblem(ctx, req.(*RejudgeProblemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OjsService_GetSubmissionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSubmissionListRequest)
+func _OjsService_RejudgeContest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejudgeContestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OjsServiceServer).GetSubmissionList(ctx, in)
+		return srv.(OjsServiceServer).RejudgeContest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OjsService_GetSubmissionList_FullMethodName,
+		FullMethod: OjsService_RejudgeContest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OjsServiceServer).GetSubmissionList(ctx, req.(*GetSubmissionListRequest))
+		return srv.(OjsServiceServer).RejudgeContest(ctx, req.(*RejudgeContestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OjsService_GetProblemSubmissionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProblemSubmissionListRequest)
+func _OjsService_GetRejudgeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRejudgeReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OjsServiceServer).GetProblemSubmissionList(ctx, in)
+		return srv.(OjsServiceServer).GetRejudgeReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OjsService_GetProblemSubmissionList_FullMethodName,
+		FullMethod: OjsService_GetRejudgeReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OjsServiceServer).GetProblemSubmissionList(ctx, req.(*GetProblemSubmissionListRequest))
+		return srv.(OjsServiceServer).GetRejudgeReport(ctx, req.(*GetRejudgeReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OjsService_GetAccountProblemSubmissionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountProblemSubmissionListRequest)
+func _OjsService_GetDeadLetteredSubmissionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeadLetteredSubmissionListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OjsServiceServer).GetAccountProblemSubmissionList(ctx, in)
+		return srv.(OjsServiceServer).GetDeadLetteredSubmissionList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OjsService_GetAccountProblemSubmissionList_FullMethodName,
+		FullMethod: OjsService_GetDeadLetteredSubmissionList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OjsServiceServer).GetAccountProblemSubmissionList(ctx, req.(*GetAccountProblemSubmissionListRequest))
+		return srv.(OjsServiceServer).GetDeadLetteredSubmissionList(ctx, req.(*GetDeadLetteredSubmissionListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OjsService_GetAndUpdateFirstSubmittedSubmissionToExecuting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAndUpdateFirstSubmittedSubmissionToExecutingRequest)
+func _OjsService_ReplayDeadLetteredSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLetteredSubmissionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OjsServiceServer).GetAndUpdateFirstSubmittedSubmissionToExecuting(ctx, in)
+		return srv.(OjsServiceServer).ReplayDeadLetteredSubmission(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OjsService_GetAndUpdateFirstSubmittedSubmissionToExecuting_FullMethodName,
+		FullMethod: OjsService_ReplayDeadLetteredSubmission_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OjsServiceServer).GetAndUpdateFirstSubmittedSubmissionToExecuting(ctx, req.(*GetAndUpdateFirstSubmittedSubmissionToExecutingRequest))
+		return srv.(OjsServiceServer).ReplayDeadLetteredSubmission(ctx, req.(*ReplayDeadLetteredSubmissionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -804,6 +1886,26 @@ var OjsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAccount",
 			Handler:    _OjsService_GetAccount_Handler,
 		},
+		{
+			MethodName: "GetAccountList",
+			Handler:    _OjsService_GetAccountList_Handler,
+		},
+		{
+			MethodName: "UpdateAccount",
+			Handler:    _OjsService_UpdateAccount_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _OjsService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "SetAccountRole",
+			Handler:    _OjsService_SetAccountRole_Handler,
+		},
+		{
+			MethodName: "DisableAccount",
+			Handler:    _OjsService_DisableAccount_Handler,
+		},
 		{
 			MethodName: "CreateSession",
 			Handler:    _OjsService_CreateSession_Handler,
@@ -812,6 +1914,18 @@ var OjsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSession",
 			Handler:    _OjsService_DeleteSession_Handler,
 		},
+		{
+			MethodName: "RefreshSession",
+			Handler:    _OjsService_RefreshSession_Handler,
+		},
+		{
+			MethodName: "DeleteAllSessions",
+			Handler:    _OjsService_DeleteAllSessions_Handler,
+		},
+		{
+			MethodName: "GetJSONWebKeySet",
+			Handler:    _OjsService_GetJSONWebKeySet_Handler,
+		},
 		{
 			MethodName: "CreateProblem",
 			Handler:    _OjsService_CreateProblem_Handler,
@@ -832,6 +1946,14 @@ var OjsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProblem",
 			Handler:    _OjsService_DeleteProblem_Handler,
 		},
+		{
+			MethodName: "UpdateProblemChecker",
+			Handler:    _OjsService_UpdateProblemChecker_Handler,
+		},
+		{
+			MethodName: "UpdateProblemInteractor",
+			Handler:    _OjsService_UpdateProblemInteractor_Handler,
+		},
 		{
 			MethodName: "CreateTestCase",
 			Handler:    _OjsService_CreateTestCase_Handler,
@@ -852,6 +1974,22 @@ var OjsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTestCase",
 			Handler:    _OjsService_DeleteTestCase_Handler,
 		},
+		{
+			MethodName: "CreateTestCaseGroup",
+			Handler:    _OjsService_CreateTestCaseGroup_Handler,
+		},
+		{
+			MethodName: "GetProblemTestCaseGroupList",
+			Handler:    _OjsService_GetProblemTestCaseGroupList_Handler,
+		},
+		{
+			MethodName: "UpdateTestCaseGroup",
+			Handler:    _OjsService_UpdateTestCaseGroup_Handler,
+		},
+		{
+			MethodName: "DeleteTestCaseGroup",
+			Handler:    _OjsService_DeleteTestCaseGroup_Handler,
+		},
 		{
 			MethodName: "CreateSubmission",
 			Handler:    _OjsService_CreateSubmission_Handler,
@@ -864,6 +2002,10 @@ var OjsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSubmissionList",
 			Handler:    _OjsService_GetSubmissionList_Handler,
 		},
+		{
+			MethodName: "GetSubmissionTestCaseResultList",
+			Handler:    _OjsService_GetSubmissionTestCaseResultList_Handler,
+		},
 		{
 			MethodName: "GetProblemSubmissionList",
 			Handler:    _OjsService_GetProblemSubmissionList_Handler,
@@ -872,15 +2014,85 @@ var OjsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAccountProblemSubmissionList",
 			Handler:    _OjsService_GetAccountProblemSubmissionList_Handler,
 		},
+		{
+			MethodName: "CreateContest",
+			Handler:    _OjsService_CreateContest_Handler,
+		},
+		{
+			MethodName: "GetContestList",
+			Handler:    _OjsService_GetContestList_Handler,
+		},
+		{
+			MethodName: "GetContest",
+			Handler:    _OjsService_GetContest_Handler,
+		},
+		{
+			MethodName: "UpdateContest",
+			Handler:    _OjsService_UpdateContest_Handler,
+		},
+		{
+			MethodName: "DeleteContest",
+			Handler:    _OjsService_DeleteContest_Handler,
+		},
+		{
+			MethodName: "RegisterContest",
+			Handler:    _OjsService_RegisterContest_Handler,
+		},
+		{
+			MethodName: "UnregisterContest",
+			Handler:    _OjsService_UnregisterContest_Handler,
+		},
+		{
+			MethodName: "GetContestScoreboard",
+			Handler:    _OjsService_GetContestScoreboard_Handler,
+		},
 		{
 			MethodName: "GetAndUpdateFirstSubmittedSubmissionToExecuting",
 			Handler:    _OjsService_GetAndUpdateFirstSubmittedSubmissionToExecuting_Handler,
 		},
+		{
+			MethodName: "RenewSubmissionJudgingLease",
+			Handler:    _OjsService_RenewSubmissionJudgingLease_Handler,
+		},
+		{
+			MethodName: "ReportSubmissionResult",
+			Handler:    _OjsService_ReportSubmissionResult_Handler,
+		},
+		{
+			MethodName: "RejudgeSubmission",
+			Handler:    _OjsService_RejudgeSubmission_Handler,
+		},
+		{
+			MethodName: "RejudgeProblem",
+			Handler:    _OjsService_RejudgeProblem_Handler,
+		},
+		{
+			MethodName: "RejudgeContest",
+			Handler:    _OjsService_RejudgeContest_Handler,
+		},
+		{
+			MethodName: "GetRejudgeReport",
+			Handler:    _OjsService_GetRejudgeReport_Handler,
+		},
+		{
+			MethodName: "GetDeadLetteredSubmissionList",
+			Handler:    _OjsService_GetDeadLetteredSubmissionList_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetteredSubmission",
+			Handler:    _OjsService_ReplayDeadLetteredSubmission_Handler,
+		},
 		{
 			MethodName: "UpdateSetting",
 			Handler:    _OjsService_UpdateSetting_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSubmission",
+			Handler:       _OjsService_WatchSubmission_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "ojs.proto",
 }
@@ -3,9 +3,15 @@ package consumer
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"sync"
+	"time"
 
+	"github.com/maxuanquang/ojs/internal/configs"
 	"github.com/maxuanquang/ojs/internal/dataaccess/mq/consumer"
 	"github.com/maxuanquang/ojs/internal/dataaccess/mq/producer"
+	"github.com/maxuanquang/ojs/internal/handler/jobs"
+	"github.com/maxuanquang/ojs/internal/logic"
 	"go.uber.org/zap"
 )
 
@@ -15,38 +21,246 @@ type RootConsumer interface {
 
 func NewRootConsumer(
 	submissionCreatedHandler SubmissionCreatedHandler,
+	submissionCreatedDeadLetterHandler SubmissionCreatedDeadLetterHandler,
+	submissionCreatedDeadLetterProducer producer.SubmissionCreatedDeadLetterProducer,
+	purgeStaleWorkspacesJob jobs.PurgeStaleWorkspacesJob,
 	mqConsumer consumer.Consumer,
+	mqConfig configs.MQ,
+	workerConfig configs.Worker,
 	logger *zap.Logger,
-) RootConsumer {
-	return &rootConsumer{
-		submissionCreatedHandler: submissionCreatedHandler,
-		mqConsumer:               mqConsumer,
-		logger:                   logger,
+) (RootConsumer, error) {
+	cpuSets, err := workerConfig.GetCPUSets()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("invalid worker cpu sets")
+		return nil, err
+	}
+
+	initialBackoff, err := mqConfig.Retry.GetInitialBackoff()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("invalid mq retry initial backoff")
+		return nil, err
 	}
+
+	maxBackoff, err := mqConfig.Retry.GetMaxBackoff()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("invalid mq retry max backoff")
+		return nil, err
+	}
+
+	return &rootConsumer{
+		submissionCreatedHandler:            submissionCreatedHandler,
+		submissionCreatedDeadLetterHandler:  submissionCreatedDeadLetterHandler,
+		submissionCreatedDeadLetterProducer: submissionCreatedDeadLetterProducer,
+		purgeStaleWorkspacesJob:             purgeStaleWorkspacesJob,
+		mqConsumer:                          mqConsumer,
+		logger:                              logger,
+		slotPool:                            newWorkerSlotPool(cpuSets, len(producer.SubmissionCreatedQueueNames)),
+		maxAttempts:                         mqConfig.Retry.GetMaxAttempts(),
+		initialBackoff:                      initialBackoff,
+		maxBackoff:                          maxBackoff,
+	}, nil
 }
 
 type rootConsumer struct {
-	submissionCreatedHandler SubmissionCreatedHandler
-	mqConsumer               consumer.Consumer
-	logger                   *zap.Logger
+	submissionCreatedHandler            SubmissionCreatedHandler
+	submissionCreatedDeadLetterHandler  SubmissionCreatedDeadLetterHandler
+	submissionCreatedDeadLetterProducer producer.SubmissionCreatedDeadLetterProducer
+	purgeStaleWorkspacesJob             jobs.PurgeStaleWorkspacesJob
+	mqConsumer                          consumer.Consumer
+	logger                              *zap.Logger
+	slotPool                            *workerSlotPool
+	waitGroup                           sync.WaitGroup
+	maxAttempts                         int
+	initialBackoff                      time.Duration
+	maxBackoff                          time.Duration
 }
 
 // Start implements RootConsumer.
 func (r *rootConsumer) Start(ctx context.Context) error {
+	// Each priority lane is consumed on its own, the submissions of a lane waiting for a slot until no submission of
+	// a higher priority lane is waiting for one
+	for priority, queueName := range producer.SubmissionCreatedQueueNames {
+		r.mqConsumer.RegisterHandler(queueName, r.newSubmissionHandlerFunc(priority))
+	}
+
 	r.mqConsumer.RegisterHandler(
-		producer.MessageQueueSubmissionCreated,
+		producer.MessageQueueSubmissionCreatedDeadLetter,
 		func(ctx context.Context, payload []byte) error {
-
-			var submissionID uint64
-
-			err := json.Unmarshal(payload, &submissionID)
+			var deadLetter producer.SubmissionCreatedDeadLetter
+			err := json.Unmarshal(payload, &deadLetter)
 			if err != nil {
 				return err
 			}
 
-			return r.submissionCreatedHandler.Handle(ctx, submissionID)
+			return r.submissionCreatedDeadLetterHandler.Handle(ctx, deadLetter)
 		},
 	)
 
-	return r.mqConsumer.Start(ctx)
+	ctx, cancelFunc := context.WithCancel(ctx)
+	defer cancelFunc()
+
+	purgeDone := make(chan struct{})
+	go func() {
+		defer close(purgeDone)
+		r.purgeStaleWorkspaces(ctx)
+	}()
+
+	err := r.mqConsumer.Start(ctx)
+	cancelFunc()
+	<-purgeDone
+
+	// Submissions being judged are finished before stopping
+	r.waitGroup.Wait()
+	return err
+}
+
+// purgeStaleWorkspaces removes the workspaces left behind on the host of the worker every interval until ctx is
+// done, the ones left behind before the worker started being purged when its handler is created.
+func (r *rootConsumer) purgeStaleWorkspaces(ctx context.Context) {
+	ticker := time.NewTicker(r.purgeStaleWorkspacesJob.GetInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// Failing to purge does not prevent judging, it is tried again at the next interval
+		if err := r.purgeStaleWorkspacesJob.Run(ctx); err != nil {
+			r.logger.With(zap.Error(err)).Warn("failed to purge stale workspaces")
+		}
+	}
+}
+
+func (r *rootConsumer) newSubmissionHandlerFunc(priority int) consumer.HandlerFunc {
+	return func(ctx context.Context, payload []byte) error {
+		var submissionID uint64
+
+		err := json.Unmarshal(payload, &submissionID)
+		if err != nil {
+			return err
+		}
+
+		// The message is only acknowledged once the submission's judging lease is held, from when the submission is
+		// submitted again by the expired judging lease job if this worker crashes, or once handling it is over. The
+		// next message can then be received while the submission is judged.
+		leaseAcquired := make(chan struct{})
+		var leaseAcquiredOnce sync.Once
+		onLeaseAcquired := func() {
+			leaseAcquiredOnce.Do(func() { close(leaseAcquired) })
+		}
+
+		handled := make(chan error, 1)
+		r.waitGroup.Add(1)
+		go func() {
+			defer r.waitGroup.Done()
+
+			handled <- r.handleSubmissionCreated(ctx, priority, submissionID, leaseAcquired, onLeaseAcquired)
+		}()
+
+		select {
+		case <-leaseAcquired:
+			return nil
+		case err := <-handled:
+			return err
+		}
+	}
+}
+
+// handleSubmissionCreated retries handling the submission with an exponential backoff, and moves it to the dead
+// letter queue once all attempts have failed. A free slot is only held while an attempt is running.
+//
+// If ctx is done before the message is acknowledged, the error of ctx is returned for the message to be received
+// again. Once the message is acknowledged, the attempts are no longer interrupted by ctx, as nothing else would
+// submit the submission again.
+func (r *rootConsumer) handleSubmissionCreated(
+	ctx context.Context,
+	priority int,
+	submissionID uint64,
+	leaseAcquired <-chan struct{},
+	onLeaseAcquired func(),
+) error {
+	logger := r.logger.With(zap.Uint64("submission_id", submissionID))
+
+	var (
+		err     error
+		backoff = r.initialBackoff
+		attempt = 1
+	)
+	for ; ; attempt++ {
+		err = r.handleSubmissionCreatedAttempt(ctx, priority, submissionID, onLeaseAcquired)
+		if err == nil {
+			return nil
+		}
+
+		select {
+		case <-leaseAcquired:
+			ctx = context.WithoutCancel(ctx)
+		default:
+		}
+
+		if attempt >= r.maxAttempts {
+			break
+		}
+
+		logger.With(zap.Int("attempt", attempt)).With(zap.Duration("backoff", backoff)).With(zap.Error(err)).
+			Warn("failed to handle submission created event, retrying")
+		if waitErr := r.waitForBackoff(ctx, backoff); waitErr != nil {
+			err = errors.Join(err, waitErr)
+			break
+		}
+		backoff = min(backoff*2, r.maxBackoff)
+	}
+
+	// A message not acknowledged yet is received again rather than dead-lettered when handling it was interrupted
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	logger.With(zap.Int("attempt_count", attempt)).With(zap.Error(err)).Error("failed to handle submission created event, dead-lettering it")
+	produceErr := r.submissionCreatedDeadLetterProducer.Produce(ctx, producer.SubmissionCreatedDeadLetter{
+		SubmissionID: submissionID,
+		Error:        err.Error(),
+		AttemptCount: attempt,
+	})
+	if produceErr != nil {
+		logger.With(zap.Error(produceErr)).Error("failed to dead-letter submission created event")
+		return produceErr
+	}
+
+	return nil
+}
+
+// handleSubmissionCreatedAttempt handles the submission once it has been handed over a free slot.
+func (r *rootConsumer) handleSubmissionCreatedAttempt(
+	ctx context.Context,
+	priority int,
+	submissionID uint64,
+	onLeaseAcquired func(),
+) error {
+	slot, err := r.slotPool.acquire(ctx, priority)
+	if err != nil {
+		return err
+	}
+	defer r.slotPool.release(slot)
+
+	// Judging is not interrupted when the consumer session ends, such as when partitions are rebalanced
+	handleCtx := logic.WithCPUSet(context.WithoutCancel(ctx), slot.cpuSet)
+	handleCtx = logic.WithOnJudgingLeaseAcquired(handleCtx, onLeaseAcquired)
+	return r.submissionCreatedHandler.Handle(handleCtx, submissionID)
+}
+
+// waitForBackoff waits for the backoff to elapse, returning the error of ctx if it is done first.
+func (r *rootConsumer) waitForBackoff(ctx context.Context, backoff time.Duration) error {
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
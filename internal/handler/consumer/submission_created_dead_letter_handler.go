@@ -0,0 +1,46 @@
+package consumer
+
+import (
+	"context"
+
+	"github.com/maxuanquang/ojs/internal/dataaccess/mq/producer"
+	"github.com/maxuanquang/ojs/internal/logic"
+	"github.com/maxuanquang/ojs/internal/utils"
+	"go.uber.org/zap"
+)
+
+type SubmissionCreatedDeadLetterHandler interface {
+	Handle(ctx context.Context, deadLetter producer.SubmissionCreatedDeadLetter) error
+}
+
+func NewSubmissionCreatedDeadLetterHandler(
+	deadLetteredSubmissionLogic logic.DeadLetteredSubmissionLogic,
+	logger *zap.Logger,
+) SubmissionCreatedDeadLetterHandler {
+	return &submissionCreatedDeadLetterHandler{
+		deadLetteredSubmissionLogic: deadLetteredSubmissionLogic,
+		logger:                      logger,
+	}
+}
+
+type submissionCreatedDeadLetterHandler struct {
+	deadLetteredSubmissionLogic logic.DeadLetteredSubmissionLogic
+	logger                      *zap.Logger
+}
+
+// Handle implements SubmissionCreatedDeadLetterHandler.
+func (s *submissionCreatedDeadLetterHandler) Handle(ctx context.Context, deadLetter producer.SubmissionCreatedDeadLetter) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("submission_id", deadLetter.SubmissionID))
+
+	err := s.deadLetteredSubmissionLogic.CreateDeadLetteredSubmission(ctx, logic.CreateDeadLetteredSubmissionInput{
+		OfSubmissionID: deadLetter.SubmissionID,
+		Error:          deadLetter.Error,
+		AttemptCount:   deadLetter.AttemptCount,
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to record dead-lettered submission")
+		return err
+	}
+
+	return nil
+}
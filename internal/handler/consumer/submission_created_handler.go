@@ -2,6 +2,9 @@ package consumer
 
 import (
 	"context"
+	"errors"
+	"sync"
+	"time"
 
 	"github.com/maxuanquang/ojs/internal/configs"
 	"github.com/maxuanquang/ojs/internal/handler/jobs"
@@ -10,6 +13,11 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	// workerTokenRefreshMargin is how long before its expiry the token of the worker is refreshed.
+	workerTokenRefreshMargin = time.Minute
+)
+
 type SubmissionCreatedHandler interface {
 	Handle(ctx context.Context, submissionID uint64) error
 }
@@ -19,6 +27,7 @@ func NewSubmissionCreatedHandler(
 	cronConfig configs.Cron,
 	submissionLogic logic.SubmissionLogic,
 	createSystemAccountsJob jobs.CreateSystemAccountsJob,
+	purgeStaleWorkspacesJob jobs.PurgeStaleWorkspacesJob,
 	logger *zap.Logger,
 ) (SubmissionCreatedHandler, error) {
 	err := createSystemAccountsJob.Run(context.Background())
@@ -27,29 +36,39 @@ func NewSubmissionCreatedHandler(
 		return nil, err
 	}
 
-	createSessionOutput, err := accountLogic.CreateSession(
-		context.Background(),
-		logic.CreateSessionInput{
-			Name:     cronConfig.CreateSystemAccounts.Worker.Name,
-			Password: cronConfig.CreateSystemAccounts.Worker.Password,
-		},
-	)
+	// Workspaces left behind by a crashed worker on this host are purged before judging anything,
+	// failing to do so does not prevent judging
+	if err := purgeStaleWorkspacesJob.Run(context.Background()); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to purge stale workspaces")
+	}
+
+	handler := &submissionCreatedHandler{
+		accountLogic:    accountLogic,
+		cronConfig:      cronConfig,
+		submissionLogic: submissionLogic,
+		logger:          logger,
+	}
+
+	err = handler.createSession(context.Background())
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to create session")
 		return nil, err
 	}
 
-	return &submissionCreatedHandler{
-		submissionLogic: submissionLogic,
-		logger:          logger,
-		token:           createSessionOutput.Token,
-	}, nil
+	return handler, nil
 }
 
 type submissionCreatedHandler struct {
+	accountLogic    logic.AccountLogic
+	cronConfig      configs.Cron
 	submissionLogic logic.SubmissionLogic
 	logger          *zap.Logger
-	token           string
+
+	sessionMutex          sync.Mutex
+	token                 string
+	tokenExpiresAt        time.Time
+	refreshToken          string
+	refreshTokenExpiresAt time.Time
 }
 
 // Handle implements DownloadTaskCreatedHandler.
@@ -57,16 +76,78 @@ func (d *submissionCreatedHandler) Handle(ctx context.Context, submissionID uint
 	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Any("submissionID", submissionID))
 
 	logger.Info("submission created event received at handlerFunc")
-	err := d.submissionLogic.ExecuteSubmission(
+	token, err := d.getToken(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get token of worker")
+		return err
+	}
+
+	err = d.submissionLogic.ExecuteSubmission(
 		ctx,
 		logic.ExecuteSubmissionInput{
 			ID:    submissionID,
-			Token: d.token,
+			Token: token,
 		},
 	)
 	if err != nil {
+		// The submission has already been judged, or is being judged by another worker
+		if errors.Is(err, logic.ErrSubmissionNotSubmitted) {
+			logger.Info("submission is not submitted, skipping it")
+			return nil
+		}
+
 		logger.With(zap.Error(err)).Error("failed to execute submission")
+		return err
+	}
+
+	return nil
+}
+
+// getToken returns the access token of the worker, refreshing its session when the token is about to expire. A new
+// session is created once the refresh token cannot be used anymore.
+func (d *submissionCreatedHandler) getToken(ctx context.Context) (string, error) {
+	d.sessionMutex.Lock()
+	defer d.sessionMutex.Unlock()
+
+	if time.Until(d.tokenExpiresAt) > workerTokenRefreshMargin {
+		return d.token, nil
+	}
+
+	if time.Until(d.refreshTokenExpiresAt) > workerTokenRefreshMargin {
+		refreshSessionOutput, err := d.accountLogic.RefreshSession(ctx, logic.RefreshSessionInput{RefreshToken: d.refreshToken})
+		if err == nil {
+			d.token = refreshSessionOutput.Token
+			d.tokenExpiresAt = refreshSessionOutput.ExpiresAt
+			d.refreshToken = refreshSessionOutput.RefreshToken
+			d.refreshTokenExpiresAt = refreshSessionOutput.RefreshTokenExpiresAt
+			return d.token, nil
+		}
+
+		utils.LoggerWithContext(ctx, d.logger).With(zap.Error(err)).Warn("failed to refresh session, will create a new one")
+	}
+
+	if err := d.createSession(ctx); err != nil {
+		return "", err
+	}
+
+	return d.token, nil
+}
+
+func (d *submissionCreatedHandler) createSession(ctx context.Context) error {
+	createSessionOutput, err := d.accountLogic.CreateSession(
+		ctx,
+		logic.CreateSessionInput{
+			Name:     d.cronConfig.CreateSystemAccounts.Worker.Name,
+			Password: d.cronConfig.CreateSystemAccounts.Worker.Password,
+		},
+	)
+	if err != nil {
+		return err
 	}
 
+	d.token = createSessionOutput.Token
+	d.tokenExpiresAt = createSessionOutput.ExpiresAt
+	d.refreshToken = createSessionOutput.RefreshToken
+	d.refreshTokenExpiresAt = createSessionOutput.RefreshTokenExpiresAt
 	return nil
 }
@@ -4,5 +4,6 @@ import "github.com/google/wire"
 
 var WireSet = wire.NewSet(
 	NewSubmissionCreatedHandler,
+	NewSubmissionCreatedDeadLetterHandler,
 	NewRootConsumer,
 )
@@ -0,0 +1,89 @@
+package consumer
+
+import (
+	"context"
+	"sync"
+)
+
+// workerSlot judges one submission at a time.
+type workerSlot struct {
+	cpuSet string
+}
+
+// workerSlotPool hands free slots over to the submissions waiting for one, higher priority submissions first.
+// Priorities go from 0, the highest, to the priority count of the pool minus one.
+type workerSlotPool struct {
+	mutex     sync.Mutex
+	freeSlots []workerSlot
+	// waiters are the channels of the submissions waiting for a slot, by priority and in arrival order. There are
+	// only waiters while there are no free slots.
+	waiters [][]chan workerSlot
+}
+
+func newWorkerSlotPool(cpuSets []string, priorityCount int) *workerSlotPool {
+	pool := &workerSlotPool{
+		waiters: make([][]chan workerSlot, priorityCount),
+	}
+	for _, cpuSet := range cpuSets {
+		pool.freeSlots = append(pool.freeSlots, workerSlot{cpuSet: cpuSet})
+	}
+
+	return pool
+}
+
+// acquire waits for a free slot, which must be released once the submission has been judged.
+func (w *workerSlotPool) acquire(ctx context.Context, priority int) (workerSlot, error) {
+	w.mutex.Lock()
+	if len(w.freeSlots) > 0 {
+		slot := w.freeSlots[len(w.freeSlots)-1]
+		w.freeSlots = w.freeSlots[:len(w.freeSlots)-1]
+		w.mutex.Unlock()
+		return slot, nil
+	}
+
+	waiter := make(chan workerSlot, 1)
+	w.waiters[priority] = append(w.waiters[priority], waiter)
+	w.mutex.Unlock()
+
+	select {
+	case slot := <-waiter:
+		return slot, nil
+	case <-ctx.Done():
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	for i, otherWaiter := range w.waiters[priority] {
+		if otherWaiter == waiter {
+			w.waiters[priority] = append(w.waiters[priority][:i], w.waiters[priority][i+1:]...)
+			return workerSlot{}, ctx.Err()
+		}
+	}
+
+	// A slot has been handed over in the meantime, it is passed on to the next waiter
+	w.releaseLocked(<-waiter)
+	return workerSlot{}, ctx.Err()
+}
+
+func (w *workerSlotPool) release(slot workerSlot) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.releaseLocked(slot)
+}
+
+func (w *workerSlotPool) releaseLocked(slot workerSlot) {
+	for priority := range w.waiters {
+		if len(w.waiters[priority]) == 0 {
+			continue
+		}
+
+		waiter := w.waiters[priority][0]
+		w.waiters[priority] = w.waiters[priority][1:]
+		waiter <- slot
+		return
+	}
+
+	w.freeSlots = append(w.freeSlots, slot)
+}
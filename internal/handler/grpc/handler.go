@@ -13,6 +13,7 @@ import (
 
 const (
 	AuthTokenMetadataName         = "OJS_AUTH"
+	RefreshTokenMetadataName      = "OJS_REFRESH"
 	GRPCGatewayCookieMetadataName = "grpcgateway-cookie"
 )
 
@@ -21,21 +22,36 @@ func NewHandler(
 	problemLogic logic.ProblemLogic,
 	submissionLogic logic.SubmissionLogic,
 	testCaseLogic logic.TestCaseLogic,
+	testCaseGroupLogic logic.TestCaseGroupLogic,
+	contestLogic logic.ContestLogic,
+	deadLetteredSubmissionLogic logic.DeadLetteredSubmissionLogic,
+	rejudgeLogic logic.RejudgeLogic,
+	tokenKeyLogic logic.TokenKeyLogic,
 ) ojs.OjsServiceServer {
 	return &Handler{
-		accountLogic:    accountLogic,
-		problemLogic:    problemLogic,
-		submissionLogic: submissionLogic,
-		testCaseLogic:   testCaseLogic,
+		accountLogic:                accountLogic,
+		problemLogic:                problemLogic,
+		submissionLogic:             submissionLogic,
+		testCaseLogic:               testCaseLogic,
+		testCaseGroupLogic:          testCaseGroupLogic,
+		contestLogic:                contestLogic,
+		deadLetteredSubmissionLogic: deadLetteredSubmissionLogic,
+		rejudgeLogic:                rejudgeLogic,
+		tokenKeyLogic:               tokenKeyLogic,
 	}
 }
 
 type Handler struct {
 	ojs.UnimplementedOjsServiceServer
-	accountLogic    logic.AccountLogic
-	problemLogic    logic.ProblemLogic
-	submissionLogic logic.SubmissionLogic
-	testCaseLogic   logic.TestCaseLogic
+	accountLogic                logic.AccountLogic
+	problemLogic                logic.ProblemLogic
+	submissionLogic             logic.SubmissionLogic
+	testCaseLogic               logic.TestCaseLogic
+	testCaseGroupLogic          logic.TestCaseGroupLogic
+	contestLogic                logic.ContestLogic
+	deadLetteredSubmissionLogic logic.DeadLetteredSubmissionLogic
+	rejudgeLogic                logic.RejudgeLogic
+	tokenKeyLogic               logic.TokenKeyLogic
 }
 
 // CreateProblem implements ojs.OjsServiceServer.
@@ -53,11 +69,13 @@ func (h *Handler) CreateProblem(ctx context.Context, in *ojs.CreateProblemReques
 	resp, err := h.problemLogic.CreateProblem(
 		ctx,
 		logic.CreateProblemInput{
-			Token:       h.getAuthTokenFromMetadata(ctx),
-			DisplayName: in.DisplayName,
-			Description: in.Description,
-			TimeLimit:   uint64(timeLimit),
-			MemoryLimit: memoryLimit,
+			Token:          h.getAuthTokenFromMetadata(ctx),
+			DisplayName:    in.DisplayName,
+			Description:    in.Description,
+			TimeLimit:      uint64(timeLimit),
+			MemoryLimit:    memoryLimit,
+			ComparisonMode: in.GetComparisonMode(),
+			FloatEpsilon:   in.GetFloatEpsilon(),
 		},
 	)
 	if err != nil {
@@ -66,13 +84,19 @@ func (h *Handler) CreateProblem(ctx context.Context, in *ojs.CreateProblemReques
 
 	return &ojs.CreateProblemResponse{
 		Problem: &ojs.Problem{
-			Id:          resp.Problem.ID,
-			AuthorId:    resp.Problem.AuthorId,
-			AuthorName:  resp.Problem.AuthorName,
-			DisplayName: resp.Problem.DisplayName,
-			Description: resp.Problem.Description,
-			TimeLimit:   time.Duration(resp.Problem.TimeLimit).String(),
-			MemoryLimit: humanize.Bytes(resp.Problem.MemoryLimit),
+			Id:                 resp.Problem.ID,
+			AuthorId:           resp.Problem.AuthorId,
+			AuthorName:         resp.Problem.AuthorName,
+			DisplayName:        resp.Problem.DisplayName,
+			Description:        resp.Problem.Description,
+			TimeLimit:          time.Duration(resp.Problem.TimeLimit).String(),
+			MemoryLimit:        humanize.Bytes(resp.Problem.MemoryLimit),
+			HasChecker:         resp.Problem.HasChecker,
+			CheckerLanguage:    resp.Problem.CheckerLanguage,
+			ComparisonMode:     resp.Problem.ComparisonMode,
+			FloatEpsilon:       resp.Problem.FloatEpsilon,
+			HasInteractor:      resp.Problem.HasInteractor,
+			InteractorLanguage: resp.Problem.InteractorLanguage,
 		},
 	}, nil
 
@@ -109,13 +133,93 @@ func (h *Handler) GetAccount(ctx context.Context, in *ojs.GetAccountRequest) (*o
 
 	return &ojs.GetAccountResponse{
 		Account: &ojs.Account{
-			Id:   output.ID,
-			Name: output.Name,
-			Role: output.Role,
+			Id:         output.ID,
+			Name:       output.Name,
+			Role:       output.Role,
+			IsDisabled: output.IsDisabled,
 		},
 	}, nil
 }
 
+// GetAccountList implements ojs.OjsServiceServer.
+func (h *Handler) GetAccountList(ctx context.Context, in *ojs.GetAccountListRequest) (*ojs.GetAccountListResponse, error) {
+	output, err := h.accountLogic.GetAccountList(ctx, logic.GetAccountListInput{
+		Token:  h.getAuthTokenFromMetadata(ctx),
+		Query:  in.Query,
+		Offset: in.Offset,
+		Limit:  in.Limit,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	var accounts []*ojs.Account
+	for _, account := range output.Accounts {
+		accounts = append(accounts, h.logicAccountToOJSAccount(account))
+	}
+
+	return &ojs.GetAccountListResponse{
+		Accounts:          accounts,
+		TotalAccountCount: output.TotalAccountCount,
+	}, nil
+}
+
+// UpdateAccount implements ojs.OjsServiceServer.
+func (h *Handler) UpdateAccount(ctx context.Context, in *ojs.UpdateAccountRequest) (*ojs.UpdateAccountResponse, error) {
+	output, err := h.accountLogic.UpdateAccount(ctx, logic.UpdateAccountInput{
+		Token: h.getAuthTokenFromMetadata(ctx),
+		ID:    in.Id,
+		Name:  in.Name,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &ojs.UpdateAccountResponse{Account: h.logicAccountToOJSAccount(output.Account)}, nil
+}
+
+// ChangePassword implements ojs.OjsServiceServer.
+func (h *Handler) ChangePassword(ctx context.Context, in *ojs.ChangePasswordRequest) (*ojs.ChangePasswordResponse, error) {
+	err := h.accountLogic.ChangePassword(ctx, logic.ChangePasswordInput{
+		Token:       h.getAuthTokenFromMetadata(ctx),
+		ID:          in.Id,
+		OldPassword: in.OldPassword,
+		NewPassword: in.NewPassword,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &ojs.ChangePasswordResponse{}, nil
+}
+
+// SetAccountRole implements ojs.OjsServiceServer.
+func (h *Handler) SetAccountRole(ctx context.Context, in *ojs.SetAccountRoleRequest) (*ojs.SetAccountRoleResponse, error) {
+	output, err := h.accountLogic.SetAccountRole(ctx, logic.SetAccountRoleInput{
+		Token: h.getAuthTokenFromMetadata(ctx),
+		ID:    in.Id,
+		Role:  in.Role,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &ojs.SetAccountRoleResponse{Account: h.logicAccountToOJSAccount(output.Account)}, nil
+}
+
+// DisableAccount implements ojs.OjsServiceServer.
+func (h *Handler) DisableAccount(ctx context.Context, in *ojs.DisableAccountRequest) (*ojs.DisableAccountResponse, error) {
+	output, err := h.accountLogic.DisableAccount(ctx, logic.DisableAccountInput{
+		Token: h.getAuthTokenFromMetadata(ctx),
+		ID:    in.Id,
+	})
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &ojs.DisableAccountResponse{Account: h.logicAccountToOJSAccount(output.Account)}, nil
+}
+
 // CreateSession implements ojs.OjsServiceServer.
 func (h *Handler) CreateSession(ctx context.Context, in *ojs.CreateSessionRequest) (*ojs.CreateSessionResponse, error) {
 	session, err := h.accountLogic.CreateSession(
@@ -129,7 +233,10 @@ func (h *Handler) CreateSession(ctx context.Context, in *ojs.CreateSessionReques
 		return nil, clientResponseError(err)
 	}
 
-	err = grpc.SendHeader(ctx, metadata.Pairs(AuthTokenMetadataName, session.Token))
+	err = grpc.SendHeader(ctx, metadata.Pairs(
+		AuthTokenMetadataName, session.Token,
+		RefreshTokenMetadataName, session.RefreshToken,
+	))
 	if err != nil {
 		return nil, clientResponseError(err)
 	}
@@ -155,7 +262,7 @@ func (h *Handler) DeleteSession(ctx context.Context, in *ojs.DeleteSessionReques
 		return nil, clientResponseError(err)
 	}
 
-	err = grpc.SendHeader(ctx, metadata.Pairs(AuthTokenMetadataName, ""))
+	err = grpc.SendHeader(ctx, metadata.Pairs(AuthTokenMetadataName, "", RefreshTokenMetadataName, ""))
 	if err != nil {
 		return nil, clientResponseError(err)
 	}
@@ -163,6 +270,77 @@ func (h *Handler) DeleteSession(ctx context.Context, in *ojs.DeleteSessionReques
 	return &ojs.DeleteSessionResponse{}, nil
 }
 
+// RefreshSession implements ojs.OjsServiceServer.
+func (h *Handler) RefreshSession(ctx context.Context, in *ojs.RefreshSessionRequest) (*ojs.RefreshSessionResponse, error) {
+	session, err := h.accountLogic.RefreshSession(
+		ctx,
+		logic.RefreshSessionInput{
+			RefreshToken: h.getMetadataValue(ctx, RefreshTokenMetadataName),
+		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	err = grpc.SendHeader(ctx, metadata.Pairs(
+		AuthTokenMetadataName, session.Token,
+		RefreshTokenMetadataName, session.RefreshToken,
+	))
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &ojs.RefreshSessionResponse{
+		Account: &ojs.Account{
+			Id:   session.ID,
+			Name: session.Name,
+			Role: session.Role,
+		},
+	}, nil
+}
+
+// DeleteAllSessions implements ojs.OjsServiceServer.
+func (h *Handler) DeleteAllSessions(ctx context.Context, in *ojs.DeleteAllSessionsRequest) (*ojs.DeleteAllSessionsResponse, error) {
+	err := h.accountLogic.DeleteAllSessions(
+		ctx,
+		logic.DeleteAllSessionsInput{
+			Token: h.getAuthTokenFromMetadata(ctx),
+		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	err = grpc.SendHeader(ctx, metadata.Pairs(AuthTokenMetadataName, "", RefreshTokenMetadataName, ""))
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &ojs.DeleteAllSessionsResponse{}, nil
+}
+
+// GetJSONWebKeySet implements ojs.OjsServiceServer.
+func (h *Handler) GetJSONWebKeySet(ctx context.Context, in *ojs.GetJSONWebKeySetRequest) (*ojs.GetJSONWebKeySetResponse, error) {
+	output, err := h.tokenKeyLogic.GetJSONWebKeySet(ctx)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	keys := make([]*ojs.JSONWebKey, 0, len(output.Keys))
+	for _, key := range output.Keys {
+		keys = append(keys, &ojs.JSONWebKey{
+			Kid: key.KeyID,
+			Kty: key.KeyType,
+			Alg: key.Algorithm,
+			Use: key.Use,
+			N:   key.Modulus,
+			E:   key.Exponent,
+		})
+	}
+
+	return &ojs.GetJSONWebKeySetResponse{Keys: keys}, nil
+}
+
 func (h *Handler) GetProblem(ctx context.Context, in *ojs.GetProblemRequest) (*ojs.GetProblemResponse, error) {
 	// Call the corresponding method of h.problemLogic
 	output, err := h.problemLogic.GetProblem(
@@ -179,12 +357,18 @@ func (h *Handler) GetProblem(ctx context.Context, in *ojs.GetProblemRequest) (*o
 	// Format the response based on the result obtained
 	response := &ojs.GetProblemResponse{
 		Problem: &ojs.Problem{
-			Id:          output.Problem.ID,
-			DisplayName: output.Problem.DisplayName,
-			AuthorId:    output.Problem.AuthorId,
-			Description: output.Problem.Description,
-			TimeLimit:   time.Duration(output.Problem.TimeLimit).String(),
-			MemoryLimit: humanize.Bytes(output.Problem.MemoryLimit),
+			Id:                 output.Problem.ID,
+			DisplayName:        output.Problem.DisplayName,
+			AuthorId:           output.Problem.AuthorId,
+			Description:        output.Problem.Description,
+			TimeLimit:          time.Duration(output.Problem.TimeLimit).String(),
+			MemoryLimit:        humanize.Bytes(output.Problem.MemoryLimit),
+			HasChecker:         output.Problem.HasChecker,
+			CheckerLanguage:    output.Problem.CheckerLanguage,
+			ComparisonMode:     output.Problem.ComparisonMode,
+			FloatEpsilon:       output.Problem.FloatEpsilon,
+			HasInteractor:      output.Problem.HasInteractor,
+			InteractorLanguage: output.Problem.InteractorLanguage,
 		},
 	}
 
@@ -212,12 +396,18 @@ func (h *Handler) GetProblemList(ctx context.Context, in *ojs.GetProblemListRequ
 	}
 	for _, pb := range output.Problems {
 		response.Problems = append(response.Problems, &ojs.Problem{
-			Id:          pb.ID,
-			DisplayName: pb.DisplayName,
-			AuthorId:    pb.AuthorId,
-			Description: pb.Description,
-			TimeLimit:   time.Duration(pb.TimeLimit).String(),
-			MemoryLimit: humanize.Bytes(pb.MemoryLimit),
+			Id:                 pb.ID,
+			DisplayName:        pb.DisplayName,
+			AuthorId:           pb.AuthorId,
+			Description:        pb.Description,
+			TimeLimit:          time.Duration(pb.TimeLimit).String(),
+			MemoryLimit:        humanize.Bytes(pb.MemoryLimit),
+			HasChecker:         pb.HasChecker,
+			CheckerLanguage:    pb.CheckerLanguage,
+			ComparisonMode:     pb.ComparisonMode,
+			FloatEpsilon:       pb.FloatEpsilon,
+			HasInteractor:      pb.HasInteractor,
+			InteractorLanguage: pb.InteractorLanguage,
 		})
 	}
 
@@ -239,12 +429,14 @@ func (h *Handler) UpdateProblem(ctx context.Context, in *ojs.UpdateProblemReques
 	output, err := h.problemLogic.UpdateProblem(
 		ctx,
 		logic.UpdateProblemInput{
-			Token:       h.getAuthTokenFromMetadata(ctx),
-			ID:          in.GetId(),
-			DisplayName: in.DisplayName,
-			Description: in.Description,
-			TimeLimit:   uint64(timeLimit),
-			MemoryLimit: memoryLimit,
+			Token:          h.getAuthTokenFromMetadata(ctx),
+			ID:             in.GetId(),
+			DisplayName:    in.DisplayName,
+			Description:    in.Description,
+			TimeLimit:      uint64(timeLimit),
+			MemoryLimit:    memoryLimit,
+			ComparisonMode: in.GetComparisonMode(),
+			FloatEpsilon:   in.GetFloatEpsilon(),
 		},
 	)
 	if err != nil {
@@ -254,13 +446,19 @@ func (h *Handler) UpdateProblem(ctx context.Context, in *ojs.UpdateProblemReques
 	// Format the response based on the result obtained
 	response := &ojs.UpdateProblemResponse{
 		Problem: &ojs.Problem{
-			Id:          output.Problem.ID,
-			DisplayName: output.Problem.DisplayName,
-			AuthorId:    output.Problem.AuthorId,
-			AuthorName:  output.Problem.AuthorName,
-			Description: output.Problem.Description,
-			TimeLimit:   time.Duration(output.Problem.TimeLimit).String(),
-			MemoryLimit: humanize.Bytes(output.Problem.MemoryLimit),
+			Id:                 output.Problem.ID,
+			DisplayName:        output.Problem.DisplayName,
+			AuthorId:           output.Problem.AuthorId,
+			AuthorName:         output.Problem.AuthorName,
+			Description:        output.Problem.Description,
+			TimeLimit:          time.Duration(output.Problem.TimeLimit).String(),
+			MemoryLimit:        humanize.Bytes(output.Problem.MemoryLimit),
+			HasChecker:         output.Problem.HasChecker,
+			CheckerLanguage:    output.Problem.CheckerLanguage,
+			ComparisonMode:     output.Problem.ComparisonMode,
+			FloatEpsilon:       output.Problem.FloatEpsilon,
+			HasInteractor:      output.Problem.HasInteractor,
+			InteractorLanguage: output.Problem.InteractorLanguage,
 		},
 	}
 
@@ -285,16 +483,85 @@ func (h *Handler) DeleteProblem(ctx context.Context, in *ojs.DeleteProblemReques
 	return &ojs.DeleteProblemResponse{}, nil
 }
 
+// UpdateProblemChecker implements ojs.OjsServiceServer.
+func (h *Handler) UpdateProblemChecker(ctx context.Context, in *ojs.UpdateProblemCheckerRequest) (*ojs.UpdateProblemCheckerResponse, error) {
+	output, err := h.problemLogic.UpdateProblemChecker(
+		ctx,
+		logic.UpdateProblemCheckerInput{
+			Token:    h.getAuthTokenFromMetadata(ctx),
+			ID:       in.GetId(),
+			Language: in.GetLanguage(),
+			Source:   in.GetSource(),
+		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &ojs.UpdateProblemCheckerResponse{
+		Problem: &ojs.Problem{
+			Id:                 output.Problem.ID,
+			AuthorId:           output.Problem.AuthorId,
+			AuthorName:         output.Problem.AuthorName,
+			DisplayName:        output.Problem.DisplayName,
+			Description:        output.Problem.Description,
+			TimeLimit:          time.Duration(output.Problem.TimeLimit).String(),
+			MemoryLimit:        humanize.Bytes(output.Problem.MemoryLimit),
+			HasChecker:         output.Problem.HasChecker,
+			CheckerLanguage:    output.Problem.CheckerLanguage,
+			ComparisonMode:     output.Problem.ComparisonMode,
+			FloatEpsilon:       output.Problem.FloatEpsilon,
+			HasInteractor:      output.Problem.HasInteractor,
+			InteractorLanguage: output.Problem.InteractorLanguage,
+		},
+	}, nil
+}
+
+// UpdateProblemInteractor implements ojs.OjsServiceServer.
+func (h *Handler) UpdateProblemInteractor(ctx context.Context, in *ojs.UpdateProblemInteractorRequest) (*ojs.UpdateProblemInteractorResponse, error) {
+	output, err := h.problemLogic.UpdateProblemInteractor(
+		ctx,
+		logic.UpdateProblemInteractorInput{
+			Token:    h.getAuthTokenFromMetadata(ctx),
+			ID:       in.GetId(),
+			Language: in.GetLanguage(),
+			Source:   in.GetSource(),
+		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &ojs.UpdateProblemInteractorResponse{
+		Problem: &ojs.Problem{
+			Id:                 output.Problem.ID,
+			AuthorId:           output.Problem.AuthorId,
+			AuthorName:         output.Problem.AuthorName,
+			DisplayName:        output.Problem.DisplayName,
+			Description:        output.Problem.Description,
+			TimeLimit:          time.Duration(output.Problem.TimeLimit).String(),
+			MemoryLimit:        humanize.Bytes(output.Problem.MemoryLimit),
+			HasChecker:         output.Problem.HasChecker,
+			CheckerLanguage:    output.Problem.CheckerLanguage,
+			ComparisonMode:     output.Problem.ComparisonMode,
+			FloatEpsilon:       output.Problem.FloatEpsilon,
+			HasInteractor:      output.Problem.HasInteractor,
+			InteractorLanguage: output.Problem.InteractorLanguage,
+		},
+	}, nil
+}
+
 func (h *Handler) CreateTestCase(ctx context.Context, in *ojs.CreateTestCaseRequest) (*ojs.CreateTestCaseResponse, error) {
 	// Call the corresponding method of h.testCaseLogic
 	output, err := h.testCaseLogic.CreateTestCase(
 		ctx,
 		logic.CreateTestCaseInput{
-			OfProblemID: in.GetOfProblemId(),
-			Input:       in.GetInput(),
-			Output:      in.GetOutput(),
-			IsHidden:    in.GetIsHidden(),
-			Token:       h.getAuthTokenFromMetadata(ctx),
+			OfProblemID:       in.GetOfProblemId(),
+			Input:             in.GetInput(),
+			Output:            in.GetOutput(),
+			IsHidden:          in.GetIsHidden(),
+			OfTestCaseGroupID: in.GetOfTestCaseGroupId(),
+			Token:             h.getAuthTokenFromMetadata(ctx),
 		},
 	)
 	if err != nil {
@@ -304,11 +571,12 @@ func (h *Handler) CreateTestCase(ctx context.Context, in *ojs.CreateTestCaseRequ
 	// Format the response based on the result obtained
 	response := &ojs.CreateTestCaseResponse{
 		TestCase: &ojs.TestCase{
-			Id:          output.TestCase.ID,
-			OfProblemId: output.TestCase.OfProblemID,
-			Input:       output.TestCase.Input,
-			Output:      output.TestCase.Output,
-			IsHidden:    output.TestCase.IsHidden,
+			Id:                output.TestCase.ID,
+			OfProblemId:       output.TestCase.OfProblemID,
+			Input:             output.TestCase.Input,
+			Output:            output.TestCase.Output,
+			IsHidden:          output.TestCase.IsHidden,
+			OfTestCaseGroupId: output.TestCase.OfTestCaseGroupID,
 		},
 	}
 
@@ -331,11 +599,12 @@ func (h *Handler) GetTestCase(ctx context.Context, in *ojs.GetTestCaseRequest) (
 	// Format the response based on the result obtained
 	response := &ojs.GetTestCaseResponse{
 		TestCase: &ojs.TestCase{
-			Id:          output.TestCase.ID,
-			OfProblemId: output.TestCase.OfProblemID,
-			Input:       output.TestCase.Input,
-			Output:      output.TestCase.Output,
-			IsHidden:    output.TestCase.IsHidden,
+			Id:                output.TestCase.ID,
+			OfProblemId:       output.TestCase.OfProblemID,
+			Input:             output.TestCase.Input,
+			Output:            output.TestCase.Output,
+			IsHidden:          output.TestCase.IsHidden,
+			OfTestCaseGroupId: output.TestCase.OfTestCaseGroupID,
 		},
 	}
 
@@ -361,10 +630,11 @@ func (h *Handler) GetProblemTestCaseList(ctx context.Context, in *ojs.GetProblem
 	var testCases []*ojs.TestCase
 	for _, testCase := range output.TestCases {
 		testCases = append(testCases, &ojs.TestCase{
-			Id:          testCase.ID,
-			OfProblemId: testCase.OfProblemID,
-			Input:       testCase.Input,
-			Output:      testCase.Output,
+			Id:                testCase.ID,
+			OfProblemId:       testCase.OfProblemID,
+			Input:             testCase.Input,
+			Output:            testCase.Output,
+			OfTestCaseGroupId: testCase.OfTestCaseGroupID,
 		})
 	}
 
@@ -398,11 +668,12 @@ func (h *Handler) UpdateTestCase(ctx context.Context, in *ojs.UpdateTestCaseRequ
 	updatedTestCase, err := h.testCaseLogic.UpdateTestCase(
 		ctx,
 		logic.UpdateTestCaseInput{
-			ID:       in.GetId(),
-			Input:    in.GetInput(),
-			Output:   in.GetOutput(),
-			IsHidden: in.GetIsHidden(),
-			Token:    h.getAuthTokenFromMetadata(ctx),
+			ID:                in.GetId(),
+			Input:             in.GetInput(),
+			Output:            in.GetOutput(),
+			IsHidden:          in.GetIsHidden(),
+			OfTestCaseGroupID: in.GetOfTestCaseGroupId(),
+			Token:             h.getAuthTokenFromMetadata(ctx),
 		},
 	)
 	if err != nil {
@@ -412,15 +683,95 @@ func (h *Handler) UpdateTestCase(ctx context.Context, in *ojs.UpdateTestCaseRequ
 	// No need to return any response for update operation
 	return &ojs.UpdateTestCaseResponse{
 		TestCase: &ojs.TestCase{
-			Id:          updatedTestCase.TestCase.ID,
-			OfProblemId: updatedTestCase.TestCase.OfProblemID,
-			Input:       updatedTestCase.TestCase.Input,
-			Output:      updatedTestCase.TestCase.Output,
-			IsHidden:    updatedTestCase.TestCase.IsHidden,
+			Id:                updatedTestCase.TestCase.ID,
+			OfProblemId:       updatedTestCase.TestCase.OfProblemID,
+			Input:             updatedTestCase.TestCase.Input,
+			Output:            updatedTestCase.TestCase.Output,
+			IsHidden:          updatedTestCase.TestCase.IsHidden,
+			OfTestCaseGroupId: updatedTestCase.TestCase.OfTestCaseGroupID,
+		},
+	}, nil
+}
+
+// CreateTestCaseGroup implements ojs.OjsServiceServer.
+func (h *Handler) CreateTestCaseGroup(ctx context.Context, in *ojs.CreateTestCaseGroupRequest) (*ojs.CreateTestCaseGroupResponse, error) {
+	output, err := h.testCaseGroupLogic.CreateTestCaseGroup(
+		ctx,
+		logic.CreateTestCaseGroupInput{
+			Token:         h.getAuthTokenFromMetadata(ctx),
+			OfProblemID:   in.GetOfProblemId(),
+			DisplayName:   in.GetDisplayName(),
+			Points:        in.GetPoints(),
+			ScoringPolicy: in.GetScoringPolicy(),
+		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &ojs.CreateTestCaseGroupResponse{
+		TestCaseGroup: h.logicTestCaseGroupToOJSTestCaseGroup(output.TestCaseGroup),
+	}, nil
+}
+
+// GetProblemTestCaseGroupList implements ojs.OjsServiceServer.
+func (h *Handler) GetProblemTestCaseGroupList(ctx context.Context, in *ojs.GetProblemTestCaseGroupListRequest) (*ojs.GetProblemTestCaseGroupListResponse, error) {
+	output, err := h.testCaseGroupLogic.GetProblemTestCaseGroupList(
+		ctx,
+		logic.GetProblemTestCaseGroupListInput{
+			Token:       h.getAuthTokenFromMetadata(ctx),
+			OfProblemID: in.GetId(),
+		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	response := &ojs.GetProblemTestCaseGroupListResponse{}
+	for _, testCaseGroup := range output.TestCaseGroups {
+		response.TestCaseGroups = append(response.TestCaseGroups, h.logicTestCaseGroupToOJSTestCaseGroup(testCaseGroup))
+	}
+
+	return response, nil
+}
+
+// UpdateTestCaseGroup implements ojs.OjsServiceServer.
+func (h *Handler) UpdateTestCaseGroup(ctx context.Context, in *ojs.UpdateTestCaseGroupRequest) (*ojs.UpdateTestCaseGroupResponse, error) {
+	output, err := h.testCaseGroupLogic.UpdateTestCaseGroup(
+		ctx,
+		logic.UpdateTestCaseGroupInput{
+			Token:         h.getAuthTokenFromMetadata(ctx),
+			ID:            in.GetId(),
+			DisplayName:   in.GetDisplayName(),
+			Points:        in.GetPoints(),
+			ScoringPolicy: in.GetScoringPolicy(),
 		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &ojs.UpdateTestCaseGroupResponse{
+		TestCaseGroup: h.logicTestCaseGroupToOJSTestCaseGroup(output.TestCaseGroup),
 	}, nil
 }
 
+// DeleteTestCaseGroup implements ojs.OjsServiceServer.
+func (h *Handler) DeleteTestCaseGroup(ctx context.Context, in *ojs.DeleteTestCaseGroupRequest) (*ojs.DeleteTestCaseGroupResponse, error) {
+	err := h.testCaseGroupLogic.DeleteTestCaseGroup(
+		ctx,
+		logic.DeleteTestCaseGroupInput{
+			Token: h.getAuthTokenFromMetadata(ctx),
+			ID:    in.GetId(),
+		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &ojs.DeleteTestCaseGroupResponse{}, nil
+}
+
 // CreateSubmission implements ojs.OjsServiceServer.
 func (h *Handler) CreateSubmission(ctx context.Context, in *ojs.CreateSubmissionRequest) (*ojs.CreateSubmissionResponse, error) {
 	// Call the corresponding method of h.submissionLogic
@@ -431,6 +782,7 @@ func (h *Handler) CreateSubmission(ctx context.Context, in *ojs.CreateSubmission
 			OfProblemID: in.GetOfProblemId(),
 			Content:     in.GetContent(),
 			Language:    in.GetLanguage(),
+			OfContestID: in.GetOfContestId(),
 		},
 	)
 	if err != nil {
@@ -440,13 +792,16 @@ func (h *Handler) CreateSubmission(ctx context.Context, in *ojs.CreateSubmission
 	// Format the response based on the result obtained
 	response := &ojs.CreateSubmissionResponse{
 		Submission: &ojs.Submission{
-			Id:          output.Submission.ID,
-			OfProblemId: output.Submission.OfProblemID,
-			AuthorId:    output.Submission.AuthorID,
-			Content:     output.Submission.Content,
-			Language:    output.Submission.Language,
-			Status:      output.Submission.Status,
-			Result:      output.Submission.Result,
+			Id:            output.Submission.ID,
+			OfProblemId:   output.Submission.OfProblemID,
+			AuthorId:      output.Submission.AuthorID,
+			Content:       output.Submission.Content,
+			Language:      output.Submission.Language,
+			Status:        output.Submission.Status,
+			Result:        output.Submission.Result,
+			CompileOutput: output.Submission.CompileOutput,
+			OfContestId:   output.Submission.OfContestID,
+			Score:         output.Submission.Score,
 		},
 	}
 
@@ -473,13 +828,16 @@ func (h *Handler) GetProblemSubmissionList(ctx context.Context, in *ojs.GetProbl
 	var submissions []*ojs.Submission
 	for _, submission := range output.Submissions {
 		submissions = append(submissions, &ojs.Submission{
-			Id:          submission.ID,
-			AuthorId:    submission.AuthorID,
-			OfProblemId: submission.OfProblemID,
-			Content:     submission.Content,
-			Language:    submission.Language,
-			Status:      submission.Status,
-			Result:      submission.Result,
+			Id:            submission.ID,
+			AuthorId:      submission.AuthorID,
+			OfProblemId:   submission.OfProblemID,
+			Content:       submission.Content,
+			Language:      submission.Language,
+			Status:        submission.Status,
+			Result:        submission.Result,
+			CompileOutput: submission.CompileOutput,
+			OfContestId:   submission.OfContestID,
+			Score:         submission.Score,
 		})
 	}
 
@@ -508,13 +866,16 @@ func (h *Handler) GetSubmission(ctx context.Context, in *ojs.GetSubmissionReques
 	// Format the response based on the result obtained
 	response := &ojs.GetSubmissionResponse{
 		Submission: &ojs.Submission{
-			Id:          output.Submission.ID,
-			AuthorId:    output.Submission.AuthorID,
-			OfProblemId: output.Submission.OfProblemID,
-			Content:     output.Submission.Content,
-			Language:    output.Submission.Language,
-			Status:      output.Submission.Status,
-			Result:      output.Submission.Result,
+			Id:            output.Submission.ID,
+			AuthorId:      output.Submission.AuthorID,
+			OfProblemId:   output.Submission.OfProblemID,
+			Content:       output.Submission.Content,
+			Language:      output.Submission.Language,
+			Status:        output.Submission.Status,
+			Result:        output.Submission.Result,
+			CompileOutput: output.Submission.CompileOutput,
+			OfContestId:   output.Submission.OfContestID,
+			Score:         output.Submission.Score,
 		},
 	}
 
@@ -540,13 +901,16 @@ func (h *Handler) GetSubmissionList(ctx context.Context, in *ojs.GetSubmissionLi
 	var submissions []*ojs.Submission
 	for _, submission := range output.Submissions {
 		submissions = append(submissions, &ojs.Submission{
-			Id:          submission.ID,
-			AuthorId:    submission.AuthorID,
-			OfProblemId: submission.OfProblemID,
-			Content:     submission.Content,
-			Language:    submission.Language,
-			Status:      submission.Status,
-			Result:      submission.Result,
+			Id:            submission.ID,
+			AuthorId:      submission.AuthorID,
+			OfProblemId:   submission.OfProblemID,
+			Content:       submission.Content,
+			Language:      submission.Language,
+			Status:        submission.Status,
+			Result:        submission.Result,
+			CompileOutput: submission.CompileOutput,
+			OfContestId:   submission.OfContestID,
+			Score:         submission.Score,
 		})
 	}
 
@@ -558,6 +922,70 @@ func (h *Handler) GetSubmissionList(ctx context.Context, in *ojs.GetSubmissionLi
 	return response, nil
 }
 
+// GetSubmissionTestCaseResultList implements ojs.OjsServiceServer.
+func (h *Handler) GetSubmissionTestCaseResultList(ctx context.Context, in *ojs.GetSubmissionTestCaseResultListRequest) (*ojs.GetSubmissionTestCaseResultListResponse, error) {
+	output, err := h.submissionLogic.GetSubmissionTestCaseResultList(
+		ctx,
+		logic.GetSubmissionTestCaseResultListInput{
+			ID:    in.GetId(),
+			Token: h.getAuthTokenFromMetadata(ctx),
+		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	var submissionTestCaseResults []*ojs.SubmissionTestCaseResult
+	for _, r := range output.SubmissionTestCaseResults {
+		submissionTestCaseResults = append(submissionTestCaseResults, &ojs.SubmissionTestCaseResult{
+			Id:             r.ID,
+			OfSubmissionId: r.OfSubmissionID,
+			OfTestCaseId:   r.OfTestCaseID,
+			Result:         r.Result,
+			WallTime:       time.Duration(r.WallTime).String(),
+			CpuTime:        time.Duration(r.CPUTime).String(),
+			Memory:         humanize.Bytes(r.Memory),
+			ExitCode:       r.ExitCode,
+			Stdout:         r.Stdout,
+			Stderr:         r.Stderr,
+		})
+	}
+
+	return &ojs.GetSubmissionTestCaseResultListResponse{
+		SubmissionTestCaseResults: submissionTestCaseResults,
+	}, nil
+}
+
+// WatchSubmission implements ojs.OjsServiceServer.
+func (h *Handler) WatchSubmission(in *ojs.WatchSubmissionRequest, stream ojs.OjsService_WatchSubmissionServer) error {
+	ctx := stream.Context()
+	output, err := h.submissionLogic.WatchSubmission(
+		ctx,
+		logic.WatchSubmissionInput{
+			ID:    in.GetId(),
+			Token: h.getAuthTokenFromMetadata(ctx),
+		},
+	)
+	if err != nil {
+		return clientResponseError(err)
+	}
+
+	for update := range output.SubmissionUpdates {
+		err = stream.Send(&ojs.WatchSubmissionResponse{
+			Id:                  update.ID,
+			Status:              update.Status,
+			Result:              update.Result,
+			JudgedTestCaseCount: update.JudgedTestCaseCount,
+			TestCaseCount:       update.TestCaseCount,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // GetAccountProblemSubmissionList implements ojs.OjsServiceServer.
 func (h *Handler) GetAccountProblemSubmissionList(ctx context.Context, in *ojs.GetAccountProblemSubmissionListRequest) (*ojs.GetAccountProblemSubmissionListResponse, error) {
 	// Call the corresponding method of h.submissionLogic
@@ -578,13 +1006,16 @@ func (h *Handler) GetAccountProblemSubmissionList(ctx context.Context, in *ojs.G
 	var submissions []*ojs.Submission
 	for _, submission := range output.Submissions {
 		submissions = append(submissions, &ojs.Submission{
-			Id:          submission.ID,
-			AuthorId:    submission.AuthorID,
-			OfProblemId: submission.OfProblemID,
-			Content:     submission.Content,
-			Language:    submission.Language,
-			Status:      submission.Status,
-			Result:      submission.Result,
+			Id:            submission.ID,
+			AuthorId:      submission.AuthorID,
+			OfProblemId:   submission.OfProblemID,
+			Content:       submission.Content,
+			Language:      submission.Language,
+			Status:        submission.Status,
+			Result:        submission.Result,
+			CompileOutput: submission.CompileOutput,
+			OfContestId:   submission.OfContestID,
+			Score:         submission.Score,
 		})
 	}
 
@@ -596,6 +1027,196 @@ func (h *Handler) GetAccountProblemSubmissionList(ctx context.Context, in *ojs.G
 	return response, nil
 }
 
+// CreateContest implements ojs.OjsServiceServer.
+func (h *Handler) CreateContest(ctx context.Context, in *ojs.CreateContestRequest) (*ojs.CreateContestResponse, error) {
+	startTime, err := time.Parse(time.RFC3339, in.GetStartTime())
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	endTime, err := time.Parse(time.RFC3339, in.GetEndTime())
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	output, err := h.contestLogic.CreateContest(
+		ctx,
+		logic.CreateContestInput{
+			Token:            h.getAuthTokenFromMetadata(ctx),
+			DisplayName:      in.GetDisplayName(),
+			Description:      in.GetDescription(),
+			StartTime:        startTime,
+			EndTime:          endTime,
+			IsPublic:         in.GetIsPublic(),
+			ScoringMode:      in.GetScoringMode(),
+			FreezeScoreboard: in.GetFreezeScoreboard(),
+			Problems:         h.ojsContestProblemListToLogicContestProblemList(in.GetProblems()),
+		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &ojs.CreateContestResponse{
+		Contest: h.logicContestToOJSContest(output.Contest),
+	}, nil
+}
+
+// GetContestList implements ojs.OjsServiceServer.
+func (h *Handler) GetContestList(ctx context.Context, in *ojs.GetContestListRequest) (*ojs.GetContestListResponse, error) {
+	output, err := h.contestLogic.GetContestList(
+		ctx,
+		logic.GetContestListInput{
+			Token:  h.getAuthTokenFromMetadata(ctx),
+			Offset: in.GetOffset(),
+			Limit:  in.GetLimit(),
+		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	response := &ojs.GetContestListResponse{
+		TotalContestCount: output.TotalContestCount,
+	}
+	for _, contest := range output.Contests {
+		response.Contests = append(response.Contests, h.logicContestToOJSContest(contest))
+	}
+
+	return response, nil
+}
+
+// GetContest implements ojs.OjsServiceServer.
+func (h *Handler) GetContest(ctx context.Context, in *ojs.GetContestRequest) (*ojs.GetContestResponse, error) {
+	output, err := h.contestLogic.GetContest(
+		ctx,
+		logic.GetContestInput{
+			Token: h.getAuthTokenFromMetadata(ctx),
+			ID:    in.GetId(),
+		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &ojs.GetContestResponse{
+		Contest: h.logicContestToOJSContest(output.Contest),
+	}, nil
+}
+
+// UpdateContest implements ojs.OjsServiceServer.
+func (h *Handler) UpdateContest(ctx context.Context, in *ojs.UpdateContestRequest) (*ojs.UpdateContestResponse, error) {
+	var startTime, endTime time.Time
+	var err error
+	if in.GetStartTime() != "" {
+		startTime, err = time.Parse(time.RFC3339, in.GetStartTime())
+		if err != nil {
+			return nil, clientResponseError(err)
+		}
+	}
+	if in.GetEndTime() != "" {
+		endTime, err = time.Parse(time.RFC3339, in.GetEndTime())
+		if err != nil {
+			return nil, clientResponseError(err)
+		}
+	}
+
+	output, err := h.contestLogic.UpdateContest(
+		ctx,
+		logic.UpdateContestInput{
+			Token:            h.getAuthTokenFromMetadata(ctx),
+			ID:               in.GetId(),
+			DisplayName:      in.GetDisplayName(),
+			Description:      in.GetDescription(),
+			StartTime:        startTime,
+			EndTime:          endTime,
+			IsPublic:         in.IsPublic,
+			ScoringMode:      in.GetScoringMode(),
+			FreezeScoreboard: in.FreezeScoreboard,
+			Problems:         h.ojsContestProblemListToLogicContestProblemList(in.GetProblems()),
+		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &ojs.UpdateContestResponse{
+		Contest: h.logicContestToOJSContest(output.Contest),
+	}, nil
+}
+
+// DeleteContest implements ojs.OjsServiceServer.
+func (h *Handler) DeleteContest(ctx context.Context, in *ojs.DeleteContestRequest) (*ojs.DeleteContestResponse, error) {
+	err := h.contestLogic.DeleteContest(
+		ctx,
+		logic.DeleteContestInput{
+			Token: h.getAuthTokenFromMetadata(ctx),
+			ID:    in.GetId(),
+		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &ojs.DeleteContestResponse{}, nil
+}
+
+// RegisterContest implements ojs.OjsServiceServer.
+func (h *Handler) RegisterContest(ctx context.Context, in *ojs.RegisterContestRequest) (*ojs.RegisterContestResponse, error) {
+	err := h.contestLogic.RegisterContest(
+		ctx,
+		logic.RegisterContestInput{
+			Token: h.getAuthTokenFromMetadata(ctx),
+			ID:    in.GetId(),
+		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &ojs.RegisterContestResponse{}, nil
+}
+
+// UnregisterContest implements ojs.OjsServiceServer.
+func (h *Handler) UnregisterContest(ctx context.Context, in *ojs.UnregisterContestRequest) (*ojs.UnregisterContestResponse, error) {
+	err := h.contestLogic.UnregisterContest(
+		ctx,
+		logic.UnregisterContestInput{
+			Token: h.getAuthTokenFromMetadata(ctx),
+			ID:    in.GetId(),
+		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &ojs.UnregisterContestResponse{}, nil
+}
+
+// GetContestScoreboard implements ojs.OjsServiceServer.
+func (h *Handler) GetContestScoreboard(ctx context.Context, in *ojs.GetContestScoreboardRequest) (*ojs.GetContestScoreboardResponse, error) {
+	output, err := h.contestLogic.GetContestScoreboard(
+		ctx,
+		logic.GetContestScoreboardInput{
+			Token: h.getAuthTokenFromMetadata(ctx),
+			ID:    in.GetId(),
+		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	response := &ojs.GetContestScoreboardResponse{
+		ScoringMode: output.Scoreboard.ScoringMode,
+		IsFrozen:    output.Scoreboard.IsFrozen,
+	}
+	for _, row := range output.Scoreboard.Rows {
+		response.Rows = append(response.Rows, h.logicScoreboardRowToOJSContestScoreboardRow(row))
+	}
+
+	return response, nil
+}
+
 // GetServerInfo implements ojs.OjsServiceServer.
 func (h *Handler) GetServerInfo(context.Context, *ojs.GetServerInfoRequest) (*ojs.GetServerInfoResponse, error) {
 	panic("unimplemented")
@@ -607,20 +1228,391 @@ func (h *Handler) UpdateSetting(context.Context, *ojs.UpdateSettingRequest) (*oj
 }
 
 // GetAndUpdateFirstSubmittedSubmissionToExecuting implements ojs.OjsServiceServer.
-func (h *Handler) GetAndUpdateFirstSubmittedSubmissionToExecuting(context.Context, *ojs.GetAndUpdateFirstSubmittedSubmissionToExecutingRequest) (*ojs.GetAndUpdateFirstSubmittedSubmissionToExecutingResponse, error) {
-	panic("unimplemented")
+func (h *Handler) GetAndUpdateFirstSubmittedSubmissionToExecuting(ctx context.Context, in *ojs.GetAndUpdateFirstSubmittedSubmissionToExecutingRequest) (*ojs.GetAndUpdateFirstSubmittedSubmissionToExecutingResponse, error) {
+	output, err := h.submissionLogic.GetAndUpdateFirstSubmittedSubmissionToExecuting(
+		ctx,
+		logic.GetAndUpdateFirstSubmittedSubmissionToExecutingInput{
+			Token: h.getAuthTokenFromMetadata(ctx),
+		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	response := &ojs.GetAndUpdateFirstSubmittedSubmissionToExecutingResponse{
+		Submission:        h.logicSubmissionToOJSSubmission(output.Submission),
+		Problem:           h.logicProblemToOJSProblem(output.Problem),
+		CheckerSource:     output.CheckerSource,
+		InteractorSource:  output.InteractorSource,
+		JudgingLeaseToken: output.JudgingLeaseToken,
+	}
+	for _, testCase := range output.TestCases {
+		response.TestCases = append(response.TestCases, &ojs.TestCase{
+			Id:                testCase.ID,
+			OfProblemId:       testCase.OfProblemID,
+			Input:             testCase.Input,
+			Output:            testCase.Output,
+			IsHidden:          testCase.IsHidden,
+			OfTestCaseGroupId: testCase.OfTestCaseGroupID,
+		})
+	}
+
+	return response, nil
+}
+
+// RenewSubmissionJudgingLease implements ojs.OjsServiceServer.
+func (h *Handler) RenewSubmissionJudgingLease(ctx context.Context, in *ojs.RenewSubmissionJudgingLeaseRequest) (*ojs.RenewSubmissionJudgingLeaseResponse, error) {
+	err := h.submissionLogic.RenewSubmissionJudgingLease(
+		ctx,
+		logic.RenewSubmissionJudgingLeaseInput{
+			Token:             h.getAuthTokenFromMetadata(ctx),
+			ID:                in.GetId(),
+			JudgingLeaseToken: in.GetJudgingLeaseToken(),
+		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &ojs.RenewSubmissionJudgingLeaseResponse{}, nil
+}
+
+// ReportSubmissionResult implements ojs.OjsServiceServer.
+func (h *Handler) ReportSubmissionResult(ctx context.Context, in *ojs.ReportSubmissionResultRequest) (*ojs.ReportSubmissionResultResponse, error) {
+	var testCaseResults []logic.JudgedTestCaseResult
+	for _, r := range in.GetTestCaseResults() {
+		wallTime, err := time.ParseDuration(r.GetWallTime())
+		if err != nil {
+			return nil, clientResponseError(err)
+		}
+
+		cpuTime, err := time.ParseDuration(r.GetCpuTime())
+		if err != nil {
+			return nil, clientResponseError(err)
+		}
+
+		memory, err := humanize.ParseBytes(r.GetMemory())
+		if err != nil {
+			return nil, clientResponseError(err)
+		}
+
+		testCaseResults = append(testCaseResults, logic.JudgedTestCaseResult{
+			OfTestCaseID: r.GetOfTestCaseId(),
+			Result:       r.GetResult(),
+			WallTime:     uint64(wallTime),
+			CPUTime:      uint64(cpuTime),
+			Memory:       memory,
+			ExitCode:     r.GetExitCode(),
+			Stdout:       r.GetStdout(),
+			Stderr:       r.GetStderr(),
+			Credit:       r.GetCredit(),
+		})
+	}
+
+	output, err := h.submissionLogic.ReportSubmissionResult(
+		ctx,
+		logic.ReportSubmissionResultInput{
+			Token:             h.getAuthTokenFromMetadata(ctx),
+			ID:                in.GetId(),
+			JudgingLeaseToken: in.GetJudgingLeaseToken(),
+			Result:            in.GetResult(),
+			CompileOutput:     in.GetCompileOutput(),
+			TestCaseResults:   testCaseResults,
+		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &ojs.ReportSubmissionResultResponse{
+		Submission: h.logicSubmissionToOJSSubmission(output.Submission),
+	}, nil
+}
+
+// RejudgeSubmission implements ojs.OjsServiceServer.
+func (h *Handler) RejudgeSubmission(ctx context.Context, in *ojs.RejudgeSubmissionRequest) (*ojs.RejudgeSubmissionResponse, error) {
+	output, err := h.rejudgeLogic.RejudgeSubmission(
+		ctx,
+		logic.RejudgeSubmissionInput{
+			Token: h.getAuthTokenFromMetadata(ctx),
+			ID:    in.GetId(),
+		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &ojs.RejudgeSubmissionResponse{
+		Rejudge: h.logicRejudgeToOJSRejudge(output.Rejudge),
+	}, nil
+}
+
+// RejudgeProblem implements ojs.OjsServiceServer.
+func (h *Handler) RejudgeProblem(ctx context.Context, in *ojs.RejudgeProblemRequest) (*ojs.RejudgeProblemResponse, error) {
+	output, err := h.rejudgeLogic.RejudgeProblem(
+		ctx,
+		logic.RejudgeProblemInput{
+			Token:     h.getAuthTokenFromMetadata(ctx),
+			ProblemID: in.GetId(),
+		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &ojs.RejudgeProblemResponse{
+		Rejudge: h.logicRejudgeToOJSRejudge(output.Rejudge),
+	}, nil
+}
+
+// RejudgeContest implements ojs.OjsServiceServer.
+func (h *Handler) RejudgeContest(ctx context.Context, in *ojs.RejudgeContestRequest) (*ojs.RejudgeContestResponse, error) {
+	output, err := h.rejudgeLogic.RejudgeContest(
+		ctx,
+		logic.RejudgeContestInput{
+			Token:     h.getAuthTokenFromMetadata(ctx),
+			ContestID: in.GetId(),
+		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &ojs.RejudgeContestResponse{
+		Rejudge: h.logicRejudgeToOJSRejudge(output.Rejudge),
+	}, nil
+}
+
+// GetRejudgeReport implements ojs.OjsServiceServer.
+func (h *Handler) GetRejudgeReport(ctx context.Context, in *ojs.GetRejudgeReportRequest) (*ojs.GetRejudgeReportResponse, error) {
+	output, err := h.rejudgeLogic.GetRejudgeReport(
+		ctx,
+		logic.GetRejudgeReportInput{
+			Token: h.getAuthTokenFromMetadata(ctx),
+			ID:    in.GetId(),
+		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	response := &ojs.GetRejudgeReportResponse{
+		Rejudge:                h.logicRejudgeToOJSRejudge(output.Rejudge),
+		PendingSubmissionCount: output.PendingSubmissionCount,
+		ChangedSubmissionCount: output.ChangedSubmissionCount,
+	}
+	for _, rejudgedSubmission := range output.RejudgedSubmissions {
+		response.RejudgedSubmissions = append(response.RejudgedSubmissions, &ojs.RejudgedSubmission{
+			SubmissionId:   rejudgedSubmission.SubmissionID,
+			PreviousResult: rejudgedSubmission.PreviousResult,
+			PreviousScore:  rejudgedSubmission.PreviousScore,
+			Status:         rejudgedSubmission.Status,
+			Result:         rejudgedSubmission.Result,
+			Score:          rejudgedSubmission.Score,
+			IsChanged:      rejudgedSubmission.IsChanged,
+		})
+	}
+
+	return response, nil
+}
+
+// GetDeadLetteredSubmissionList implements ojs.OjsServiceServer.
+func (h *Handler) GetDeadLetteredSubmissionList(
+	ctx context.Context,
+	in *ojs.GetDeadLetteredSubmissionListRequest,
+) (*ojs.GetDeadLetteredSubmissionListResponse, error) {
+	output, err := h.deadLetteredSubmissionLogic.GetDeadLetteredSubmissionList(
+		ctx,
+		logic.GetDeadLetteredSubmissionListInput{
+			Token:  h.getAuthTokenFromMetadata(ctx),
+			Offset: in.GetOffset(),
+			Limit:  in.GetLimit(),
+		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	response := &ojs.GetDeadLetteredSubmissionListResponse{
+		TotalDeadLetteredSubmissionCount: output.TotalDeadLetteredSubmissionCount,
+	}
+	for _, deadLetteredSubmission := range output.DeadLetteredSubmissions {
+		response.DeadLetteredSubmissions = append(
+			response.DeadLetteredSubmissions,
+			h.logicDeadLetteredSubmissionToOJSDeadLetteredSubmission(deadLetteredSubmission),
+		)
+	}
+
+	return response, nil
+}
+
+// ReplayDeadLetteredSubmission implements ojs.OjsServiceServer.
+func (h *Handler) ReplayDeadLetteredSubmission(
+	ctx context.Context,
+	in *ojs.ReplayDeadLetteredSubmissionRequest,
+) (*ojs.ReplayDeadLetteredSubmissionResponse, error) {
+	output, err := h.deadLetteredSubmissionLogic.ReplayDeadLetteredSubmission(
+		ctx,
+		logic.ReplayDeadLetteredSubmissionInput{
+			Token: h.getAuthTokenFromMetadata(ctx),
+			ID:    in.GetId(),
+		},
+	)
+	if err != nil {
+		return nil, clientResponseError(err)
+	}
+
+	return &ojs.ReplayDeadLetteredSubmissionResponse{
+		DeadLetteredSubmission: h.logicDeadLetteredSubmissionToOJSDeadLetteredSubmission(output.DeadLetteredSubmission),
+		IsReplayed:             output.IsReplayed,
+	}, nil
+}
+
+func (h *Handler) logicAccountToOJSAccount(account logic.Account) *ojs.Account {
+	return &ojs.Account{
+		Id:         account.ID,
+		Name:       account.Name,
+		Role:       account.Role,
+		IsDisabled: account.IsDisabled,
+	}
 }
 
 func (h *Handler) getAuthTokenFromMetadata(ctx context.Context) string {
+	return h.getMetadataValue(ctx, AuthTokenMetadataName)
+}
+
+func (h *Handler) getMetadataValue(ctx context.Context, name string) string {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return ""
 	}
 
-	authTokenValues := md.Get(AuthTokenMetadataName)
-	if len(authTokenValues) == 0 {
+	values := md.Get(name)
+	if len(values) == 0 {
 		return ""
 	}
 
-	return authTokenValues[0]
+	return values[0]
+}
+
+func (h *Handler) ojsContestProblemListToLogicContestProblemList(contestProblems []*ojs.ContestProblem) []logic.ContestProblem {
+	var logicContestProblems []logic.ContestProblem
+	for _, contestProblem := range contestProblems {
+		logicContestProblems = append(logicContestProblems, logic.ContestProblem{
+			ProblemID: contestProblem.GetProblemId(),
+			Label:     contestProblem.GetLabel(),
+		})
+	}
+
+	return logicContestProblems
+}
+
+func (h *Handler) logicContestToOJSContest(contest logic.Contest) *ojs.Contest {
+	ojsContest := &ojs.Contest{
+		Id:               contest.ID,
+		DisplayName:      contest.DisplayName,
+		AuthorId:         contest.AuthorID,
+		Description:      contest.Description,
+		StartTime:        contest.StartTime.Format(time.RFC3339),
+		EndTime:          contest.EndTime.Format(time.RFC3339),
+		IsPublic:         contest.IsPublic,
+		ScoringMode:      contest.ScoringMode,
+		FreezeScoreboard: contest.FreezeScoreboard,
+		IsRegistered:     contest.IsRegistered,
+	}
+	for _, contestProblem := range contest.Problems {
+		ojsContest.Problems = append(ojsContest.Problems, &ojs.ContestProblem{
+			ProblemId: contestProblem.ProblemID,
+			Label:     contestProblem.Label,
+		})
+	}
+
+	return ojsContest
+}
+
+func (h *Handler) logicScoreboardRowToOJSContestScoreboardRow(row logic.ScoreboardRow) *ojs.ContestScoreboardRow {
+	ojsRow := &ojs.ContestScoreboardRow{
+		Rank:        row.Rank,
+		AccountId:   row.AccountID,
+		AccountName: row.AccountName,
+		SolvedCount: row.SolvedCount,
+		Penalty:     row.Penalty,
+		TotalScore:  row.TotalScore,
+	}
+	for _, cell := range row.Cells {
+		ojsRow.Cells = append(ojsRow.Cells, &ojs.ContestScoreboardCell{
+			ProblemId:         cell.ProblemID,
+			Label:             cell.Label,
+			IsSolved:          cell.IsSolved,
+			WrongAttemptCount: cell.WrongAttemptCount,
+			SolvedAtMinute:    cell.SolvedAtMinute,
+			Score:             cell.Score,
+		})
+	}
+
+	return ojsRow
+}
+
+func (h *Handler) logicProblemToOJSProblem(problem logic.Problem) *ojs.Problem {
+	return &ojs.Problem{
+		Id:                 problem.ID,
+		DisplayName:        problem.DisplayName,
+		AuthorId:           problem.AuthorId,
+		AuthorName:         problem.AuthorName,
+		Description:        problem.Description,
+		TimeLimit:          time.Duration(problem.TimeLimit).String(),
+		MemoryLimit:        humanize.Bytes(problem.MemoryLimit),
+		HasChecker:         problem.HasChecker,
+		CheckerLanguage:    problem.CheckerLanguage,
+		ComparisonMode:     problem.ComparisonMode,
+		FloatEpsilon:       problem.FloatEpsilon,
+		HasInteractor:      problem.HasInteractor,
+		InteractorLanguage: problem.InteractorLanguage,
+	}
+}
+
+func (h *Handler) logicSubmissionToOJSSubmission(submission logic.Submission) *ojs.Submission {
+	return &ojs.Submission{
+		Id:            submission.ID,
+		AuthorId:      submission.AuthorID,
+		OfProblemId:   submission.OfProblemID,
+		Content:       submission.Content,
+		Language:      submission.Language,
+		Status:        submission.Status,
+		Result:        submission.Result,
+		CompileOutput: submission.CompileOutput,
+		OfContestId:   submission.OfContestID,
+		Score:         submission.Score,
+	}
+}
+
+func (h *Handler) logicRejudgeToOJSRejudge(rejudge logic.Rejudge) *ojs.Rejudge {
+	return &ojs.Rejudge{
+		Id:              rejudge.ID,
+		AuthorId:        rejudge.AuthorID,
+		CreatedAt:       rejudge.CreatedAt.Format(time.RFC3339),
+		SubmissionCount: rejudge.SubmissionCount,
+	}
+}
+
+func (h *Handler) logicDeadLetteredSubmissionToOJSDeadLetteredSubmission(
+	deadLetteredSubmission logic.DeadLetteredSubmission,
+) *ojs.DeadLetteredSubmission {
+	return &ojs.DeadLetteredSubmission{
+		Id:             deadLetteredSubmission.ID,
+		OfSubmissionId: deadLetteredSubmission.OfSubmissionID,
+		Error:          deadLetteredSubmission.Error,
+		AttemptCount:   uint32(deadLetteredSubmission.AttemptCount),
+		CreatedAt:      deadLetteredSubmission.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func (h *Handler) logicTestCaseGroupToOJSTestCaseGroup(testCaseGroup logic.TestCaseGroup) *ojs.TestCaseGroup {
+	return &ojs.TestCaseGroup{
+		Id:            testCaseGroup.ID,
+		OfProblemId:   testCaseGroup.OfProblemID,
+		DisplayName:   testCaseGroup.DisplayName,
+		Points:        testCaseGroup.Points,
+		ScoringPolicy: testCaseGroup.ScoringPolicy,
+	}
 }
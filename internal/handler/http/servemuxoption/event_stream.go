@@ -0,0 +1,45 @@
+package servemuxoption
+
+import (
+	"bytes"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+const (
+	EventStreamMIMEType = "text/event-stream"
+)
+
+var (
+	eventStreamDataPrefix = []byte("data: ")
+	eventStreamDelimiter  = []byte("\n\n")
+)
+
+// eventStreamMarshaler writes each message of a server stream as a Server-Sent Event, so that
+// browsers can consume streaming endpoints with EventSource.
+type eventStreamMarshaler struct {
+	runtime.JSONPb
+}
+
+func (m *eventStreamMarshaler) ContentType(_ interface{}) string {
+	return EventStreamMIMEType
+}
+
+func (m *eventStreamMarshaler) Marshal(v interface{}) ([]byte, error) {
+	data, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	// Event data must not contain line breaks, otherwise it would be split into several fields
+	data = bytes.ReplaceAll(data, []byte("\n"), []byte(""))
+	return append(append([]byte{}, eventStreamDataPrefix...), data...), nil
+}
+
+func (m *eventStreamMarshaler) Delimiter() []byte {
+	return eventStreamDelimiter
+}
+
+func WithEventStreamMarshaler() runtime.ServeMuxOption {
+	return runtime.WithMarshalerOption(EventStreamMIMEType, &eventStreamMarshaler{})
+}
@@ -17,7 +17,8 @@ import (
 )
 
 const (
-	AuthCookieName = "OJS_AUTH"
+	AuthCookieName    = "OJS_AUTH"
+	RefreshCookieName = "OJS_REFRESH"
 )
 
 type Server interface {
@@ -49,6 +50,9 @@ func (s *server) Start(ctx context.Context) error {
 	mux := runtime.NewServeMux(
 		servemuxoption.WithAuthCookieToAuthMetadata(AuthCookieName, grpcHandler.AuthTokenMetadataName),
 		servemuxoption.WithAuthMetadataToAuthCookie(AuthCookieName, grpcHandler.AuthTokenMetadataName, s.authConfig.Token.GetTokenDuration()),
+		servemuxoption.WithAuthCookieToAuthMetadata(RefreshCookieName, grpcHandler.RefreshTokenMetadataName),
+		servemuxoption.WithAuthMetadataToAuthCookie(RefreshCookieName, grpcHandler.RefreshTokenMetadataName, s.authConfig.Token.GetRefreshTokenDuration()),
+		servemuxoption.WithEventStreamMarshaler(),
 	)
 
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
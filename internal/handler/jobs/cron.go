@@ -19,6 +19,9 @@ type Cron interface {
 }
 
 func NewCron(
+	resetExpiredJudgingLeasesJob ResetExpiredJudgingLeasesJob,
+	relayOutboxMessagesJob RelayOutboxMessagesJob,
+	rotateTokenKeysJob RotateTokenKeysJob,
 	logger *zap.Logger,
 ) (Cron, error) {
 	scheduler, err := gocron.NewScheduler()
@@ -27,7 +30,13 @@ func NewCron(
 		return nil, err
 	}
 
-	err = scheduleCronJobs(scheduler, logger)
+	err = scheduleCronJobs(
+		scheduler,
+		logger,
+		resetExpiredJudgingLeasesJob,
+		relayOutboxMessagesJob,
+		rotateTokenKeysJob,
+	)
 	if err != nil {
 		logger.Error("failed to schedule jobs", zap.Error(err))
 		return nil, err
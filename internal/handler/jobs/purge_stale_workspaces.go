@@ -0,0 +1,66 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/maxuanquang/ojs/internal/configs"
+	"github.com/maxuanquang/ojs/internal/logic"
+	"go.uber.org/zap"
+)
+
+// PurgeStaleWorkspacesJob removes the workspaces left behind on the host it runs on, it is therefore run by the
+// workers rather than by the cron.
+type PurgeStaleWorkspacesJob interface {
+	Run(ctx context.Context) error
+	GetInterval() time.Duration
+}
+
+func NewPurgeStaleWorkspacesJob(
+	workspaceLogic logic.WorkspaceLogic,
+	cronConfig configs.Cron,
+	logger *zap.Logger,
+) (PurgeStaleWorkspacesJob, error) {
+	interval, err := cronConfig.PurgeStaleWorkspaces.GetInterval()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get interval of purging workspaces")
+		return nil, err
+	}
+
+	maxAge, err := cronConfig.PurgeStaleWorkspaces.GetMaxAge()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get max age of workspaces")
+		return nil, err
+	}
+
+	return &purgeStaleWorkspacesJob{
+		workspaceLogic: workspaceLogic,
+		interval:       interval,
+		maxAge:         maxAge,
+		logger:         logger,
+	}, nil
+}
+
+type purgeStaleWorkspacesJob struct {
+	workspaceLogic logic.WorkspaceLogic
+	interval       time.Duration
+	maxAge         time.Duration
+	logger         *zap.Logger
+}
+
+// GetInterval implements PurgeStaleWorkspacesJob.
+func (p *purgeStaleWorkspacesJob) GetInterval() time.Duration {
+	return p.interval
+}
+
+// Run implements PurgeStaleWorkspacesJob.
+func (p *purgeStaleWorkspacesJob) Run(ctx context.Context) error {
+	err := p.workspaceLogic.PurgeStaleWorkspaces(ctx, p.maxAge)
+	if err != nil {
+		p.logger.Error("purge stale workspaces failed", zap.Error(err))
+		return err
+	}
+	p.logger.Info("stale workspaces purged")
+
+	return nil
+}
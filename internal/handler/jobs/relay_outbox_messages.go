@@ -0,0 +1,63 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/maxuanquang/ojs/internal/configs"
+	"github.com/maxuanquang/ojs/internal/logic"
+	"go.uber.org/zap"
+)
+
+type RelayOutboxMessagesJob interface {
+	Run(ctx context.Context) error
+	GetSchedule() string
+}
+
+func NewRelayOutboxMessagesJob(
+	outboxLogic logic.OutboxLogic,
+	cronConfig configs.Cron,
+	logger *zap.Logger,
+) (RelayOutboxMessagesJob, error) {
+	sentMessageRetention, err := cronConfig.RelayOutboxMessages.GetSentMessageRetention()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get sent outbox message retention")
+		return nil, err
+	}
+
+	return &relayOutboxMessagesJob{
+		outboxLogic:          outboxLogic,
+		cronConfig:           cronConfig,
+		sentMessageRetention: sentMessageRetention,
+		logger:               logger,
+	}, nil
+}
+
+type relayOutboxMessagesJob struct {
+	outboxLogic          logic.OutboxLogic
+	cronConfig           configs.Cron
+	sentMessageRetention time.Duration
+	logger               *zap.Logger
+}
+
+// GetSchedule implements RelayOutboxMessagesJob.
+func (r *relayOutboxMessagesJob) GetSchedule() string {
+	return r.cronConfig.RelayOutboxMessages.Schedule
+}
+
+// Run implements RelayOutboxMessagesJob.
+func (r *relayOutboxMessagesJob) Run(ctx context.Context) error {
+	err := r.outboxLogic.RelayOutboxMessages(ctx)
+	if err != nil {
+		r.logger.Error("relay outbox messages failed", zap.Error(err))
+		return err
+	}
+
+	err = r.outboxLogic.DeleteSentOutboxMessages(ctx, r.sentMessageRetention)
+	if err != nil {
+		r.logger.Error("delete sent outbox messages failed", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/maxuanquang/ojs/internal/configs"
+	"github.com/maxuanquang/ojs/internal/logic"
+	"go.uber.org/zap"
+)
+
+type ResetExpiredJudgingLeasesJob interface {
+	Run(ctx context.Context) error
+	GetSchedule() string
+}
+
+func NewResetExpiredJudgingLeasesJob(
+	submissionLogic logic.SubmissionLogic,
+	cronConfig configs.Cron,
+	logger *zap.Logger,
+) (ResetExpiredJudgingLeasesJob, error) {
+	return &resetExpiredJudgingLeasesJob{
+		submissionLogic: submissionLogic,
+		cronConfig:      cronConfig,
+		logger:          logger,
+	}, nil
+}
+
+type resetExpiredJudgingLeasesJob struct {
+	submissionLogic logic.SubmissionLogic
+	cronConfig      configs.Cron
+	logger          *zap.Logger
+}
+
+// GetSchedule implements ResetExpiredJudgingLeasesJob.
+func (r *resetExpiredJudgingLeasesJob) GetSchedule() string {
+	return r.cronConfig.ResetExpiredJudgingLeases.Schedule
+}
+
+// Run implements ResetExpiredJudgingLeasesJob.
+func (r *resetExpiredJudgingLeasesJob) Run(ctx context.Context) error {
+	err := r.submissionLogic.ResetExpiredJudgingLeases(ctx)
+	if err != nil {
+		r.logger.Error("reset expired judging leases failed", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/maxuanquang/ojs/internal/configs"
+	"github.com/maxuanquang/ojs/internal/logic"
+	"go.uber.org/zap"
+)
+
+type RotateTokenKeysJob interface {
+	Run(ctx context.Context) error
+	GetSchedule() string
+}
+
+func NewRotateTokenKeysJob(
+	tokenKeyLogic logic.TokenKeyLogic,
+	cronConfig configs.Cron,
+	logger *zap.Logger,
+) (RotateTokenKeysJob, error) {
+	keyMaxAge, err := cronConfig.RotateTokenKeys.GetKeyMaxAge()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get token key max age")
+		return nil, err
+	}
+
+	return &rotateTokenKeysJob{
+		tokenKeyLogic: tokenKeyLogic,
+		cronConfig:    cronConfig,
+		keyMaxAge:     keyMaxAge,
+		logger:        logger,
+	}, nil
+}
+
+type rotateTokenKeysJob struct {
+	tokenKeyLogic logic.TokenKeyLogic
+	cronConfig    configs.Cron
+	keyMaxAge     time.Duration
+	logger        *zap.Logger
+}
+
+// GetSchedule implements RotateTokenKeysJob.
+func (r *rotateTokenKeysJob) GetSchedule() string {
+	return r.cronConfig.RotateTokenKeys.Schedule
+}
+
+// Run implements RotateTokenKeysJob.
+func (r *rotateTokenKeysJob) Run(ctx context.Context) error {
+	err := r.tokenKeyLogic.RotateTokenKeys(ctx, r.keyMaxAge)
+	if err != nil {
+		r.logger.Error("rotate token keys failed", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
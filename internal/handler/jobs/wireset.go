@@ -5,4 +5,8 @@ import "github.com/google/wire"
 var WireSet = wire.NewSet(
 	NewCron,
 	NewCreateSystemAccountsJob,
+	NewPurgeStaleWorkspacesJob,
+	NewResetExpiredJudgingLeasesJob,
+	NewRelayOutboxMessagesJob,
+	NewRotateTokenKeysJob,
 )
@@ -2,10 +2,17 @@ package logic
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/maxuanquang/ojs/internal/configs"
 	"github.com/maxuanquang/ojs/internal/dataaccess/cache"
 	"github.com/maxuanquang/ojs/internal/dataaccess/database"
 	"github.com/maxuanquang/ojs/internal/generated/grpc/ojs"
@@ -36,9 +43,65 @@ type GetAccountInput struct {
 }
 
 type GetAccountOutput struct {
-	ID   uint64
-	Name string
-	Role ojs.Role
+	ID         uint64
+	Name       string
+	Role       ojs.Role
+	IsDisabled bool
+}
+
+type Account struct {
+	ID         uint64
+	Name       string
+	Role       ojs.Role
+	IsDisabled bool
+}
+
+type GetAccountListInput struct {
+	Token  string
+	Query  string
+	Offset uint64
+	Limit  uint64
+}
+
+type GetAccountListOutput struct {
+	Accounts          []Account
+	TotalAccountCount uint64
+}
+
+type UpdateAccountInput struct {
+	Token string
+	ID    uint64
+	Name  string
+}
+
+type UpdateAccountOutput struct {
+	Account Account
+}
+
+type ChangePasswordInput struct {
+	Token       string
+	ID          uint64
+	OldPassword string
+	NewPassword string
+}
+
+type SetAccountRoleInput struct {
+	Token string
+	ID    uint64
+	Role  ojs.Role
+}
+
+type SetAccountRoleOutput struct {
+	Account Account
+}
+
+type DisableAccountInput struct {
+	Token string
+	ID    uint64
+}
+
+type DisableAccountOutput struct {
+	Account Account
 }
 
 type CreateSessionInput struct {
@@ -47,42 +110,81 @@ type CreateSessionInput struct {
 }
 
 type CreateSessionOutput struct {
-	Token     string
-	ExpiresAt time.Time
-	ID        uint64
-	Name      string
-	Role      ojs.Role
+	Token                 string
+	ExpiresAt             time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+	ID                    uint64
+	Name                  string
+	Role                  ojs.Role
+}
+
+type RefreshSessionInput struct {
+	RefreshToken string
+}
+
+type RefreshSessionOutput struct {
+	Token                 string
+	ExpiresAt             time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+	ID                    uint64
+	Name                  string
+	Role                  ojs.Role
 }
 
 type DeleteSessionInput struct {
 	Token string
 }
 
+type DeleteAllSessionsInput struct {
+	Token string
+}
+
 type AccountLogic interface {
 	CreateAccount(ctx context.Context, in CreateAccountInput) (CreateAccountOutput, error)
 	GetAccount(ctx context.Context, in GetAccountInput) (GetAccountOutput, error)
+	GetAccountList(ctx context.Context, in GetAccountListInput) (GetAccountListOutput, error)
+	UpdateAccount(ctx context.Context, in UpdateAccountInput) (UpdateAccountOutput, error)
+	// ChangePassword changes the password of the account of the token, which has to give its current password.
+	ChangePassword(ctx context.Context, in ChangePasswordInput) error
+	// SetAccountRole changes the role of another account. Its access tokens are revoked, so that the new role is
+	// used once they are refreshed.
+	SetAccountRole(ctx context.Context, in SetAccountRoleInput) (SetAccountRoleOutput, error)
+	// DisableAccount prevents another account from logging in, and logs it out of all of its devices.
+	DisableAccount(ctx context.Context, in DisableAccountInput) (DisableAccountOutput, error)
+	// CreateSession logs the account in, returning a short-lived access token and the refresh token of the session.
 	CreateSession(ctx context.Context, in CreateSessionInput) (CreateSessionOutput, error)
+	// RefreshSession replaces the tokens of the session with new ones, revoking the previous access token. Using a
+	// refresh token that was already replaced revokes the session, as it may have been stolen.
+	RefreshSession(ctx context.Context, in RefreshSessionInput) (RefreshSessionOutput, error)
 	DeleteSession(ctx context.Context, in DeleteSessionInput) error
+	// DeleteAllSessions logs the account of the token out of all of its devices.
+	DeleteAllSessions(ctx context.Context, in DeleteAllSessionsInput) error
 }
 
 func NewAccountLogic(
 	database database.Database,
 	accountDataAccessor database.AccountDataAccessor,
 	passwordDataAccessor database.AccountPasswordDataAccessor,
+	sessionDataAccessor database.SessionDataAccessor,
 	hashLogic HashLogic,
 	tokenLogic TokenLogic,
 	roleLogic RoleLogic,
 	takenAccountNameCache cache.TakenAccountName,
+	authConfig configs.Auth,
 	logger *zap.Logger,
 ) AccountLogic {
 	return &accountLogic{
 		database:              database,
 		accountDataAccessor:   accountDataAccessor,
 		passwordDataAccessor:  passwordDataAccessor,
+		sessionDataAccessor:   sessionDataAccessor,
 		hashLogic:             hashLogic,
 		tokenLogic:            tokenLogic,
 		roleLogic:             roleLogic,
 		takenAccountNameCache: takenAccountNameCache,
+		authConfig:            authConfig,
 		logger:                logger,
 	}
 }
@@ -91,10 +193,12 @@ type accountLogic struct {
 	database              database.Database
 	accountDataAccessor   database.AccountDataAccessor
 	passwordDataAccessor  database.AccountPasswordDataAccessor
+	sessionDataAccessor   database.SessionDataAccessor
 	hashLogic             HashLogic
 	tokenLogic            TokenLogic
 	roleLogic             RoleLogic
 	takenAccountNameCache cache.TakenAccountName
+	authConfig            configs.Auth
 	logger                *zap.Logger
 }
 
@@ -110,7 +214,7 @@ func (a *accountLogic) GetAccount(ctx context.Context, in GetAccountInput) (GetA
 		return GetAccountOutput{}, status.Error(codes.NotFound, "account not found")
 	}
 
-	requestingAccountID, _, _, _, err := a.tokenLogic.VerifyTokenString(ctx, in.Token)
+	requestingAccountID, _, requestingAccountRole, _, err := a.tokenLogic.VerifyTokenString(ctx, in.Token)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to find account from token")
 		return GetAccountOutput{}, status.Error(codes.NotFound, "account not found")
@@ -121,7 +225,7 @@ func (a *accountLogic) GetAccount(ctx context.Context, in GetAccountInput) (GetA
 		requiredPermissions = append(requiredPermissions, PermissionAccountsReadSelf)
 	}
 
-	hasPermission, err := a.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(foundAccount.Role)], requiredPermissions...)
+	hasPermission, err := a.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(requestingAccountRole)], requiredPermissions...)
 	if err != nil {
 		return GetAccountOutput{}, err
 	}
@@ -130,12 +234,222 @@ func (a *accountLogic) GetAccount(ctx context.Context, in GetAccountInput) (GetA
 	}
 
 	return GetAccountOutput{
-		ID:   foundAccount.ID,
-		Name: foundAccount.Name,
-		Role: ojs.Role(foundAccount.Role),
+		ID:         foundAccount.ID,
+		Name:       foundAccount.Name,
+		Role:       ojs.Role(foundAccount.Role),
+		IsDisabled: foundAccount.IsDisabled,
 	}, nil
 }
 
+// GetAccountList implements AccountLogic.
+func (a *accountLogic) GetAccountList(ctx context.Context, in GetAccountListInput) (GetAccountListOutput, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("method", "GetAccountList"))
+
+	_, _, requestingAccountRole, _, err := a.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return GetAccountListOutput{}, ErrTokenInvalid
+	}
+
+	hasPermission, err := a.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(requestingAccountRole)], PermissionAccountsReadAll)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to check permission")
+		return GetAccountListOutput{}, ErrInternal
+	}
+	if !hasPermission {
+		return GetAccountListOutput{}, ErrPermissionDenied
+	}
+
+	dbAccountList, err := a.accountDataAccessor.GetAccountList(ctx, in.Query, in.Offset, in.Limit)
+	if err != nil {
+		return GetAccountListOutput{}, ErrInternal
+	}
+
+	totalAccountCount, err := a.accountDataAccessor.GetAccountCount(ctx, in.Query)
+	if err != nil {
+		return GetAccountListOutput{}, ErrInternal
+	}
+
+	var accountList []Account
+	for _, account := range dbAccountList {
+		accountList = append(accountList, a.dbAccountToLogicAccount(account))
+	}
+
+	return GetAccountListOutput{
+		Accounts:          accountList,
+		TotalAccountCount: totalAccountCount,
+	}, nil
+}
+
+// UpdateAccount implements AccountLogic.
+func (a *accountLogic) UpdateAccount(ctx context.Context, in UpdateAccountInput) (UpdateAccountOutput, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", in.ID))
+
+	requestingAccountID, _, requestingAccountRole, _, err := a.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return UpdateAccountOutput{}, ErrTokenInvalid
+	}
+
+	requiredPermissions := []gorbac.Permission{PermissionAccountsWriteAll}
+	if requestingAccountID == in.ID {
+		requiredPermissions = append(requiredPermissions, PermissionAccountsWriteSelf)
+	}
+
+	hasPermission, err := a.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(requestingAccountRole)], requiredPermissions...)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to check permission")
+		return UpdateAccountOutput{}, ErrInternal
+	}
+	if !hasPermission {
+		return UpdateAccountOutput{}, ErrPermissionDenied
+	}
+
+	foundAccount, err := a.getAccountByID(ctx, in.ID)
+	if err != nil {
+		return UpdateAccountOutput{}, err
+	}
+
+	if in.Name == "" || in.Name == foundAccount.Name {
+		return UpdateAccountOutput{Account: a.dbAccountToLogicAccount(foundAccount)}, nil
+	}
+
+	taken, err := a.isAccountNameTaken(ctx, in.Name)
+	if err != nil {
+		logger.Error("failed to check if account name taken", zap.Error(err))
+		return UpdateAccountOutput{}, ErrInternal
+	}
+	if taken {
+		return UpdateAccountOutput{}, ErrAccountAlreadyExists
+	}
+
+	err = a.accountDataAccessor.UpdateAccountName(ctx, in.ID, in.Name)
+	if err != nil {
+		if errors.Is(err, database.ErrAccountAlreadyExists) {
+			return UpdateAccountOutput{}, ErrAccountAlreadyExists
+		}
+
+		return UpdateAccountOutput{}, ErrInternal
+	}
+
+	// The previous name is freed, so that it can be taken again
+	err = a.takenAccountNameCache.Remove(ctx, foundAccount.Name)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to remove previous account name from taken set in cache")
+	}
+
+	foundAccount.Name = in.Name
+	err = a.takenAccountNameCache.Add(ctx, foundAccount.Name)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to set account name into taken set in cache")
+	}
+
+	return UpdateAccountOutput{Account: a.dbAccountToLogicAccount(foundAccount)}, nil
+}
+
+// ChangePassword implements AccountLogic.
+func (a *accountLogic) ChangePassword(ctx context.Context, in ChangePasswordInput) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", in.ID))
+
+	requestingSessionID, _, requestingAccountID, _, err := a.tokenLogic.VerifyTokenSession(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return ErrTokenInvalid
+	}
+
+	// Not even admins can change the password of another account, as they do not know it
+	if requestingAccountID != in.ID {
+		return ErrPermissionDenied
+	}
+
+	foundPassword, err := a.passwordDataAccessor.GetPassword(ctx, in.ID)
+	if err != nil {
+		logger.Error("failed to get account password", zap.Error(err))
+		return ErrInternal
+	}
+
+	matched, err := a.hashLogic.IsHashEqual(ctx, in.OldPassword, foundPassword.Hashed)
+	if err != nil {
+		logger.Error("failed comparing password", zap.Error(err))
+		return ErrInternal
+	}
+	if !matched {
+		return ErrAccountPasswordIncorrect
+	}
+
+	hashedPassword, err := a.hashLogic.HashPassword(ctx, in.NewPassword)
+	if err != nil {
+		logger.Error("failed to hash password", zap.Error(err))
+		return ErrInternal
+	}
+
+	err = a.passwordDataAccessor.UpdatePassword(ctx, in.ID, hashedPassword)
+	if err != nil {
+		logger.Error("failed to update account password", zap.Error(err))
+		return ErrInternal
+	}
+
+	// Whoever got hold of another session of the account loses it, only the one changing the password is kept
+	return a.revokeAccountSessionList(ctx, in.ID, requestingSessionID)
+}
+
+// SetAccountRole implements AccountLogic.
+func (a *accountLogic) SetAccountRole(ctx context.Context, in SetAccountRoleInput) (SetAccountRoleOutput, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", in.ID))
+
+	if _, ok := ojs.Role_name[int32(in.Role)]; !ok || in.Role == ojs.Role_UndefinedRole {
+		return SetAccountRoleOutput{}, ErrAccountRoleInvalid
+	}
+
+	foundAccount, err := a.getOtherAccountForAdmin(ctx, in.Token, in.ID)
+	if err != nil {
+		return SetAccountRoleOutput{}, err
+	}
+
+	err = a.accountDataAccessor.UpdateAccountRole(ctx, in.ID, int8(in.Role))
+	if err != nil {
+		return SetAccountRoleOutput{}, ErrInternal
+	}
+
+	foundAccount.Role = int8(in.Role)
+
+	sessionList, err := a.sessionDataAccessor.GetAccountActiveSessionList(ctx, in.ID)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to get sessions of account, its access tokens keep their role until they expire")
+	}
+
+	for _, session := range sessionList {
+		err = a.tokenLogic.RevokeToken(ctx, session.AccessTokenID, session.AccessTokenExpiresAt)
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("failed to revoke access token of session")
+		}
+	}
+
+	return SetAccountRoleOutput{Account: a.dbAccountToLogicAccount(foundAccount)}, nil
+}
+
+// DisableAccount implements AccountLogic.
+func (a *accountLogic) DisableAccount(ctx context.Context, in DisableAccountInput) (DisableAccountOutput, error) {
+	foundAccount, err := a.getOtherAccountForAdmin(ctx, in.Token, in.ID)
+	if err != nil {
+		return DisableAccountOutput{}, err
+	}
+
+	err = a.accountDataAccessor.DisableAccount(ctx, in.ID)
+	if err != nil {
+		return DisableAccountOutput{}, ErrInternal
+	}
+
+	foundAccount.IsDisabled = true
+
+	err = a.revokeAccountSessionList(ctx, in.ID, 0)
+	if err != nil {
+		return DisableAccountOutput{}, err
+	}
+
+	return DisableAccountOutput{Account: a.dbAccountToLogicAccount(foundAccount)}, nil
+}
+
 // CreateAccount implements Account.
 func (a *accountLogic) CreateAccount(ctx context.Context, in CreateAccountInput) (CreateAccountOutput, error) {
 	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Any("create_account_input", in))
@@ -223,19 +537,108 @@ func (a *accountLogic) CreateSession(ctx context.Context, in CreateSessionInput)
 	if !matched {
 		return CreateSessionOutput{}, status.Error(codes.NotFound, "wrong account name or password")
 	}
+	if foundAccount.IsDisabled {
+		return CreateSessionOutput{}, ErrAccountDisabled
+	}
 
-	stringToken, expiresAt, err := a.tokenLogic.CreateTokenString(ctx, foundAccount.ID, foundAccount.Name, foundAccount.Role)
-	if err != nil {
-		logger.Error("failed to create token", zap.Error(err))
-		return CreateSessionOutput{}, status.Error(codes.Internal, "failed to create token")
+	var tokens sessionTokens
+	txErr := a.database.Transaction(func(tx *gorm.DB) error {
+		sessionDataAccessor := a.sessionDataAccessor.WithDatabaseTransaction(tx)
+		// The tokens are only issued once the session has an ID, replacing the empty refresh token hash
+		createdSession, err := sessionDataAccessor.CreateSession(ctx, database.Session{
+			OfAccountID:          foundAccount.ID,
+			AccessTokenExpiresAt: time.Now(),
+			ExpiresAt:            time.Now().Add(a.authConfig.Token.GetRefreshTokenDuration()),
+		})
+		if err != nil {
+			return err
+		}
+
+		tokens, err = a.rotateSessionTokens(ctx, sessionDataAccessor, createdSession, foundAccount)
+		return err
+	})
+	if txErr != nil {
+		logger.Error("failed to create session", zap.Error(txErr))
+		return CreateSessionOutput{}, status.Error(codes.Internal, "failed to create session")
 	}
 
 	return CreateSessionOutput{
-		Token:     stringToken,
-		ExpiresAt: expiresAt,
-		ID:        foundAccount.ID,
-		Name:      foundAccount.Name,
-		Role:      ojs.Role(foundAccount.Role),
+		Token:                 tokens.AccessToken,
+		ExpiresAt:             tokens.AccessTokenExpiresAt,
+		RefreshToken:          tokens.RefreshToken,
+		RefreshTokenExpiresAt: tokens.RefreshTokenExpiresAt,
+		ID:                    foundAccount.ID,
+		Name:                  foundAccount.Name,
+		Role:                  ojs.Role(foundAccount.Role),
+	}, nil
+}
+
+// RefreshSession implements AccountLogic.
+func (a *accountLogic) RefreshSession(ctx context.Context, in RefreshSessionInput) (RefreshSessionOutput, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	sessionID, refreshTokenSecret, err := parseRefreshToken(in.RefreshToken)
+	if err != nil {
+		return RefreshSessionOutput{}, ErrRefreshTokenInvalid
+	}
+
+	logger = logger.With(zap.Uint64("session_id", sessionID))
+	foundSession, err := a.sessionDataAccessor.GetSessionByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, database.ErrSessionNotFound) {
+			return RefreshSessionOutput{}, ErrRefreshTokenInvalid
+		}
+
+		return RefreshSessionOutput{}, ErrInternal
+	}
+
+	if foundSession.RevokedAt != nil || !time.Now().Before(foundSession.ExpiresAt) {
+		return RefreshSessionOutput{}, ErrRefreshTokenInvalid
+	}
+
+	if hashRefreshTokenSecret(refreshTokenSecret) != foundSession.RefreshTokenHash {
+		logger.Warn("replaced refresh token reused, revoking session")
+		a.revokeSessionList(ctx, []database.Session{foundSession})
+		return RefreshSessionOutput{}, ErrRefreshTokenInvalid
+	}
+
+	// The role of the account may have changed since the session was created
+	foundAccount, err := a.accountDataAccessor.GetAccountByID(ctx, foundSession.OfAccountID)
+	if err != nil {
+		logger.Error("failed to get account", zap.Error(err))
+		return RefreshSessionOutput{}, ErrInternal
+	}
+	if foundAccount.ID == 0 {
+		return RefreshSessionOutput{}, ErrRefreshTokenInvalid
+	}
+	if foundAccount.IsDisabled {
+		return RefreshSessionOutput{}, ErrAccountDisabled
+	}
+
+	tokens, err := a.rotateSessionTokens(ctx, a.sessionDataAccessor, foundSession, foundAccount)
+	if err != nil {
+		// The session was refreshed or revoked concurrently
+		if errors.Is(err, database.ErrSessionRefreshTokenInvalid) {
+			return RefreshSessionOutput{}, ErrRefreshTokenInvalid
+		}
+
+		logger.Error("failed to rotate session tokens", zap.Error(err))
+		return RefreshSessionOutput{}, ErrInternal
+	}
+
+	err = a.tokenLogic.RevokeToken(ctx, foundSession.AccessTokenID, foundSession.AccessTokenExpiresAt)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to revoke previous access token of session")
+	}
+
+	return RefreshSessionOutput{
+		Token:                 tokens.AccessToken,
+		ExpiresAt:             tokens.AccessTokenExpiresAt,
+		RefreshToken:          tokens.RefreshToken,
+		RefreshTokenExpiresAt: tokens.RefreshTokenExpiresAt,
+		ID:                    foundAccount.ID,
+		Name:                  foundAccount.Name,
+		Role:                  ojs.Role(foundAccount.Role),
 	}, nil
 }
 
@@ -243,15 +646,191 @@ func (a *accountLogic) CreateSession(ctx context.Context, in CreateSessionInput)
 func (a *accountLogic) DeleteSession(ctx context.Context, in DeleteSessionInput) error {
 	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Any("delete_session_input", in))
 
-	_, _, _, _, err := a.tokenLogic.VerifyTokenString(ctx, in.Token)
+	sessionID, tokenID, _, expiresAt, err := a.tokenLogic.VerifyTokenSession(ctx, in.Token)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to find account from token")
 		return status.Error(codes.NotFound, "account not found")
 	}
 
+	a.revokeSessionList(ctx, []database.Session{{
+		ID:                   sessionID,
+		AccessTokenID:        tokenID,
+		AccessTokenExpiresAt: expiresAt,
+	}})
+	return nil
+}
+
+// DeleteAllSessions implements AccountLogic.
+func (a *accountLogic) DeleteAllSessions(ctx context.Context, in DeleteAllSessionsInput) error {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	accountID, _, _, _, err := a.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return ErrTokenInvalid
+	}
+
+	return a.revokeAccountSessionList(ctx, accountID, 0)
+}
+
+// revokeAccountSessionList revokes every session of the account but the excepted one, 0 to except none, along with
+// their current access token.
+func (a *accountLogic) revokeAccountSessionList(ctx context.Context, accountID uint64, exceptSessionID uint64) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	var revokedSessionList []database.Session
+	txErr := a.database.Transaction(func(tx *gorm.DB) error {
+		sessionDataAccessor := a.sessionDataAccessor.WithDatabaseTransaction(tx)
+
+		sessionList, err := sessionDataAccessor.GetAccountActiveSessionList(ctx, accountID)
+		if err != nil {
+			return err
+		}
+
+		if exceptSessionID == 0 {
+			revokedSessionList = sessionList
+			return sessionDataAccessor.RevokeAccountSessionList(ctx, accountID)
+		}
+
+		for _, session := range sessionList {
+			if session.ID == exceptSessionID {
+				continue
+			}
+
+			err = sessionDataAccessor.RevokeSession(ctx, session.ID)
+			if err != nil {
+				return err
+			}
+
+			revokedSessionList = append(revokedSessionList, session)
+		}
+
+		return nil
+	})
+	if txErr != nil {
+		logger.With(zap.Error(txErr)).Error("revoke account sessions transaction failed")
+		return ErrInternal
+	}
+
+	for _, session := range revokedSessionList {
+		err := a.tokenLogic.RevokeToken(ctx, session.AccessTokenID, session.AccessTokenExpiresAt)
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("failed to revoke access token of session")
+		}
+	}
+
 	return nil
 }
 
+// getOtherAccountForAdmin returns the account an admin manages with the token, which cannot be the admin's own
+// account so that admins do not lock themselves out.
+func (a *accountLogic) getOtherAccountForAdmin(ctx context.Context, token string, accountID uint64) (database.Account, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	requestingAccountID, _, requestingAccountRole, _, err := a.tokenLogic.VerifyTokenString(ctx, token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return database.Account{}, ErrTokenInvalid
+	}
+
+	hasPermission, err := a.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(requestingAccountRole)], PermissionAccountsWriteAll)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to check permission")
+		return database.Account{}, ErrInternal
+	}
+	if !hasPermission {
+		return database.Account{}, ErrPermissionDenied
+	}
+
+	if requestingAccountID == accountID {
+		return database.Account{}, ErrAccountSelfUpdateDenied
+	}
+
+	return a.getAccountByID(ctx, accountID)
+}
+
+func (a *accountLogic) getAccountByID(ctx context.Context, accountID uint64) (database.Account, error) {
+	foundAccount, err := a.accountDataAccessor.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return database.Account{}, ErrInternal
+	}
+	if foundAccount.ID == 0 {
+		return database.Account{}, ErrAccountNotFound
+	}
+
+	return foundAccount, nil
+}
+
+func (a *accountLogic) dbAccountToLogicAccount(account database.Account) Account {
+	return Account{
+		ID:         account.ID,
+		Name:       account.Name,
+		Role:       ojs.Role(account.Role),
+		IsDisabled: account.IsDisabled,
+	}
+}
+
+// rotateSessionTokens issues a new access token and refresh token for the session, replacing its current ones.
+func (a *accountLogic) rotateSessionTokens(
+	ctx context.Context,
+	sessionDataAccessor database.SessionDataAccessor,
+	session database.Session,
+	account database.Account,
+) (sessionTokens, error) {
+	refreshTokenSecret, err := generateRefreshTokenSecret()
+	if err != nil {
+		return sessionTokens{}, err
+	}
+
+	accessToken, accessTokenID, accessTokenExpiresAt, err := a.tokenLogic.CreateTokenString(
+		ctx,
+		session.ID,
+		account.ID,
+		account.Name,
+		account.Role,
+	)
+	if err != nil {
+		return sessionTokens{}, err
+	}
+
+	err = sessionDataAccessor.RotateSessionTokens(
+		ctx,
+		session.ID,
+		session.RefreshTokenHash,
+		hashRefreshTokenSecret(refreshTokenSecret),
+		accessTokenID,
+		accessTokenExpiresAt,
+	)
+	if err != nil {
+		return sessionTokens{}, err
+	}
+
+	return sessionTokens{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessTokenExpiresAt,
+		RefreshToken:          fmt.Sprintf("%d%s%s", session.ID, refreshTokenSeparator, refreshTokenSecret),
+		RefreshTokenExpiresAt: session.ExpiresAt,
+	}, nil
+}
+
+// revokeSessionList revokes the sessions and their current access token. Failing to revoke an access token is only
+// logged, as it expires shortly anyway.
+func (a *accountLogic) revokeSessionList(ctx context.Context, sessionList []database.Session) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	for _, session := range sessionList {
+		err := a.sessionDataAccessor.RevokeSession(ctx, session.ID)
+		if err != nil {
+			logger.With(zap.Uint64("session_id", session.ID)).With(zap.Error(err)).Error("failed to revoke session")
+		}
+
+		err = a.tokenLogic.RevokeToken(ctx, session.AccessTokenID, session.AccessTokenExpiresAt)
+		if err != nil {
+			logger.With(zap.Uint64("session_id", session.ID)).With(zap.Error(err)).Warn("failed to revoke access token of session")
+		}
+	}
+}
+
 func (a *accountLogic) isAccountNameTaken(ctx context.Context, accountName string) (bool, error) {
 	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("account_name", accountName))
 
@@ -282,3 +861,46 @@ func (a *accountLogic) isAccountNameTaken(ctx context.Context, accountName strin
 
 	return true, nil
 }
+
+// A refresh token is made of the ID of its session and a random secret, only the hash of the secret is stored.
+const (
+	refreshTokenSeparator      = "."
+	refreshTokenSecretByteSize = 32
+)
+
+type sessionTokens struct {
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
+
+func generateRefreshTokenSecret() (string, error) {
+	secretBytes := make([]byte, refreshTokenSecretByteSize)
+	if _, err := rand.Read(secretBytes); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(secretBytes), nil
+}
+
+// hashRefreshTokenSecret hashes the secret with SHA-256 rather than with the password hash, as the secret is random
+// and long enough not to be guessed.
+func hashRefreshTokenSecret(refreshTokenSecret string) string {
+	hash := sha256.Sum256([]byte(refreshTokenSecret))
+	return hex.EncodeToString(hash[:])
+}
+
+func parseRefreshToken(refreshToken string) (uint64, string, error) {
+	sessionIDString, refreshTokenSecret, found := strings.Cut(refreshToken, refreshTokenSeparator)
+	if !found || refreshTokenSecret == "" {
+		return 0, "", errors.New("malformed refresh token")
+	}
+
+	sessionID, err := strconv.ParseUint(sessionIDString, 10, 64)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return sessionID, refreshTokenSecret, nil
+}
@@ -0,0 +1,180 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/maxuanquang/ojs/internal/dataaccess/database"
+	"github.com/maxuanquang/ojs/internal/generated/grpc/ojs"
+	"go.uber.org/zap"
+)
+
+// Exit codes of testlib-compatible checkers and interactors.
+const (
+	testlibExitCodeOK                = 0
+	testlibExitCodeWrongAnswer       = 1
+	testlibExitCodePresentationError = 2
+	testlibExitCodePoints            = 7
+
+	testlibPointsPrefix       = "points"
+	modeOwnerReadWriteAllRead = 0644 // sandboxed programs may not run as the owner of their files
+)
+
+var (
+	errCheckerFailed = errors.New("checker failed")
+)
+
+type CheckInput struct {
+	Problem          database.Problem
+	Input            string
+	ExpectedOutput   string
+	ContestantOutput string
+}
+
+type CheckOutput struct {
+	Result ojs.SubmissionResult
+	// Credit is the fraction of the test case's points earned by the contestant, between 0 and 1.
+	Credit float64
+}
+
+// CheckerLogic judges the output of a test case with the custom checker of its problem.
+// Checkers follow the testlib convention: they are run as `checker <input> <expected output> <contestant output>`
+// and report their verdict with their exit code.
+type CheckerLogic interface {
+	Check(ctx context.Context, in CheckInput) (CheckOutput, error)
+}
+
+func NewCheckerLogic(
+	logger *zap.Logger,
+	workspaceLogic WorkspaceLogic,
+	languageToCompileLogic map[string]CompileLogic,
+	languageToExecuteLogic map[string]ExecuteLogic,
+) CheckerLogic {
+	return &checkerLogic{
+		logger:                 logger,
+		languageToExecuteLogic: languageToExecuteLogic,
+		checkerCache:           newProblemProgramCache(logger, workspaceLogic, languageToCompileLogic),
+	}
+}
+
+type checkerLogic struct {
+	logger                 *zap.Logger
+	languageToExecuteLogic map[string]ExecuteLogic
+	checkerCache           *problemProgramCache
+}
+
+// Check implements CheckerLogic.
+func (c *checkerLogic) Check(ctx context.Context, in CheckInput) (CheckOutput, error) {
+	logger := c.logger.With(zap.Uint64("problem_id", in.Problem.ID))
+
+	executeLogic, ok := c.languageToExecuteLogic[in.Problem.CheckerLanguage]
+	if !ok {
+		logger.With(zap.String("checker_language", in.Problem.CheckerLanguage)).Error("unsupported checker language")
+		return CheckOutput{}, errCheckerFailed
+	}
+
+	programFilePath, releaseProgram, err := c.checkerCache.getProgramFilePath(ctx, problemProgram{
+		ProblemID:      in.Problem.ID,
+		ProblemVersion: in.Problem.Version,
+		Language:       in.Problem.CheckerLanguage,
+		Source:         in.Problem.CheckerSource,
+	})
+	if err != nil {
+		return CheckOutput{}, err
+	}
+	defer releaseProgram()
+
+	// Files are written next to the checker program, as that is the directory mounted into the container
+	hostWorkingDir := filepath.Dir(programFilePath)
+	filePrefix := uuid.NewString()
+	var filePaths []string
+	for _, file := range []struct {
+		suffix  string
+		content string
+	}{
+		{suffix: "input", content: in.Input},
+		{suffix: "expected", content: in.ExpectedOutput},
+		{suffix: "output", content: in.ContestantOutput},
+	} {
+		filePath := filepath.Join(hostWorkingDir, fmt.Sprintf("%s.%s", filePrefix, file.suffix))
+		filePaths = append(filePaths, filePath)
+		if err := os.WriteFile(filePath, []byte(file.content), modeOwnerReadWriteAllRead); err != nil {
+			logger.With(zap.Error(err)).Error("failed to write checker file")
+			return CheckOutput{}, err
+		}
+	}
+
+	defer func() {
+		for _, filePath := range filePaths {
+			if err := os.Remove(filePath); err != nil {
+				logger.With(zap.String("file_path", filePath)).With(zap.Error(err)).Warn("failed to remove checker file")
+			}
+		}
+	}()
+
+	output, err := executeLogic.Execute(ctx, programFilePath, "", ExecuteLimits{}, filePaths...)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to execute checker")
+		return CheckOutput{}, err
+	}
+
+	if output.TimeLimitExceeded || output.MemoryLimitExceeded {
+		logger.With(zap.Any("output", output)).Error("checker exceeded its limits")
+		return CheckOutput{}, errCheckerFailed
+	}
+
+	result, credit, err := getTestlibVerdict(output)
+	if err != nil {
+		logger.With(zap.Any("output", output)).With(zap.Error(err)).Error("checker failed")
+		return CheckOutput{}, errCheckerFailed
+	}
+
+	return CheckOutput{Result: result, Credit: credit}, nil
+}
+
+// getTestlibVerdict maps the exit code of a testlib program to a result and the fraction of points earned.
+func getTestlibVerdict(output ExecuteOutput) (ojs.SubmissionResult, float64, error) {
+	switch output.ReturnCode {
+	case testlibExitCodeOK:
+		return ojs.SubmissionResult_OK, 1, nil
+	case testlibExitCodeWrongAnswer, testlibExitCodePresentationError:
+		return ojs.SubmissionResult_WrongAnswer, 0, nil
+	case testlibExitCodePoints:
+		credit, err := parseTestlibCredit(output.Stderr)
+		if err != nil {
+			return ojs.SubmissionResult_UndefinedResult, 0, err
+		}
+
+		if credit >= 1 {
+			return ojs.SubmissionResult_OK, 1, nil
+		}
+
+		return ojs.SubmissionResult_WrongAnswer, credit, nil
+	default:
+		return ojs.SubmissionResult_UndefinedResult, 0, fmt.Errorf("unexpected exit code %d", output.ReturnCode)
+	}
+}
+
+// parseTestlibCredit parses the points reported by testlib's quitp, written to stderr as "points <value> <message>".
+func parseTestlibCredit(stderr string) (float64, error) {
+	fields := strings.Fields(stderr)
+	if len(fields) > 0 && fields[0] == testlibPointsPrefix {
+		fields = fields[1:]
+	}
+	if len(fields) == 0 {
+		return 0, errors.New("no points were reported")
+	}
+
+	credit, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return min(max(credit, 0), 1), nil
+}
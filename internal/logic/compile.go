@@ -3,26 +3,18 @@ package logic
 import (
 	"bytes"
 	"context"
-	"fmt"
 	"os"
 	"path/filepath"
 	"time"
 
-	"github.com/docker/docker/api/types/container"
-	"github.com/docker/docker/api/types/image"
-	"github.com/docker/docker/api/types/strslice"
-	"github.com/docker/docker/client"
-	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/google/uuid"
 	"github.com/maxuanquang/ojs/internal/configs"
+	"github.com/maxuanquang/ojs/internal/sandbox"
 	"github.com/maxuanquang/ojs/internal/utils"
 	"go.uber.org/zap"
 )
 
 const (
-	hostTempWorkingDir                = "/tmp/ojs-compile"
-	modeOwnerAllPermission            = 0700
-	defaultCPUPeriod                  = 100000
 	compileSourceFilePathPlaceholder  = "$SOURCE"
 	compileProgramFilePathPlaceholder = "$PROGRAM"
 )
@@ -35,19 +27,20 @@ type CompileOutput struct {
 }
 
 type CompileLogic interface {
-	Compile(ctx context.Context, content string) (CompileOutput, error)
+	// Compile writes the source file into the workspace, the compiled program is written next to it.
+	Compile(ctx context.Context, workspaceDir string, content string) (CompileOutput, error)
 }
 
 func NewCompileLogic(
 	logger *zap.Logger,
-	dockerClient *client.Client,
+	sandbox sandbox.Sandbox,
 	language string,
 	compileConfig *configs.Compile,
 	appArguments utils.Arguments,
 ) (CompileLogic, error) {
 	c := &compileLogic{
 		logger:        logger.With(zap.String("language", language)).With(zap.Any("compile_config", compileConfig)),
-		dockerClient:  dockerClient,
+		sandbox:       sandbox,
 		language:      language,
 		compileConfig: compileConfig,
 		appArguments:  appArguments,
@@ -71,15 +64,15 @@ func NewCompileLogic(
 		return nil, err
 	}
 
-	c.memoryLimitInBytes = int64(memoryInBytes)
+	c.memoryLimitInBytes = memoryInBytes
 
 	if c.appArguments.PullImageAtStartUp {
-		if err := c.pullImage(); err != nil {
+		if err := c.sandbox.PrepareImage(context.Background(), c.compileConfig.Image); err != nil {
 			return nil, err
 		}
 	} else {
 		go func() {
-			c.pullImage()
+			c.sandbox.PrepareImage(context.Background(), c.compileConfig.Image)
 		}()
 	}
 
@@ -88,26 +81,17 @@ func NewCompileLogic(
 
 type compileLogic struct {
 	logger        *zap.Logger
-	dockerClient  *client.Client
+	sandbox       sandbox.Sandbox
 	language      string
 	compileConfig *configs.Compile
 	appArguments  utils.Arguments
 
 	timeoutDuration    time.Duration
-	memoryLimitInBytes int64
+	memoryLimitInBytes uint64
 }
 
 // Compile implements CompileLogic.
-func (c *compileLogic) Compile(ctx context.Context, content string) (CompileOutput, error) {
-	hostWorkingDir := filepath.Join(hostTempWorkingDir, uuid.NewString())
-
-	err := os.MkdirAll(hostWorkingDir, modeOwnerAllPermission)
-	if err != nil {
-		c.logger.With(zap.Error(err)).Error("failed to create temp dir")
-		return CompileOutput{}, err
-	}
-	c.logger.Info("temp dir created", zap.String("temp_dir", hostWorkingDir))
-
+func (c *compileLogic) Compile(ctx context.Context, workspaceDir string, content string) (CompileOutput, error) {
 	var sourceFileName string
 	if c.compileConfig == nil {
 		sourceFileName = uuid.NewString()
@@ -115,7 +99,7 @@ func (c *compileLogic) Compile(ctx context.Context, content string) (CompileOutp
 		sourceFileName = c.compileConfig.SourceFileName
 	}
 
-	sourceFile, err := c.createSourceFile(ctx, hostWorkingDir, sourceFileName, content)
+	sourceFile, err := c.createSourceFile(ctx, workspaceDir, sourceFileName, content)
 	if err != nil {
 		c.logger.With(zap.Error(err)).Error("failed to create source file")
 		return CompileOutput{}, err
@@ -129,7 +113,7 @@ func (c *compileLogic) Compile(ctx context.Context, content string) (CompileOutp
 		}, nil
 	}
 
-	compileOutput, err := c.compileSourceFile(ctx, hostWorkingDir, sourceFile)
+	compileOutput, err := c.compileSourceFile(ctx, workspaceDir, sourceFile)
 	if err != nil {
 		c.logger.With(zap.Error(err)).Error("failed to compile source file")
 		return CompileOutput{}, err
@@ -138,18 +122,6 @@ func (c *compileLogic) Compile(ctx context.Context, content string) (CompileOutp
 	return compileOutput, nil
 }
 
-func (c *compileLogic) pullImage() error {
-	c.logger.Info("pulling image")
-	_, err := c.dockerClient.ImagePull(context.Background(), c.compileConfig.Image, image.PullOptions{})
-	if err != nil {
-		c.logger.With(zap.Error(err)).Error("failed to pull image")
-		return err
-	}
-
-	c.logger.Info("compile image pulled successfully")
-	return nil
-}
-
 func (c *compileLogic) createSourceFile(_ context.Context, hostWorkingDir, fileName, content string) (*os.File, error) {
 	logger := c.logger.With(zap.String("file_name", fileName)).With(zap.String("host_working_dir", hostWorkingDir))
 
@@ -180,105 +152,43 @@ func (c *compileLogic) compileSourceFile(ctx context.Context, hostWorkingDir str
 	containerSourceFilePath := filepath.Join(containerWorkingDir, filepath.Base(sourceFile.Name()))
 	containerProgramFilePath := filepath.Join(containerWorkingDir, filepath.Base(c.compileConfig.ProgramFileName))
 
-	dockerContainerCtx, dockerContainerCancelFunc := context.WithTimeout(ctx, c.timeoutDuration)
-	defer dockerContainerCancelFunc()
-
-	containerCreateResponse, err := c.dockerClient.ContainerCreate(
-		dockerContainerCtx,
-		&container.Config{
-			Image:        c.compileConfig.Image,
-			WorkingDir:   containerWorkingDir,
-			Cmd:          c.getCompileCommand(containerSourceFilePath, containerProgramFilePath),
-			AttachStdout: true,
-			AttachStderr: true,
-		},
-		&container.HostConfig{
-			Binds:       []string{fmt.Sprintf("%s:%s", hostWorkingDir, containerWorkingDir)},
-			NetworkMode: "none",
-			Resources: container.Resources{
-				CPUPeriod: defaultCPUPeriod,
-				CPUQuota:  int64(c.compileConfig.CPUs * defaultCPUPeriod),
-				Memory:    c.memoryLimitInBytes,
-			},
-		},
-		nil,
-		nil,
-		"",
-	)
+	sandboxCtx, sandboxCancelFunc := context.WithTimeout(ctx, c.timeoutDuration)
+	defer sandboxCancelFunc()
+
+	stdoutBuffer := new(bytes.Buffer)
+	stderrBuffer := new(bytes.Buffer)
+	result, err := sandbox.Run(sandboxCtx, c.sandbox, sandbox.Command{
+		Image:              c.compileConfig.Image,
+		WorkingDir:         containerWorkingDir,
+		Args:               c.getCompileCommand(containerSourceFilePath, containerProgramFilePath),
+		CPUs:               c.compileConfig.CPUs,
+		CPUSet:             getCPUSet(ctx),
+		MemoryLimitInBytes: c.memoryLimitInBytes,
+		Stdout:             stdoutBuffer,
+		Stderr:             stderrBuffer,
+	})
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to create container")
+		logger.With(zap.Error(err)).Error("failed to run compile command")
 		return CompileOutput{}, err
 	}
 
-	defer func() {
-		err = c.dockerClient.ContainerRemove(ctx, containerCreateResponse.ID, container.RemoveOptions{})
-		if err != nil {
-			logger.With(zap.Error(err)).Error("failed to remove container")
+	if result.ExitCode != 0 {
+		compileOutput := CompileOutput{
+			ReturnCode: result.ExitCode,
+			Stdout:     stdoutBuffer.String(),
+			Stderr:     stderrBuffer.String(),
 		}
-	}()
-
-	containerID := containerCreateResponse.ID
-	containerAttachResponse, err := c.dockerClient.ContainerAttach(
-		dockerContainerCtx,
-		containerID,
-		container.AttachOptions{
-			Stream: true,
-			Stdout: true,
-			Stderr: true,
-		},
-	)
-	if err != nil {
-		logger.With(zap.String("container_id", containerID)).With(zap.Error(err)).Error("failed to attach container")
-		return CompileOutput{}, err
-	}
-
-	defer containerAttachResponse.Close()
-
-	err = c.dockerClient.ContainerStart(
-		dockerContainerCtx,
-		containerID,
-		container.StartOptions{},
-	)
-	if err != nil {
-		logger.With(zap.String("container_id", containerID)).With(zap.Error(err)).Error("failed to start container")
+		logger.With(zap.Any("compile_output", compileOutput)).Info("failed to compile source file, compiler exited with non-zero code")
+		return compileOutput, nil
 	}
 
-	dataChan, errChan := c.dockerClient.ContainerWait(
-		dockerContainerCtx,
-		containerID,
-		container.WaitConditionNotRunning,
-	)
-	select {
-	case data := <-dataChan:
-		if data.StatusCode != 0 {
-			stdoutBuffer := new(bytes.Buffer)
-			stderrBuffer := new(bytes.Buffer)
-			_, err = stdcopy.StdCopy(stdoutBuffer, stderrBuffer, containerAttachResponse.Reader)
-			if err != nil {
-				logger.With(zap.Error(err)).Error("failed to read container output")
-				return CompileOutput{}, err
-			}
-
-			compileOutput := CompileOutput{
-				ReturnCode: int(data.StatusCode),
-				Stdout:     stdoutBuffer.String(),
-				Stderr:     stderrBuffer.String(),
-			}
-			logger.With(zap.Any("compile_output", compileOutput)).Info("failed to compile source file, compiler exited with non-zero code")
-			return compileOutput, nil
-		}
-
-		logger.With(zap.String("source_file_name", sourceFile.Name())).With(zap.String("program_file_name", hostProgramFilePath)).Info("source file compiled successfully")
-		return CompileOutput{
-			ProgramFilePath: hostProgramFilePath,
-		}, nil
-	case err := <-errChan:
-		logger.With(zap.String("container_id", containerID)).With(zap.Error(err)).Error("failed to wait container")
-		return CompileOutput{}, err
-	}
+	logger.With(zap.String("source_file_name", sourceFile.Name())).With(zap.String("program_file_name", hostProgramFilePath)).Info("source file compiled successfully")
+	return CompileOutput{
+		ProgramFilePath: hostProgramFilePath,
+	}, nil
 }
 
-func (c *compileLogic) getCompileCommand(sourceFilePath, compiledProgramFilePath string) strslice.StrSlice {
+func (c *compileLogic) getCompileCommand(sourceFilePath, compiledProgramFilePath string) []string {
 	commandTemplate := make([]string, len(c.compileConfig.CommandTemplate))
 	for i := range c.compileConfig.CommandTemplate {
 		switch c.compileConfig.CommandTemplate[i] {
@@ -290,5 +200,5 @@ func (c *compileLogic) getCompileCommand(sourceFilePath, compiledProgramFilePath
 			commandTemplate[i] = c.compileConfig.CommandTemplate[i]
 		}
 	}
-	return strslice.StrSlice(commandTemplate)
+	return commandTemplate
 }
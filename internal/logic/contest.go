@@ -0,0 +1,711 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maxuanquang/ojs/internal/dataaccess/database"
+	"github.com/maxuanquang/ojs/internal/generated/grpc/ojs"
+	"github.com/mikespook/gorbac"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type ContestLogic interface {
+	CreateContest(ctx context.Context, in CreateContestInput) (CreateContestOutput, error)
+	GetContest(ctx context.Context, in GetContestInput) (GetContestOutput, error)
+	GetContestList(ctx context.Context, in GetContestListInput) (GetContestListOutput, error)
+	UpdateContest(ctx context.Context, in UpdateContestInput) (UpdateContestOutput, error)
+	DeleteContest(ctx context.Context, in DeleteContestInput) error
+	RegisterContest(ctx context.Context, in RegisterContestInput) error
+	UnregisterContest(ctx context.Context, in UnregisterContestInput) error
+	GetContestScoreboard(ctx context.Context, in GetContestScoreboardInput) (GetContestScoreboardOutput, error)
+}
+
+func NewContestLogic(
+	logger *zap.Logger,
+	contestDataAccessor database.ContestDataAccessor,
+	contestProblemDataAccessor database.ContestProblemDataAccessor,
+	contestParticipantDataAccessor database.ContestParticipantDataAccessor,
+	problemDataAccessor database.ProblemDataAccessor,
+	tokenLogic TokenLogic,
+	roleLogic RoleLogic,
+	scoreboardLogic ScoreboardLogic,
+	database database.Database,
+) ContestLogic {
+	return &contestLogic{
+		logger:                         logger,
+		contestDataAccessor:            contestDataAccessor,
+		contestProblemDataAccessor:     contestProblemDataAccessor,
+		contestParticipantDataAccessor: contestParticipantDataAccessor,
+		problemDataAccessor:            problemDataAccessor,
+		tokenLogic:                     tokenLogic,
+		roleLogic:                      roleLogic,
+		scoreboardLogic:                scoreboardLogic,
+		database:                       database,
+	}
+}
+
+type contestLogic struct {
+	logger                         *zap.Logger
+	contestDataAccessor            database.ContestDataAccessor
+	contestProblemDataAccessor     database.ContestProblemDataAccessor
+	contestParticipantDataAccessor database.ContestParticipantDataAccessor
+	problemDataAccessor            database.ProblemDataAccessor
+	tokenLogic                     TokenLogic
+	roleLogic                      RoleLogic
+	scoreboardLogic                ScoreboardLogic
+	database                       database.Database
+}
+
+// CreateContest implements ContestLogic.
+func (c *contestLogic) CreateContest(ctx context.Context, in CreateContestInput) (CreateContestOutput, error) {
+	logger := c.logger.With(zap.String("method", "CreateContest"))
+
+	requestingAccountID, _, requestingAccountRole, _, err := c.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return CreateContestOutput{}, ErrTokenInvalid
+	}
+
+	requiredPermissions := []gorbac.Permission{PermissionContestsWriteAll, PermissionContestsWriteSelf}
+	hasPermission, err := c.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(requestingAccountRole)], requiredPermissions...)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to check permission")
+		return CreateContestOutput{}, ErrInternal
+	}
+	if !hasPermission {
+		return CreateContestOutput{}, ErrPermissionDenied
+	}
+
+	if !in.EndTime.After(in.StartTime) {
+		return CreateContestOutput{}, ErrContestTimeInvalid
+	}
+
+	err = c.validateContestProblemList(ctx, in.Problems)
+	if err != nil {
+		return CreateContestOutput{}, err
+	}
+
+	var createdContest database.Contest
+	txErr := c.database.Transaction(func(tx *gorm.DB) error {
+		createdContest, err = c.contestDataAccessor.WithDatabaseTransaction(tx).CreateContest(ctx, database.Contest{
+			DisplayName:      in.DisplayName,
+			AuthorID:         requestingAccountID,
+			Description:      in.Description,
+			StartTime:        in.StartTime,
+			EndTime:          in.EndTime,
+			IsPublic:         in.IsPublic,
+			ScoringMode:      int8(in.ScoringMode),
+			FreezeScoreboard: in.FreezeScoreboard,
+		})
+		if err != nil {
+			return err
+		}
+
+		return c.contestProblemDataAccessor.WithDatabaseTransaction(tx).CreateContestProblemList(
+			ctx,
+			c.logicContestProblemListToDBContestProblemList(createdContest.ID, in.Problems),
+		)
+	})
+	if txErr != nil {
+		logger.With(zap.Error(txErr)).Error("create contest transaction failed")
+		return CreateContestOutput{}, ErrInternal
+	}
+
+	return CreateContestOutput{
+		Contest: c.dbContestToLogicContest(createdContest, in.Problems, false),
+	}, nil
+}
+
+// GetContest implements ContestLogic.
+func (c *contestLogic) GetContest(ctx context.Context, in GetContestInput) (GetContestOutput, error) {
+	logger := c.logger.With(zap.Uint64("contest_id", in.ID))
+
+	requestingAccountID, _, requestingAccountRole, _, err := c.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return GetContestOutput{}, ErrTokenInvalid
+	}
+
+	contest, err := c.getVisibleContest(ctx, in.ID, requestingAccountID, requestingAccountRole)
+	if err != nil {
+		return GetContestOutput{}, err
+	}
+
+	contestProblems, err := c.getContestProblemList(ctx, contest.ID)
+	if err != nil {
+		return GetContestOutput{}, err
+	}
+
+	isRegistered, err := c.isContestParticipant(ctx, contest.ID, requestingAccountID)
+	if err != nil {
+		return GetContestOutput{}, err
+	}
+
+	return GetContestOutput{
+		Contest: c.dbContestToLogicContest(contest, contestProblems, isRegistered),
+	}, nil
+}
+
+// GetContestList implements ContestLogic.
+func (c *contestLogic) GetContestList(ctx context.Context, in GetContestListInput) (GetContestListOutput, error) {
+	logger := c.logger.With(zap.String("method", "GetContestList"))
+
+	requestingAccountID, _, requestingAccountRole, _, err := c.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return GetContestListOutput{}, ErrTokenInvalid
+	}
+
+	canReadAllContests, err := c.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(requestingAccountRole)], PermissionContestsReadAll)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to check permission")
+		return GetContestListOutput{}, ErrInternal
+	}
+
+	var (
+		dbContestList     []database.Contest
+		totalContestCount uint64
+	)
+	if canReadAllContests {
+		dbContestList, err = c.contestDataAccessor.GetContestList(ctx, in.Offset, in.Limit)
+		if err == nil {
+			totalContestCount, err = c.contestDataAccessor.GetContestCount(ctx)
+		}
+	} else {
+		dbContestList, err = c.contestDataAccessor.GetVisibleContestList(ctx, requestingAccountID, in.Offset, in.Limit)
+		if err == nil {
+			totalContestCount, err = c.contestDataAccessor.GetVisibleContestCount(ctx, requestingAccountID)
+		}
+	}
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get contest list")
+		return GetContestListOutput{}, ErrInternal
+	}
+
+	var contestList []Contest
+	for _, contest := range dbContestList {
+		contestProblems, err := c.getContestProblemList(ctx, contest.ID)
+		if err != nil {
+			return GetContestListOutput{}, err
+		}
+
+		isRegistered, err := c.isContestParticipant(ctx, contest.ID, requestingAccountID)
+		if err != nil {
+			return GetContestListOutput{}, err
+		}
+
+		contestList = append(contestList, c.dbContestToLogicContest(contest, contestProblems, isRegistered))
+	}
+
+	return GetContestListOutput{
+		Contests:          contestList,
+		TotalContestCount: totalContestCount,
+	}, nil
+}
+
+// UpdateContest implements ContestLogic.
+func (c *contestLogic) UpdateContest(ctx context.Context, in UpdateContestInput) (UpdateContestOutput, error) {
+	logger := c.logger.With(zap.Uint64("contest_id", in.ID))
+
+	requestingAccountID, _, requestingAccountRole, _, err := c.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return UpdateContestOutput{}, ErrTokenInvalid
+	}
+
+	contest, err := c.getWritableContest(ctx, in.ID, requestingAccountID, requestingAccountRole)
+	if err != nil {
+		return UpdateContestOutput{}, err
+	}
+
+	startTime, endTime := contest.StartTime, contest.EndTime
+	if !in.StartTime.IsZero() {
+		startTime = in.StartTime
+	}
+	if !in.EndTime.IsZero() {
+		endTime = in.EndTime
+	}
+	if !endTime.After(startTime) {
+		return UpdateContestOutput{}, ErrContestTimeInvalid
+	}
+
+	isPublic, freezeScoreboard := contest.IsPublic, contest.FreezeScoreboard
+	if in.IsPublic != nil {
+		isPublic = *in.IsPublic
+	}
+	if in.FreezeScoreboard != nil {
+		freezeScoreboard = *in.FreezeScoreboard
+	}
+
+	// The problem list is only replaced when a new one is provided
+	if len(in.Problems) > 0 {
+		err = c.validateContestProblemList(ctx, in.Problems)
+		if err != nil {
+			return UpdateContestOutput{}, err
+		}
+	}
+
+	var updatedContest database.Contest
+	txErr := c.database.Transaction(func(tx *gorm.DB) error {
+		updatedContest, err = c.contestDataAccessor.WithDatabaseTransaction(tx).UpdateContest(ctx, database.Contest{
+			ID:               in.ID,
+			DisplayName:      in.DisplayName,
+			Description:      in.Description,
+			StartTime:        in.StartTime,
+			EndTime:          in.EndTime,
+			IsPublic:         isPublic,
+			ScoringMode:      int8(in.ScoringMode),
+			FreezeScoreboard: freezeScoreboard,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(in.Problems) == 0 {
+			return nil
+		}
+
+		contestProblemDataAccessor := c.contestProblemDataAccessor.WithDatabaseTransaction(tx)
+		err = contestProblemDataAccessor.DeleteContestProblemList(ctx, in.ID)
+		if err != nil {
+			return err
+		}
+
+		return contestProblemDataAccessor.CreateContestProblemList(
+			ctx,
+			c.logicContestProblemListToDBContestProblemList(in.ID, in.Problems),
+		)
+	})
+	if txErr != nil {
+		logger.With(zap.Error(txErr)).Error("update contest transaction failed")
+		return UpdateContestOutput{}, ErrInternal
+	}
+
+	contestProblems, err := c.getContestProblemList(ctx, in.ID)
+	if err != nil {
+		return UpdateContestOutput{}, err
+	}
+
+	isRegistered, err := c.isContestParticipant(ctx, in.ID, requestingAccountID)
+	if err != nil {
+		return UpdateContestOutput{}, err
+	}
+
+	return UpdateContestOutput{
+		Contest: c.dbContestToLogicContest(updatedContest, contestProblems, isRegistered),
+	}, nil
+}
+
+// DeleteContest implements ContestLogic.
+func (c *contestLogic) DeleteContest(ctx context.Context, in DeleteContestInput) error {
+	logger := c.logger.With(zap.Uint64("contest_id", in.ID))
+
+	requestingAccountID, _, requestingAccountRole, _, err := c.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return ErrTokenInvalid
+	}
+
+	_, err = c.getWritableContest(ctx, in.ID, requestingAccountID, requestingAccountRole)
+	if err != nil {
+		return err
+	}
+
+	err = c.contestDataAccessor.DeleteContest(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrContestNotFound) {
+			return ErrContestNotFound
+		}
+
+		logger.With(zap.Error(err)).Error("failed to delete contest")
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// RegisterContest implements ContestLogic.
+func (c *contestLogic) RegisterContest(ctx context.Context, in RegisterContestInput) error {
+	logger := c.logger.With(zap.Uint64("contest_id", in.ID))
+
+	requestingAccountID, _, requestingAccountRole, _, err := c.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return ErrTokenInvalid
+	}
+
+	hasPermission, err := c.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(requestingAccountRole)], PermissionContestParticipantsWriteSelf)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to check permission")
+		return ErrInternal
+	}
+	if !hasPermission {
+		return ErrPermissionDenied
+	}
+
+	contest, err := c.getVisibleContest(ctx, in.ID, requestingAccountID, requestingAccountRole)
+	if err != nil {
+		return err
+	}
+
+	if !time.Now().Before(contest.EndTime) {
+		return ErrContestAlreadyEnded
+	}
+
+	isRegistered, err := c.isContestParticipant(ctx, contest.ID, requestingAccountID)
+	if err != nil {
+		return err
+	}
+	if isRegistered {
+		return ErrContestAlreadyRegistered
+	}
+
+	_, err = c.contestParticipantDataAccessor.CreateContestParticipant(ctx, database.ContestParticipant{
+		OfContestID:  contest.ID,
+		OfAccountID:  requestingAccountID,
+		RegisteredAt: time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, database.ErrContestParticipantAlreadyExists) {
+			return ErrContestAlreadyRegistered
+		}
+
+		logger.With(zap.Error(err)).Error("failed to create contest participant")
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// UnregisterContest implements ContestLogic.
+func (c *contestLogic) UnregisterContest(ctx context.Context, in UnregisterContestInput) error {
+	logger := c.logger.With(zap.Uint64("contest_id", in.ID))
+
+	requestingAccountID, _, requestingAccountRole, _, err := c.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return ErrTokenInvalid
+	}
+
+	hasPermission, err := c.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(requestingAccountRole)], PermissionContestParticipantsWriteSelf)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to check permission")
+		return ErrInternal
+	}
+	if !hasPermission {
+		return ErrPermissionDenied
+	}
+
+	contest, err := c.getVisibleContest(ctx, in.ID, requestingAccountID, requestingAccountRole)
+	if err != nil {
+		return err
+	}
+
+	// Participants can only leave a contest before it starts, so that the scoreboard stays consistent
+	if !time.Now().Before(contest.StartTime) {
+		return ErrContestAlreadyStarted
+	}
+
+	err = c.contestParticipantDataAccessor.DeleteContestParticipant(ctx, contest.ID, requestingAccountID)
+	if err != nil {
+		if errors.Is(err, database.ErrContestParticipantNotFound) {
+			return ErrContestNotRegistered
+		}
+
+		logger.With(zap.Error(err)).Error("failed to delete contest participant")
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// GetContestScoreboard implements ContestLogic.
+func (c *contestLogic) GetContestScoreboard(ctx context.Context, in GetContestScoreboardInput) (GetContestScoreboardOutput, error) {
+	logger := c.logger.With(zap.Uint64("contest_id", in.ID))
+
+	requestingAccountID, _, requestingAccountRole, _, err := c.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return GetContestScoreboardOutput{}, ErrTokenInvalid
+	}
+
+	contest, err := c.getVisibleContest(ctx, in.ID, requestingAccountID, requestingAccountRole)
+	if err != nil {
+		return GetContestScoreboardOutput{}, err
+	}
+
+	contestProblems, err := c.getContestProblemList(ctx, contest.ID)
+	if err != nil {
+		return GetContestScoreboardOutput{}, err
+	}
+
+	logicContest := c.dbContestToLogicContest(contest, contestProblems, false)
+
+	// Accounts that can manage the contest always see the live scoreboard
+	freezeTime := getScoreboardFreezeTime(logicContest, time.Now())
+	if !freezeTime.IsZero() {
+		_, err = c.getWritableContest(ctx, contest.ID, requestingAccountID, requestingAccountRole)
+		if err == nil {
+			freezeTime = time.Time{}
+		} else if !errors.Is(err, ErrPermissionDenied) {
+			return GetContestScoreboardOutput{}, err
+		}
+	}
+
+	getScoreboardOutput, err := c.scoreboardLogic.GetScoreboard(ctx, GetScoreboardInput{
+		Contest:    logicContest,
+		FreezeTime: freezeTime,
+	})
+	if err != nil {
+		return GetContestScoreboardOutput{}, err
+	}
+
+	return GetContestScoreboardOutput{
+		Scoreboard: getScoreboardOutput.Scoreboard,
+	}, nil
+}
+
+// getVisibleContest returns the contest if it is public or if the account is allowed to see it while hidden.
+// Hidden contests are reported as not found to accounts that can not see them.
+func (c *contestLogic) getVisibleContest(ctx context.Context, contestID, accountID uint64, accountRole int8) (database.Contest, error) {
+	contest, err := c.contestDataAccessor.GetContestByID(ctx, contestID)
+	if err != nil {
+		if errors.Is(err, database.ErrContestNotFound) {
+			return database.Contest{}, ErrContestNotFound
+		}
+
+		c.logger.With(zap.Uint64("contest_id", contestID)).With(zap.Error(err)).Error("failed to get contest")
+		return database.Contest{}, ErrInternal
+	}
+
+	if contest.IsPublic {
+		return contest, nil
+	}
+
+	requiredPermissions := []gorbac.Permission{PermissionContestsReadAll}
+	if contest.AuthorID == accountID {
+		requiredPermissions = append(requiredPermissions, PermissionContestsReadSelf)
+	}
+
+	hasPermission, err := c.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(accountRole)], requiredPermissions...)
+	if err != nil {
+		c.logger.With(zap.Error(err)).Error("failed to check permission")
+		return database.Contest{}, ErrInternal
+	}
+	if !hasPermission {
+		return database.Contest{}, ErrContestNotFound
+	}
+
+	return contest, nil
+}
+
+func (c *contestLogic) getWritableContest(ctx context.Context, contestID, accountID uint64, accountRole int8) (database.Contest, error) {
+	contest, err := c.getVisibleContest(ctx, contestID, accountID, accountRole)
+	if err != nil {
+		return database.Contest{}, err
+	}
+
+	requiredPermissions := []gorbac.Permission{PermissionContestsWriteAll}
+	if contest.AuthorID == accountID {
+		requiredPermissions = append(requiredPermissions, PermissionContestsWriteSelf)
+	}
+
+	hasPermission, err := c.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(accountRole)], requiredPermissions...)
+	if err != nil {
+		c.logger.With(zap.Error(err)).Error("failed to check permission")
+		return database.Contest{}, ErrInternal
+	}
+	if !hasPermission {
+		return database.Contest{}, ErrPermissionDenied
+	}
+
+	return contest, nil
+}
+
+func (c *contestLogic) validateContestProblemList(ctx context.Context, contestProblems []ContestProblem) error {
+	labels := make(map[string]struct{})
+	problemIDs := make(map[uint64]struct{})
+	for _, contestProblem := range contestProblems {
+		if _, ok := labels[contestProblem.Label]; ok {
+			return ErrContestProblemListInvalid
+		}
+		labels[contestProblem.Label] = struct{}{}
+
+		if _, ok := problemIDs[contestProblem.ProblemID]; ok {
+			return ErrContestProblemListInvalid
+		}
+		problemIDs[contestProblem.ProblemID] = struct{}{}
+
+		_, err := c.problemDataAccessor.GetProblemByID(ctx, contestProblem.ProblemID)
+		if err != nil {
+			if errors.Is(err, database.ErrProblemNotFound) {
+				return ErrProblemNotFound
+			}
+
+			c.logger.With(zap.Uint64("problem_id", contestProblem.ProblemID)).With(zap.Error(err)).Error("failed to get problem")
+			return ErrInternal
+		}
+	}
+
+	return nil
+}
+
+func (c *contestLogic) getContestProblemList(ctx context.Context, contestID uint64) ([]ContestProblem, error) {
+	dbContestProblems, err := c.contestProblemDataAccessor.GetContestProblemList(ctx, contestID)
+	if err != nil {
+		c.logger.With(zap.Uint64("contest_id", contestID)).With(zap.Error(err)).Error("failed to get contest problem list")
+		return nil, ErrInternal
+	}
+
+	var contestProblems []ContestProblem
+	for _, contestProblem := range dbContestProblems {
+		contestProblems = append(contestProblems, ContestProblem{
+			ProblemID: contestProblem.OfProblemID,
+			Label:     contestProblem.Label,
+		})
+	}
+
+	return contestProblems, nil
+}
+
+func (c *contestLogic) isContestParticipant(ctx context.Context, contestID, accountID uint64) (bool, error) {
+	_, err := c.contestParticipantDataAccessor.GetContestParticipant(ctx, contestID, accountID)
+	if err != nil {
+		if errors.Is(err, database.ErrContestParticipantNotFound) {
+			return false, nil
+		}
+
+		c.logger.With(zap.Uint64("contest_id", contestID)).With(zap.Error(err)).Error("failed to get contest participant")
+		return false, ErrInternal
+	}
+
+	return true, nil
+}
+
+func (c *contestLogic) logicContestProblemListToDBContestProblemList(contestID uint64, contestProblems []ContestProblem) []database.ContestProblem {
+	var dbContestProblems []database.ContestProblem
+	for _, contestProblem := range contestProblems {
+		dbContestProblems = append(dbContestProblems, database.ContestProblem{
+			OfContestID: contestID,
+			OfProblemID: contestProblem.ProblemID,
+			Label:       contestProblem.Label,
+		})
+	}
+
+	return dbContestProblems
+}
+
+func (c *contestLogic) dbContestToLogicContest(dbContest database.Contest, contestProblems []ContestProblem, isRegistered bool) Contest {
+	return Contest{
+		ID:               dbContest.ID,
+		DisplayName:      dbContest.DisplayName,
+		AuthorID:         dbContest.AuthorID,
+		Description:      dbContest.Description,
+		StartTime:        dbContest.StartTime,
+		EndTime:          dbContest.EndTime,
+		IsPublic:         dbContest.IsPublic,
+		ScoringMode:      ojs.ContestScoringMode(dbContest.ScoringMode),
+		FreezeScoreboard: dbContest.FreezeScoreboard,
+		Problems:         contestProblems,
+		IsRegistered:     isRegistered,
+	}
+}
+
+type ContestProblem struct {
+	ProblemID uint64
+	Label     string
+}
+
+type Contest struct {
+	ID               uint64
+	DisplayName      string
+	AuthorID         uint64
+	Description      string
+	StartTime        time.Time
+	EndTime          time.Time
+	IsPublic         bool
+	ScoringMode      ojs.ContestScoringMode
+	FreezeScoreboard bool
+	Problems         []ContestProblem
+	IsRegistered     bool
+}
+
+type CreateContestInput struct {
+	Token            string
+	DisplayName      string
+	Description      string
+	StartTime        time.Time
+	EndTime          time.Time
+	IsPublic         bool
+	ScoringMode      ojs.ContestScoringMode
+	FreezeScoreboard bool
+	Problems         []ContestProblem
+}
+
+type CreateContestOutput struct {
+	Contest Contest
+}
+
+type GetContestInput struct {
+	Token string
+	ID    uint64
+}
+
+type GetContestOutput struct {
+	Contest Contest
+}
+
+type GetContestListInput struct {
+	Token  string
+	Offset uint64
+	Limit  uint64
+}
+
+type GetContestListOutput struct {
+	Contests          []Contest
+	TotalContestCount uint64
+}
+
+type UpdateContestInput struct {
+	Token            string
+	ID               uint64
+	DisplayName      string
+	Description      string
+	StartTime        time.Time
+	EndTime          time.Time
+	IsPublic         *bool
+	ScoringMode      ojs.ContestScoringMode
+	FreezeScoreboard *bool
+	Problems         []ContestProblem
+}
+
+type UpdateContestOutput struct {
+	Contest Contest
+}
+
+type DeleteContestInput struct {
+	Token string
+	ID    uint64
+}
+
+type RegisterContestInput struct {
+	Token string
+	ID    uint64
+}
+
+type UnregisterContestInput struct {
+	Token string
+	ID    uint64
+}
+
+type GetContestScoreboardInput struct {
+	Token string
+	ID    uint64
+}
+
+type GetContestScoreboardOutput struct {
+	Scoreboard Scoreboard
+}
@@ -0,0 +1,16 @@
+package logic
+
+import "context"
+
+type cpuSetContextKey struct{}
+
+// WithCPUSet pins the programs compiled and executed on behalf of ctx to the given CPUs, in the cpuset list format
+// like "0-3,8". Programs are not pinned if the CPU set is empty.
+func WithCPUSet(ctx context.Context, cpuSet string) context.Context {
+	return context.WithValue(ctx, cpuSetContextKey{}, cpuSet)
+}
+
+func getCPUSet(ctx context.Context) string {
+	cpuSet, _ := ctx.Value(cpuSetContextKey{}).(string)
+	return cpuSet
+}
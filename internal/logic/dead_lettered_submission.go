@@ -0,0 +1,246 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maxuanquang/ojs/internal/dataaccess/database"
+	"github.com/maxuanquang/ojs/internal/generated/grpc/ojs"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// DeadLetteredSubmissionLogic keeps track of the submissions that no worker could judge, so that admins can replay
+// them once the cause of the failure is fixed.
+type DeadLetteredSubmissionLogic interface {
+	// CreateDeadLetteredSubmission records a dead-lettered submission, it is only called by the consumer of the
+	// dead letter queue.
+	CreateDeadLetteredSubmission(ctx context.Context, in CreateDeadLetteredSubmissionInput) error
+	GetDeadLetteredSubmissionList(ctx context.Context, in GetDeadLetteredSubmissionListInput) (GetDeadLetteredSubmissionListOutput, error)
+	// ReplayDeadLetteredSubmission removes the dead-lettered submission, and publishes its submission created
+	// message again if the submission is still waiting to be judged.
+	ReplayDeadLetteredSubmission(ctx context.Context, in ReplayDeadLetteredSubmissionInput) (ReplayDeadLetteredSubmissionOutput, error)
+}
+
+func NewDeadLetteredSubmissionLogic(
+	deadLetteredSubmissionDataAccessor database.DeadLetteredSubmissionDataAccessor,
+	submissionDataAccessor database.SubmissionDataAccessor,
+	outboxMessageDataAccessor database.OutboxMessageDataAccessor,
+	outboxLogic OutboxLogic,
+	tokenLogic TokenLogic,
+	roleLogic RoleLogic,
+	database database.Database,
+	logger *zap.Logger,
+) DeadLetteredSubmissionLogic {
+	return &deadLetteredSubmissionLogic{
+		deadLetteredSubmissionDataAccessor: deadLetteredSubmissionDataAccessor,
+		submissionDataAccessor:             submissionDataAccessor,
+		outboxMessageDataAccessor:          outboxMessageDataAccessor,
+		outboxLogic:                        outboxLogic,
+		tokenLogic:                         tokenLogic,
+		roleLogic:                          roleLogic,
+		database:                           database,
+		logger:                             logger,
+	}
+}
+
+type deadLetteredSubmissionLogic struct {
+	deadLetteredSubmissionDataAccessor database.DeadLetteredSubmissionDataAccessor
+	submissionDataAccessor             database.SubmissionDataAccessor
+	outboxMessageDataAccessor          database.OutboxMessageDataAccessor
+	outboxLogic                        OutboxLogic
+	tokenLogic                         TokenLogic
+	roleLogic                          RoleLogic
+	database                           database.Database
+	logger                             *zap.Logger
+}
+
+// CreateDeadLetteredSubmission implements DeadLetteredSubmissionLogic.
+func (d *deadLetteredSubmissionLogic) CreateDeadLetteredSubmission(ctx context.Context, in CreateDeadLetteredSubmissionInput) error {
+	logger := d.logger.With(zap.Uint64("submission_id", in.OfSubmissionID))
+
+	_, err := d.deadLetteredSubmissionDataAccessor.CreateDeadLetteredSubmission(ctx, database.DeadLetteredSubmission{
+		OfSubmissionID: in.OfSubmissionID,
+		Error:          in.Error,
+		AttemptCount:   in.AttemptCount,
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create dead-lettered submission")
+		return ErrInternal
+	}
+
+	logger.Warn("submission dead-lettered")
+	return nil
+}
+
+// GetDeadLetteredSubmissionList implements DeadLetteredSubmissionLogic.
+func (d *deadLetteredSubmissionLogic) GetDeadLetteredSubmissionList(
+	ctx context.Context,
+	in GetDeadLetteredSubmissionListInput,
+) (GetDeadLetteredSubmissionListOutput, error) {
+	logger := d.logger.With(zap.String("method", "GetDeadLetteredSubmissionList"))
+
+	_, _, accountRole, _, err := d.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return GetDeadLetteredSubmissionListOutput{}, ErrTokenInvalid
+	}
+
+	hasPermission, err := d.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(accountRole)], PermissionDeadLettersReadAll)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to check permission")
+		return GetDeadLetteredSubmissionListOutput{}, ErrInternal
+	}
+	if !hasPermission {
+		return GetDeadLetteredSubmissionListOutput{}, ErrPermissionDenied
+	}
+
+	dbDeadLetteredSubmissionList, err := d.deadLetteredSubmissionDataAccessor.GetDeadLetteredSubmissionList(ctx, in.Offset, in.Limit)
+	if err != nil {
+		return GetDeadLetteredSubmissionListOutput{}, ErrInternal
+	}
+
+	totalDeadLetteredSubmissionCount, err := d.deadLetteredSubmissionDataAccessor.GetDeadLetteredSubmissionCount(ctx)
+	if err != nil {
+		return GetDeadLetteredSubmissionListOutput{}, ErrInternal
+	}
+
+	var deadLetteredSubmissionList []DeadLetteredSubmission
+	for _, deadLetteredSubmission := range dbDeadLetteredSubmissionList {
+		deadLetteredSubmissionList = append(deadLetteredSubmissionList, d.dbDeadLetteredSubmissionToLogicDeadLetteredSubmission(deadLetteredSubmission))
+	}
+
+	return GetDeadLetteredSubmissionListOutput{
+		DeadLetteredSubmissions:          deadLetteredSubmissionList,
+		TotalDeadLetteredSubmissionCount: totalDeadLetteredSubmissionCount,
+	}, nil
+}
+
+// ReplayDeadLetteredSubmission implements DeadLetteredSubmissionLogic.
+func (d *deadLetteredSubmissionLogic) ReplayDeadLetteredSubmission(
+	ctx context.Context,
+	in ReplayDeadLetteredSubmissionInput,
+) (ReplayDeadLetteredSubmissionOutput, error) {
+	logger := d.logger.With(zap.Uint64("dead_lettered_submission_id", in.ID))
+
+	_, _, accountRole, _, err := d.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return ReplayDeadLetteredSubmissionOutput{}, ErrTokenInvalid
+	}
+
+	hasPermission, err := d.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(accountRole)], PermissionDeadLettersWriteAll)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to check permission")
+		return ReplayDeadLetteredSubmissionOutput{}, ErrInternal
+	}
+	if !hasPermission {
+		return ReplayDeadLetteredSubmissionOutput{}, ErrPermissionDenied
+	}
+
+	var (
+		deadLetteredSubmission database.DeadLetteredSubmission
+		outboxMessage          database.OutboxMessage
+		isReplayed             bool
+	)
+	txErr := d.database.Transaction(func(tx *gorm.DB) error {
+		deadLetteredSubmissionDataAccessor := d.deadLetteredSubmissionDataAccessor.WithDatabaseTransaction(tx)
+		deadLetteredSubmission, err = deadLetteredSubmissionDataAccessor.GetDeadLetteredSubmissionByID(ctx, in.ID)
+		if err != nil {
+			return err
+		}
+
+		err = deadLetteredSubmissionDataAccessor.DeleteDeadLetteredSubmission(ctx, in.ID)
+		if err != nil {
+			return err
+		}
+
+		submission, err := d.submissionDataAccessor.WithDatabaseTransaction(tx).GetSubmissionByID(ctx, deadLetteredSubmission.OfSubmissionID)
+		if err != nil {
+			return err
+		}
+
+		// A submission judged since it was dead-lettered, such as by a remote worker, is not judged again
+		if submission.Status != int8(ojs.SubmissionStatus_Submitted) {
+			return nil
+		}
+
+		outboxMessage, err = createSubmissionCreatedOutboxMessage(ctx, d.outboxMessageDataAccessor.WithDatabaseTransaction(tx), submission)
+		if err != nil {
+			return err
+		}
+
+		isReplayed = true
+		return nil
+	})
+	if txErr != nil {
+		if errors.Is(txErr, database.ErrDeadLetteredSubmissionNotFound) {
+			return ReplayDeadLetteredSubmissionOutput{}, ErrDeadLetteredSubmissionNotFound
+		}
+		if errors.Is(txErr, database.ErrSubmissionNotFound) {
+			return ReplayDeadLetteredSubmissionOutput{}, ErrSubmissionNotFound
+		}
+
+		logger.With(zap.Error(txErr)).Error("replay dead-lettered submission transaction failed")
+		return ReplayDeadLetteredSubmissionOutput{}, ErrInternal
+	}
+
+	if isReplayed {
+		d.outboxLogic.PublishOutboxMessage(ctx, outboxMessage)
+	}
+
+	return ReplayDeadLetteredSubmissionOutput{
+		DeadLetteredSubmission: d.dbDeadLetteredSubmissionToLogicDeadLetteredSubmission(deadLetteredSubmission),
+		IsReplayed:             isReplayed,
+	}, nil
+}
+
+func (d *deadLetteredSubmissionLogic) dbDeadLetteredSubmissionToLogicDeadLetteredSubmission(
+	deadLetteredSubmission database.DeadLetteredSubmission,
+) DeadLetteredSubmission {
+	return DeadLetteredSubmission{
+		ID:             deadLetteredSubmission.ID,
+		OfSubmissionID: deadLetteredSubmission.OfSubmissionID,
+		Error:          deadLetteredSubmission.Error,
+		AttemptCount:   deadLetteredSubmission.AttemptCount,
+		CreatedAt:      deadLetteredSubmission.CreatedAt,
+	}
+}
+
+type DeadLetteredSubmission struct {
+	ID             uint64
+	OfSubmissionID uint64
+	Error          string
+	AttemptCount   int
+	CreatedAt      time.Time
+}
+
+type CreateDeadLetteredSubmissionInput struct {
+	OfSubmissionID uint64
+	Error          string
+	AttemptCount   int
+}
+
+type GetDeadLetteredSubmissionListInput struct {
+	Token  string
+	Offset uint64
+	Limit  uint64
+}
+
+type GetDeadLetteredSubmissionListOutput struct {
+	DeadLetteredSubmissions          []DeadLetteredSubmission
+	TotalDeadLetteredSubmissionCount uint64
+}
+
+type ReplayDeadLetteredSubmissionInput struct {
+	Token string
+	ID    uint64
+}
+
+type ReplayDeadLetteredSubmissionOutput struct {
+	DeadLetteredSubmission DeadLetteredSubmission
+	// IsReplayed is false if the submission was no longer waiting to be judged, it is then only removed from the
+	// dead-lettered submissions.
+	IsReplayed bool
+}
@@ -8,9 +8,39 @@ import (
 var (
 	ErrProblemNotFound      = status.Error(codes.NotFound, "problem not found")
 	ErrTestCaseNotFound     = status.Error(codes.NotFound, "test case not found")
+	ErrSubmissionNotFound   = status.Error(codes.NotFound, "submission not found")
 	ErrAccountNotFound      = status.Error(codes.NotFound, "account not found")
 	ErrPermissionDenied     = status.Error(codes.PermissionDenied, "permission denied")
 	ErrInternal             = status.Error(codes.Internal, "internal error")
 	ErrTokenInvalid         = status.Error(codes.Unauthenticated, "invalid authentication token")
 	ErrAccountAlreadyExists = status.Error(codes.AlreadyExists, "account name already exists")
+	ErrRefreshTokenInvalid  = status.Error(codes.Unauthenticated, "invalid refresh token")
+
+	ErrAccountDisabled          = status.Error(codes.PermissionDenied, "account is disabled")
+	ErrAccountPasswordIncorrect = status.Error(codes.PermissionDenied, "wrong password")
+	ErrAccountRoleInvalid       = status.Error(codes.InvalidArgument, "invalid account role")
+	ErrAccountSelfUpdateDenied  = status.Error(codes.FailedPrecondition, "cannot change the role of, or disable, your own account")
+
+	ErrContestNotFound           = status.Error(codes.NotFound, "contest not found")
+	ErrContestTimeInvalid        = status.Error(codes.InvalidArgument, "contest end time must be after its start time")
+	ErrContestProblemListInvalid = status.Error(codes.InvalidArgument, "contest problems and their labels must be unique")
+	ErrContestNotRunning         = status.Error(codes.FailedPrecondition, "contest is not running")
+	ErrContestAlreadyStarted     = status.Error(codes.FailedPrecondition, "contest has already started")
+	ErrContestAlreadyEnded       = status.Error(codes.FailedPrecondition, "contest has already ended")
+	ErrContestAlreadyRegistered  = status.Error(codes.AlreadyExists, "account is already registered for the contest")
+	ErrContestNotRegistered      = status.Error(codes.PermissionDenied, "account is not registered for the contest")
+	ErrProblemNotInContest       = status.Error(codes.InvalidArgument, "problem is not part of the contest")
+
+	ErrTestCaseGroupNotFound = status.Error(codes.NotFound, "test case group not found")
+
+	ErrSubmissionNotSubmitted        = status.Error(codes.FailedPrecondition, "submission is not submitted")
+	ErrSubmissionNotFinished         = status.Error(codes.FailedPrecondition, "submission has not been judged yet")
+	ErrSubmissionJudgingLeaseNotHeld = status.Error(codes.FailedPrecondition, "submission is not being judged by the worker")
+	ErrTestCaseResultListInvalid     = status.Error(codes.InvalidArgument, "test case results must be unique, of the submission's problem, and cover all test cases up to the first failed one")
+
+	ErrDeadLetteredSubmissionNotFound = status.Error(codes.NotFound, "dead-lettered submission not found")
+	ErrRejudgeNotFound                = status.Error(codes.NotFound, "rejudge not found")
+
+	ErrCheckerLanguageUnsupported    = status.Error(codes.InvalidArgument, "checker language is not supported")
+	ErrInteractorLanguageUnsupported = status.Error(codes.InvalidArgument, "interactor language is not supported")
 )
@@ -4,17 +4,14 @@ import (
 	"bytes"
 	"context"
 	"errors"
-	"fmt"
+	"io"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
 
-	"github.com/docker/docker/api/types"
-	"github.com/docker/docker/api/types/container"
-	"github.com/docker/docker/api/types/image"
-	"github.com/docker/docker/api/types/strslice"
-	"github.com/docker/docker/client"
-	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/maxuanquang/ojs/internal/configs"
+	"github.com/maxuanquang/ojs/internal/sandbox"
 	"github.com/maxuanquang/ojs/internal/utils"
 	"go.uber.org/zap"
 )
@@ -24,27 +21,62 @@ const (
 	executeProgramFilePathPlaceholder = "$PROGRAM"
 	statusCodeTimeLimitExceeded       = 124
 	statusCodeMemoryLimitExceeded     = 137
+	minContainerWaitTimeout           = time.Minute
+	// wallTimeLimitMultiplier bounds the wall time of a program to a multiple of its time limit, as the time limit
+	// is checked against its CPU time, which a program waiting on its input or sleeping does not use
+	wallTimeLimitMultiplier = 3
 )
 
+type ExecuteLimits struct {
+	TimeLimit          time.Duration
+	MemoryLimitInBytes uint64
+}
+
 type ExecuteOutput struct {
 	ReturnCode          int
 	TimeLimitExceeded   bool
 	MemoryLimitExceeded bool
 	Stdout              string
 	Stderr              string
+	WallTime            time.Duration
+	CPUTime             time.Duration // zero if the sandbox could not measure it, like the peak memory usage
+	PeakMemoryInBytes   uint64
+}
+
+// Interactor is a program that talks to an executed program over its stdin and stdout.
+type Interactor struct {
+	ExecuteLogic    ExecuteLogic // the interactor may be written in a different language than the program
+	ProgramFilePath string
+	Arguments       []string
+}
+
+type InteractiveExecuteOutput struct {
+	ProgramOutput    ExecuteOutput // the stdout of the program is what it wrote to the interactor
+	InteractorOutput ExecuteOutput
 }
 
 type ExecuteLogic interface {
+	// Execute runs the program with the given input on stdin. Arguments are appended to the execute command.
 	Execute(
 		ctx context.Context,
 		programFilePath string,
 		programInput string,
+		limits ExecuteLimits,
+		arguments ...string,
 	) (ExecuteOutput, error)
+	// ExecuteInteractive runs the program alongside the interactor, with the stdout of each one piped into
+	// the stdin of the other. The interactor runs with the limits of its execute config.
+	ExecuteInteractive(
+		ctx context.Context,
+		programFilePath string,
+		limits ExecuteLimits,
+		interactor Interactor,
+	) (InteractiveExecuteOutput, error)
 }
 
 func NewExecuteLogic(
 	logger *zap.Logger,
-	dockerClient *client.Client,
+	sandbox sandbox.Sandbox,
 	language string,
 	executeConfig *configs.Execute,
 	appArguments utils.Arguments,
@@ -52,7 +84,7 @@ func NewExecuteLogic(
 
 	output := &executeLogic{
 		logger:        logger,
-		dockerClient:  dockerClient,
+		sandbox:       sandbox,
 		language:      language,
 		executeConfig: executeConfig,
 		appArguments:  appArguments,
@@ -75,12 +107,12 @@ func NewExecuteLogic(
 	output.timeoutDuration = timeoutDuration
 
 	if appArguments.PullImageAtStartUp {
-		if err := output.pullImage(); err != nil {
+		if err := sandbox.PrepareImage(context.Background(), executeConfig.Image); err != nil {
 			return nil, err
 		}
 	} else {
 		go func() {
-			output.pullImage()
+			sandbox.PrepareImage(context.Background(), executeConfig.Image)
 		}()
 	}
 
@@ -89,7 +121,7 @@ func NewExecuteLogic(
 
 type executeLogic struct {
 	logger        *zap.Logger
-	dockerClient  *client.Client
+	sandbox       sandbox.Sandbox
 	language      string
 	executeConfig *configs.Execute
 	appArguments  utils.Arguments
@@ -99,166 +131,227 @@ type executeLogic struct {
 }
 
 // Execute implements ExecuteLogic.
-func (e *executeLogic) Execute(ctx context.Context, programFilePath string, programInput string) (ExecuteOutput, error) {
-	logger := e.logger.With(zap.String("program_file_path", programFilePath))
-	hostWorkingDir := filepath.Dir(programFilePath)
-	programFileName := filepath.Base(programFilePath)
-
-	// // TODO: Handle cleanup
-	// defer func() {
-	// 	if err := os.RemoveAll(hostWorkingDir); err != nil {
-	// 		e.logger.With(zap.Error(err)).Error("failed to remove temp dir")
-	// 	}
-	// }()
-
-	containerWorkingDir := hostWorkingDir
-	containerProgramFilePath := filepath.Join(containerWorkingDir, programFileName)
-
-	dockerContainerCtx, dockerContainerCancelFunc := context.WithTimeout(ctx, e.timeoutDuration)
-	defer dockerContainerCancelFunc()
-
-	containerCreateResponse, err := e.dockerClient.ContainerCreate(
-		dockerContainerCtx,
-		&container.Config{
-			Image:        e.executeConfig.Image,
-			WorkingDir:   containerWorkingDir,
-			Cmd:          e.getExecuteCommand(e.timeoutDuration, containerProgramFilePath),
-			AttachStdin:  true,
-			AttachStdout: true,
-			AttachStderr: true,
-			OpenStdin:    true,
-			StdinOnce:    true,
-		},
-		&container.HostConfig{
-			Binds:       []string{fmt.Sprintf("%s:%s", hostWorkingDir, containerWorkingDir)},
-			NetworkMode: "none",
-			Resources: container.Resources{
-				CPUPeriod: defaultCPUPeriod,
-				CPUQuota:  int64(e.executeConfig.CPUs * defaultCPUPeriod),
-				Memory:    int64(e.memoryLimitInBytes),
-			},
-		},
-		nil,
-		nil,
-		"",
-	)
+func (e *executeLogic) Execute(
+	ctx context.Context,
+	programFilePath string,
+	programInput string,
+	limits ExecuteLimits,
+	arguments ...string,
+) (ExecuteOutput, error) {
+	limits = e.getExecuteLimits(limits)
+
+	stdoutBuffer := new(bytes.Buffer)
+	stderrBuffer := new(bytes.Buffer)
+	process, err := e.start(ctx, programFilePath, limits, arguments, strings.NewReader(programInput+"\n"), stdoutBuffer, stderrBuffer)
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to create container")
 		return ExecuteOutput{}, err
 	}
 
-	defer func() {
-		err = e.dockerClient.ContainerRemove(ctx, containerCreateResponse.ID, container.RemoveOptions{})
-		if err != nil {
-			logger.With(zap.Error(err)).Error("failed to remove execute container")
-		}
-	}()
+	return e.wait(ctx, process, limits, stdoutBuffer, stderrBuffer)
+}
 
-	containerID := containerCreateResponse.ID
-	containerAttachResponse, err := e.dockerClient.ContainerAttach(
-		dockerContainerCtx,
-		containerID,
-		container.AttachOptions{
-			Stream: true,
-			Stdin:  true,
-			Stdout: true,
-			Stderr: true,
-		},
+// ExecuteInteractive implements ExecuteLogic.
+func (e *executeLogic) ExecuteInteractive(
+	ctx context.Context,
+	programFilePath string,
+	limits ExecuteLimits,
+	interactor Interactor,
+) (InteractiveExecuteOutput, error) {
+	limits = e.getExecuteLimits(limits)
+	logger := e.logger.With(zap.String("program_file_path", programFilePath)).With(zap.Any("limits", limits))
+
+	interactorExecuteLogic, ok := interactor.ExecuteLogic.(*executeLogic)
+	if !ok {
+		logger.Error("unsupported interactor execute logic")
+		return InteractiveExecuteOutput{}, errors.New("unsupported interactor execute logic")
+	}
+
+	interactorLimits := interactorExecuteLogic.getExecuteLimits(ExecuteLimits{})
+
+	// The stdout of each process is piped into the stdin of the other one, and also kept for the output excerpts
+	programToInteractorReader, programToInteractorWriter := io.Pipe()
+	interactorToProgramReader, interactorToProgramWriter := io.Pipe()
+	programStdoutBuffer, programStderrBuffer := new(bytes.Buffer), new(bytes.Buffer)
+	interactorStdoutBuffer, interactorStderrBuffer := new(bytes.Buffer), new(bytes.Buffer)
+
+	// The interactor is started first, so that it is ready to talk as soon as the program starts
+	interactorProcess, err := interactorExecuteLogic.start(
+		ctx,
+		interactor.ProgramFilePath,
+		interactorLimits,
+		interactor.Arguments,
+		programToInteractorReader,
+		io.MultiWriter(&drainingWriter{writer: interactorToProgramWriter}, interactorStdoutBuffer),
+		interactorStderrBuffer,
 	)
 	if err != nil {
-		logger.With(zap.String("container_id", containerID)).With(zap.Error(err)).Error("failed to attach container")
-		return ExecuteOutput{}, err
+		return InteractiveExecuteOutput{}, err
 	}
 
-	defer containerAttachResponse.Close()
-
-	_, err = containerAttachResponse.Conn.Write(append([]byte(programInput), '\n'))
+	programProcess, err := e.start(
+		ctx,
+		programFilePath,
+		limits,
+		nil,
+		interactorToProgramReader,
+		io.MultiWriter(&drainingWriter{writer: programToInteractorWriter}, programStdoutBuffer),
+		programStderrBuffer,
+	)
 	if err != nil {
-		logger.With(zap.String("container_id", containerID)).With(zap.String("input", programInput)).With(zap.Error(err)).Error("failed to write to container")
-		return ExecuteOutput{}, err
+		// Waiting with a done context kills the interactor
+		killCtx, killCancelFunc := context.WithCancel(ctx)
+		killCancelFunc()
+		interactorProcess.Wait(killCtx)
+		return InteractiveExecuteOutput{}, err
 	}
 
-	err = e.dockerClient.ContainerStart(
-		dockerContainerCtx,
-		containerID,
-		container.StartOptions{},
-	)
+	// Once a process has exited, the other one reads EOF on its stdin, and whatever it still writes is discarded
+	type waitResult struct {
+		output ExecuteOutput
+		err    error
+	}
+	interactorWaitResultChan := make(chan waitResult, 1)
+	go func() {
+		output, err := interactorExecuteLogic.wait(ctx, interactorProcess, interactorLimits, interactorStdoutBuffer, interactorStderrBuffer)
+		interactorToProgramWriter.Close()
+		programToInteractorReader.Close()
+		interactorWaitResultChan <- waitResult{output: output, err: err}
+	}()
+
+	programOutput, err := e.wait(ctx, programProcess, limits, programStdoutBuffer, programStderrBuffer)
+	programToInteractorWriter.Close()
+	interactorToProgramReader.Close()
+
+	interactorWaitResult := <-interactorWaitResultChan
 	if err != nil {
-		logger.With(zap.String("container_id", containerID)).With(zap.Error(err)).Error("failed to start container")
-		return ExecuteOutput{}, err
+		return InteractiveExecuteOutput{}, err
+	}
+	if interactorWaitResult.err != nil {
+		return InteractiveExecuteOutput{}, interactorWaitResult.err
 	}
 
-	containerWaitCtx, containerWaitCancelFunc := context.WithTimeout(ctx, time.Minute)
-	defer containerWaitCancelFunc()
-
-	dataChan, errChan := e.dockerClient.ContainerWait(containerWaitCtx, containerID, container.WaitConditionNotRunning)
-	select {
-	case err = <-errChan:
-		return e.onContainerWaitError(ctx, containerID, err)
-	case <-containerWaitCtx.Done():
-		return e.onContainerWaitError(ctx, containerID, containerWaitCtx.Err())
-	case data := <-dataChan:
-		return e.onContainerWaitData(ctx, data, containerAttachResponse)
+	return InteractiveExecuteOutput{
+		ProgramOutput:    programOutput,
+		InteractorOutput: interactorWaitResult.output,
+	}, nil
+}
+
+// start starts the program in the sandbox, the program's directory being its working directory.
+func (e *executeLogic) start(
+	ctx context.Context,
+	programFilePath string,
+	limits ExecuteLimits,
+	arguments []string,
+	stdin io.Reader,
+	stdout io.Writer,
+	stderr io.Writer,
+) (sandbox.Process, error) {
+	logger := e.logger.With(zap.String("program_file_path", programFilePath)).With(zap.Any("limits", limits))
+	workingDir := filepath.Dir(programFilePath)
+
+	// The process outlives ctx once started, how long it runs is bounded by wait
+	process, err := e.sandbox.Start(ctx, sandbox.Command{
+		Image:              e.executeConfig.Image,
+		WorkingDir:         workingDir,
+		Args:               e.getExecuteCommand(wallTimeLimitMultiplier*limits.TimeLimit, programFilePath, arguments),
+		CPUs:               e.executeConfig.CPUs,
+		CPUSet:             getCPUSet(ctx),
+		MemoryLimitInBytes: limits.MemoryLimitInBytes,
+		Stdin:              stdin,
+		Stdout:             stdout,
+		Stderr:             stderr,
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to start program")
+		return nil, err
 	}
+
+	return process, nil
 }
 
-func (e *executeLogic) onContainerWaitData(
-	_ context.Context,
-	data container.WaitResponse,
-	attachResponse types.HijackedResponse,
+// wait waits for the program to exit. Its stdout and stderr are complete once it has exited.
+func (e *executeLogic) wait(
+	ctx context.Context,
+	process sandbox.Process,
+	limits ExecuteLimits,
+	stdoutBuffer *bytes.Buffer,
+	stderrBuffer *bytes.Buffer,
 ) (ExecuteOutput, error) {
-	stdoutBuffer := new(bytes.Buffer)
-	stderrBuffer := new(bytes.Buffer)
-	_, err := stdcopy.StdCopy(stdoutBuffer, stderrBuffer, attachResponse.Reader)
+	waitCtx, waitCancelFunc := context.WithTimeout(ctx, e.getContainerWaitTimeout(limits.TimeLimit))
+	defer waitCancelFunc()
+
+	result, err := process.Wait(waitCtx)
 	if err != nil {
-		e.logger.With(zap.Error(err)).Error("failed to read from stdout and stderr of container")
-		return ExecuteOutput{}, err
+		return e.onWaitError(ctx, err)
 	}
 
-	stdOut := utils.TrimSpaceRight(stdoutBuffer.String())
-	stdErr := utils.TrimSpaceRight(stderrBuffer.String())
+	output := e.onWaitResult(ctx, result, limits, stdoutBuffer.String(), stderrBuffer.String())
+	output.WallTime = result.WallTime
+	output.CPUTime = result.CPUTime
+	output.PeakMemoryInBytes = result.PeakMemoryInBytes
+	return output, nil
+}
+
+// onWaitResult decides whether the program exceeded its limits from its measured usage. The time limit is checked
+// against CPU time, or against wall time if the sandbox could not measure it, and a program killed by the execute
+// command's timeout exceeded it too, as it ran for a multiple of the time limit.
+func (e *executeLogic) onWaitResult(
+	_ context.Context,
+	result sandbox.Result,
+	limits ExecuteLimits,
+	stdout string,
+	stderr string,
+) ExecuteOutput {
+	stdOut := utils.TrimSpaceRight(stdout)
+	stdErr := utils.TrimSpaceRight(stderr)
+
+	// A program reaching its memory limit is killed by the kernel, with an exit code that depends on the sandbox
+	memoryLimitReached := result.ExitCode != 0 && limits.MemoryLimitInBytes > 0 && result.PeakMemoryInBytes >= limits.MemoryLimitInBytes
+	timeLimitExceeded := result.CPUTime > limits.TimeLimit
+	if result.CPUTime == 0 {
+		timeLimitExceeded = result.WallTime > limits.TimeLimit
+	}
 
-	switch data.StatusCode {
-	case 0:
+	switch {
+	case memoryLimitReached || result.ExitCode == statusCodeMemoryLimitExceeded && !timeLimitExceeded:
 		output := ExecuteOutput{
-			Stdout: stdOut,
-			Stderr: stdErr,
+			ReturnCode:          result.ExitCode,
+			MemoryLimitExceeded: true,
+			Stdout:              stdOut,
+			Stderr:              stdErr,
 		}
-		e.logger.With(zap.Any("output", output)).Info("test case run successfully")
-		return output, nil
-	case statusCodeTimeLimitExceeded:
+		e.logger.With(zap.Any("output", output)).Info("memory limit exceeded")
+		return output
+	case timeLimitExceeded || result.ExitCode == statusCodeTimeLimitExceeded:
 		output := ExecuteOutput{
-			ReturnCode:        int(data.StatusCode),
+			ReturnCode:        result.ExitCode,
 			TimeLimitExceeded: true,
 			Stdout:            stdOut,
 			Stderr:            stdErr,
 		}
 		e.logger.With(zap.Any("output", output)).Info("time limit exceeded")
-		return output, nil
-	case statusCodeMemoryLimitExceeded:
+		return output
+	case result.ExitCode == 0:
 		output := ExecuteOutput{
-			ReturnCode:          int(data.StatusCode),
-			MemoryLimitExceeded: true,
-			Stdout:              stdOut,
-			Stderr:              stdErr,
+			Stdout: stdOut,
+			Stderr: stdErr,
 		}
-		e.logger.With(zap.Any("output", output)).Info("memory limit exceeded")
-		return output, nil
+		e.logger.With(zap.Any("output", output)).Info("test case run successfully")
+		return output
 	default:
 		output := ExecuteOutput{
-			ReturnCode: int(data.StatusCode),
+			ReturnCode: result.ExitCode,
 			Stdout:     stdOut,
 			Stderr:     stdErr,
 		}
 
 		e.logger.With(zap.Any("execute_output", output)).Info("test case run failed")
-		return output, nil
+		return output
 	}
 }
 
-func (e *executeLogic) onContainerWaitError(
+func (e *executeLogic) onWaitError(
 	_ context.Context,
-	containerID string,
 	err error,
 ) (ExecuteOutput, error) {
 	if errors.Is(err, context.DeadlineExceeded) {
@@ -266,33 +359,59 @@ func (e *executeLogic) onContainerWaitError(
 		return ExecuteOutput{TimeLimitExceeded: true}, nil
 	}
 
-	e.logger.With(zap.String("container_id", containerID)).With(zap.Error(err)).Error("failed to wait for container")
+	e.logger.With(zap.Error(err)).Error("failed to wait for program")
 	return ExecuteOutput{}, err
 }
 
-func (e *executeLogic) getExecuteCommand(timeout time.Duration, programFilePath string) strslice.StrSlice {
-	executeTemplate := make([]string, len(e.executeConfig.CommandTemplate))
+func (e *executeLogic) getExecuteCommand(timeout time.Duration, programFilePath string, arguments []string) []string {
+	executeTemplate := make([]string, len(e.executeConfig.CommandTemplate), len(e.executeConfig.CommandTemplate)+len(arguments))
 	for i := range e.executeConfig.CommandTemplate {
 		switch e.executeConfig.CommandTemplate[i] {
 		case executeTimeoutPlaceholder:
-			executeTemplate[i] = fmt.Sprintf("%d", int64(timeout.Seconds()))
+			executeTemplate[i] = strconv.FormatFloat(timeout.Seconds(), 'f', -1, 64)
 		case compileProgramFilePathPlaceholder:
 			executeTemplate[i] = programFilePath
 		default:
 			executeTemplate[i] = e.executeConfig.CommandTemplate[i]
 		}
 	}
-	return strslice.StrSlice(executeTemplate)
+	executeTemplate = append(executeTemplate, arguments...)
+	return executeTemplate
 }
 
-func (e *executeLogic) pullImage() error {
-	e.logger.Info("pulling image")
-	_, err := e.dockerClient.ImagePull(context.Background(), e.executeConfig.Image, image.PullOptions{})
-	if err != nil {
-		e.logger.With(zap.Error(err)).Error("failed to pull image")
-		return err
+// getExecuteLimits falls back to the limits of the execute config for any limit
+// that is not set by the caller.
+func (e *executeLogic) getExecuteLimits(limits ExecuteLimits) ExecuteLimits {
+	if limits.TimeLimit <= 0 {
+		limits.TimeLimit = e.timeoutDuration
+	}
+	if limits.MemoryLimitInBytes == 0 {
+		limits.MemoryLimitInBytes = e.memoryLimitInBytes
+	}
+
+	return limits
+}
+
+func (e *executeLogic) getContainerWaitTimeout(timeLimit time.Duration) time.Duration {
+	// The execute command times out first, unless the sandbox itself is stuck
+	if waitTimeout := (wallTimeLimitMultiplier + 1) * timeLimit; waitTimeout > minContainerWaitTimeout {
+		return waitTimeout
+	}
+
+	return minContainerWaitTimeout
+}
+
+// drainingWriter keeps accepting writes after its underlying writer has failed, so that the output of a process
+// is still drained after the process it is piped into has exited.
+type drainingWriter struct {
+	writer io.Writer
+	err    error
+}
+
+func (w *drainingWriter) Write(p []byte) (int, error) {
+	if w.err == nil {
+		_, w.err = w.writer.Write(p)
 	}
 
-	e.logger.Info("execute image pulled successfully")
-	return nil
+	return len(p), nil
 }
@@ -0,0 +1,134 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/google/uuid"
+	"github.com/maxuanquang/ojs/internal/dataaccess/database"
+	"github.com/maxuanquang/ojs/internal/generated/grpc/ojs"
+	"go.uber.org/zap"
+)
+
+var (
+	errInteractorFailed = errors.New("interactor failed")
+)
+
+type InteractInput struct {
+	Problem         database.Problem
+	Input           string
+	ProgramFilePath string
+	ExecuteLogic    ExecuteLogic // the execute logic of the program's language
+	Limits          ExecuteLimits
+}
+
+type InteractOutput struct {
+	Result ojs.SubmissionResult
+	// Credit is the fraction of the test case's points earned by the contestant, between 0 and 1.
+	Credit        float64
+	ProgramOutput ExecuteOutput
+}
+
+// InteractorLogic runs the program of a submission against the interactor of its problem.
+// Interactors follow the testlib convention: they are run as `interactor <input> <output>`, talk to the program
+// over their stdin and stdout, and report their verdict with their exit code.
+type InteractorLogic interface {
+	Interact(ctx context.Context, in InteractInput) (InteractOutput, error)
+}
+
+func NewInteractorLogic(
+	logger *zap.Logger,
+	workspaceLogic WorkspaceLogic,
+	languageToCompileLogic map[string]CompileLogic,
+	languageToExecuteLogic map[string]ExecuteLogic,
+) InteractorLogic {
+	return &interactorLogic{
+		logger:                 logger,
+		languageToExecuteLogic: languageToExecuteLogic,
+		interactorCache:        newProblemProgramCache(logger, workspaceLogic, languageToCompileLogic),
+	}
+}
+
+type interactorLogic struct {
+	logger                 *zap.Logger
+	languageToExecuteLogic map[string]ExecuteLogic
+	interactorCache        *problemProgramCache
+}
+
+// Interact implements InteractorLogic.
+func (i *interactorLogic) Interact(ctx context.Context, in InteractInput) (InteractOutput, error) {
+	logger := i.logger.With(zap.Uint64("problem_id", in.Problem.ID))
+
+	interactorExecuteLogic, ok := i.languageToExecuteLogic[in.Problem.InteractorLanguage]
+	if !ok {
+		logger.With(zap.String("interactor_language", in.Problem.InteractorLanguage)).Error("unsupported interactor language")
+		return InteractOutput{}, errInteractorFailed
+	}
+
+	interactorFilePath, releaseProgram, err := i.interactorCache.getProgramFilePath(ctx, problemProgram{
+		ProblemID:      in.Problem.ID,
+		ProblemVersion: in.Problem.Version,
+		Language:       in.Problem.InteractorLanguage,
+		Source:         in.Problem.InteractorSource,
+	})
+	if err != nil {
+		return InteractOutput{}, err
+	}
+	defer releaseProgram()
+
+	// Files are written next to the interactor program, as that is the directory mounted into its container
+	hostWorkingDir := filepath.Dir(interactorFilePath)
+	filePrefix := uuid.NewString()
+	inputFilePath := filepath.Join(hostWorkingDir, fmt.Sprintf("%s.input", filePrefix))
+	outputFilePath := filepath.Join(hostWorkingDir, fmt.Sprintf("%s.output", filePrefix))
+	if err := os.WriteFile(inputFilePath, []byte(in.Input), modeOwnerReadWriteAllRead); err != nil {
+		logger.With(zap.Error(err)).Error("failed to write interactor input file")
+		return InteractOutput{}, err
+	}
+
+	defer func() {
+		for _, filePath := range []string{inputFilePath, outputFilePath} {
+			if err := os.Remove(filePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+				logger.With(zap.String("file_path", filePath)).With(zap.Error(err)).Warn("failed to remove interactor file")
+			}
+		}
+	}()
+
+	output, err := in.ExecuteLogic.ExecuteInteractive(ctx, in.ProgramFilePath, in.Limits, Interactor{
+		ExecuteLogic:    interactorExecuteLogic,
+		ProgramFilePath: interactorFilePath,
+		Arguments:       []string{inputFilePath, outputFilePath},
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to execute interactively")
+		return InteractOutput{}, err
+	}
+
+	programOutput, interactorOutput := output.ProgramOutput, output.InteractorOutput
+	switch {
+	case programOutput.MemoryLimitExceeded:
+		return InteractOutput{Result: ojs.SubmissionResult_MemoryLimitExceeded, ProgramOutput: programOutput}, nil
+	case programOutput.TimeLimitExceeded:
+		return InteractOutput{Result: ojs.SubmissionResult_TimeLimitExceeded, ProgramOutput: programOutput}, nil
+	case interactorOutput.TimeLimitExceeded || interactorOutput.MemoryLimitExceeded:
+		logger.With(zap.Any("interactor_output", interactorOutput)).Error("interactor exceeded its limits")
+		return InteractOutput{}, errInteractorFailed
+	}
+
+	result, credit, err := getTestlibVerdict(interactorOutput)
+	if err != nil {
+		logger.With(zap.Any("interactor_output", interactorOutput)).With(zap.Error(err)).Error("interactor failed")
+		return InteractOutput{}, errInteractorFailed
+	}
+
+	// A wrong answer takes precedence over a runtime error, as the program may have been killed by a broken pipe
+	// after the interactor gave up on it
+	if result == ojs.SubmissionResult_OK && programOutput.ReturnCode != 0 {
+		return InteractOutput{Result: ojs.SubmissionResult_RuntimeError, ProgramOutput: programOutput}, nil
+	}
+
+	return InteractOutput{Result: result, Credit: credit, ProgramOutput: programOutput}, nil
+}
@@ -2,37 +2,58 @@ package logic
 
 import (
 	"context"
+	"sync"
+	"time"
 
-	"github.com/docker/docker/client"
 	"github.com/maxuanquang/ojs/internal/configs"
+	"github.com/maxuanquang/ojs/internal/dataaccess/cache"
 	"github.com/maxuanquang/ojs/internal/dataaccess/database"
 	"github.com/maxuanquang/ojs/internal/generated/grpc/ojs"
+	"github.com/maxuanquang/ojs/internal/sandbox"
 	"github.com/maxuanquang/ojs/internal/utils"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 )
 
+const (
+	// fullScoreWithoutTestCaseGroups is awarded for accepted submissions to problems without test case groups.
+	fullScoreWithoutTestCaseGroups = 100
+)
+
+type JudgeOutput struct {
+	Result        ojs.SubmissionResult
+	CompileOutput string
+	Score         uint64
+}
+
 type JudgeLogic interface {
-	Judge(ctx context.Context, submission Submission) (ojs.SubmissionResult, error)
+	Judge(ctx context.Context, submission Submission) (JudgeOutput, error)
 }
 
 func NewJudgeLogic(
 	problemDataAccessor database.ProblemDataAccessor,
 	submissionDataAccessor database.SubmissionDataAccessor,
 	testCaseDataAccessor database.TestCaseDataAccessor,
-	dockerClient *client.Client,
+	testCaseGroupDataAccessor database.TestCaseGroupDataAccessor,
+	submissionTestCaseResultDataAccessor database.SubmissionTestCaseResultDataAccessor,
+	submissionUpdatePubSub cache.SubmissionUpdatePubSub,
+	workspaceLogic WorkspaceLogic,
+	sandbox sandbox.Sandbox,
 	judgeConfig configs.Judge,
 	appArguments utils.Arguments,
 	logger *zap.Logger,
 ) (JudgeLogic, error) {
 	var languageToCompileLogic = make(map[string]CompileLogic)
 	var languageToExecuteLogic = make(map[string]ExecuteLogic)
+	var languageToLanguageConfig = make(map[string]configs.Language)
 
 	for _, config := range judgeConfig.Languages {
 		language := config.Value
+		languageToLanguageConfig[language] = config
 
 		compileLogic, err := NewCompileLogic(
 			logger,
-			dockerClient,
+			sandbox,
 			language,
 			config.Compile,
 			appArguments,
@@ -46,7 +67,7 @@ func NewJudgeLogic(
 
 		executeLogic, err := NewExecuteLogic(
 			logger,
-			dockerClient,
+			sandbox,
 			language,
 			config.Execute,
 			appArguments,
@@ -59,68 +80,434 @@ func NewJudgeLogic(
 		languageToExecuteLogic[language] = executeLogic
 	}
 
+	outputExcerptSizeInBytes, err := judgeConfig.GetOutputExcerptSizeInBytes()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get output excerpt size in bytes")
+		return nil, err
+	}
+
+	compileOutputSizeInBytes, err := judgeConfig.GetCompileOutputSizeInBytes()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get compile output size in bytes")
+		return nil, err
+	}
+
 	return &judgeLogic{
-		problemDataAccessor:    problemDataAccessor,
-		submissionDataAccessor: submissionDataAccessor,
-		testCaseDataAccessor:   testCaseDataAccessor,
-		logger:                 logger,
-		languageToCompileLogic: languageToCompileLogic,
-		languageToExecuteLogic: languageToExecuteLogic,
+		checkerLogic:                         NewCheckerLogic(logger, workspaceLogic, languageToCompileLogic, languageToExecuteLogic),
+		interactorLogic:                      NewInteractorLogic(logger, workspaceLogic, languageToCompileLogic, languageToExecuteLogic),
+		workspaceLogic:                       workspaceLogic,
+		problemDataAccessor:                  problemDataAccessor,
+		submissionDataAccessor:               submissionDataAccessor,
+		testCaseDataAccessor:                 testCaseDataAccessor,
+		testCaseGroupDataAccessor:            testCaseGroupDataAccessor,
+		submissionTestCaseResultDataAccessor: submissionTestCaseResultDataAccessor,
+		submissionUpdatePubSub:               submissionUpdatePubSub,
+		logger:                               logger,
+		languageToCompileLogic:               languageToCompileLogic,
+		languageToExecuteLogic:               languageToExecuteLogic,
+		languageToLanguageConfig:             languageToLanguageConfig,
+		outputExcerptSizeInBytes:             int(outputExcerptSizeInBytes),
+		compileOutputSizeInBytes:             int(compileOutputSizeInBytes),
+		parallelTestCases:                    judgeConfig.GetParallelTestCases(),
 	}, nil
 }
 
 type judgeLogic struct {
-	problemDataAccessor    database.ProblemDataAccessor
-	submissionDataAccessor database.SubmissionDataAccessor
-	testCaseDataAccessor   database.TestCaseDataAccessor
+	checkerLogic                         CheckerLogic
+	interactorLogic                      InteractorLogic
+	workspaceLogic                       WorkspaceLogic
+	problemDataAccessor                  database.ProblemDataAccessor
+	submissionDataAccessor               database.SubmissionDataAccessor
+	testCaseDataAccessor                 database.TestCaseDataAccessor
+	testCaseGroupDataAccessor            database.TestCaseGroupDataAccessor
+	submissionTestCaseResultDataAccessor database.SubmissionTestCaseResultDataAccessor
+	submissionUpdatePubSub               cache.SubmissionUpdatePubSub
 
-	logger                 *zap.Logger
-	languageToCompileLogic map[string]CompileLogic
-	languageToExecuteLogic map[string]ExecuteLogic
+	logger                   *zap.Logger
+	languageToCompileLogic   map[string]CompileLogic
+	languageToExecuteLogic   map[string]ExecuteLogic
+	languageToLanguageConfig map[string]configs.Language
+	outputExcerptSizeInBytes int
+	compileOutputSizeInBytes int
+	parallelTestCases        int
 }
 
 // Judge implements JudgeLogic.
-func (j *judgeLogic) Judge(ctx context.Context, submission Submission) (ojs.SubmissionResult, error) {
+func (j *judgeLogic) Judge(ctx context.Context, submission Submission) (JudgeOutput, error) {
 	compileLogic, ok := j.languageToCompileLogic[submission.Language]
 	if !ok {
 		j.logger.Error("unsupported language")
-		return ojs.SubmissionResult_UnsupportedLanguage, nil
+		return JudgeOutput{Result: ojs.SubmissionResult_UnsupportedLanguage}, nil
+	}
+
+	problem, err := j.problemDataAccessor.GetProblemByID(ctx, submission.OfProblemID)
+	if err != nil {
+		j.logger.With(zap.Error(err)).Error("failed to get problem")
+		return JudgeOutput{Result: ojs.SubmissionResult_UndefinedResult}, err
 	}
 
-	compileOutput, err := compileLogic.Compile(ctx, submission.Content)
+	// The workspace is shared by the compilation and all executions, and removed once the submission is judged
+	workspace, err := j.workspaceLogic.CreateWorkspace(ctx)
+	if err != nil {
+		return JudgeOutput{Result: ojs.SubmissionResult_UndefinedResult}, err
+	}
+
+	defer workspace.Remove(ctx)
+
+	compileOutput, err := compileLogic.Compile(ctx, workspace.GetDir(), submission.Content)
 	if err != nil {
 		j.logger.With(zap.Error(err)).Error("failed to compile submission")
-		return ojs.SubmissionResult_CompileError, nil
+		return JudgeOutput{Result: ojs.SubmissionResult_CompileError}, nil
+	}
+
+	if compileOutput.ReturnCode != 0 {
+		j.logger.With(zap.Int("return_code", compileOutput.ReturnCode)).Info("submission failed to compile")
+		return JudgeOutput{
+			Result:        ojs.SubmissionResult_CompileError,
+			CompileOutput: utils.TruncateString(compileOutput.Stdout+compileOutput.Stderr, j.compileOutputSizeInBytes),
+		}, nil
 	}
 
 	testCases, err := j.testCaseDataAccessor.GetProblemTestCaseListAll(ctx, submission.OfProblemID)
 	if err != nil {
 		j.logger.With(zap.Error(err)).Error("failed to get test cases")
-		return ojs.SubmissionResult_UndefinedResult, err
+		return JudgeOutput{Result: ojs.SubmissionResult_UndefinedResult}, err
+	}
+
+	testCaseGroups, err := j.testCaseGroupDataAccessor.GetProblemTestCaseGroupList(ctx, submission.OfProblemID)
+	if err != nil {
+		j.logger.With(zap.Error(err)).Error("failed to get test case groups")
+		return JudgeOutput{Result: ojs.SubmissionResult_UndefinedResult}, err
 	}
 
 	executeLogic, ok := j.languageToExecuteLogic[submission.Language]
 	if !ok {
 		j.logger.Error("unsupported language")
-		return ojs.SubmissionResult_UnsupportedLanguage, nil
+		return JudgeOutput{Result: ojs.SubmissionResult_UnsupportedLanguage}, nil
+	}
+
+	executeLimits := j.getExecuteLimits(problem, j.languageToLanguageConfig[submission.Language])
+	outputComparator := NewOutputComparator(ojs.OutputComparisonMode(problem.ComparisonMode), problem.FloatEpsilon)
+
+	// Results of a previous run of this submission are discarded
+	err = j.submissionTestCaseResultDataAccessor.DeleteSubmissionTestCaseResultList(ctx, submission.ID)
+	if err != nil {
+		j.logger.With(zap.Error(err)).Error("failed to delete previous submission test case results")
+		return JudgeOutput{Result: ojs.SubmissionResult_UndefinedResult}, err
+	}
+
+	// Without groups no partial score can be earned, so there is no point in running the test cases after a failed one
+	judgedTestCases, err := j.judgeTestCases(
+		ctx, submission.ID, problem, executeLogic, compileOutput.ProgramFilePath, executeLimits, outputComparator,
+		testCases, len(testCaseGroups) > 0,
+	)
+	if err != nil {
+		return JudgeOutput{Result: ojs.SubmissionResult_UndefinedResult}, err
 	}
 
+	// The submission's result is the result of its first failed test case
+	result := ojs.SubmissionResult_OK
+	testCaseResults := make(map[uint64]ojs.SubmissionResult)
+	testCaseCredits := make(map[uint64]float64)
+	for i, judgedTestCase := range judgedTestCases {
+		if !judgedTestCase.judged {
+			continue
+		}
+
+		testCase := testCases[i]
+		testCaseResults[testCase.ID] = judgedTestCase.result
+		testCaseCredits[testCase.ID] = judgedTestCase.credit
+		j.createSubmissionTestCaseResult(ctx, submission.ID, testCase.ID, judgedTestCase.result, judgedTestCase.output)
+
+		if judgedTestCase.result != ojs.SubmissionResult_OK && result == ojs.SubmissionResult_OK {
+			result = judgedTestCase.result
+		}
+	}
+
+	if result != ojs.SubmissionResult_OK && len(testCaseGroups) == 0 {
+		return JudgeOutput{Result: result}, nil
+	}
+
+	if result == ojs.SubmissionResult_OK {
+		j.logger.Info("submission passed all test cases")
+	}
+
+	return JudgeOutput{
+		Result: result,
+		Score:  getScore(testCases, testCaseGroups, testCaseResults, testCaseCredits),
+	}, nil
+}
+
+// getScore sums up the points earned in each test case group according to the group's scoring policy.
+// Test cases that are not in any group do not earn points. Problems without groups are all-or-nothing.
+// Proportional groups also award the partial credit given to a test case by the problem's checker.
+func getScore(
+	testCases []database.TestCase,
+	testCaseGroups []database.TestCaseGroup,
+	testCaseResults map[uint64]ojs.SubmissionResult,
+	testCaseCredits map[uint64]float64,
+) uint64 {
+	if len(testCaseGroups) == 0 {
+		for _, testCase := range testCases {
+			if testCaseResults[testCase.ID] != ojs.SubmissionResult_OK {
+				return 0
+			}
+		}
+
+		return fullScoreWithoutTestCaseGroups
+	}
+
+	testCaseCounts := make(map[uint64]uint64)
+	passedTestCaseCounts := make(map[uint64]uint64)
+	credits := make(map[uint64]float64)
 	for _, testCase := range testCases {
-		output, err := executeLogic.Execute(ctx, compileOutput.ProgramFilePath, testCase.Input)
-		if err != nil {
-			return ojs.SubmissionResult_RuntimeError, nil
+		testCaseCounts[testCase.OfTestCaseGroupID]++
+		credits[testCase.OfTestCaseGroupID] += testCaseCredits[testCase.ID]
+		if testCaseResults[testCase.ID] == ojs.SubmissionResult_OK {
+			passedTestCaseCounts[testCase.OfTestCaseGroupID]++
 		}
-		if output.MemoryLimitExceeded {
-			return ojs.SubmissionResult_MemoryLimitExceeded, nil
+	}
+
+	var score uint64
+	for _, testCaseGroup := range testCaseGroups {
+		testCaseCount, passedTestCaseCount := testCaseCounts[testCaseGroup.ID], passedTestCaseCounts[testCaseGroup.ID]
+		if testCaseCount == 0 {
+			continue
 		}
-		if output.TimeLimitExceeded {
-			return ojs.SubmissionResult_TimeLimitExceeded, nil
+
+		switch ojs.TestCaseGroupScoringPolicy(testCaseGroup.ScoringPolicy) {
+		case ojs.TestCaseGroupScoringPolicy_Proportional:
+			score += uint64(float64(testCaseGroup.Points) * credits[testCaseGroup.ID] / float64(testCaseCount))
+		default:
+			if passedTestCaseCount == testCaseCount {
+				score += testCaseGroup.Points
+			}
 		}
-		if output.Stdout != testCase.Output {
-			return ojs.SubmissionResult_WrongAnswer, nil
+	}
+
+	return score
+}
+
+// getExecuteLimits scales the problem's limits by the multipliers of the submission's language,
+// so that slower languages are given a fair amount of extra time and memory.
+func (j *judgeLogic) getExecuteLimits(problem database.Problem, languageConfig configs.Language) ExecuteLimits {
+	return ExecuteLimits{
+		TimeLimit:          time.Duration(float64(problem.TimeLimit) * languageConfig.GetTimeLimitMultiplier()),
+		MemoryLimitInBytes: uint64(float64(problem.MemoryLimit) * languageConfig.GetMemoryLimitMultiplier()),
+	}
+}
+
+// judgedTestCase is the outcome of running the program on a test case, test cases that were skipped are not judged.
+type judgedTestCase struct {
+	judged bool
+	output ExecuteOutput
+	result ojs.SubmissionResult
+	credit float64
+}
+
+// judgeTestCases runs the program on up to parallelTestCases test cases at a time. Unless all results are required,
+// the test cases after a failed one are skipped or cancelled, while the ones before it still run to completion,
+// so that the first failed test case by index is the same no matter in which order test cases finish.
+func (j *judgeLogic) judgeTestCases(
+	ctx context.Context,
+	submissionID uint64,
+	problem database.Problem,
+	executeLogic ExecuteLogic,
+	programFilePath string,
+	executeLimits ExecuteLimits,
+	outputComparator OutputComparator,
+	testCases []database.TestCase,
+	requireAllResults bool,
+) ([]judgedTestCase, error) {
+	judgedTestCases := make([]judgedTestCase, len(testCases))
+
+	// mutex guards the variables below
+	var mutex sync.Mutex
+	firstFailedIndex := len(testCases)
+	judgedTestCaseCount := uint64(0)
+	cancelFuncs := make([]context.CancelFunc, len(testCases))
+	isSkipped := func(i int) bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return i > firstFailedIndex
+	}
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(j.parallelTestCases)
+	for i, testCase := range testCases {
+		if isSkipped(i) {
+			break
 		}
+
+		testCaseCtx, cancelFunc := context.WithCancel(groupCtx)
+		mutex.Lock()
+		cancelFuncs[i] = cancelFunc
+		mutex.Unlock()
+
+		group.Go(func() error {
+			defer cancelFunc()
+			if isSkipped(i) {
+				return nil
+			}
+
+			output, testCaseResult, testCaseCredit, err := j.judgeTestCase(
+				testCaseCtx, problem, executeLogic, programFilePath, executeLimits, outputComparator, testCase,
+			)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			// The test case was cancelled by the failure of a test case before it
+			if i > firstFailedIndex {
+				return nil
+			}
+
+			if err != nil {
+				j.logger.With(zap.Uint64("test_case_id", testCase.ID)).With(zap.Error(err)).Error("failed to judge test case")
+				return err
+			}
+
+			judgedTestCases[i] = judgedTestCase{
+				judged: true,
+				output: output,
+				result: testCaseResult,
+				credit: testCaseCredit,
+			}
+			judgedTestCaseCount++
+			j.publishTestCaseProgress(ctx, submissionID, judgedTestCaseCount, uint64(len(testCases)))
+
+			if testCaseResult != ojs.SubmissionResult_OK && !requireAllResults && i < firstFailedIndex {
+				firstFailedIndex = i
+				for _, cancelFunc := range cancelFuncs[i+1:] {
+					if cancelFunc != nil {
+						cancelFunc()
+					}
+				}
+			}
+
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Test cases after the first failed one may have finished before it failed
+	for i := firstFailedIndex + 1; i < len(judgedTestCases); i++ {
+		judgedTestCases[i] = judgedTestCase{}
 	}
 
-	j.logger.Info("submission passed all test cases")
-	return ojs.SubmissionResult_OK, nil
+	return judgedTestCases, nil
+}
+
+// judgeTestCase runs the program on a test case, returning its output, its result and the fraction of the
+// test case's points it earned. Interactive problems are judged by their interactor instead of the test case's output.
+func (j *judgeLogic) judgeTestCase(
+	ctx context.Context,
+	problem database.Problem,
+	executeLogic ExecuteLogic,
+	programFilePath string,
+	executeLimits ExecuteLimits,
+	outputComparator OutputComparator,
+	testCase database.TestCase,
+) (ExecuteOutput, ojs.SubmissionResult, float64, error) {
+	if problem.InteractorSource != "" {
+		interactOutput, err := j.interactorLogic.Interact(ctx, InteractInput{
+			Problem:         problem,
+			Input:           testCase.Input,
+			ProgramFilePath: programFilePath,
+			ExecuteLogic:    executeLogic,
+			Limits:          executeLimits,
+		})
+		if err != nil {
+			return ExecuteOutput{}, ojs.SubmissionResult_UndefinedResult, 0, err
+		}
+
+		return interactOutput.ProgramOutput, interactOutput.Result, interactOutput.Credit, nil
+	}
+
+	output, err := executeLogic.Execute(ctx, programFilePath, testCase.Input, executeLimits)
+	if err != nil {
+		j.logger.With(zap.Uint64("test_case_id", testCase.ID)).With(zap.Error(err)).Error("failed to execute test case")
+	}
+
+	testCaseResult, testCaseCredit, err := j.getTestCaseResult(ctx, problem, outputComparator, testCase, output, err)
+	return output, testCaseResult, testCaseCredit, err
+}
+
+// getTestCaseResult returns the result of a test case and the fraction of its points earned by the submission.
+// Outputs are compared by the problem's comparison mode, unless the problem has a custom checker.
+func (j *judgeLogic) getTestCaseResult(
+	ctx context.Context,
+	problem database.Problem,
+	outputComparator OutputComparator,
+	testCase database.TestCase,
+	output ExecuteOutput,
+	executeErr error,
+) (ojs.SubmissionResult, float64, error) {
+	switch {
+	case executeErr != nil:
+		return ojs.SubmissionResult_RuntimeError, 0, nil
+	case output.MemoryLimitExceeded:
+		return ojs.SubmissionResult_MemoryLimitExceeded, 0, nil
+	case output.TimeLimitExceeded:
+		return ojs.SubmissionResult_TimeLimitExceeded, 0, nil
+	case output.ReturnCode != 0:
+		return ojs.SubmissionResult_RuntimeError, 0, nil
+	case problem.CheckerSource != "":
+		checkOutput, err := j.checkerLogic.Check(ctx, CheckInput{
+			Problem:          problem,
+			Input:            testCase.Input,
+			ExpectedOutput:   testCase.Output,
+			ContestantOutput: output.Stdout,
+		})
+		if err != nil {
+			return ojs.SubmissionResult_UndefinedResult, 0, err
+		}
+
+		return checkOutput.Result, checkOutput.Credit, nil
+	case !outputComparator.Compare(testCase.Output, output.Stdout):
+		return ojs.SubmissionResult_WrongAnswer, 0, nil
+	default:
+		return ojs.SubmissionResult_OK, 1, nil
+	}
+}
+
+func (j *judgeLogic) createSubmissionTestCaseResult(
+	ctx context.Context,
+	submissionID uint64,
+	testCaseID uint64,
+	testCaseResult ojs.SubmissionResult,
+	output ExecuteOutput,
+) {
+	_, err := j.submissionTestCaseResultDataAccessor.CreateSubmissionTestCaseResult(ctx, database.SubmissionTestCaseResult{
+		OfSubmissionID: submissionID,
+		OfTestCaseID:   testCaseID,
+		Result:         int8(testCaseResult),
+		WallTime:       uint64(output.WallTime),
+		CPUTime:        uint64(output.CPUTime),
+		Memory:         output.PeakMemoryInBytes,
+		ExitCode:       int32(output.ReturnCode),
+		Stdout:         utils.TruncateString(output.Stdout, j.outputExcerptSizeInBytes),
+		Stderr:         utils.TruncateString(output.Stderr, j.outputExcerptSizeInBytes),
+	})
+	if err != nil {
+		j.logger.
+			With(zap.Uint64("submission_id", submissionID)).
+			With(zap.Uint64("test_case_id", testCaseID)).
+			With(zap.Error(err)).
+			Error("failed to create submission test case result")
+	}
+}
+
+func (j *judgeLogic) publishTestCaseProgress(ctx context.Context, submissionID, judgedTestCaseCount, testCaseCount uint64) {
+	err := j.submissionUpdatePubSub.Publish(ctx, submissionID, cache.SubmissionUpdate{
+		Status:              int8(ojs.SubmissionStatus_Executing),
+		JudgedTestCaseCount: judgedTestCaseCount,
+		TestCaseCount:       testCaseCount,
+	})
+	if err != nil {
+		j.logger.With(zap.Uint64("submission_id", submissionID)).With(zap.Error(err)).Error("failed to publish test case progress")
+	}
 }
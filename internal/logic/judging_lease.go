@@ -0,0 +1,17 @@
+package logic
+
+import "context"
+
+type onJudgingLeaseAcquiredContextKey struct{}
+
+// WithOnJudgingLeaseAcquired makes ExecuteSubmission call onJudgingLeaseAcquired once it holds the judging lease of
+// the submission, from when the submission is submitted again by ResetExpiredJudgingLeases if its worker crashes.
+func WithOnJudgingLeaseAcquired(ctx context.Context, onJudgingLeaseAcquired func()) context.Context {
+	return context.WithValue(ctx, onJudgingLeaseAcquiredContextKey{}, onJudgingLeaseAcquired)
+}
+
+func notifyJudgingLeaseAcquired(ctx context.Context) {
+	if onJudgingLeaseAcquired, ok := ctx.Value(onJudgingLeaseAcquiredContextKey{}).(func()); ok {
+		onJudgingLeaseAcquired()
+	}
+}
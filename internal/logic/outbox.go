@@ -0,0 +1,182 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/maxuanquang/ojs/internal/dataaccess/database"
+	"github.com/maxuanquang/ojs/internal/dataaccess/mq/producer"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	relayOutboxMessagesBatchSize = 100
+)
+
+// OutboxLogic publishes the messages written to the outbox. A message may be published more than once, such as when
+// it is published right after being written while the relay picks it up too, so consumers have to be idempotent.
+type OutboxLogic interface {
+	// PublishOutboxMessage publishes a message right after the transaction writing it has been committed. The message
+	// is left to the relay if it fails to be published.
+	PublishOutboxMessage(ctx context.Context, outboxMessage database.OutboxMessage)
+	// RelayOutboxMessages publishes all pending messages, in the order they were written.
+	RelayOutboxMessages(ctx context.Context) error
+	DeleteSentOutboxMessages(ctx context.Context, retention time.Duration) error
+}
+
+func NewOutboxLogic(
+	outboxMessageDataAccessor database.OutboxMessageDataAccessor,
+	producerClient producer.Client,
+	database database.Database,
+	logger *zap.Logger,
+) OutboxLogic {
+	return &outboxLogic{
+		outboxMessageDataAccessor: outboxMessageDataAccessor,
+		producerClient:            producerClient,
+		database:                  database,
+		logger:                    logger,
+	}
+}
+
+type outboxLogic struct {
+	outboxMessageDataAccessor database.OutboxMessageDataAccessor
+	producerClient            producer.Client
+	database                  database.Database
+	logger                    *zap.Logger
+}
+
+// PublishOutboxMessage implements OutboxLogic.
+func (o *outboxLogic) PublishOutboxMessage(ctx context.Context, outboxMessage database.OutboxMessage) {
+	logger := o.logger.With(zap.Uint64("outbox_message_id", outboxMessage.ID)).With(zap.String("topic", outboxMessage.Topic))
+
+	err := o.producerClient.Produce(ctx, outboxMessage.Topic, outboxMessage.Payload)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to publish outbox message, leaving it to the relay")
+		return
+	}
+
+	err = o.outboxMessageDataAccessor.MarkOutboxMessageSent(ctx, outboxMessage.ID)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to mark outbox message sent, it will be published again by the relay")
+	}
+}
+
+// RelayOutboxMessages implements OutboxLogic.
+func (o *outboxLogic) RelayOutboxMessages(ctx context.Context) error {
+	for {
+		var (
+			pendingOutboxMessageCount int
+			relayedOutboxMessageCount int
+			publishErr                error
+		)
+
+		// Pending messages stay locked while they are published, so that concurrent relays skip them
+		txErr := o.database.Transaction(func(tx *gorm.DB) error {
+			outboxMessageDataAccessor := o.outboxMessageDataAccessor.WithDatabaseTransaction(tx)
+			outboxMessages, err := outboxMessageDataAccessor.GetPendingOutboxMessageList(ctx, relayOutboxMessagesBatchSize)
+			if err != nil {
+				return err
+			}
+
+			pendingOutboxMessageCount = len(outboxMessages)
+			for _, outboxMessage := range outboxMessages {
+				// Messages are published in order, so the batch stops at the first one failing to be published
+				publishErr = o.producerClient.Produce(ctx, outboxMessage.Topic, outboxMessage.Payload)
+				if publishErr != nil {
+					return nil
+				}
+
+				err = outboxMessageDataAccessor.MarkOutboxMessageSent(ctx, outboxMessage.ID)
+				if err != nil {
+					return err
+				}
+
+				relayedOutboxMessageCount++
+			}
+
+			return nil
+		})
+		if txErr != nil {
+			o.logger.With(zap.Error(txErr)).Error("relay outbox messages transaction failed")
+			return txErr
+		}
+
+		if relayedOutboxMessageCount > 0 {
+			o.logger.With(zap.Int("relayed_outbox_message_count", relayedOutboxMessageCount)).Info("outbox messages relayed")
+		}
+
+		if publishErr != nil {
+			o.logger.With(zap.Error(publishErr)).Error("failed to publish outbox message")
+			return publishErr
+		}
+
+		if pendingOutboxMessageCount < relayOutboxMessagesBatchSize {
+			return nil
+		}
+	}
+}
+
+// DeleteSentOutboxMessages implements OutboxLogic.
+func (o *outboxLogic) DeleteSentOutboxMessages(ctx context.Context, retention time.Duration) error {
+	err := o.outboxMessageDataAccessor.DeleteSentOutboxMessageList(ctx, retention)
+	if err != nil {
+		o.logger.With(zap.Error(err)).Error("failed to delete sent outbox messages")
+		return err
+	}
+
+	return nil
+}
+
+// getSubmissionCreatedQueueName returns the priority lane a new submission is submitted to.
+func getSubmissionCreatedQueueName(ofContestID uint64) string {
+	if ofContestID != 0 {
+		return producer.MessageQueueSubmissionCreatedContest
+	}
+
+	return producer.MessageQueueSubmissionCreated
+}
+
+// createSubmissionCreatedOutboxMessage writes a submission created message to the outbox, in the priority lane the
+// submission was last submitted to, the data accessor being bound to the transaction that creates or resets the
+// submission.
+func createSubmissionCreatedOutboxMessage(
+	ctx context.Context,
+	outboxMessageDataAccessor database.OutboxMessageDataAccessor,
+	submission database.Submission,
+) (database.OutboxMessage, error) {
+	queueName := submission.JudgingQueue
+	// Submissions created before their lane was recorded are submitted to the lane of new submissions
+	if queueName == "" {
+		queueName = getSubmissionCreatedQueueName(submission.OfContestID)
+	}
+
+	return createSubmissionOutboxMessage(ctx, outboxMessageDataAccessor, queueName, submission.ID)
+}
+
+// createSubmissionRejudgedOutboxMessage writes a submission created message to the lowest priority lane,
+// the one of rejudged submissions.
+func createSubmissionRejudgedOutboxMessage(
+	ctx context.Context,
+	outboxMessageDataAccessor database.OutboxMessageDataAccessor,
+	submissionID uint64,
+) (database.OutboxMessage, error) {
+	return createSubmissionOutboxMessage(ctx, outboxMessageDataAccessor, producer.MessageQueueSubmissionRejudged, submissionID)
+}
+
+func createSubmissionOutboxMessage(
+	ctx context.Context,
+	outboxMessageDataAccessor database.OutboxMessageDataAccessor,
+	queueName string,
+	submissionID uint64,
+) (database.OutboxMessage, error) {
+	payload, err := producer.NewSubmissionCreatedPayload(submissionID)
+	if err != nil {
+		return database.OutboxMessage{}, err
+	}
+
+	return outboxMessageDataAccessor.CreateOutboxMessage(ctx, database.OutboxMessage{
+		Topic:   queueName,
+		Payload: payload,
+	})
+}
@@ -0,0 +1,139 @@
+package logic
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/maxuanquang/ojs/internal/generated/grpc/ojs"
+)
+
+// OutputComparator decides whether the output of a submission matches the expected output of a test case.
+type OutputComparator interface {
+	Compare(expectedOutput, actualOutput string) bool
+}
+
+// NewOutputComparator returns the comparator of a comparison mode. Outputs are compared exactly if the mode is undefined.
+func NewOutputComparator(comparisonMode ojs.OutputComparisonMode, floatEpsilon float64) OutputComparator {
+	switch comparisonMode {
+	case ojs.OutputComparisonMode_IgnoreTrailingWhitespace:
+		return ignoreTrailingWhitespaceOutputComparator{}
+	case ojs.OutputComparisonMode_Token:
+		return tokenOutputComparator{}
+	case ojs.OutputComparisonMode_CaseInsensitive:
+		return tokenOutputComparator{caseInsensitive: true}
+	case ojs.OutputComparisonMode_Float:
+		return floatOutputComparator{epsilon: floatEpsilon}
+	default:
+		return exactOutputComparator{}
+	}
+}
+
+type exactOutputComparator struct{}
+
+// Compare implements OutputComparator.
+func (exactOutputComparator) Compare(expectedOutput, actualOutput string) bool {
+	return expectedOutput == actualOutput
+}
+
+// ignoreTrailingWhitespaceOutputComparator compares outputs line by line, ignoring whitespace at the end of
+// each line and empty lines at the end of the output.
+type ignoreTrailingWhitespaceOutputComparator struct{}
+
+// Compare implements OutputComparator.
+func (c ignoreTrailingWhitespaceOutputComparator) Compare(expectedOutput, actualOutput string) bool {
+	expectedLines, actualLines := c.getLines(expectedOutput), c.getLines(actualOutput)
+	if len(expectedLines) != len(actualLines) {
+		return false
+	}
+
+	for i := range expectedLines {
+		if expectedLines[i] != actualLines[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (ignoreTrailingWhitespaceOutputComparator) getLines(output string) []string {
+	lines := strings.Split(strings.ReplaceAll(output, "\r\n", "\n"), "\n")
+	for i := range lines {
+		lines[i] = strings.TrimRight(lines[i], " \t\r")
+	}
+
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+// tokenOutputComparator compares outputs as sequences of whitespace separated tokens.
+type tokenOutputComparator struct {
+	caseInsensitive bool
+}
+
+// Compare implements OutputComparator.
+func (c tokenOutputComparator) Compare(expectedOutput, actualOutput string) bool {
+	expectedTokens, actualTokens := strings.Fields(expectedOutput), strings.Fields(actualOutput)
+	if len(expectedTokens) != len(actualTokens) {
+		return false
+	}
+
+	for i := range expectedTokens {
+		if c.caseInsensitive && !strings.EqualFold(expectedTokens[i], actualTokens[i]) {
+			return false
+		}
+		if !c.caseInsensitive && expectedTokens[i] != actualTokens[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// floatOutputComparator compares outputs token by token, accepting numbers whose absolute or relative error
+// is within epsilon. Tokens that are not numbers must match exactly.
+type floatOutputComparator struct {
+	epsilon float64
+}
+
+// Compare implements OutputComparator.
+func (c floatOutputComparator) Compare(expectedOutput, actualOutput string) bool {
+	expectedTokens, actualTokens := strings.Fields(expectedOutput), strings.Fields(actualOutput)
+	if len(expectedTokens) != len(actualTokens) {
+		return false
+	}
+
+	for i := range expectedTokens {
+		if expectedTokens[i] == actualTokens[i] {
+			continue
+		}
+
+		expectedNumber, err := strconv.ParseFloat(expectedTokens[i], 64)
+		if err != nil {
+			return false
+		}
+
+		actualNumber, err := strconv.ParseFloat(actualTokens[i], 64)
+		if err != nil || math.IsNaN(actualNumber) {
+			return false
+		}
+
+		if !c.isWithinEpsilon(expectedNumber, actualNumber) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c floatOutputComparator) isWithinEpsilon(expectedNumber, actualNumber float64) bool {
+	absoluteError := math.Abs(expectedNumber - actualNumber)
+	if absoluteError <= c.epsilon {
+		return true
+	}
+
+	return absoluteError <= c.epsilon*math.Abs(expectedNumber)
+}
@@ -2,7 +2,9 @@ package logic
 
 import (
 	"context"
+	"errors"
 
+	"github.com/maxuanquang/ojs/internal/configs"
 	"github.com/maxuanquang/ojs/internal/dataaccess/database"
 	"github.com/maxuanquang/ojs/internal/generated/grpc/ojs"
 	"github.com/mikespook/gorbac"
@@ -15,6 +17,8 @@ type ProblemLogic interface {
 	GetProblemList(ctx context.Context, in GetProblemListInput) (GetProblemListOutput, error)
 	UpdateProblem(ctx context.Context, in UpdateProblemInput) (UpdateProblemOutput, error)
 	DeleteProblem(ctx context.Context, in DeleteProblemInput) error
+	UpdateProblemChecker(ctx context.Context, in UpdateProblemCheckerInput) (UpdateProblemCheckerOutput, error)
+	UpdateProblemInteractor(ctx context.Context, in UpdateProblemInteractorInput) (UpdateProblemInteractorOutput, error)
 }
 
 func NewProblemLogic(
@@ -25,7 +29,13 @@ func NewProblemLogic(
 	testCaseDataAccessor database.TestCaseDataAccessor,
 	tokenLogic TokenLogic,
 	roleLogic RoleLogic,
+	judgeConfig configs.Judge,
 ) ProblemLogic {
+	supportedLanguages := make(map[string]struct{})
+	for _, language := range judgeConfig.Languages {
+		supportedLanguages[language.Value] = struct{}{}
+	}
+
 	return &problemLogic{
 		logger:                 logger,
 		accountDataAccessor:    accountDataAccessor,
@@ -34,6 +44,7 @@ func NewProblemLogic(
 		testCaseDataAccessor:   testCaseDataAccessor,
 		tokenLogic:             tokenLogic,
 		roleLogic:              roleLogic,
+		supportedLanguages:     supportedLanguages,
 	}
 }
 
@@ -45,6 +56,7 @@ type problemLogic struct {
 	testCaseDataAccessor   database.TestCaseDataAccessor
 	roleLogic              RoleLogic
 	tokenLogic             TokenLogic
+	supportedLanguages     map[string]struct{}
 }
 
 func (p *problemLogic) CreateProblem(ctx context.Context, in CreateProblemInput) (CreateProblemOutput, error) {
@@ -66,12 +78,19 @@ func (p *problemLogic) CreateProblem(ctx context.Context, in CreateProblemInput)
 		return CreateProblemOutput{}, ErrPermissionDenied
 	}
 
+	comparisonMode := in.ComparisonMode
+	if comparisonMode == ojs.OutputComparisonMode_UndefinedComparisonMode {
+		comparisonMode = ojs.OutputComparisonMode_Exact
+	}
+
 	createdProblem, err := p.problemDataAccessor.CreateProblem(ctx, database.Problem{
-		DisplayName: in.DisplayName,
-		Description: in.Description,
-		TimeLimit:   in.TimeLimit,
-		MemoryLimit: in.MemoryLimit,
-		AuthorID:    requestingAccountID,
+		DisplayName:    in.DisplayName,
+		Description:    in.Description,
+		TimeLimit:      in.TimeLimit,
+		MemoryLimit:    in.MemoryLimit,
+		AuthorID:       requestingAccountID,
+		ComparisonMode: int8(comparisonMode),
+		FloatEpsilon:   in.FloatEpsilon,
 	})
 	if err != nil {
 		logger.Error("failed to create problem", zap.Error(err))
@@ -227,10 +246,12 @@ func (p *problemLogic) UpdateProblem(ctx context.Context, in UpdateProblemInput)
 
 	// Update the problem in the database
 	updatedProblem, err := p.problemDataAccessor.UpdateProblem(ctx, in.ID, database.Problem{
-		DisplayName: in.DisplayName,
-		Description: in.Description,
-		TimeLimit:   in.TimeLimit,
-		MemoryLimit: in.MemoryLimit,
+		DisplayName:    in.DisplayName,
+		Description:    in.Description,
+		TimeLimit:      in.TimeLimit,
+		MemoryLimit:    in.MemoryLimit,
+		ComparisonMode: int8(in.ComparisonMode),
+		FloatEpsilon:   in.FloatEpsilon,
 	})
 	if err != nil {
 		logger.Error("failed to update problem", zap.Error(err))
@@ -284,24 +305,150 @@ func (p *problemLogic) DeleteProblem(ctx context.Context, in DeleteProblemInput)
 	return nil
 }
 
+// UpdateProblemChecker implements ProblemLogic.
+func (p *problemLogic) UpdateProblemChecker(ctx context.Context, in UpdateProblemCheckerInput) (UpdateProblemCheckerOutput, error) {
+	logger := p.logger.With(zap.Uint64("problem_id", in.ID))
+
+	requestingAccountID, _, requestingAccountRole, _, err := p.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return UpdateProblemCheckerOutput{}, ErrTokenInvalid
+	}
+
+	problem, err := p.problemDataAccessor.GetProblemByID(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrProblemNotFound) {
+			return UpdateProblemCheckerOutput{}, ErrProblemNotFound
+		}
+
+		logger.With(zap.Error(err)).Error("failed to get problem")
+		return UpdateProblemCheckerOutput{}, ErrInternal
+	}
+
+	requiredPermissions := []gorbac.Permission{PermissionProblemsWriteAll}
+	if requestingAccountID == problem.AuthorID {
+		requiredPermissions = append(requiredPermissions, PermissionProblemsWriteSelf)
+	}
+
+	hasPermission, err := p.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(requestingAccountRole)], requiredPermissions...)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to check permission")
+		return UpdateProblemCheckerOutput{}, ErrInternal
+	}
+	if !hasPermission {
+		return UpdateProblemCheckerOutput{}, ErrPermissionDenied
+	}
+
+	// An empty source removes the checker, so that outputs are compared exactly again
+	checkerLanguage := in.Language
+	if in.Source == "" {
+		checkerLanguage = ""
+	} else if _, ok := p.supportedLanguages[checkerLanguage]; !ok {
+		return UpdateProblemCheckerOutput{}, ErrCheckerLanguageUnsupported
+	}
+
+	author, err := p.accountDataAccessor.GetAccountByID(ctx, problem.AuthorID)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get author")
+		return UpdateProblemCheckerOutput{}, ErrInternal
+	}
+
+	updatedProblem, err := p.problemDataAccessor.UpdateProblemChecker(ctx, in.ID, checkerLanguage, in.Source)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update problem checker")
+		return UpdateProblemCheckerOutput{}, ErrInternal
+	}
+
+	return UpdateProblemCheckerOutput{
+		Problem: p.dbProblemToLogicProblem(updatedProblem, author.Name),
+	}, nil
+}
+
+// UpdateProblemInteractor implements ProblemLogic.
+func (p *problemLogic) UpdateProblemInteractor(ctx context.Context, in UpdateProblemInteractorInput) (UpdateProblemInteractorOutput, error) {
+	logger := p.logger.With(zap.Uint64("problem_id", in.ID))
+
+	requestingAccountID, _, requestingAccountRole, _, err := p.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return UpdateProblemInteractorOutput{}, ErrTokenInvalid
+	}
+
+	problem, err := p.problemDataAccessor.GetProblemByID(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrProblemNotFound) {
+			return UpdateProblemInteractorOutput{}, ErrProblemNotFound
+		}
+
+		logger.With(zap.Error(err)).Error("failed to get problem")
+		return UpdateProblemInteractorOutput{}, ErrInternal
+	}
+
+	requiredPermissions := []gorbac.Permission{PermissionProblemsWriteAll}
+	if requestingAccountID == problem.AuthorID {
+		requiredPermissions = append(requiredPermissions, PermissionProblemsWriteSelf)
+	}
+
+	hasPermission, err := p.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(requestingAccountRole)], requiredPermissions...)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to check permission")
+		return UpdateProblemInteractorOutput{}, ErrInternal
+	}
+	if !hasPermission {
+		return UpdateProblemInteractorOutput{}, ErrPermissionDenied
+	}
+
+	// An empty source removes the interactor, so that the problem is judged with its test case outputs again
+	interactorLanguage := in.Language
+	if in.Source == "" {
+		interactorLanguage = ""
+	} else if _, ok := p.supportedLanguages[interactorLanguage]; !ok {
+		return UpdateProblemInteractorOutput{}, ErrInteractorLanguageUnsupported
+	}
+
+	author, err := p.accountDataAccessor.GetAccountByID(ctx, problem.AuthorID)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get author")
+		return UpdateProblemInteractorOutput{}, ErrInternal
+	}
+
+	updatedProblem, err := p.problemDataAccessor.UpdateProblemInteractor(ctx, in.ID, interactorLanguage, in.Source)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update problem interactor")
+		return UpdateProblemInteractorOutput{}, ErrInternal
+	}
+
+	return UpdateProblemInteractorOutput{
+		Problem: p.dbProblemToLogicProblem(updatedProblem, author.Name),
+	}, nil
+}
+
 func (p *problemLogic) dbProblemToLogicProblem(dbProblem database.Problem, accountName string) Problem {
 	return Problem{
-		ID:          dbProblem.ID,
-		DisplayName: dbProblem.DisplayName,
-		AuthorId:    dbProblem.AuthorID,
-		AuthorName:  accountName,
-		Description: dbProblem.Description,
-		TimeLimit:   dbProblem.TimeLimit,
-		MemoryLimit: dbProblem.MemoryLimit,
+		ID:                 dbProblem.ID,
+		DisplayName:        dbProblem.DisplayName,
+		AuthorId:           dbProblem.AuthorID,
+		AuthorName:         accountName,
+		Description:        dbProblem.Description,
+		TimeLimit:          dbProblem.TimeLimit,
+		MemoryLimit:        dbProblem.MemoryLimit,
+		HasChecker:         dbProblem.CheckerSource != "",
+		CheckerLanguage:    dbProblem.CheckerLanguage,
+		ComparisonMode:     ojs.OutputComparisonMode(dbProblem.ComparisonMode),
+		FloatEpsilon:       dbProblem.FloatEpsilon,
+		HasInteractor:      dbProblem.InteractorSource != "",
+		InteractorLanguage: dbProblem.InteractorLanguage,
 	}
 }
 
 type CreateProblemInput struct {
-	Token       string
-	DisplayName string
-	Description string
-	TimeLimit   uint64
-	MemoryLimit uint64
+	Token          string
+	DisplayName    string
+	Description    string
+	TimeLimit      uint64
+	MemoryLimit    uint64
+	ComparisonMode ojs.OutputComparisonMode
+	FloatEpsilon   float64
 }
 
 type CreateProblemOutput struct {
@@ -309,13 +456,19 @@ type CreateProblemOutput struct {
 }
 
 type Problem struct {
-	ID          uint64
-	DisplayName string
-	AuthorId    uint64
-	AuthorName  string
-	Description string
-	TimeLimit   uint64
-	MemoryLimit uint64
+	ID                 uint64
+	DisplayName        string
+	AuthorId           uint64
+	AuthorName         string
+	Description        string
+	TimeLimit          uint64
+	MemoryLimit        uint64
+	HasChecker         bool // the checker source itself is never returned
+	CheckerLanguage    string
+	ComparisonMode     ojs.OutputComparisonMode
+	FloatEpsilon       float64
+	HasInteractor      bool
+	InteractorLanguage string
 }
 
 type GetProblemListInput struct {
@@ -339,12 +492,14 @@ type GetProblemOutput struct {
 }
 
 type UpdateProblemInput struct {
-	Token       string
-	ID          uint64
-	DisplayName string
-	Description string
-	TimeLimit   uint64
-	MemoryLimit uint64
+	Token          string
+	ID             uint64
+	DisplayName    string
+	Description    string
+	TimeLimit      uint64
+	MemoryLimit    uint64
+	ComparisonMode ojs.OutputComparisonMode
+	FloatEpsilon   float64
 }
 
 type UpdateProblemOutput struct {
@@ -355,3 +510,25 @@ type DeleteProblemInput struct {
 	Token string
 	ID    uint64
 }
+
+type UpdateProblemCheckerInput struct {
+	Token    string
+	ID       uint64
+	Language string
+	Source   string
+}
+
+type UpdateProblemCheckerOutput struct {
+	Problem Problem
+}
+
+type UpdateProblemInteractorInput struct {
+	Token    string
+	ID       uint64
+	Language string
+	Source   string
+}
+
+type UpdateProblemInteractorOutput struct {
+	Problem Problem
+}
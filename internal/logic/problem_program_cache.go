@@ -0,0 +1,155 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+var (
+	errProblemProgramCompileFailed = errors.New("problem program failed to compile")
+)
+
+// problemProgram is a program supplied by a problem, such as its checker or its interactor.
+type problemProgram struct {
+	ProblemID      uint64
+	ProblemVersion uint64
+	Language       string
+	Source         string
+}
+
+// compiledProblemProgram is a problem program compiled from a given version of its problem,
+// into a workspace of its own. Its workspace is only removed once it was replaced by a newer version and is not
+// used by any judge anymore, as judges of the older version may still be running it.
+type compiledProblemProgram struct {
+	problemVersion  uint64
+	workspace       Workspace
+	programFilePath string
+	userCount       int
+	isReplaced      bool
+}
+
+// problemProgramCache compiles problem programs, keeping the latest compiled version of each problem's program
+// so that it is not compiled again for every test case.
+type problemProgramCache struct {
+	logger                 *zap.Logger
+	workspaceLogic         WorkspaceLogic
+	languageToCompileLogic map[string]CompileLogic
+
+	// mutex guards the maps below, compiling a program only holds the compile mutex of its problem
+	mutex                      sync.Mutex
+	problemIDToCompiledProgram map[uint64]*compiledProblemProgram
+	problemIDToCompileMutex    map[uint64]*sync.Mutex
+}
+
+func newProblemProgramCache(
+	logger *zap.Logger,
+	workspaceLogic WorkspaceLogic,
+	languageToCompileLogic map[string]CompileLogic,
+) *problemProgramCache {
+	return &problemProgramCache{
+		logger:                     logger,
+		workspaceLogic:             workspaceLogic,
+		languageToCompileLogic:     languageToCompileLogic,
+		problemIDToCompiledProgram: make(map[uint64]*compiledProblemProgram),
+		problemIDToCompileMutex:    make(map[uint64]*sync.Mutex),
+	}
+}
+
+// getProgramFilePath returns the compiled program, compiling it if the cached one is missing, was compiled
+// from an older version of the problem, or was purged from the host along with stale workspaces. The returned
+// release function must be called once the program is not used anymore.
+func (c *problemProgramCache) getProgramFilePath(ctx context.Context, program problemProgram) (string, func(), error) {
+	compileMutex := c.getCompileMutex(program.ProblemID)
+	compileMutex.Lock()
+	defer compileMutex.Unlock()
+
+	c.mutex.Lock()
+	cachedProgram, isCached := c.problemIDToCompiledProgram[program.ProblemID]
+	c.mutex.Unlock()
+	if isCached && cachedProgram.problemVersion == program.ProblemVersion {
+		if _, err := os.Stat(cachedProgram.programFilePath); err == nil {
+			return cachedProgram.programFilePath, c.useProgram(ctx, cachedProgram), nil
+		}
+	}
+
+	logger := c.logger.With(zap.Uint64("problem_id", program.ProblemID)).With(zap.Uint64("problem_version", program.ProblemVersion))
+
+	compileLogic, ok := c.languageToCompileLogic[program.Language]
+	if !ok {
+		logger.With(zap.String("language", program.Language)).Error("unsupported problem program language")
+		return "", nil, errProblemProgramCompileFailed
+	}
+
+	workspace, err := c.workspaceLogic.CreateWorkspace(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+
+	compileOutput, err := compileLogic.Compile(ctx, workspace.GetDir(), program.Source)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to compile problem program")
+		workspace.Remove(ctx)
+		return "", nil, err
+	}
+
+	if compileOutput.ReturnCode != 0 {
+		logger.With(zap.Any("compile_output", compileOutput)).Error("problem program failed to compile")
+		workspace.Remove(ctx)
+		return "", nil, errProblemProgramCompileFailed
+	}
+
+	compiledProgram := &compiledProblemProgram{
+		problemVersion:  program.ProblemVersion,
+		workspace:       workspace,
+		programFilePath: compileOutput.ProgramFilePath,
+	}
+
+	c.mutex.Lock()
+	c.problemIDToCompiledProgram[program.ProblemID] = compiledProgram
+	if isCached {
+		cachedProgram.isReplaced = true
+		if cachedProgram.userCount == 0 {
+			cachedProgram.workspace.Remove(ctx)
+		}
+	}
+	c.mutex.Unlock()
+
+	return compiledProgram.programFilePath, c.useProgram(ctx, compiledProgram), nil
+}
+
+// useProgram counts a new user of the compiled program, and returns the function releasing it.
+func (c *problemProgramCache) useProgram(ctx context.Context, compiledProgram *compiledProblemProgram) func() {
+	c.mutex.Lock()
+	compiledProgram.userCount++
+	c.mutex.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			c.mutex.Lock()
+			defer c.mutex.Unlock()
+
+			compiledProgram.userCount--
+			if compiledProgram.isReplaced && compiledProgram.userCount == 0 {
+				compiledProgram.workspace.Remove(ctx)
+			}
+		})
+	}
+}
+
+func (c *problemProgramCache) getCompileMutex(problemID uint64) *sync.Mutex {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	compileMutex, ok := c.problemIDToCompileMutex[problemID]
+	if !ok {
+		compileMutex = new(sync.Mutex)
+		c.problemIDToCompileMutex[problemID] = compileMutex
+	}
+
+	return compileMutex
+}
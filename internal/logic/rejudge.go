@@ -0,0 +1,469 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maxuanquang/ojs/internal/dataaccess/cache"
+	"github.com/maxuanquang/ojs/internal/dataaccess/database"
+	"github.com/maxuanquang/ojs/internal/dataaccess/mq/producer"
+	"github.com/maxuanquang/ojs/internal/generated/grpc/ojs"
+	"github.com/mikespook/gorbac"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// RejudgeLogic judges again submissions that have already been judged, such as after a test case has been fixed.
+// Rejudged submissions are submitted again at a lower priority than new submissions, and their previous results
+// are kept so that the verdicts changed by a rejudge can be reported.
+type RejudgeLogic interface {
+	RejudgeSubmission(ctx context.Context, in RejudgeSubmissionInput) (RejudgeSubmissionOutput, error)
+	// RejudgeProblem rejudges all judged submissions of the problem, submissions not judged yet are left as they are.
+	RejudgeProblem(ctx context.Context, in RejudgeProblemInput) (RejudgeProblemOutput, error)
+	// RejudgeContest rejudges all judged submissions made during the contest.
+	RejudgeContest(ctx context.Context, in RejudgeContestInput) (RejudgeContestOutput, error)
+	GetRejudgeReport(ctx context.Context, in GetRejudgeReportInput) (GetRejudgeReportOutput, error)
+}
+
+func NewRejudgeLogic(
+	rejudgeDataAccessor database.RejudgeDataAccessor,
+	submissionJudgeHistoryDataAccessor database.SubmissionJudgeHistoryDataAccessor,
+	submissionDataAccessor database.SubmissionDataAccessor,
+	problemDataAccessor database.ProblemDataAccessor,
+	contestDataAccessor database.ContestDataAccessor,
+	outboxMessageDataAccessor database.OutboxMessageDataAccessor,
+	outboxLogic OutboxLogic,
+	tokenLogic TokenLogic,
+	roleLogic RoleLogic,
+	submissionUpdatePubSub cache.SubmissionUpdatePubSub,
+	database database.Database,
+	logger *zap.Logger,
+) RejudgeLogic {
+	return &rejudgeLogic{
+		rejudgeDataAccessor:                rejudgeDataAccessor,
+		submissionJudgeHistoryDataAccessor: submissionJudgeHistoryDataAccessor,
+		submissionDataAccessor:             submissionDataAccessor,
+		problemDataAccessor:                problemDataAccessor,
+		contestDataAccessor:                contestDataAccessor,
+		outboxMessageDataAccessor:          outboxMessageDataAccessor,
+		outboxLogic:                        outboxLogic,
+		tokenLogic:                         tokenLogic,
+		roleLogic:                          roleLogic,
+		submissionUpdatePubSub:             submissionUpdatePubSub,
+		database:                           database,
+		logger:                             logger,
+	}
+}
+
+type rejudgeLogic struct {
+	rejudgeDataAccessor                database.RejudgeDataAccessor
+	submissionJudgeHistoryDataAccessor database.SubmissionJudgeHistoryDataAccessor
+	submissionDataAccessor             database.SubmissionDataAccessor
+	problemDataAccessor                database.ProblemDataAccessor
+	contestDataAccessor                database.ContestDataAccessor
+	outboxMessageDataAccessor          database.OutboxMessageDataAccessor
+	outboxLogic                        OutboxLogic
+	tokenLogic                         TokenLogic
+	roleLogic                          RoleLogic
+	submissionUpdatePubSub             cache.SubmissionUpdatePubSub
+	database                           database.Database
+	logger                             *zap.Logger
+}
+
+// RejudgeSubmission implements RejudgeLogic.
+func (r *rejudgeLogic) RejudgeSubmission(ctx context.Context, in RejudgeSubmissionInput) (RejudgeSubmissionOutput, error) {
+	logger := r.logger.With(zap.Uint64("submission_id", in.ID))
+
+	accountID, _, accountRole, _, err := r.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return RejudgeSubmissionOutput{}, ErrTokenInvalid
+	}
+
+	submission, err := r.submissionDataAccessor.GetSubmissionByID(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrSubmissionNotFound) {
+			return RejudgeSubmissionOutput{}, ErrSubmissionNotFound
+		}
+
+		logger.With(zap.Error(err)).Error("failed to get submission")
+		return RejudgeSubmissionOutput{}, ErrInternal
+	}
+
+	err = r.checkProblemRejudgePermission(ctx, submission.OfProblemID, accountID, accountRole)
+	if err != nil {
+		return RejudgeSubmissionOutput{}, err
+	}
+
+	rejudge, err := r.rejudgeSubmissionList(ctx, accountID, func(submissionDataAccessor database.SubmissionDataAccessor) ([]database.Submission, error) {
+		submission, err := submissionDataAccessor.GetSubmissionByID(ctx, in.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if submission.Status != int8(ojs.SubmissionStatus_Finished) {
+			return nil, ErrSubmissionNotFinished
+		}
+
+		return []database.Submission{submission}, nil
+	})
+	if err != nil {
+		return RejudgeSubmissionOutput{}, err
+	}
+
+	return RejudgeSubmissionOutput{
+		Rejudge: rejudge,
+	}, nil
+}
+
+// RejudgeProblem implements RejudgeLogic.
+func (r *rejudgeLogic) RejudgeProblem(ctx context.Context, in RejudgeProblemInput) (RejudgeProblemOutput, error) {
+	logger := r.logger.With(zap.Uint64("problem_id", in.ProblemID))
+
+	accountID, _, accountRole, _, err := r.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return RejudgeProblemOutput{}, ErrTokenInvalid
+	}
+
+	err = r.checkProblemRejudgePermission(ctx, in.ProblemID, accountID, accountRole)
+	if err != nil {
+		return RejudgeProblemOutput{}, err
+	}
+
+	rejudge, err := r.rejudgeSubmissionList(ctx, accountID, func(submissionDataAccessor database.SubmissionDataAccessor) ([]database.Submission, error) {
+		return submissionDataAccessor.GetProblemSubmissionListByStatus(ctx, in.ProblemID, int8(ojs.SubmissionStatus_Finished))
+	})
+	if err != nil {
+		return RejudgeProblemOutput{}, err
+	}
+
+	return RejudgeProblemOutput{
+		Rejudge: rejudge,
+	}, nil
+}
+
+// RejudgeContest implements RejudgeLogic.
+func (r *rejudgeLogic) RejudgeContest(ctx context.Context, in RejudgeContestInput) (RejudgeContestOutput, error) {
+	logger := r.logger.With(zap.Uint64("contest_id", in.ContestID))
+
+	accountID, _, accountRole, _, err := r.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return RejudgeContestOutput{}, ErrTokenInvalid
+	}
+
+	contest, err := r.contestDataAccessor.GetContestByID(ctx, in.ContestID)
+	if err != nil {
+		if errors.Is(err, database.ErrContestNotFound) {
+			return RejudgeContestOutput{}, ErrContestNotFound
+		}
+
+		logger.With(zap.Error(err)).Error("failed to get contest")
+		return RejudgeContestOutput{}, ErrInternal
+	}
+
+	requiredPermissions := []gorbac.Permission{PermissionContestsWriteAll}
+	if contest.AuthorID == accountID {
+		requiredPermissions = append(requiredPermissions, PermissionContestsWriteSelf)
+	}
+
+	hasPermission, err := r.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(accountRole)], requiredPermissions...)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to check permission")
+		return RejudgeContestOutput{}, ErrInternal
+	}
+	if !hasPermission {
+		return RejudgeContestOutput{}, ErrPermissionDenied
+	}
+
+	rejudge, err := r.rejudgeSubmissionList(ctx, accountID, func(submissionDataAccessor database.SubmissionDataAccessor) ([]database.Submission, error) {
+		return submissionDataAccessor.GetContestSubmissionListByStatus(ctx, in.ContestID, int8(ojs.SubmissionStatus_Finished))
+	})
+	if err != nil {
+		return RejudgeContestOutput{}, err
+	}
+
+	return RejudgeContestOutput{
+		Rejudge: rejudge,
+	}, nil
+}
+
+// GetRejudgeReport implements RejudgeLogic.
+func (r *rejudgeLogic) GetRejudgeReport(ctx context.Context, in GetRejudgeReportInput) (GetRejudgeReportOutput, error) {
+	logger := r.logger.With(zap.Uint64("rejudge_id", in.ID))
+
+	accountID, _, accountRole, _, err := r.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return GetRejudgeReportOutput{}, ErrTokenInvalid
+	}
+
+	rejudge, err := r.rejudgeDataAccessor.GetRejudgeByID(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrRejudgeNotFound) {
+			return GetRejudgeReportOutput{}, ErrRejudgeNotFound
+		}
+
+		logger.With(zap.Error(err)).Error("failed to get rejudge")
+		return GetRejudgeReportOutput{}, ErrInternal
+	}
+
+	// Rejudges are visible to their author, and to the accounts allowed to rejudge any problem
+	if rejudge.AuthorID != accountID {
+		hasPermission, err := r.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(accountRole)], PermissionProblemsWriteAll)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to check permission")
+			return GetRejudgeReportOutput{}, ErrInternal
+		}
+		if !hasPermission {
+			return GetRejudgeReportOutput{}, ErrPermissionDenied
+		}
+	}
+
+	submissionJudgeHistories, err := r.submissionJudgeHistoryDataAccessor.GetRejudgeSubmissionJudgeHistoryList(ctx, rejudge.ID)
+	if err != nil {
+		return GetRejudgeReportOutput{}, ErrInternal
+	}
+
+	submissionIDs := make([]uint64, 0, len(submissionJudgeHistories))
+	for _, submissionJudgeHistory := range submissionJudgeHistories {
+		submissionIDs = append(submissionIDs, submissionJudgeHistory.OfSubmissionID)
+	}
+
+	submissions, err := r.submissionDataAccessor.GetSubmissionListByIDList(ctx, submissionIDs)
+	if err != nil {
+		return GetRejudgeReportOutput{}, ErrInternal
+	}
+
+	submissionIDToSubmissionMap := make(map[uint64]database.Submission, len(submissions))
+	for _, submission := range submissions {
+		submissionIDToSubmissionMap[submission.ID] = submission
+	}
+
+	output := GetRejudgeReportOutput{
+		Rejudge: r.dbRejudgeToLogicRejudge(rejudge, uint64(len(submissionJudgeHistories))),
+	}
+	for _, submissionJudgeHistory := range submissionJudgeHistories {
+		// The submission may have been deleted since its history was read
+		submission, ok := submissionIDToSubmissionMap[submissionJudgeHistory.OfSubmissionID]
+		if !ok {
+			continue
+		}
+
+		rejudgedSubmission := RejudgedSubmission{
+			SubmissionID:   submission.ID,
+			PreviousResult: ojs.SubmissionResult(submissionJudgeHistory.Result),
+			PreviousScore:  submissionJudgeHistory.Score,
+			Status:         ojs.SubmissionStatus(submission.Status),
+			Result:         ojs.SubmissionResult(submission.Result),
+			Score:          submission.Score,
+		}
+		if rejudgedSubmission.Status != ojs.SubmissionStatus_Finished {
+			output.PendingSubmissionCount++
+		} else if rejudgedSubmission.Result != rejudgedSubmission.PreviousResult ||
+			rejudgedSubmission.Score != rejudgedSubmission.PreviousScore {
+			rejudgedSubmission.IsChanged = true
+			output.ChangedSubmissionCount++
+		}
+
+		output.RejudgedSubmissions = append(output.RejudgedSubmissions, rejudgedSubmission)
+	}
+
+	return output, nil
+}
+
+// checkProblemRejudgePermission allows the accounts that can update any problem, and the author of the problem
+// if it can update its own problems.
+func (r *rejudgeLogic) checkProblemRejudgePermission(ctx context.Context, problemID, accountID uint64, accountRole int8) error {
+	logger := r.logger.With(zap.Uint64("problem_id", problemID))
+
+	problem, err := r.problemDataAccessor.GetProblemByID(ctx, problemID)
+	if err != nil {
+		if errors.Is(err, database.ErrProblemNotFound) {
+			return ErrProblemNotFound
+		}
+
+		logger.With(zap.Error(err)).Error("failed to get problem")
+		return ErrInternal
+	}
+
+	requiredPermissions := []gorbac.Permission{PermissionProblemsWriteAll}
+	if problem.AuthorID == accountID {
+		requiredPermissions = append(requiredPermissions, PermissionProblemsWriteSelf)
+	}
+
+	hasPermission, err := r.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(accountRole)], requiredPermissions...)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to check permission")
+		return ErrInternal
+	}
+	if !hasPermission {
+		return ErrPermissionDenied
+	}
+
+	return nil
+}
+
+// rejudgeSubmissionList creates a rejudge of the judged submissions returned by getSubmissionList, which is called
+// within the rejudge's transaction. The previous results of the submissions are recorded before they are reset
+// and submitted again.
+func (r *rejudgeLogic) rejudgeSubmissionList(
+	ctx context.Context,
+	accountID uint64,
+	getSubmissionList func(submissionDataAccessor database.SubmissionDataAccessor) ([]database.Submission, error),
+) (Rejudge, error) {
+	var (
+		rejudge                  database.Rejudge
+		rejudgedSubmissionIDs    []uint64
+		outboxMessages           []database.OutboxMessage
+		submissionJudgeHistories []database.SubmissionJudgeHistory
+	)
+	txErr := r.database.Transaction(func(tx *gorm.DB) error {
+		submissionDataAccessor := r.submissionDataAccessor.WithDatabaseTransaction(tx)
+		outboxMessageDataAccessor := r.outboxMessageDataAccessor.WithDatabaseTransaction(tx)
+
+		submissions, err := getSubmissionList(submissionDataAccessor)
+		if err != nil {
+			return err
+		}
+
+		rejudge, err = r.rejudgeDataAccessor.WithDatabaseTransaction(tx).CreateRejudge(ctx, database.Rejudge{
+			AuthorID: accountID,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, submission := range submissions {
+			err = submissionDataAccessor.ResetSubmissionResult(
+				ctx, submission.ID, int8(ojs.SubmissionStatus_Finished), int8(ojs.SubmissionStatus_Submitted),
+			)
+			if err != nil {
+				// The submission is being rejudged by a concurrent rejudge
+				if errors.Is(err, database.ErrSubmissionStatusNotMatched) {
+					continue
+				}
+
+				return err
+			}
+
+			err = submissionDataAccessor.UpdateSubmissionJudgingQueue(ctx, submission.ID, producer.MessageQueueSubmissionRejudged)
+			if err != nil {
+				return err
+			}
+
+			outboxMessage, err := createSubmissionRejudgedOutboxMessage(ctx, outboxMessageDataAccessor, submission.ID)
+			if err != nil {
+				return err
+			}
+
+			rejudgedSubmissionIDs = append(rejudgedSubmissionIDs, submission.ID)
+			outboxMessages = append(outboxMessages, outboxMessage)
+			submissionJudgeHistories = append(submissionJudgeHistories, database.SubmissionJudgeHistory{
+				OfRejudgeID:    rejudge.ID,
+				OfSubmissionID: submission.ID,
+				Result:         submission.Result,
+				Score:          submission.Score,
+			})
+		}
+
+		return r.submissionJudgeHistoryDataAccessor.WithDatabaseTransaction(tx).CreateSubmissionJudgeHistoryList(ctx, submissionJudgeHistories)
+	})
+	if txErr != nil {
+		if errors.Is(txErr, database.ErrSubmissionNotFound) {
+			return Rejudge{}, ErrSubmissionNotFound
+		}
+		if errors.Is(txErr, ErrSubmissionNotFinished) {
+			return Rejudge{}, ErrSubmissionNotFinished
+		}
+
+		r.logger.With(zap.Error(txErr)).Error("rejudge transaction failed")
+		return Rejudge{}, ErrInternal
+	}
+
+	for _, submissionID := range rejudgedSubmissionIDs {
+		err := r.submissionUpdatePubSub.Publish(ctx, submissionID, cache.SubmissionUpdate{
+			Status: int8(ojs.SubmissionStatus_Submitted),
+		})
+		if err != nil {
+			r.logger.With(zap.Uint64("submission_id", submissionID)).With(zap.Error(err)).Error("failed to publish submission update")
+		}
+	}
+
+	for _, outboxMessage := range outboxMessages {
+		r.outboxLogic.PublishOutboxMessage(ctx, outboxMessage)
+	}
+
+	r.logger.With(zap.Uint64("rejudge_id", rejudge.ID)).With(zap.Int("submission_count", len(rejudgedSubmissionIDs))).
+		Info("submissions rejudged")
+	return r.dbRejudgeToLogicRejudge(rejudge, uint64(len(rejudgedSubmissionIDs))), nil
+}
+
+func (r *rejudgeLogic) dbRejudgeToLogicRejudge(rejudge database.Rejudge, submissionCount uint64) Rejudge {
+	return Rejudge{
+		ID:              rejudge.ID,
+		AuthorID:        rejudge.AuthorID,
+		CreatedAt:       rejudge.CreatedAt,
+		SubmissionCount: submissionCount,
+	}
+}
+
+type Rejudge struct {
+	ID              uint64
+	AuthorID        uint64
+	CreatedAt       time.Time
+	SubmissionCount uint64
+}
+
+// RejudgedSubmission compares the result of a submission before a rejudge with its current result.
+type RejudgedSubmission struct {
+	SubmissionID   uint64
+	PreviousResult ojs.SubmissionResult
+	PreviousScore  uint64
+	Status         ojs.SubmissionStatus
+	Result         ojs.SubmissionResult
+	Score          uint64
+	// IsChanged is true if the submission has been judged again, with a different result or score.
+	IsChanged bool
+}
+
+type RejudgeSubmissionInput struct {
+	Token string
+	ID    uint64
+}
+
+type RejudgeSubmissionOutput struct {
+	Rejudge Rejudge
+}
+
+type RejudgeProblemInput struct {
+	Token     string
+	ProblemID uint64
+}
+
+type RejudgeProblemOutput struct {
+	Rejudge Rejudge
+}
+
+type RejudgeContestInput struct {
+	Token     string
+	ContestID uint64
+}
+
+type RejudgeContestOutput struct {
+	Rejudge Rejudge
+}
+
+type GetRejudgeReportInput struct {
+	Token string
+	ID    uint64
+}
+
+type GetRejudgeReportOutput struct {
+	Rejudge                Rejudge
+	RejudgedSubmissions    []RejudgedSubmission
+	PendingSubmissionCount uint64
+	ChangedSubmissionCount uint64
+}
@@ -34,6 +34,18 @@ var (
 	PermissionProblemsWriteSelf = gorbac.NewLayerPermission("problems:write:self")
 	PermissionProblemsReadAll   = gorbac.NewLayerPermission("problems:read")
 	PermissionProblemsWriteAll  = gorbac.NewLayerPermission("problems:write")
+
+	PermissionContestsReadSelf  = gorbac.NewLayerPermission("contests:read:self")
+	PermissionContestsWriteSelf = gorbac.NewLayerPermission("contests:write:self")
+	PermissionContestsReadAll   = gorbac.NewLayerPermission("contests:read")
+	PermissionContestsWriteAll  = gorbac.NewLayerPermission("contests:write")
+
+	PermissionContestParticipantsWriteSelf = gorbac.NewLayerPermission("contestparticipants:write:self")
+
+	PermissionCompileOutputsReadAll = gorbac.NewLayerPermission("compileoutputs:read")
+
+	PermissionDeadLettersReadAll  = gorbac.NewLayerPermission("deadletters:read")
+	PermissionDeadLettersWriteAll = gorbac.NewLayerPermission("deadletters:write")
 )
 
 type RoleLogic interface {
@@ -52,6 +64,12 @@ func NewRoleLogic(logger *zap.Logger) RoleLogic {
 	roleAdmin.Assign(PermissionTestCasesWriteAll)
 	roleAdmin.Assign(PermissionSubmissionsReadAll)
 	roleAdmin.Assign(PermissionSubmissionsWriteAll)
+	roleAdmin.Assign(PermissionContestsReadAll)
+	roleAdmin.Assign(PermissionContestsWriteAll)
+	roleAdmin.Assign(PermissionContestParticipantsWriteSelf)
+	roleAdmin.Assign(PermissionCompileOutputsReadAll)
+	roleAdmin.Assign(PermissionDeadLettersReadAll)
+	roleAdmin.Assign(PermissionDeadLettersWriteAll)
 
 	roleProblemSetter := gorbac.NewStdRole(AccountRoleProblemSetter)
 	roleProblemSetter.Assign(PermissionAccountsReadAll)
@@ -62,6 +80,9 @@ func NewRoleLogic(logger *zap.Logger) RoleLogic {
 	roleProblemSetter.Assign(PermissionTestCasesWriteSelf)
 	roleProblemSetter.Assign(PermissionSubmissionsReadAll)
 	roleProblemSetter.Assign(PermissionSubmissionsWriteSelf)
+	roleProblemSetter.Assign(PermissionContestsReadSelf)
+	roleProblemSetter.Assign(PermissionContestsWriteSelf)
+	roleProblemSetter.Assign(PermissionContestParticipantsWriteSelf)
 
 	roleContester := gorbac.NewStdRole(AccountRoleContester)
 	roleContester.Assign(PermissionAccountsReadSelf)
@@ -69,6 +90,7 @@ func NewRoleLogic(logger *zap.Logger) RoleLogic {
 	roleContester.Assign(PermissionProblemsReadAll)
 	roleContester.Assign(PermissionSubmissionsReadAll)
 	roleContester.Assign(PermissionSubmissionsWriteSelf)
+	roleContester.Assign(PermissionContestParticipantsWriteSelf)
 
 	roleWorker := gorbac.NewStdRole(AccountRoleWorker)
 	roleWorker.Assign(PermissionProblemsReadAll)
@@ -0,0 +1,354 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/maxuanquang/ojs/internal/dataaccess/cache"
+	"github.com/maxuanquang/ojs/internal/dataaccess/database"
+	"github.com/maxuanquang/ojs/internal/generated/grpc/ojs"
+	"go.uber.org/zap"
+)
+
+const (
+	icpcPenaltyMinutesPerWrongAttempt = 20
+	scoreboardFreezeDuration          = time.Hour
+)
+
+type ScoreboardLogic interface {
+	GetScoreboard(ctx context.Context, in GetScoreboardInput) (GetScoreboardOutput, error)
+	// UpdateScoreboard records a finished contest submission into the cached scoreboard of its contest.
+	UpdateScoreboard(ctx context.Context, submission Submission) error
+}
+
+func NewScoreboardLogic(
+	logger *zap.Logger,
+	accountDataAccessor database.AccountDataAccessor,
+	submissionDataAccessor database.SubmissionDataAccessor,
+	contestParticipantDataAccessor database.ContestParticipantDataAccessor,
+	contestScoreboard cache.ContestScoreboard,
+) ScoreboardLogic {
+	return &scoreboardLogic{
+		logger:                         logger,
+		accountDataAccessor:            accountDataAccessor,
+		submissionDataAccessor:         submissionDataAccessor,
+		contestParticipantDataAccessor: contestParticipantDataAccessor,
+		contestScoreboard:              contestScoreboard,
+	}
+}
+
+type scoreboardLogic struct {
+	logger                         *zap.Logger
+	accountDataAccessor            database.AccountDataAccessor
+	submissionDataAccessor         database.SubmissionDataAccessor
+	contestParticipantDataAccessor database.ContestParticipantDataAccessor
+	contestScoreboard              cache.ContestScoreboard
+}
+
+// scoreboardAttempt is a judged submission as seen by the scoreboard, cached on its own so that attempts recorded
+// concurrently do not overwrite each other.
+type scoreboardAttempt struct {
+	SubmissionID uint64    `json:"submission_id"`
+	AccountID    uint64    `json:"account_id"`
+	ProblemID    uint64    `json:"problem_id"`
+	SubmittedAt  time.Time `json:"submitted_at"`
+	Result       int8      `json:"result"`
+	Score        uint64    `json:"score"`
+}
+
+// scoreboardState is the attempts of a contest keyed by account ID, problem ID and submission ID, so that
+// recording a submission twice is harmless and rankings (live or frozen) can be derived from it when reading.
+type scoreboardState struct {
+	Attempts map[uint64]map[uint64]map[uint64]scoreboardAttempt
+}
+
+func (s *scoreboardState) addAttempt(attempt scoreboardAttempt) {
+	accountID, problemID := attempt.AccountID, attempt.ProblemID
+	if s.Attempts == nil {
+		s.Attempts = make(map[uint64]map[uint64]map[uint64]scoreboardAttempt)
+	}
+	if s.Attempts[accountID] == nil {
+		s.Attempts[accountID] = make(map[uint64]map[uint64]scoreboardAttempt)
+	}
+	if s.Attempts[accountID][problemID] == nil {
+		s.Attempts[accountID][problemID] = make(map[uint64]scoreboardAttempt)
+	}
+
+	s.Attempts[accountID][problemID][attempt.SubmissionID] = attempt
+}
+
+// GetScoreboard implements ScoreboardLogic.
+func (s *scoreboardLogic) GetScoreboard(ctx context.Context, in GetScoreboardInput) (GetScoreboardOutput, error) {
+	logger := s.logger.With(zap.Uint64("contest_id", in.Contest.ID))
+
+	state, err := s.getScoreboardState(ctx, in.Contest.ID)
+	if err != nil {
+		return GetScoreboardOutput{}, err
+	}
+
+	participants, err := s.contestParticipantDataAccessor.GetContestParticipantList(ctx, in.Contest.ID)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get contest participant list")
+		return GetScoreboardOutput{}, ErrInternal
+	}
+
+	var rows []ScoreboardRow
+	for _, participant := range participants {
+		account, err := s.accountDataAccessor.GetAccountByID(ctx, participant.OfAccountID)
+		if err != nil {
+			logger.With(zap.Uint64("account_id", participant.OfAccountID)).With(zap.Error(err)).Error("failed to get account")
+			return GetScoreboardOutput{}, ErrInternal
+		}
+
+		row := ScoreboardRow{
+			AccountID:   account.ID,
+			AccountName: account.Name,
+		}
+		for _, contestProblem := range in.Contest.Problems {
+			cell := s.getScoreboardCell(in.Contest, contestProblem, state.Attempts[account.ID][contestProblem.ProblemID], in.FreezeTime)
+			if cell.IsSolved {
+				row.SolvedCount++
+				row.Penalty += cell.SolvedAtMinute + cell.WrongAttemptCount*icpcPenaltyMinutesPerWrongAttempt
+			}
+			row.TotalScore += cell.Score
+			row.Cells = append(row.Cells, cell)
+		}
+
+		rows = append(rows, row)
+	}
+
+	s.rankScoreboardRows(in.Contest.ScoringMode, rows)
+
+	return GetScoreboardOutput{
+		Scoreboard: Scoreboard{
+			ScoringMode: in.Contest.ScoringMode,
+			IsFrozen:    !in.FreezeTime.IsZero(),
+			Rows:        rows,
+		},
+	}, nil
+}
+
+// UpdateScoreboard implements ScoreboardLogic.
+func (s *scoreboardLogic) UpdateScoreboard(ctx context.Context, submission Submission) error {
+	if submission.OfContestID == 0 {
+		return nil
+	}
+
+	logger := s.logger.With(zap.Uint64("contest_id", submission.OfContestID)).With(zap.Uint64("submission_id", submission.ID))
+
+	value, err := json.Marshal(s.submissionToScoreboardAttempt(submission))
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to marshal scoreboard attempt")
+		return ErrInternal
+	}
+
+	// If the cached scoreboard was lost, it is only marked complete again once rebuilt from the database
+	err = s.contestScoreboard.SetSubmission(ctx, submission.OfContestID, submission.ID, value)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to set scoreboard attempt in cache")
+		return ErrInternal
+	}
+
+	return nil
+}
+
+func (s *scoreboardLogic) getScoreboardState(ctx context.Context, contestID uint64) (scoreboardState, error) {
+	logger := s.logger.With(zap.Uint64("contest_id", contestID))
+
+	cachedAttempts, err := s.contestScoreboard.Get(ctx, contestID)
+	if err != nil {
+		if !errors.Is(err, cache.ErrCacheMissed) {
+			logger.With(zap.Error(err)).Error("failed to get cached scoreboard")
+		}
+
+		return s.rebuildScoreboardState(ctx, contestID)
+	}
+
+	var state scoreboardState
+	for _, cachedAttempt := range cachedAttempts {
+		var attempt scoreboardAttempt
+		if err = json.Unmarshal(cachedAttempt, &attempt); err != nil {
+			logger.With(zap.Error(err)).Warn("failed to unmarshal cached scoreboard attempt, rebuilding scoreboard")
+			return s.rebuildScoreboardState(ctx, contestID)
+		}
+
+		state.addAttempt(attempt)
+	}
+
+	return state, nil
+}
+
+func (s *scoreboardLogic) rebuildScoreboardState(ctx context.Context, contestID uint64) (scoreboardState, error) {
+	logger := s.logger.With(zap.Uint64("contest_id", contestID))
+
+	submissions, err := s.submissionDataAccessor.GetContestSubmissionListByStatus(ctx, contestID, int8(ojs.SubmissionStatus_Finished))
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get contest submission list")
+		return scoreboardState{}, ErrInternal
+	}
+
+	var state scoreboardState
+	cachedAttempts := make(map[uint64][]byte, len(submissions))
+	for _, submission := range submissions {
+		attempt := s.submissionToScoreboardAttempt(Submission{
+			ID:          submission.ID,
+			AuthorID:    submission.AuthorID,
+			OfProblemID: submission.OfProblemID,
+			Result:      ojs.SubmissionResult(submission.Result),
+			Score:       submission.Score,
+			CreatedAt:   submission.CreatedAt,
+		})
+		state.addAttempt(attempt)
+
+		cachedAttempts[submission.ID], err = json.Marshal(attempt)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to marshal scoreboard attempt")
+			return scoreboardState{}, ErrInternal
+		}
+	}
+
+	// Attempts of other submissions recorded meanwhile are kept, as each submission is cached on its own
+	err = s.contestScoreboard.Set(ctx, contestID, cachedAttempts)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to set cached scoreboard")
+	}
+
+	return state, nil
+}
+
+func (s *scoreboardLogic) submissionToScoreboardAttempt(submission Submission) scoreboardAttempt {
+	return scoreboardAttempt{
+		SubmissionID: submission.ID,
+		AccountID:    submission.AuthorID,
+		ProblemID:    submission.OfProblemID,
+		SubmittedAt:  submission.CreatedAt,
+		Result:       int8(submission.Result),
+		Score:        submission.Score,
+	}
+}
+
+// getScoreboardCell summarizes the attempts of an account on a problem. Attempts submitted at or after
+// freezeTime are ignored when it is set.
+func (s *scoreboardLogic) getScoreboardCell(
+	contest Contest,
+	contestProblem ContestProblem,
+	attempts map[uint64]scoreboardAttempt,
+	freezeTime time.Time,
+) ScoreboardCell {
+	cell := ScoreboardCell{
+		ProblemID: contestProblem.ProblemID,
+		Label:     contestProblem.Label,
+	}
+
+	var sortedAttempts []scoreboardAttempt
+	for _, attempt := range attempts {
+		if !freezeTime.IsZero() && !attempt.SubmittedAt.Before(freezeTime) {
+			continue
+		}
+
+		sortedAttempts = append(sortedAttempts, attempt)
+	}
+	sort.Slice(sortedAttempts, func(i, j int) bool {
+		return sortedAttempts[i].SubmissionID < sortedAttempts[j].SubmissionID
+	})
+
+	for _, attempt := range sortedAttempts {
+		if attempt.Score > cell.Score {
+			cell.Score = attempt.Score
+		}
+
+		if cell.IsSolved {
+			continue
+		}
+
+		switch ojs.SubmissionResult(attempt.Result) {
+		case ojs.SubmissionResult_OK:
+			cell.IsSolved = true
+			cell.SolvedAtMinute = uint64(attempt.SubmittedAt.Sub(contest.StartTime) / time.Minute)
+		case ojs.SubmissionResult_CompileError, ojs.SubmissionResult_UnsupportedLanguage, ojs.SubmissionResult_UndefinedResult:
+			// Submissions that never ran are not penalized
+		default:
+			cell.WrongAttemptCount++
+		}
+	}
+
+	return cell
+}
+
+func (s *scoreboardLogic) rankScoreboardRows(scoringMode ojs.ContestScoringMode, rows []ScoreboardRow) {
+	isBetter := func(a, b ScoreboardRow) bool {
+		if scoringMode == ojs.ContestScoringMode_IOI {
+			return a.TotalScore > b.TotalScore
+		}
+
+		if a.SolvedCount != b.SolvedCount {
+			return a.SolvedCount > b.SolvedCount
+		}
+		return a.Penalty < b.Penalty
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		return isBetter(rows[i], rows[j])
+	})
+
+	for i := range rows {
+		if i > 0 && !isBetter(rows[i-1], rows[i]) {
+			rows[i].Rank = rows[i-1].Rank
+		} else {
+			rows[i].Rank = uint64(i + 1)
+		}
+	}
+}
+
+// getScoreboardFreezeTime returns the time after which attempts are hidden from the scoreboard,
+// or the zero time if the scoreboard of the contest is not frozen at the given time.
+func getScoreboardFreezeTime(contest Contest, now time.Time) time.Time {
+	if !contest.FreezeScoreboard {
+		return time.Time{}
+	}
+
+	freezeTime := contest.EndTime.Add(-scoreboardFreezeDuration)
+	if now.Before(freezeTime) || !now.Before(contest.EndTime) {
+		return time.Time{}
+	}
+
+	return freezeTime
+}
+
+type ScoreboardCell struct {
+	ProblemID         uint64
+	Label             string
+	IsSolved          bool
+	WrongAttemptCount uint64
+	SolvedAtMinute    uint64
+	Score             uint64
+}
+
+type ScoreboardRow struct {
+	Rank        uint64
+	AccountID   uint64
+	AccountName string
+	SolvedCount uint64
+	Penalty     uint64
+	TotalScore  uint64
+	Cells       []ScoreboardCell
+}
+
+type Scoreboard struct {
+	ScoringMode ojs.ContestScoringMode
+	IsFrozen    bool
+	Rows        []ScoreboardRow
+}
+
+type GetScoreboardInput struct {
+	Contest Contest
+	// FreezeTime hides attempts submitted at or after it when set.
+	FreezeTime time.Time
+}
+
+type GetScoreboardOutput struct {
+	Scoreboard Scoreboard
+}
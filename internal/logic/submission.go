@@ -3,23 +3,53 @@ package logic
 import (
 	"context"
 	"errors"
+	"fmt"
+	"os"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/maxuanquang/ojs/internal/configs"
+	"github.com/maxuanquang/ojs/internal/dataaccess/cache"
 	"github.com/maxuanquang/ojs/internal/dataaccess/database"
 	"github.com/maxuanquang/ojs/internal/dataaccess/mq/producer"
 	"github.com/maxuanquang/ojs/internal/generated/grpc/ojs"
+	"github.com/maxuanquang/ojs/internal/utils"
 	"github.com/mikespook/gorbac"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
 
+const (
+	// resetExpiredJudgingLeasesBatchSize bounds how many submissions are submitted again at once
+	resetExpiredJudgingLeasesBatchSize = 100
+	// watchSubmissionPollInterval is how often a watched submission is read again, in case its final update was lost
+	watchSubmissionPollInterval = 5 * time.Second
+)
+
 type SubmissionLogic interface {
 	CreateSubmission(ctx context.Context, in CreateSubmissionInput) (CreateSubmissionOutput, error)
 	GetSubmission(ctx context.Context, in GetSubmissionInput) (GetSubmissionOutput, error)
 	GetSubmissionList(ctx context.Context, in GetSubmissionListInput) (GetSubmissionListOutput, error)
 	GetAccountProblemSubmissionList(ctx context.Context, in GetAccountProblemSubmissionListInput) (GetAccountProblemSubmissionListOutput, error)
 	GetProblemSubmissionList(ctx context.Context, in GetProblemSubmissionListInput) (GetProblemSubmissionListOutput, error)
+	GetSubmissionTestCaseResultList(ctx context.Context, in GetSubmissionTestCaseResultListInput) (GetSubmissionTestCaseResultListOutput, error)
+	WatchSubmission(ctx context.Context, in WatchSubmissionInput) (WatchSubmissionOutput, error)
 
+	// ExecuteSubmission judges a submitted submission, only recording its verdict once judging it succeeded. The
+	// submission is submitted again when judging it fails, and the error is returned so that it can be retried.
 	ExecuteSubmission(ctx context.Context, in ExecuteSubmissionInput) error
+	// ResetExpiredJudgingLeases submits again the submissions whose worker stopped renewing its judging lease,
+	// such as the ones of a crashed worker.
+	ResetExpiredJudgingLeases(ctx context.Context) error
+	// GetAndUpdateFirstSubmittedSubmissionToExecuting gives the oldest submitted submission to a remote worker,
+	// which holds its judging lease, identified by a token of its own, until it reports the submission's result.
+	GetAndUpdateFirstSubmittedSubmissionToExecuting(
+		ctx context.Context,
+		in GetAndUpdateFirstSubmittedSubmissionToExecutingInput,
+	) (GetAndUpdateFirstSubmittedSubmissionToExecutingOutput, error)
+	// RenewSubmissionJudgingLease keeps the judging lease of a remote worker alive while it judges the submission.
+	RenewSubmissionJudgingLease(ctx context.Context, in RenewSubmissionJudgingLeaseInput) error
+	ReportSubmissionResult(ctx context.Context, in ReportSubmissionResultInput) (ReportSubmissionResultOutput, error)
 }
 
 func NewSubmissionLogic(
@@ -28,37 +58,117 @@ func NewSubmissionLogic(
 	problemDataAccessor database.ProblemDataAccessor,
 	submissionDataAccessor database.SubmissionDataAccessor,
 	testCaseDataAccessor database.TestCaseDataAccessor,
+	testCaseGroupDataAccessor database.TestCaseGroupDataAccessor,
+	submissionTestCaseResultDataAccessor database.SubmissionTestCaseResultDataAccessor,
+	contestDataAccessor database.ContestDataAccessor,
+	contestProblemDataAccessor database.ContestProblemDataAccessor,
+	contestParticipantDataAccessor database.ContestParticipantDataAccessor,
 	tokenLogic TokenLogic,
 	judgeLogic JudgeLogic,
 	roleLogic RoleLogic,
-	submissionCreatedProducer producer.SubmissionCreatedProducer,
+	scoreboardLogic ScoreboardLogic,
+	outboxMessageDataAccessor database.OutboxMessageDataAccessor,
+	outboxLogic OutboxLogic,
+	submissionUpdatePubSub cache.SubmissionUpdatePubSub,
 	database database.Database,
-) SubmissionLogic {
-	return &submissionLogic{
-		logger:                    logger,
-		accountDataAccessor:       accountDataAccessor,
-		problemDataAccessor:       problemDataAccessor,
-		submissionDataAccessor:    submissionDataAccessor,
-		testCaseDataAccessor:      testCaseDataAccessor,
-		tokenLogic:                tokenLogic,
-		judgeLogic:                judgeLogic,
-		roleLogic:                 roleLogic,
-		submissionCreatedProducer: submissionCreatedProducer,
-		database:                  database,
+	workerConfig configs.Worker,
+	judgeConfig configs.Judge,
+) (SubmissionLogic, error) {
+	heartbeatInterval, err := workerConfig.GetHeartbeatInterval()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get heartbeat interval")
+		return nil, err
+	}
+
+	judgingLeaseDuration, err := workerConfig.GetJudgingLeaseDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get judging lease duration")
+		return nil, err
+	}
+
+	workerName, err := getWorkerName(workerConfig)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get worker name")
+		return nil, err
+	}
+
+	outputExcerptSizeInBytes, err := judgeConfig.GetOutputExcerptSizeInBytes()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get output excerpt size in bytes")
+		return nil, err
 	}
+
+	compileOutputSizeInBytes, err := judgeConfig.GetCompileOutputSizeInBytes()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get compile output size in bytes")
+		return nil, err
+	}
+
+	return &submissionLogic{
+		logger:                               logger,
+		accountDataAccessor:                  accountDataAccessor,
+		problemDataAccessor:                  problemDataAccessor,
+		submissionDataAccessor:               submissionDataAccessor,
+		testCaseDataAccessor:                 testCaseDataAccessor,
+		testCaseGroupDataAccessor:            testCaseGroupDataAccessor,
+		submissionTestCaseResultDataAccessor: submissionTestCaseResultDataAccessor,
+		contestDataAccessor:                  contestDataAccessor,
+		contestProblemDataAccessor:           contestProblemDataAccessor,
+		contestParticipantDataAccessor:       contestParticipantDataAccessor,
+		tokenLogic:                           tokenLogic,
+		judgeLogic:                           judgeLogic,
+		roleLogic:                            roleLogic,
+		scoreboardLogic:                      scoreboardLogic,
+		outboxMessageDataAccessor:            outboxMessageDataAccessor,
+		outboxLogic:                          outboxLogic,
+		submissionUpdatePubSub:               submissionUpdatePubSub,
+		database:                             database,
+		workerName:                           workerName,
+		heartbeatInterval:                    heartbeatInterval,
+		judgingLeaseDuration:                 judgingLeaseDuration,
+		outputExcerptSizeInBytes:             int(outputExcerptSizeInBytes),
+		compileOutputSizeInBytes:             int(compileOutputSizeInBytes),
+	}, nil
 }
 
 type submissionLogic struct {
-	logger                    *zap.Logger
-	accountDataAccessor       database.AccountDataAccessor
-	problemDataAccessor       database.ProblemDataAccessor
-	submissionDataAccessor    database.SubmissionDataAccessor
-	testCaseDataAccessor      database.TestCaseDataAccessor
-	tokenLogic                TokenLogic
-	judgeLogic                JudgeLogic
-	roleLogic                 RoleLogic
-	submissionCreatedProducer producer.SubmissionCreatedProducer
-	database                  database.Database
+	logger                               *zap.Logger
+	accountDataAccessor                  database.AccountDataAccessor
+	problemDataAccessor                  database.ProblemDataAccessor
+	submissionDataAccessor               database.SubmissionDataAccessor
+	testCaseDataAccessor                 database.TestCaseDataAccessor
+	testCaseGroupDataAccessor            database.TestCaseGroupDataAccessor
+	submissionTestCaseResultDataAccessor database.SubmissionTestCaseResultDataAccessor
+	contestDataAccessor                  database.ContestDataAccessor
+	contestProblemDataAccessor           database.ContestProblemDataAccessor
+	contestParticipantDataAccessor       database.ContestParticipantDataAccessor
+	tokenLogic                           TokenLogic
+	judgeLogic                           JudgeLogic
+	roleLogic                            RoleLogic
+	scoreboardLogic                      ScoreboardLogic
+	outboxMessageDataAccessor            database.OutboxMessageDataAccessor
+	outboxLogic                          OutboxLogic
+	submissionUpdatePubSub               cache.SubmissionUpdatePubSub
+	database                             database.Database
+	workerName                           string
+	heartbeatInterval                    time.Duration
+	judgingLeaseDuration                 time.Duration
+	outputExcerptSizeInBytes             int
+	compileOutputSizeInBytes             int
+}
+
+// getWorkerName returns the name the worker holds judging leases under, which has to be unique among workers.
+func getWorkerName(workerConfig configs.Worker) (string, error) {
+	if workerConfig.Name != "" {
+		return workerConfig.Name, nil
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s-%s", hostname, uuid.NewString()[:8]), nil
 }
 
 func (p *submissionLogic) CreateSubmission(ctx context.Context, in CreateSubmissionInput) (CreateSubmissionOutput, error) {
@@ -87,20 +197,36 @@ func (p *submissionLogic) CreateSubmission(ctx context.Context, in CreateSubmiss
 		return CreateSubmissionOutput{}, ErrPermissionDenied
 	}
 
-	// Create submission in the database
+	if in.OfContestID != 0 {
+		err = p.validateContestSubmission(ctx, in.OfContestID, in.OfProblemID, requestingAccountID)
+		if err != nil {
+			return CreateSubmissionOutput{}, err
+		}
+	}
+
+	// Create submission in the database, along with the message announcing it
+	var outboxMessage database.OutboxMessage
 	txErr = p.database.Transaction(func(tx *gorm.DB) error {
 		createdSubmission, err = p.submissionDataAccessor.WithDatabaseTransaction(tx).CreateSubmission(ctx, database.Submission{
-			OfProblemID: in.OfProblemID,
-			AuthorID:    requestingAccountID,
-			Content:     in.Content,
-			Language:    in.Language,
-			Status:      int8(ojs.SubmissionStatus_Submitted),
+			OfProblemID:  in.OfProblemID,
+			AuthorID:     requestingAccountID,
+			Content:      in.Content,
+			Language:     in.Language,
+			Status:       int8(ojs.SubmissionStatus_Submitted),
+			OfContestID:  in.OfContestID,
+			JudgingQueue: getSubmissionCreatedQueueName(in.OfContestID),
 		})
 		if err != nil {
 			p.logger.Error("failed to create submission", zap.Error(err))
 			return err
 		}
 
+		outboxMessage, err = createSubmissionCreatedOutboxMessage(ctx, p.outboxMessageDataAccessor.WithDatabaseTransaction(tx), createdSubmission)
+		if err != nil {
+			p.logger.Error("failed to create submission created outbox message", zap.Error(err))
+			return err
+		}
+
 		return nil
 	})
 	if txErr != nil {
@@ -108,25 +234,56 @@ func (p *submissionLogic) CreateSubmission(ctx context.Context, in CreateSubmiss
 		return CreateSubmissionOutput{}, err
 	}
 
-	// Produce a message to the submission created queue
-	err = p.submissionCreatedProducer.Produce(ctx, createdSubmission.ID)
-	if err != nil {
-		p.logger.Error("failed to send message to submission created queue", zap.Error(err))
-	}
+	p.outboxLogic.PublishOutboxMessage(ctx, outboxMessage)
 
 	return CreateSubmissionOutput{
-		Submission: Submission{
-			ID:          createdSubmission.ID,
-			OfProblemID: createdSubmission.OfProblemID,
-			AuthorID:    createdSubmission.AuthorID,
-			Content:     createdSubmission.Content,
-			Language:    createdSubmission.Language,
-			Status:      ojs.SubmissionStatus(createdSubmission.Status),
-			Result:      ojs.SubmissionResult(createdSubmission.Result),
-		},
+		Submission: p.dbSubmissionToLogicSubmission(createdSubmission),
 	}, nil
 }
 
+// validateContestSubmission checks that the problem belongs to the contest, that the contest is running
+// and that the account is registered for it.
+func (p *submissionLogic) validateContestSubmission(ctx context.Context, contestID, problemID, accountID uint64) error {
+	logger := p.logger.With(zap.Uint64("contest_id", contestID))
+
+	contest, err := p.contestDataAccessor.GetContestByID(ctx, contestID)
+	if err != nil {
+		if errors.Is(err, database.ErrContestNotFound) {
+			return ErrContestNotFound
+		}
+
+		logger.With(zap.Error(err)).Error("failed to get contest")
+		return ErrInternal
+	}
+
+	now := time.Now()
+	if now.Before(contest.StartTime) || !now.Before(contest.EndTime) {
+		return ErrContestNotRunning
+	}
+
+	_, err = p.contestProblemDataAccessor.GetContestProblem(ctx, contestID, problemID)
+	if err != nil {
+		if errors.Is(err, database.ErrContestProblemNotFound) {
+			return ErrProblemNotInContest
+		}
+
+		logger.With(zap.Error(err)).Error("failed to get contest problem")
+		return ErrInternal
+	}
+
+	_, err = p.contestParticipantDataAccessor.GetContestParticipant(ctx, contestID, accountID)
+	if err != nil {
+		if errors.Is(err, database.ErrContestParticipantNotFound) {
+			return ErrContestNotRegistered
+		}
+
+		logger.With(zap.Error(err)).Error("failed to get contest participant")
+		return ErrInternal
+	}
+
+	return nil
+}
+
 func (p *submissionLogic) GetSubmission(ctx context.Context, in GetSubmissionInput) (GetSubmissionOutput, error) {
 	// Retrieve submission from the database
 	submission, err := p.submissionDataAccessor.GetSubmissionByID(ctx, in.ID)
@@ -155,8 +312,19 @@ func (p *submissionLogic) GetSubmission(ctx context.Context, in GetSubmissionInp
 		return GetSubmissionOutput{}, ErrPermissionDenied
 	}
 
+	canReadAllCompileOutputs, err := p.canReadAllCompileOutputs(ctx, requestingAccountRole)
+	if err != nil {
+		p.logger.Error("failed to check permission", zap.Error(err))
+		return GetSubmissionOutput{}, ErrInternal
+	}
+
+	outputSubmission := p.dbSubmissionToLogicSubmission(submission)
+	if submission.AuthorID != requestingAccountID && !canReadAllCompileOutputs {
+		outputSubmission.CompileOutput = ""
+	}
+
 	return GetSubmissionOutput{
-		Submission: p.dbSubmissionToLogicSubmission(submission),
+		Submission: outputSubmission,
 	}, nil
 }
 
@@ -168,7 +336,7 @@ func (p *submissionLogic) GetSubmissionList(ctx context.Context, in GetSubmissio
 		return GetSubmissionListOutput{}, err
 	}
 
-	_, _, requestingAccountRole, _, err := p.tokenLogic.VerifyTokenString(ctx, in.Token)
+	requestingAccountID, _, requestingAccountRole, _, err := p.tokenLogic.VerifyTokenString(ctx, in.Token)
 	if err != nil {
 		p.logger.With(zap.Error(err)).Error("failed to verify token")
 		return GetSubmissionListOutput{}, ErrTokenInvalid
@@ -184,17 +352,20 @@ func (p *submissionLogic) GetSubmissionList(ctx context.Context, in GetSubmissio
 		return GetSubmissionListOutput{}, ErrPermissionDenied
 	}
 
+	canReadAllCompileOutputs, err := p.canReadAllCompileOutputs(ctx, requestingAccountRole)
+	if err != nil {
+		p.logger.Error("failed to check permission", zap.Error(err))
+		return GetSubmissionListOutput{}, ErrInternal
+	}
+
 	var submissionList []Submission
 	for _, s := range submissions {
-		submissionList = append(submissionList, Submission{
-			ID:          s.ID,
-			OfProblemID: s.OfProblemID,
-			AuthorID:    s.AuthorID,
-			Content:     s.Content,
-			Language:    s.Language,
-			Status:      ojs.SubmissionStatus(s.Status),
-			Result:      ojs.SubmissionResult(s.Result),
-		})
+		submission := p.dbSubmissionToLogicSubmission(s)
+		if s.AuthorID != requestingAccountID && !canReadAllCompileOutputs {
+			submission.CompileOutput = ""
+		}
+
+		submissionList = append(submissionList, submission)
 	}
 
 	totalSubmissionsCount, err := p.submissionDataAccessor.GetSubmissionCount(ctx)
@@ -234,17 +405,10 @@ func (p *submissionLogic) GetAccountProblemSubmissionList(ctx context.Context, i
 		return GetAccountProblemSubmissionListOutput{}, err
 	}
 
+	// The requesting account is the author of all of these submissions, so compile outputs are kept
 	var submissionList []Submission
 	for _, s := range submissions {
-		submissionList = append(submissionList, Submission{
-			ID:          s.ID,
-			OfProblemID: s.OfProblemID,
-			AuthorID:    s.AuthorID,
-			Content:     s.Content,
-			Language:    s.Language,
-			Status:      ojs.SubmissionStatus(s.Status),
-			Result:      ojs.SubmissionResult(s.Result),
-		})
+		submissionList = append(submissionList, p.dbSubmissionToLogicSubmission(s))
 	}
 
 	totalSubmissionsCount, err := p.submissionDataAccessor.GetAccountProblemSubmissionCount(ctx, accountID, in.OfProblemID)
@@ -260,7 +424,7 @@ func (p *submissionLogic) GetAccountProblemSubmissionList(ctx context.Context, i
 }
 
 func (p *submissionLogic) GetProblemSubmissionList(ctx context.Context, in GetProblemSubmissionListInput) (GetProblemSubmissionListOutput, error) {
-	_, _, accountRole, _, err := p.tokenLogic.VerifyTokenString(ctx, in.Token)
+	accountID, _, accountRole, _, err := p.tokenLogic.VerifyTokenString(ctx, in.Token)
 	if err != nil {
 		p.logger.Error("Failed to verify token", zap.Error(err))
 		return GetProblemSubmissionListOutput{}, err
@@ -283,17 +447,20 @@ func (p *submissionLogic) GetProblemSubmissionList(ctx context.Context, in GetPr
 		return GetProblemSubmissionListOutput{}, err
 	}
 
+	canReadAllCompileOutputs, err := p.canReadAllCompileOutputs(ctx, accountRole)
+	if err != nil {
+		p.logger.With(zap.Error(err)).Error("failed to check permission")
+		return GetProblemSubmissionListOutput{}, ErrInternal
+	}
+
 	var submissionList []Submission
 	for _, s := range submissions {
-		submissionList = append(submissionList, Submission{
-			ID:          s.ID,
-			OfProblemID: s.OfProblemID,
-			AuthorID:    s.AuthorID,
-			Content:     s.Content,
-			Language:    s.Language,
-			Status:      ojs.SubmissionStatus(s.Status),
-			Result:      ojs.SubmissionResult(s.Result),
-		})
+		submission := p.dbSubmissionToLogicSubmission(s)
+		if s.AuthorID != accountID && !canReadAllCompileOutputs {
+			submission.CompileOutput = ""
+		}
+
+		submissionList = append(submissionList, submission)
 	}
 
 	totalSubmissionsCount, err := p.submissionDataAccessor.GetProblemSubmissionCount(ctx, in.OfProblemID)
@@ -308,6 +475,102 @@ func (p *submissionLogic) GetProblemSubmissionList(ctx context.Context, in GetPr
 	}, nil
 }
 
+// GetSubmissionTestCaseResultList implements SubmissionLogic.
+func (p *submissionLogic) GetSubmissionTestCaseResultList(ctx context.Context, in GetSubmissionTestCaseResultListInput) (GetSubmissionTestCaseResultListOutput, error) {
+	logger := p.logger.With(zap.Uint64("submission_id", in.ID))
+
+	requestingAccountID, _, requestingAccountRole, _, err := p.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return GetSubmissionTestCaseResultListOutput{}, ErrTokenInvalid
+	}
+
+	submission, err := p.submissionDataAccessor.GetSubmissionByID(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrSubmissionNotFound) {
+			return GetSubmissionTestCaseResultListOutput{}, ErrSubmissionNotFound
+		}
+
+		logger.With(zap.Error(err)).Error("failed to get submission")
+		return GetSubmissionTestCaseResultListOutput{}, ErrInternal
+	}
+
+	requiredPermissions := []gorbac.Permission{PermissionSubmissionsReadAll}
+	if submission.AuthorID == requestingAccountID {
+		requiredPermissions = append(requiredPermissions, PermissionSubmissionsReadSelf)
+	}
+
+	hasPermission, err := p.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(requestingAccountRole)], requiredPermissions...)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to check permission")
+		return GetSubmissionTestCaseResultListOutput{}, ErrInternal
+	}
+	if !hasPermission {
+		return GetSubmissionTestCaseResultListOutput{}, ErrPermissionDenied
+	}
+
+	problem, err := p.problemDataAccessor.GetProblemByID(ctx, submission.OfProblemID)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get problem")
+		return GetSubmissionTestCaseResultListOutput{}, ErrInternal
+	}
+
+	// Only problem setters are allowed to see the results of hidden test cases
+	hiddenTestCasesPermissions := []gorbac.Permission{PermissionTestCasesReadAll}
+	if problem.AuthorID == requestingAccountID {
+		hiddenTestCasesPermissions = append(hiddenTestCasesPermissions, PermissionTestCasesReadSelf)
+	}
+
+	canReadHiddenTestCases, err := p.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(requestingAccountRole)], hiddenTestCasesPermissions...)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to check permission")
+		return GetSubmissionTestCaseResultListOutput{}, ErrInternal
+	}
+
+	testCases, err := p.testCaseDataAccessor.GetProblemTestCaseListAll(ctx, submission.OfProblemID)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get test cases")
+		return GetSubmissionTestCaseResultListOutput{}, ErrInternal
+	}
+
+	hiddenTestCaseIDs := make(map[uint64]struct{})
+	for _, testCase := range testCases {
+		if testCase.IsHidden {
+			hiddenTestCaseIDs[testCase.ID] = struct{}{}
+		}
+	}
+
+	dbSubmissionTestCaseResults, err := p.submissionTestCaseResultDataAccessor.GetSubmissionTestCaseResultList(ctx, in.ID)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get submission test case results")
+		return GetSubmissionTestCaseResultListOutput{}, ErrInternal
+	}
+
+	var submissionTestCaseResults []SubmissionTestCaseResult
+	for _, r := range dbSubmissionTestCaseResults {
+		if _, isHidden := hiddenTestCaseIDs[r.OfTestCaseID]; isHidden && !canReadHiddenTestCases {
+			continue
+		}
+
+		submissionTestCaseResults = append(submissionTestCaseResults, SubmissionTestCaseResult{
+			ID:             r.ID,
+			OfSubmissionID: r.OfSubmissionID,
+			OfTestCaseID:   r.OfTestCaseID,
+			Result:         ojs.SubmissionResult(r.Result),
+			WallTime:       r.WallTime,
+			CPUTime:        r.CPUTime,
+			Memory:         r.Memory,
+			ExitCode:       r.ExitCode,
+			Stdout:         r.Stdout,
+			Stderr:         r.Stderr,
+		})
+	}
+
+	return GetSubmissionTestCaseResultListOutput{
+		SubmissionTestCaseResults: submissionTestCaseResults,
+	}, nil
+}
+
 // ExecuteSubmission implements SubmissionLogic.
 func (s *submissionLogic) ExecuteSubmission(ctx context.Context, in ExecuteSubmissionInput) error {
 	var (
@@ -334,21 +597,22 @@ func (s *submissionLogic) ExecuteSubmission(ctx context.Context, in ExecuteSubmi
 	}
 
 	txErr = s.database.Transaction(func(tx *gorm.DB) error {
-		submission, err = s.submissionDataAccessor.WithDatabaseTransaction(tx).GetSubmissionByID(ctx, in.ID)
+		err = s.submissionDataAccessor.WithDatabaseTransaction(tx).AcquireSubmissionJudgingLease(
+			ctx, in.ID, int8(ojs.SubmissionStatus_Submitted), int8(ojs.SubmissionStatus_Executing), s.workerName,
+		)
 		if err != nil {
-			s.logger.Error("Failed to get submission", zap.Error(err))
-			return err
-		}
+			if errors.Is(err, database.ErrSubmissionJudgingLeaseNotAcquired) {
+				s.logger.Error("Submission is not submitted", zap.Uint64("submission_id", in.ID))
+				return ErrSubmissionNotSubmitted
+			}
 
-		if submission.Status != int8(ojs.SubmissionStatus_Submitted) {
-			s.logger.Error("Submission is not submitted", zap.Uint64("submission_id", submission.ID))
-			return errors.New("submission is not submitted")
+			s.logger.Error("Failed to acquire submission judging lease", zap.Error(err))
+			return err
 		}
 
-		submission.Status = int8(ojs.SubmissionStatus_Executing)
-		_, err = s.submissionDataAccessor.WithDatabaseTransaction(tx).UpdateSubmission(ctx, submission)
+		submission, err = s.submissionDataAccessor.WithDatabaseTransaction(tx).GetSubmissionByID(ctx, in.ID)
 		if err != nil {
-			s.logger.Error("Failed to update submission", zap.Error(err))
+			s.logger.Error("Failed to get submission", zap.Error(err))
 			return err
 		}
 
@@ -359,52 +623,670 @@ func (s *submissionLogic) ExecuteSubmission(ctx context.Context, in ExecuteSubmi
 		return txErr
 	}
 
-	result, err := s.judgeLogic.Judge(
-		ctx,
+	notifyJudgingLeaseAcquired(ctx)
+	s.publishSubmissionUpdate(ctx, submission.ID, cache.SubmissionUpdate{
+		Status: int8(ojs.SubmissionStatus_Executing),
+	})
+
+	// Judging is cancelled if the lease is lost, as the submission is then judged again by another worker
+	judgeCtx, judgeCancelFunc := context.WithCancel(ctx)
+	heartbeatDone := make(chan struct{})
+	go func() {
+		defer close(heartbeatDone)
+		s.sendJudgingHeartbeats(judgeCtx, submission.ID, judgeCancelFunc)
+	}()
+
+	judgeOutput, err := s.judgeLogic.Judge(
+		judgeCtx,
 		s.dbSubmissionToLogicSubmission(submission),
 	)
+	judgeCancelFunc()
+	<-heartbeatDone
+
 	if err != nil {
 		s.logger.Error("Failed to judge submission", zap.Error(err))
+		return s.abandonSubmissionJudging(ctx, submission.ID, err)
 	}
 
-	// Update submission result and status in the database
-	submission.Result = int8(result)
+	// Update submission result, status, compile output and score in the database, unless the lease was lost
+	submission.Result = int8(judgeOutput.Result)
 	submission.Status = int8(ojs.SubmissionStatus_Finished)
-	s.submissionDataAccessor.UpdateSubmission(
-		ctx,
-		submission,
+	submission.CompileOutput = judgeOutput.CompileOutput
+	submission.Score = judgeOutput.Score
+	txErr = s.database.Transaction(func(tx *gorm.DB) error {
+		err = s.submissionDataAccessor.WithDatabaseTransaction(tx).ReleaseSubmissionJudgingLease(ctx, submission.ID, s.workerName)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.submissionDataAccessor.WithDatabaseTransaction(tx).UpdateSubmission(ctx, submission)
+		return err
+	})
+	if txErr != nil {
+		if errors.Is(txErr, database.ErrSubmissionJudgingLeaseNotHeld) {
+			s.logger.Warn("submission judging lease lost, discarding result", zap.Uint64("submission_id", submission.ID))
+			return nil
+		}
+
+		s.logger.Error("Failed to update submission", zap.Error(txErr))
+		return txErr
+	}
+
+	s.onSubmissionFinished(ctx, submission)
+	return nil
+}
+
+// abandonSubmissionJudging gives up the judging lease of a submission that could not be judged, so that judging it
+// can be retried, and returns the error of judging it.
+func (s *submissionLogic) abandonSubmissionJudging(ctx context.Context, submissionID uint64, judgeErr error) error {
+	logger := s.logger.With(zap.Uint64("submission_id", submissionID))
+
+	// The worker is stopping, the lease is left to expire so that the submission is submitted again by
+	// ResetExpiredJudgingLeases
+	if ctx.Err() != nil {
+		logger.Warn("judging interrupted, leaving submission judging lease to expire")
+		return ctx.Err()
+	}
+
+	txErr := s.database.Transaction(func(tx *gorm.DB) error {
+		err := s.submissionDataAccessor.WithDatabaseTransaction(tx).ReleaseSubmissionJudgingLease(ctx, submissionID, s.workerName)
+		if err != nil {
+			return err
+		}
+
+		return s.submissionDataAccessor.WithDatabaseTransaction(tx).ResetSubmissionResult(
+			ctx, submissionID, int8(ojs.SubmissionStatus_Executing), int8(ojs.SubmissionStatus_Submitted),
+		)
+	})
+	if txErr != nil {
+		if errors.Is(txErr, database.ErrSubmissionJudgingLeaseNotHeld) {
+			logger.Warn("submission judging lease lost, another worker is judging it")
+			return nil
+		}
+
+		logger.Error("Failed to give up submission judging lease", zap.Error(txErr))
+		return txErr
+	}
+
+	s.publishSubmissionUpdate(ctx, submissionID, cache.SubmissionUpdate{
+		Status: int8(ojs.SubmissionStatus_Submitted),
+	})
+
+	return judgeErr
+}
+
+// onSubmissionFinished notifies the watchers of a submission and its contest's scoreboard once it is judged.
+func (s *submissionLogic) onSubmissionFinished(ctx context.Context, submission database.Submission) {
+	s.publishSubmissionUpdate(ctx, submission.ID, cache.SubmissionUpdate{
+		Status: submission.Status,
+		Result: submission.Result,
+	})
+
+	if submission.OfContestID != 0 {
+		err := s.scoreboardLogic.UpdateScoreboard(ctx, s.dbSubmissionToLogicSubmission(submission))
+		if err != nil {
+			s.logger.Error("Failed to update contest scoreboard", zap.Error(err))
+		}
+	}
+}
+
+// sendJudgingHeartbeats renews the judging lease of the submission until ctx is done, calling onLeaseLost
+// if another worker has taken the lease over in the meantime.
+func (s *submissionLogic) sendJudgingHeartbeats(ctx context.Context, submissionID uint64, onLeaseLost func()) {
+	logger := s.logger.With(zap.Uint64("submission_id", submissionID)).With(zap.String("worker", s.workerName))
+
+	ticker := time.NewTicker(s.heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := s.submissionDataAccessor.RenewSubmissionJudgingLease(ctx, submissionID, s.workerName)
+		if err == nil {
+			continue
+		}
+
+		if errors.Is(err, database.ErrSubmissionJudgingLeaseNotHeld) {
+			logger.Warn("submission judging lease lost")
+			onLeaseLost()
+			return
+		}
+
+		// The lease is kept as long as a later heartbeat gets through before it expires
+		logger.With(zap.Error(err)).Warn("failed to renew submission judging lease")
+	}
+}
+
+// ResetExpiredJudgingLeases implements SubmissionLogic.
+func (s *submissionLogic) ResetExpiredJudgingLeases(ctx context.Context) error {
+	submissions, err := s.submissionDataAccessor.GetExpiredJudgingLeaseSubmissionList(
+		ctx, s.judgingLeaseDuration, resetExpiredJudgingLeasesBatchSize,
 	)
+	if err != nil {
+		s.logger.With(zap.Error(err)).Error("failed to get submissions with expired judging lease")
+		return err
+	}
+
+	for _, submission := range submissions {
+		logger := s.logger.With(zap.Uint64("submission_id", submission.ID)).With(zap.String("worker", submission.JudgedByWorker))
+
+		var outboxMessage database.OutboxMessage
+		err = s.database.Transaction(func(tx *gorm.DB) error {
+			err := s.submissionDataAccessor.WithDatabaseTransaction(tx).ResetExpiredSubmissionJudgingLease(
+				ctx, submission.ID, s.judgingLeaseDuration, int8(ojs.SubmissionStatus_Submitted),
+			)
+			if err != nil {
+				return err
+			}
+
+			outboxMessage, err = createSubmissionCreatedOutboxMessage(ctx, s.outboxMessageDataAccessor.WithDatabaseTransaction(tx), submission)
+			return err
+		})
+		if err != nil {
+			if errors.Is(err, database.ErrSubmissionJudgingLeaseNotExpired) {
+				continue
+			}
+
+			logger.With(zap.Error(err)).Error("failed to reset expired judging lease")
+			return err
+		}
+
+		logger.Info("expired judging lease reset")
+		s.publishSubmissionUpdate(ctx, submission.ID, cache.SubmissionUpdate{
+			Status: int8(ojs.SubmissionStatus_Submitted),
+		})
+
+		s.outboxLogic.PublishOutboxMessage(ctx, outboxMessage)
+	}
 
 	return nil
 }
 
+// GetAndUpdateFirstSubmittedSubmissionToExecuting implements SubmissionLogic.
+func (s *submissionLogic) GetAndUpdateFirstSubmittedSubmissionToExecuting(
+	ctx context.Context,
+	in GetAndUpdateFirstSubmittedSubmissionToExecutingInput,
+) (GetAndUpdateFirstSubmittedSubmissionToExecutingOutput, error) {
+	_, accountName, accountRole, _, err := s.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		s.logger.With(zap.Error(err)).Error("failed to verify token")
+		return GetAndUpdateFirstSubmittedSubmissionToExecutingOutput{}, ErrTokenInvalid
+	}
+
+	hasPermission, err := s.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(accountRole)], PermissionSubmissionsWriteAll)
+	if err != nil {
+		s.logger.With(zap.Error(err)).Error("failed to check permission")
+		return GetAndUpdateFirstSubmittedSubmissionToExecutingOutput{}, ErrInternal
+	}
+	if !hasPermission {
+		return GetAndUpdateFirstSubmittedSubmissionToExecutingOutput{}, ErrPermissionDenied
+	}
+
+	// Each claim holds the lease under a token of its own, so that a worker can not renew nor report for a claim of
+	// the same account that has since expired
+	judgingLeaseToken := uuid.NewString()
+	judgingLeaseHolder := getRemoteJudgingLeaseHolder(accountName, judgingLeaseToken)
+
+	// Submissions are claimed by priority lane like on the message queue. Concurrent workers skip the submission
+	// locked here instead of waiting for it, and claim the next one
+	var submission database.Submission
+	txErr := s.database.Transaction(func(tx *gorm.DB) error {
+		submission, err = s.submissionDataAccessor.WithDatabaseTransaction(tx).GetFirstSubmissionByStatusForUpdate(
+			ctx, int8(ojs.SubmissionStatus_Submitted), producer.SubmissionCreatedQueueNames,
+		)
+		if err != nil {
+			return err
+		}
+
+		return s.submissionDataAccessor.WithDatabaseTransaction(tx).AcquireSubmissionJudgingLease(
+			ctx, submission.ID, int8(ojs.SubmissionStatus_Submitted), int8(ojs.SubmissionStatus_Executing), judgingLeaseHolder,
+		)
+	})
+	if txErr != nil {
+		if errors.Is(txErr, database.ErrSubmissionNotFound) {
+			return GetAndUpdateFirstSubmittedSubmissionToExecutingOutput{}, ErrSubmissionNotFound
+		}
+
+		s.logger.With(zap.Error(txErr)).Error("failed to claim first submitted submission")
+		return GetAndUpdateFirstSubmittedSubmissionToExecutingOutput{}, ErrInternal
+	}
+
+	logger := s.logger.With(zap.Uint64("submission_id", submission.ID)).With(zap.String("worker", judgingLeaseHolder))
+	logger.Info("submission claimed by remote worker")
+
+	submission.Status = int8(ojs.SubmissionStatus_Executing)
+	s.publishSubmissionUpdate(ctx, submission.ID, cache.SubmissionUpdate{
+		Status: submission.Status,
+	})
+
+	problem, err := s.problemDataAccessor.GetProblemByID(ctx, submission.OfProblemID)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get problem")
+		return GetAndUpdateFirstSubmittedSubmissionToExecutingOutput{}, ErrInternal
+	}
+
+	author, err := s.accountDataAccessor.GetAccountByID(ctx, problem.AuthorID)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get problem author")
+		return GetAndUpdateFirstSubmittedSubmissionToExecutingOutput{}, ErrInternal
+	}
+
+	dbTestCases, err := s.testCaseDataAccessor.GetProblemTestCaseListAll(ctx, submission.OfProblemID)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get test cases")
+		return GetAndUpdateFirstSubmittedSubmissionToExecutingOutput{}, ErrInternal
+	}
+
+	var testCases []TestCase
+	for _, dbTestCase := range dbTestCases {
+		testCases = append(testCases, TestCase{
+			ID:                dbTestCase.ID,
+			OfProblemID:       dbTestCase.OfProblemID,
+			Input:             dbTestCase.Input,
+			Output:            dbTestCase.Output,
+			IsHidden:          dbTestCase.IsHidden,
+			OfTestCaseGroupID: dbTestCase.OfTestCaseGroupID,
+		})
+	}
+
+	return GetAndUpdateFirstSubmittedSubmissionToExecutingOutput{
+		Submission: s.dbSubmissionToLogicSubmission(submission),
+		Problem: Problem{
+			ID:                 problem.ID,
+			DisplayName:        problem.DisplayName,
+			AuthorId:           problem.AuthorID,
+			AuthorName:         author.Name,
+			Description:        problem.Description,
+			TimeLimit:          problem.TimeLimit,
+			MemoryLimit:        problem.MemoryLimit,
+			HasChecker:         problem.CheckerSource != "",
+			CheckerLanguage:    problem.CheckerLanguage,
+			ComparisonMode:     ojs.OutputComparisonMode(problem.ComparisonMode),
+			FloatEpsilon:       problem.FloatEpsilon,
+			HasInteractor:      problem.InteractorSource != "",
+			InteractorLanguage: problem.InteractorLanguage,
+		},
+		TestCases:         testCases,
+		CheckerSource:     problem.CheckerSource,
+		InteractorSource:  problem.InteractorSource,
+		JudgingLeaseToken: judgingLeaseToken,
+	}, nil
+}
+
+// RenewSubmissionJudgingLease implements SubmissionLogic.
+func (s *submissionLogic) RenewSubmissionJudgingLease(ctx context.Context, in RenewSubmissionJudgingLeaseInput) error {
+	logger := s.logger.With(zap.Uint64("submission_id", in.ID))
+
+	_, accountName, accountRole, _, err := s.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return ErrTokenInvalid
+	}
+
+	hasPermission, err := s.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(accountRole)], PermissionSubmissionsWriteAll)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to check permission")
+		return ErrInternal
+	}
+	if !hasPermission {
+		return ErrPermissionDenied
+	}
+
+	err = s.submissionDataAccessor.RenewSubmissionJudgingLease(
+		ctx, in.ID, getRemoteJudgingLeaseHolder(accountName, in.JudgingLeaseToken),
+	)
+	if err != nil {
+		if errors.Is(err, database.ErrSubmissionJudgingLeaseNotHeld) {
+			logger.Warn("submission judging lease lost, rejecting renewal")
+			return ErrSubmissionJudgingLeaseNotHeld
+		}
+
+		logger.With(zap.Error(err)).Error("failed to renew submission judging lease")
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// getRemoteJudgingLeaseHolder returns who holds the judging lease of a remote worker's claim, the lease token being
+// bound to the account of the worker.
+func getRemoteJudgingLeaseHolder(accountName, judgingLeaseToken string) string {
+	return fmt.Sprintf("%s/%s", accountName, judgingLeaseToken)
+}
+
+// ReportSubmissionResult implements SubmissionLogic.
+func (s *submissionLogic) ReportSubmissionResult(ctx context.Context, in ReportSubmissionResultInput) (ReportSubmissionResultOutput, error) {
+	logger := s.logger.With(zap.Uint64("submission_id", in.ID))
+
+	_, accountName, accountRole, _, err := s.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return ReportSubmissionResultOutput{}, ErrTokenInvalid
+	}
+
+	hasPermission, err := s.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(accountRole)], PermissionSubmissionsWriteAll)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to check permission")
+		return ReportSubmissionResultOutput{}, ErrInternal
+	}
+	if !hasPermission {
+		return ReportSubmissionResultOutput{}, ErrPermissionDenied
+	}
+
+	submission, err := s.submissionDataAccessor.GetSubmissionByID(ctx, in.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrSubmissionNotFound) {
+			return ReportSubmissionResultOutput{}, ErrSubmissionNotFound
+		}
+
+		logger.With(zap.Error(err)).Error("failed to get submission")
+		return ReportSubmissionResultOutput{}, ErrInternal
+	}
+
+	judgingLeaseHolder := getRemoteJudgingLeaseHolder(accountName, in.JudgingLeaseToken)
+	if submission.JudgedByWorker != judgingLeaseHolder {
+		return ReportSubmissionResultOutput{}, ErrSubmissionJudgingLeaseNotHeld
+	}
+
+	submission.Status = int8(ojs.SubmissionStatus_Finished)
+	submission.Score = 0
+	var submissionTestCaseResults []database.SubmissionTestCaseResult
+	switch in.Result {
+	case ojs.SubmissionResult_CompileError, ojs.SubmissionResult_UnsupportedLanguage:
+		submission.Result = int8(in.Result)
+		submission.CompileOutput = utils.TruncateString(in.CompileOutput, s.compileOutputSizeInBytes)
+	default:
+		var result ojs.SubmissionResult
+		result, submission.Score, submissionTestCaseResults, err = s.getReportedJudgeOutput(ctx, submission, in.TestCaseResults)
+		if err != nil {
+			return ReportSubmissionResultOutput{}, err
+		}
+
+		submission.Result = int8(result)
+	}
+
+	txErr := s.database.Transaction(func(tx *gorm.DB) error {
+		err := s.submissionDataAccessor.WithDatabaseTransaction(tx).ReleaseSubmissionJudgingLease(ctx, submission.ID, judgingLeaseHolder)
+		if err != nil {
+			return err
+		}
+
+		// Results of a previous run of this submission are discarded
+		err = s.submissionTestCaseResultDataAccessor.WithDatabaseTransaction(tx).DeleteSubmissionTestCaseResultList(ctx, submission.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, submissionTestCaseResult := range submissionTestCaseResults {
+			_, err = s.submissionTestCaseResultDataAccessor.WithDatabaseTransaction(tx).CreateSubmissionTestCaseResult(ctx, submissionTestCaseResult)
+			if err != nil {
+				return err
+			}
+		}
+
+		submission, err = s.submissionDataAccessor.WithDatabaseTransaction(tx).UpdateSubmission(ctx, submission)
+		return err
+	})
+	if txErr != nil {
+		if errors.Is(txErr, database.ErrSubmissionJudgingLeaseNotHeld) {
+			logger.Warn("submission judging lease lost, rejecting reported result")
+			return ReportSubmissionResultOutput{}, ErrSubmissionJudgingLeaseNotHeld
+		}
+
+		logger.With(zap.Error(txErr)).Error("failed to save reported submission result")
+		return ReportSubmissionResultOutput{}, ErrInternal
+	}
+
+	s.onSubmissionFinished(ctx, submission)
+	return ReportSubmissionResultOutput{
+		Submission: s.dbSubmissionToLogicSubmission(submission),
+	}, nil
+}
+
+// getReportedJudgeOutput computes the result and the score of a submission from the results of its test cases
+// reported by a remote worker, the same way as if the submission had been judged locally.
+// Test cases may only be left out after a failed one.
+func (s *submissionLogic) getReportedJudgeOutput(
+	ctx context.Context,
+	submission database.Submission,
+	judgedTestCaseResults []JudgedTestCaseResult,
+) (ojs.SubmissionResult, uint64, []database.SubmissionTestCaseResult, error) {
+	testCases, err := s.testCaseDataAccessor.GetProblemTestCaseListAll(ctx, submission.OfProblemID)
+	if err != nil {
+		s.logger.With(zap.Error(err)).Error("failed to get test cases")
+		return ojs.SubmissionResult_UndefinedResult, 0, nil, ErrInternal
+	}
+
+	testCaseGroups, err := s.testCaseGroupDataAccessor.GetProblemTestCaseGroupList(ctx, submission.OfProblemID)
+	if err != nil {
+		s.logger.With(zap.Error(err)).Error("failed to get test case groups")
+		return ojs.SubmissionResult_UndefinedResult, 0, nil, ErrInternal
+	}
+
+	testCaseIDToJudgedTestCaseResult := make(map[uint64]JudgedTestCaseResult)
+	for _, judgedTestCaseResult := range judgedTestCaseResults {
+		if _, ok := testCaseIDToJudgedTestCaseResult[judgedTestCaseResult.OfTestCaseID]; ok {
+			return ojs.SubmissionResult_UndefinedResult, 0, nil, ErrTestCaseResultListInvalid
+		}
+
+		testCaseIDToJudgedTestCaseResult[judgedTestCaseResult.OfTestCaseID] = judgedTestCaseResult
+	}
+
+	// The submission's result is the result of its first failed test case
+	result := ojs.SubmissionResult_OK
+	testCaseResults := make(map[uint64]ojs.SubmissionResult)
+	testCaseCredits := make(map[uint64]float64)
+	var submissionTestCaseResults []database.SubmissionTestCaseResult
+	for _, testCase := range testCases {
+		judgedTestCaseResult, ok := testCaseIDToJudgedTestCaseResult[testCase.ID]
+		if !ok {
+			if result == ojs.SubmissionResult_OK {
+				return ojs.SubmissionResult_UndefinedResult, 0, nil, ErrTestCaseResultListInvalid
+			}
+
+			continue
+		}
+
+		delete(testCaseIDToJudgedTestCaseResult, testCase.ID)
+		testCaseResults[testCase.ID] = judgedTestCaseResult.Result
+		testCaseCredits[testCase.ID] = judgedTestCaseResult.Credit
+		if judgedTestCaseResult.Result == ojs.SubmissionResult_OK {
+			testCaseCredits[testCase.ID] = 1
+		} else if result == ojs.SubmissionResult_OK {
+			result = judgedTestCaseResult.Result
+		}
+
+		submissionTestCaseResults = append(submissionTestCaseResults, database.SubmissionTestCaseResult{
+			OfSubmissionID: submission.ID,
+			OfTestCaseID:   testCase.ID,
+			Result:         int8(judgedTestCaseResult.Result),
+			WallTime:       judgedTestCaseResult.WallTime,
+			CPUTime:        judgedTestCaseResult.CPUTime,
+			Memory:         judgedTestCaseResult.Memory,
+			ExitCode:       judgedTestCaseResult.ExitCode,
+			Stdout:         utils.TruncateString(judgedTestCaseResult.Stdout, s.outputExcerptSizeInBytes),
+			Stderr:         utils.TruncateString(judgedTestCaseResult.Stderr, s.outputExcerptSizeInBytes),
+		})
+	}
+
+	// Results left over are of test cases not in the submission's problem
+	if len(testCaseIDToJudgedTestCaseResult) > 0 {
+		return ojs.SubmissionResult_UndefinedResult, 0, nil, ErrTestCaseResultListInvalid
+	}
+
+	if result != ojs.SubmissionResult_OK && len(testCaseGroups) == 0 {
+		return result, 0, submissionTestCaseResults, nil
+	}
+
+	return result, getScore(testCases, testCaseGroups, testCaseResults, testCaseCredits), submissionTestCaseResults, nil
+}
+
+// WatchSubmission implements SubmissionLogic.
+func (s *submissionLogic) WatchSubmission(ctx context.Context, in WatchSubmissionInput) (WatchSubmissionOutput, error) {
+	logger := s.logger.With(zap.Uint64("submission_id", in.ID))
+
+	requestingAccountID, _, requestingAccountRole, _, err := s.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return WatchSubmissionOutput{}, ErrTokenInvalid
+	}
+
+	// Subscribe before reading the submission, so that no update is missed in between
+	watchCtx, watchCancelFunc := context.WithCancel(ctx)
+	cacheSubmissionUpdates, err := s.submissionUpdatePubSub.Subscribe(watchCtx, in.ID)
+	if err != nil {
+		watchCancelFunc()
+		logger.With(zap.Error(err)).Error("failed to subscribe to submission updates")
+		return WatchSubmissionOutput{}, ErrInternal
+	}
+
+	submission, err := s.submissionDataAccessor.GetSubmissionByID(ctx, in.ID)
+	if err != nil {
+		watchCancelFunc()
+		if errors.Is(err, database.ErrSubmissionNotFound) {
+			return WatchSubmissionOutput{}, ErrSubmissionNotFound
+		}
+
+		logger.With(zap.Error(err)).Error("failed to get submission")
+		return WatchSubmissionOutput{}, ErrInternal
+	}
+
+	requiredPermissions := []gorbac.Permission{PermissionSubmissionsReadAll}
+	if submission.AuthorID == requestingAccountID {
+		requiredPermissions = append(requiredPermissions, PermissionSubmissionsReadSelf)
+	}
+
+	hasPermission, err := s.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(requestingAccountRole)], requiredPermissions...)
+	if err != nil {
+		watchCancelFunc()
+		logger.With(zap.Error(err)).Error("failed to check permission")
+		return WatchSubmissionOutput{}, ErrInternal
+	}
+	if !hasPermission {
+		watchCancelFunc()
+		return WatchSubmissionOutput{}, ErrPermissionDenied
+	}
+
+	submissionUpdates := make(chan SubmissionUpdate)
+	go func() {
+		defer watchCancelFunc()
+		defer close(submissionUpdates)
+
+		pollTicker := time.NewTicker(watchSubmissionPollInterval)
+		defer pollTicker.Stop()
+
+		update := SubmissionUpdate{
+			ID:     submission.ID,
+			Status: ojs.SubmissionStatus(submission.Status),
+			Result: ojs.SubmissionResult(submission.Result),
+		}
+		for {
+			select {
+			case submissionUpdates <- update:
+			case <-watchCtx.Done():
+				return
+			}
+
+			if update.Status == ojs.SubmissionStatus_Finished {
+				return
+			}
+
+			select {
+			case cacheSubmissionUpdate, ok := <-cacheSubmissionUpdates:
+				if !ok {
+					return
+				}
+
+				update = SubmissionUpdate{
+					ID:                  submission.ID,
+					Status:              ojs.SubmissionStatus(cacheSubmissionUpdate.Status),
+					Result:              ojs.SubmissionResult(cacheSubmissionUpdate.Result),
+					JudgedTestCaseCount: cacheSubmissionUpdate.JudgedTestCaseCount,
+					TestCaseCount:       cacheSubmissionUpdate.TestCaseCount,
+				}
+
+			case <-pollTicker.C:
+				// Updates are not guaranteed to be delivered, so the submission is read again in case the final
+				// one was missed
+				polledSubmission, err := s.submissionDataAccessor.GetSubmissionByID(watchCtx, submission.ID)
+				if err != nil {
+					logger.With(zap.Error(err)).Warn("failed to poll submission")
+					continue
+				}
+				if polledSubmission.Status != int8(ojs.SubmissionStatus_Finished) {
+					continue
+				}
+
+				update = SubmissionUpdate{
+					ID:     submission.ID,
+					Status: ojs.SubmissionStatus(polledSubmission.Status),
+					Result: ojs.SubmissionResult(polledSubmission.Result),
+				}
+
+			case <-watchCtx.Done():
+				return
+			}
+		}
+	}()
+
+	return WatchSubmissionOutput{
+		SubmissionUpdates: submissionUpdates,
+	}, nil
+}
+
+func (s *submissionLogic) publishSubmissionUpdate(ctx context.Context, submissionID uint64, update cache.SubmissionUpdate) {
+	err := s.submissionUpdatePubSub.Publish(ctx, submissionID, update)
+	if err != nil {
+		s.logger.With(zap.Uint64("submission_id", submissionID)).With(zap.Error(err)).Error("failed to publish submission update")
+	}
+}
+
 func (s *submissionLogic) dbSubmissionToLogicSubmission(dbSubmission database.Submission) Submission {
 	return Submission{
-		ID:          dbSubmission.ID,
-		OfProblemID: dbSubmission.OfProblemID,
-		AuthorID:    dbSubmission.AuthorID,
-		Content:     dbSubmission.Content,
-		Language:    dbSubmission.Language,
-		Status:      ojs.SubmissionStatus(dbSubmission.Status),
-		Result:      ojs.SubmissionResult(dbSubmission.Result),
+		ID:            dbSubmission.ID,
+		OfProblemID:   dbSubmission.OfProblemID,
+		AuthorID:      dbSubmission.AuthorID,
+		Content:       dbSubmission.Content,
+		Language:      dbSubmission.Language,
+		Status:        ojs.SubmissionStatus(dbSubmission.Status),
+		Result:        ojs.SubmissionResult(dbSubmission.Result),
+		CompileOutput: dbSubmission.CompileOutput,
+		OfContestID:   dbSubmission.OfContestID,
+		Score:         dbSubmission.Score,
+		CreatedAt:     dbSubmission.CreatedAt,
 	}
 }
 
+// canReadAllCompileOutputs returns true if the account role is allowed to see the compile output of
+// submissions it does not own. Authors can always see the compile output of their own submissions.
+func (s *submissionLogic) canReadAllCompileOutputs(ctx context.Context, accountRole int8) (bool, error) {
+	return s.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(accountRole)], PermissionCompileOutputsReadAll)
+}
+
 type CreateSubmissionInput struct {
 	Token       string
 	OfProblemID uint64
 	Content     string
 	Language    string
+	OfContestID uint64
 }
 
 type Submission struct {
-	ID          uint64
-	AuthorID    uint64
-	OfProblemID uint64
-	Content     string
-	Language    string
-	Status      ojs.SubmissionStatus
-	Result      ojs.SubmissionResult
+	ID            uint64
+	AuthorID      uint64
+	OfProblemID   uint64
+	Content       string
+	Language      string
+	Status        ojs.SubmissionStatus
+	Result        ojs.SubmissionResult
+	CompileOutput string
+	OfContestID   uint64
+	Score         uint64
+	CreatedAt     time.Time
 }
 
 type CreateSubmissionOutput struct {
@@ -456,7 +1338,90 @@ type GetProblemSubmissionListOutput struct {
 	TotalSubmissionsCount uint64
 }
 
+type SubmissionTestCaseResult struct {
+	ID             uint64
+	OfSubmissionID uint64
+	OfTestCaseID   uint64
+	Result         ojs.SubmissionResult
+	WallTime       uint64
+	CPUTime        uint64
+	Memory         uint64
+	ExitCode       int32
+	Stdout         string
+	Stderr         string
+}
+
+type GetSubmissionTestCaseResultListInput struct {
+	ID    uint64
+	Token string
+}
+
+type GetSubmissionTestCaseResultListOutput struct {
+	SubmissionTestCaseResults []SubmissionTestCaseResult
+}
+
 type ExecuteSubmissionInput struct {
 	ID    uint64
 	Token string
 }
+
+type SubmissionUpdate struct {
+	ID                  uint64
+	Status              ojs.SubmissionStatus
+	Result              ojs.SubmissionResult
+	JudgedTestCaseCount uint64
+	TestCaseCount       uint64
+}
+
+type GetAndUpdateFirstSubmittedSubmissionToExecutingInput struct {
+	Token string
+}
+
+type GetAndUpdateFirstSubmittedSubmissionToExecutingOutput struct {
+	Submission        Submission
+	Problem           Problem
+	TestCases         []TestCase
+	CheckerSource     string
+	InteractorSource  string
+	JudgingLeaseToken string
+}
+
+type RenewSubmissionJudgingLeaseInput struct {
+	Token             string
+	ID                uint64
+	JudgingLeaseToken string
+}
+
+type JudgedTestCaseResult struct {
+	OfTestCaseID uint64
+	Result       ojs.SubmissionResult
+	WallTime     uint64
+	CPUTime      uint64
+	Memory       uint64
+	ExitCode     int32
+	Stdout       string
+	Stderr       string
+	Credit       float64
+}
+
+type ReportSubmissionResultInput struct {
+	Token             string
+	ID                uint64
+	JudgingLeaseToken string
+	Result            ojs.SubmissionResult
+	CompileOutput     string
+	TestCaseResults   []JudgedTestCaseResult
+}
+
+type ReportSubmissionResultOutput struct {
+	Submission Submission
+}
+
+type WatchSubmissionInput struct {
+	ID    uint64
+	Token string
+}
+
+type WatchSubmissionOutput struct {
+	SubmissionUpdates <-chan SubmissionUpdate
+}
@@ -2,6 +2,7 @@ package logic
 
 import (
 	"context"
+	"errors"
 
 	"github.com/maxuanquang/ojs/internal/dataaccess/database"
 	"github.com/maxuanquang/ojs/internal/generated/grpc/ojs"
@@ -23,28 +24,31 @@ func NewTestCaseLogic(
 	problemDataAccessor database.ProblemDataAccessor,
 	submissionDataAccessor database.SubmissionDataAccessor,
 	testCaseDataAccessor database.TestCaseDataAccessor,
+	testCaseGroupDataAccessor database.TestCaseGroupDataAccessor,
 	tokenLogic TokenLogic,
 	roleLogic RoleLogic,
 ) TestCaseLogic {
 	return &testCaseLogic{
-		logger:                 logger,
-		accountDataAccessor:    accountDataAccessor,
-		problemDataAccessor:    problemDataAccessor,
-		submissionDataAccessor: submissionDataAccessor,
-		testCaseDataAccessor:   testCaseDataAccessor,
-		tokenLogic:             tokenLogic,
-		roleLogic:              roleLogic,
+		logger:                    logger,
+		accountDataAccessor:       accountDataAccessor,
+		problemDataAccessor:       problemDataAccessor,
+		submissionDataAccessor:    submissionDataAccessor,
+		testCaseDataAccessor:      testCaseDataAccessor,
+		testCaseGroupDataAccessor: testCaseGroupDataAccessor,
+		tokenLogic:                tokenLogic,
+		roleLogic:                 roleLogic,
 	}
 }
 
 type testCaseLogic struct {
-	logger                 *zap.Logger
-	accountDataAccessor    database.AccountDataAccessor
-	problemDataAccessor    database.ProblemDataAccessor
-	submissionDataAccessor database.SubmissionDataAccessor
-	testCaseDataAccessor   database.TestCaseDataAccessor
-	tokenLogic             TokenLogic
-	roleLogic              RoleLogic
+	logger                    *zap.Logger
+	accountDataAccessor       database.AccountDataAccessor
+	problemDataAccessor       database.ProblemDataAccessor
+	submissionDataAccessor    database.SubmissionDataAccessor
+	testCaseDataAccessor      database.TestCaseDataAccessor
+	testCaseGroupDataAccessor database.TestCaseGroupDataAccessor
+	tokenLogic                TokenLogic
+	roleLogic                 RoleLogic
 }
 
 func (t *testCaseLogic) CreateTestCase(ctx context.Context, in CreateTestCaseInput) (CreateTestCaseOutput, error) {
@@ -66,11 +70,17 @@ func (t *testCaseLogic) CreateTestCase(ctx context.Context, in CreateTestCaseInp
 		return CreateTestCaseOutput{}, ErrPermissionDenied
 	}
 
+	err = t.validateTestCaseGroup(ctx, in.OfTestCaseGroupID, in.OfProblemID)
+	if err != nil {
+		return CreateTestCaseOutput{}, err
+	}
+
 	createdTestCase, err := t.testCaseDataAccessor.CreateTestCase(ctx, database.TestCase{
-		OfProblemID: in.OfProblemID,
-		Input:       in.Input,
-		Output:      in.Output,
-		IsHidden:    in.IsHidden,
+		OfProblemID:       in.OfProblemID,
+		Input:             in.Input,
+		Output:            in.Output,
+		IsHidden:          in.IsHidden,
+		OfTestCaseGroupID: in.OfTestCaseGroupID,
 	})
 	if err != nil {
 		logger.Error("failed to create test case", zap.Error(err))
@@ -218,11 +228,17 @@ func (t *testCaseLogic) UpdateTestCase(ctx context.Context, in UpdateTestCaseInp
 		return UpdateTestCaseOutput{}, ErrPermissionDenied
 	}
 
+	err = t.validateTestCaseGroup(ctx, in.OfTestCaseGroupID, dbTestCase.OfProblemID)
+	if err != nil {
+		return UpdateTestCaseOutput{}, err
+	}
+
 	updatedDbTestCase, err := t.testCaseDataAccessor.UpdateTestCase(ctx, database.TestCase{
-		ID:       in.ID,
-		Input:    in.Input,
-		Output:   in.Output,
-		IsHidden: in.IsHidden,
+		ID:                in.ID,
+		Input:             in.Input,
+		Output:            in.Output,
+		IsHidden:          in.IsHidden,
+		OfTestCaseGroupID: in.OfTestCaseGroupID,
 	})
 	if err != nil {
 		logger.Error("failed to update test case", zap.Error(err))
@@ -287,29 +303,56 @@ func (t *testCaseLogic) DeleteTestCase(ctx context.Context, in DeleteTestCaseInp
 	return nil
 }
 
+// validateTestCaseGroup checks that a test case of the problem can be put into the group.
+// A group ID of 0 leaves the test case ungrouped.
+func (t *testCaseLogic) validateTestCaseGroup(ctx context.Context, testCaseGroupID, problemID uint64) error {
+	if testCaseGroupID == 0 {
+		return nil
+	}
+
+	testCaseGroup, err := t.testCaseGroupDataAccessor.GetTestCaseGroupByID(ctx, testCaseGroupID)
+	if err != nil {
+		if errors.Is(err, database.ErrTestCaseGroupNotFound) {
+			return ErrTestCaseGroupNotFound
+		}
+
+		t.logger.With(zap.Uint64("test_case_group_id", testCaseGroupID)).With(zap.Error(err)).Error("failed to get test case group")
+		return ErrInternal
+	}
+
+	if testCaseGroup.OfProblemID != problemID {
+		return ErrTestCaseGroupNotFound
+	}
+
+	return nil
+}
+
 func (t *testCaseLogic) dbTestCaseToLogicTestCase(dbTestCase database.TestCase) TestCase {
 	return TestCase{
-		ID:          dbTestCase.ID,
-		OfProblemID: dbTestCase.OfProblemID,
-		Input:       dbTestCase.Input,
-		Output:      dbTestCase.Output,
-		IsHidden:    dbTestCase.IsHidden,
+		ID:                dbTestCase.ID,
+		OfProblemID:       dbTestCase.OfProblemID,
+		Input:             dbTestCase.Input,
+		Output:            dbTestCase.Output,
+		IsHidden:          dbTestCase.IsHidden,
+		OfTestCaseGroupID: dbTestCase.OfTestCaseGroupID,
 	}
 }
 
 type TestCase struct {
-	ID          uint64
-	OfProblemID uint64
-	Input       string
-	Output      string
-	IsHidden    bool
+	ID                uint64
+	OfProblemID       uint64
+	Input             string
+	Output            string
+	IsHidden          bool
+	OfTestCaseGroupID uint64
 }
 type CreateTestCaseInput struct {
-	OfProblemID uint64
-	Input       string
-	Output      string
-	IsHidden    bool
-	Token       string
+	OfProblemID       uint64
+	Input             string
+	Output            string
+	IsHidden          bool
+	OfTestCaseGroupID uint64
+	Token             string
 }
 
 type CreateTestCaseOutput struct {
@@ -338,11 +381,12 @@ type GetProblemTestCaseListOutput struct {
 }
 
 type UpdateTestCaseInput struct {
-	ID       uint64
-	Input    string
-	Output   string
-	IsHidden bool
-	Token    string
+	ID                uint64
+	Input             string
+	Output            string
+	IsHidden          bool
+	OfTestCaseGroupID uint64
+	Token             string
 }
 
 type UpdateTestCaseOutput struct {
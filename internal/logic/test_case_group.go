@@ -0,0 +1,291 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"github.com/maxuanquang/ojs/internal/dataaccess/database"
+	"github.com/maxuanquang/ojs/internal/generated/grpc/ojs"
+	"github.com/mikespook/gorbac"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type TestCaseGroupLogic interface {
+	CreateTestCaseGroup(ctx context.Context, in CreateTestCaseGroupInput) (CreateTestCaseGroupOutput, error)
+	GetProblemTestCaseGroupList(ctx context.Context, in GetProblemTestCaseGroupListInput) (GetProblemTestCaseGroupListOutput, error)
+	UpdateTestCaseGroup(ctx context.Context, in UpdateTestCaseGroupInput) (UpdateTestCaseGroupOutput, error)
+	DeleteTestCaseGroup(ctx context.Context, in DeleteTestCaseGroupInput) error
+}
+
+func NewTestCaseGroupLogic(
+	logger *zap.Logger,
+	problemDataAccessor database.ProblemDataAccessor,
+	testCaseDataAccessor database.TestCaseDataAccessor,
+	testCaseGroupDataAccessor database.TestCaseGroupDataAccessor,
+	tokenLogic TokenLogic,
+	roleLogic RoleLogic,
+	database database.Database,
+) TestCaseGroupLogic {
+	return &testCaseGroupLogic{
+		logger:                    logger,
+		problemDataAccessor:       problemDataAccessor,
+		testCaseDataAccessor:      testCaseDataAccessor,
+		testCaseGroupDataAccessor: testCaseGroupDataAccessor,
+		tokenLogic:                tokenLogic,
+		roleLogic:                 roleLogic,
+		database:                  database,
+	}
+}
+
+type testCaseGroupLogic struct {
+	logger                    *zap.Logger
+	problemDataAccessor       database.ProblemDataAccessor
+	testCaseDataAccessor      database.TestCaseDataAccessor
+	testCaseGroupDataAccessor database.TestCaseGroupDataAccessor
+	tokenLogic                TokenLogic
+	roleLogic                 RoleLogic
+	database                  database.Database
+}
+
+// CreateTestCaseGroup implements TestCaseGroupLogic.
+func (t *testCaseGroupLogic) CreateTestCaseGroup(ctx context.Context, in CreateTestCaseGroupInput) (CreateTestCaseGroupOutput, error) {
+	logger := t.logger.With(zap.Uint64("problem_id", in.OfProblemID))
+
+	requestingAccountID, _, requestingAccountRole, _, err := t.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return CreateTestCaseGroupOutput{}, ErrTokenInvalid
+	}
+
+	err = t.checkProblemTestCasesWritable(ctx, in.OfProblemID, requestingAccountID, requestingAccountRole)
+	if err != nil {
+		return CreateTestCaseGroupOutput{}, err
+	}
+
+	createdTestCaseGroup, err := t.testCaseGroupDataAccessor.CreateTestCaseGroup(ctx, database.TestCaseGroup{
+		OfProblemID:   in.OfProblemID,
+		DisplayName:   in.DisplayName,
+		Points:        in.Points,
+		ScoringPolicy: int8(in.ScoringPolicy),
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create test case group")
+		return CreateTestCaseGroupOutput{}, ErrInternal
+	}
+
+	return CreateTestCaseGroupOutput{
+		TestCaseGroup: t.dbTestCaseGroupToLogicTestCaseGroup(createdTestCaseGroup),
+	}, nil
+}
+
+// GetProblemTestCaseGroupList implements TestCaseGroupLogic.
+func (t *testCaseGroupLogic) GetProblemTestCaseGroupList(ctx context.Context, in GetProblemTestCaseGroupListInput) (GetProblemTestCaseGroupListOutput, error) {
+	logger := t.logger.With(zap.Uint64("problem_id", in.OfProblemID))
+
+	_, _, requestingAccountRole, _, err := t.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return GetProblemTestCaseGroupListOutput{}, ErrTokenInvalid
+	}
+
+	// Groups only expose point values, so everyone who can read the problem can read them
+	hasPermission, err := t.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(requestingAccountRole)], PermissionProblemsReadAll)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to check permission")
+		return GetProblemTestCaseGroupListOutput{}, ErrInternal
+	}
+	if !hasPermission {
+		return GetProblemTestCaseGroupListOutput{}, ErrPermissionDenied
+	}
+
+	dbTestCaseGroups, err := t.testCaseGroupDataAccessor.GetProblemTestCaseGroupList(ctx, in.OfProblemID)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get test case group list")
+		return GetProblemTestCaseGroupListOutput{}, ErrInternal
+	}
+
+	var testCaseGroups []TestCaseGroup
+	for _, dbTestCaseGroup := range dbTestCaseGroups {
+		testCaseGroups = append(testCaseGroups, t.dbTestCaseGroupToLogicTestCaseGroup(dbTestCaseGroup))
+	}
+
+	return GetProblemTestCaseGroupListOutput{
+		TestCaseGroups: testCaseGroups,
+	}, nil
+}
+
+// UpdateTestCaseGroup implements TestCaseGroupLogic.
+func (t *testCaseGroupLogic) UpdateTestCaseGroup(ctx context.Context, in UpdateTestCaseGroupInput) (UpdateTestCaseGroupOutput, error) {
+	logger := t.logger.With(zap.Uint64("test_case_group_id", in.ID))
+
+	requestingAccountID, _, requestingAccountRole, _, err := t.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return UpdateTestCaseGroupOutput{}, ErrTokenInvalid
+	}
+
+	testCaseGroup, err := t.getTestCaseGroup(ctx, in.ID)
+	if err != nil {
+		return UpdateTestCaseGroupOutput{}, err
+	}
+
+	err = t.checkProblemTestCasesWritable(ctx, testCaseGroup.OfProblemID, requestingAccountID, requestingAccountRole)
+	if err != nil {
+		return UpdateTestCaseGroupOutput{}, err
+	}
+
+	updatedTestCaseGroup, err := t.testCaseGroupDataAccessor.UpdateTestCaseGroup(ctx, database.TestCaseGroup{
+		ID:            in.ID,
+		DisplayName:   in.DisplayName,
+		Points:        in.Points,
+		ScoringPolicy: int8(in.ScoringPolicy),
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update test case group")
+		return UpdateTestCaseGroupOutput{}, ErrInternal
+	}
+
+	return UpdateTestCaseGroupOutput{
+		TestCaseGroup: t.dbTestCaseGroupToLogicTestCaseGroup(updatedTestCaseGroup),
+	}, nil
+}
+
+// DeleteTestCaseGroup implements TestCaseGroupLogic.
+func (t *testCaseGroupLogic) DeleteTestCaseGroup(ctx context.Context, in DeleteTestCaseGroupInput) error {
+	logger := t.logger.With(zap.Uint64("test_case_group_id", in.ID))
+
+	requestingAccountID, _, requestingAccountRole, _, err := t.tokenLogic.VerifyTokenString(ctx, in.Token)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to verify token")
+		return ErrTokenInvalid
+	}
+
+	testCaseGroup, err := t.getTestCaseGroup(ctx, in.ID)
+	if err != nil {
+		return err
+	}
+
+	err = t.checkProblemTestCasesWritable(ctx, testCaseGroup.OfProblemID, requestingAccountID, requestingAccountRole)
+	if err != nil {
+		return err
+	}
+
+	// Test cases of the group are kept, they just no longer count towards the score
+	txErr := t.database.Transaction(func(tx *gorm.DB) error {
+		err := t.testCaseDataAccessor.WithDatabaseTransaction(tx).UngroupTestCaseList(ctx, in.ID)
+		if err != nil {
+			return err
+		}
+
+		return t.testCaseGroupDataAccessor.WithDatabaseTransaction(tx).DeleteTestCaseGroup(ctx, in.ID)
+	})
+	if txErr != nil {
+		if errors.Is(txErr, database.ErrTestCaseGroupNotFound) {
+			return ErrTestCaseGroupNotFound
+		}
+
+		logger.With(zap.Error(txErr)).Error("delete test case group transaction failed")
+		return ErrInternal
+	}
+
+	return nil
+}
+
+func (t *testCaseGroupLogic) getTestCaseGroup(ctx context.Context, id uint64) (database.TestCaseGroup, error) {
+	testCaseGroup, err := t.testCaseGroupDataAccessor.GetTestCaseGroupByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, database.ErrTestCaseGroupNotFound) {
+			return database.TestCaseGroup{}, ErrTestCaseGroupNotFound
+		}
+
+		t.logger.With(zap.Uint64("test_case_group_id", id)).With(zap.Error(err)).Error("failed to get test case group")
+		return database.TestCaseGroup{}, ErrInternal
+	}
+
+	return testCaseGroup, nil
+}
+
+func (t *testCaseGroupLogic) checkProblemTestCasesWritable(ctx context.Context, problemID, accountID uint64, accountRole int8) error {
+	problem, err := t.problemDataAccessor.GetProblemByID(ctx, problemID)
+	if err != nil {
+		if errors.Is(err, database.ErrProblemNotFound) {
+			return ErrProblemNotFound
+		}
+
+		t.logger.With(zap.Uint64("problem_id", problemID)).With(zap.Error(err)).Error("failed to get problem")
+		return ErrInternal
+	}
+
+	requiredPermissions := []gorbac.Permission{PermissionTestCasesWriteAll}
+	if problem.AuthorID == accountID {
+		requiredPermissions = append(requiredPermissions, PermissionTestCasesWriteSelf)
+	}
+
+	hasPermission, err := t.roleLogic.AccountHasPermission(ctx, ojs.Role_name[int32(accountRole)], requiredPermissions...)
+	if err != nil {
+		t.logger.With(zap.Error(err)).Error("failed to check permission")
+		return ErrInternal
+	}
+	if !hasPermission {
+		return ErrPermissionDenied
+	}
+
+	return nil
+}
+
+func (t *testCaseGroupLogic) dbTestCaseGroupToLogicTestCaseGroup(dbTestCaseGroup database.TestCaseGroup) TestCaseGroup {
+	return TestCaseGroup{
+		ID:            dbTestCaseGroup.ID,
+		OfProblemID:   dbTestCaseGroup.OfProblemID,
+		DisplayName:   dbTestCaseGroup.DisplayName,
+		Points:        dbTestCaseGroup.Points,
+		ScoringPolicy: ojs.TestCaseGroupScoringPolicy(dbTestCaseGroup.ScoringPolicy),
+	}
+}
+
+type TestCaseGroup struct {
+	ID            uint64
+	OfProblemID   uint64
+	DisplayName   string
+	Points        uint64
+	ScoringPolicy ojs.TestCaseGroupScoringPolicy
+}
+
+type CreateTestCaseGroupInput struct {
+	Token         string
+	OfProblemID   uint64
+	DisplayName   string
+	Points        uint64
+	ScoringPolicy ojs.TestCaseGroupScoringPolicy
+}
+
+type CreateTestCaseGroupOutput struct {
+	TestCaseGroup TestCaseGroup
+}
+
+type GetProblemTestCaseGroupListInput struct {
+	Token       string
+	OfProblemID uint64
+}
+
+type GetProblemTestCaseGroupListOutput struct {
+	TestCaseGroups []TestCaseGroup
+}
+
+type UpdateTestCaseGroupInput struct {
+	Token         string
+	ID            uint64
+	DisplayName   string
+	Points        uint64
+	ScoringPolicy ojs.TestCaseGroupScoringPolicy
+}
+
+type UpdateTestCaseGroupOutput struct {
+	TestCaseGroup TestCaseGroup
+}
+
+type DeleteTestCaseGroupInput struct {
+	Token string
+	ID    uint64
+}
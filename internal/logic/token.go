@@ -2,15 +2,12 @@ package logic
 
 import (
 	"context"
-	"crypto/rand"
-	"crypto/rsa"
-	"crypto/x509"
-	"encoding/pem"
 	"errors"
 	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
 	"github.com/maxuanquang/ojs/internal/configs"
 	"github.com/maxuanquang/ojs/internal/dataaccess/cache"
 	"github.com/maxuanquang/ojs/internal/dataaccess/database"
@@ -19,68 +16,129 @@ import (
 )
 
 type TokenLogic interface {
-	CreateTokenString(ctx context.Context, accountID uint64, accountName string, accountRole int8) (string, time.Time, error)
+	// CreateTokenString creates an access token of the session, identified by the returned token ID.
+	CreateTokenString(
+		ctx context.Context,
+		sessionID uint64,
+		accountID uint64,
+		accountName string,
+		accountRole int8,
+	) (token string, tokenID string, expiresAt time.Time, err error)
 	VerifyTokenString(ctx context.Context, token string) (accountId uint64, accountName string, accountRole int8, expiresAt time.Time, err error)
+	// VerifyTokenSession verifies the token like VerifyTokenString, and returns the session it was issued with.
+	VerifyTokenSession(ctx context.Context, token string) (sessionID uint64, tokenID string, accountID uint64, expiresAt time.Time, err error)
+	// RevokeToken rejects the token from now on, until it expires.
+	RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) error
 	WithDatabase(database database.Database) TokenLogic
 }
 
 func NewTokenLogic(
 	accountDataAccessor database.AccountDataAccessor,
-	tokenPublicKeyDataAccessor database.TokenPublicKeyDataAccessor,
+	sessionDataAccessor database.SessionDataAccessor,
+	tokenKeyLogic TokenKeyLogic,
 	logger *zap.Logger,
 	authConfig configs.Auth,
-	tokenPublicKeyCache cache.TokenPublicKey,
-) (TokenLogic, error) {
+	revokedTokenCache cache.RevokedToken,
+) TokenLogic {
+	return &tokenLogic{
+		accountDataAccessor: accountDataAccessor,
+		sessionDataAccessor: sessionDataAccessor,
+		tokenKeyLogic:       tokenKeyLogic,
+		logger:              logger,
+		authConfig:          authConfig,
+		revokedTokenCache:   revokedTokenCache,
+	}
+}
+
+type tokenLogic struct {
+	accountDataAccessor database.AccountDataAccessor
+	sessionDataAccessor database.SessionDataAccessor
+	tokenKeyLogic       TokenKeyLogic
+	logger              *zap.Logger
+	authConfig          configs.Auth
+	revokedTokenCache   cache.RevokedToken
+}
+
+// VerifyTokenString implements Token.
+func (t *tokenLogic) VerifyTokenString(ctx context.Context, tokenString string) (uint64, string, int8, time.Time, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
 
-	rsaKeyPair, err := generateRSAKeyPair(int(authConfig.Token.RS512KeyPairBitSize))
+	claims, err := t.verifyTokenClaims(ctx, tokenString)
 	if err != nil {
-		logger.Error("failed to genereate RSA key pair", zap.Error(err))
-		return nil, err
+		return 0, "", 0, time.Time{}, err
 	}
 
-	publicKeyBytes, err := pemEncodePublicKey(&rsaKeyPair.PublicKey)
-	if err != nil {
-		logger.Error("failed to encode public key in pem format", zap.Error(err))
-		return nil, err
+	accountID, ok := claims["sub"].(float64)
+	if !ok {
+		logger.Error("cannot get token's sub claim")
+		return 0, "", 0, time.Time{}, errors.New("cannot get token's sub claim")
 	}
 
-	tokenPublicKeyID, err := tokenPublicKeyDataAccessor.CreatePublicKey(
-		context.Background(),
-		database.TokenPublicKey{
-			TokenPublicKeyValue: publicKeyBytes,
-		})
-	if err != nil {
-		logger.Error("failed to create token public key", zap.Error(err))
-		return nil, err
+	accountName, ok := claims["name"].(string)
+	if !ok {
+		logger.Error("cannot get token's name claim")
+		return 0, "", 0, time.Time{}, errors.New("cannot get token's name claim")
+	}
+
+	accountRole, ok := claims["role"].(float64)
+	if !ok {
+		logger.Error("cannot get token's role claim")
+		return 0, "", 0, time.Time{}, errors.New("cannot get token's role claim")
+	}
+
+	expiresAtUnix, ok := claims["exp"].(float64)
+	if !ok {
+		logger.Error("cannot get token's exp claim")
+		return 0, "", 0, time.Time{}, errors.New("cannot get token's exp claim")
 	}
-	err = tokenPublicKeyCache.Set(context.Background(), fmt.Sprint(tokenPublicKeyID), publicKeyBytes)
+
+	return uint64(accountID), accountName, int8(accountRole), time.Unix(int64(expiresAtUnix), 0), nil
+
+}
+
+// VerifyTokenSession implements Token.
+func (t *tokenLogic) VerifyTokenSession(ctx context.Context, tokenString string) (uint64, string, uint64, time.Time, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	claims, err := t.verifyTokenClaims(ctx, tokenString)
 	if err != nil {
-		logger.With(zap.Error(err)).Warn("can not set token public key in cache")
+		return 0, "", 0, time.Time{}, err
 	}
 
-	return &tokenLogic{
-		accountDataAccessor:        accountDataAccessor,
-		tokenPublicKeyDataAccessor: tokenPublicKeyDataAccessor,
-		logger:                     logger,
-		authConfig:                 authConfig,
-		tokenPublicKeyID:           tokenPublicKeyID,
-		tokenPrivateKeyValue:       rsaKeyPair,
-		tokenPublicKeyCache:        tokenPublicKeyCache,
-	}, nil
+	// The session and token ID claims are known to be there once the token is verified
+	sessionID := claims["sid"].(float64)
+	tokenID := claims["jti"].(string)
+
+	accountID, ok := claims["sub"].(float64)
+	if !ok {
+		logger.Error("cannot get token's sub claim")
+		return 0, "", 0, time.Time{}, errors.New("cannot get token's sub claim")
+	}
+
+	expiresAtUnix, ok := claims["exp"].(float64)
+	if !ok {
+		logger.Error("cannot get token's exp claim")
+		return 0, "", 0, time.Time{}, errors.New("cannot get token's exp claim")
+	}
+
+	return uint64(sessionID), tokenID, uint64(accountID), time.Unix(int64(expiresAtUnix), 0), nil
 }
 
-type tokenLogic struct {
-	accountDataAccessor        database.AccountDataAccessor
-	tokenPublicKeyDataAccessor database.TokenPublicKeyDataAccessor
-	logger                     *zap.Logger
-	authConfig                 configs.Auth
-	tokenPublicKeyID           uint64
-	tokenPrivateKeyValue       *rsa.PrivateKey
-	tokenPublicKeyCache        cache.TokenPublicKey
+// RevokeToken implements Token.
+func (t *tokenLogic) RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.String("token_id", tokenID))
+
+	err := t.revokedTokenCache.Add(ctx, tokenID, expiresAt)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to add token to revoked tokens in cache")
+		return err
+	}
+
+	return nil
 }
 
-// VerifyTokenString implements Token.
-func (t *tokenLogic) VerifyTokenString(ctx context.Context, tokenString string) (uint64, string, int8, time.Time, error) {
+// verifyTokenClaims checks the signature, expiry and revocation of the token, and returns its claims.
+func (t *tokenLogic) verifyTokenClaims(ctx context.Context, tokenString string) (jwt.MapClaims, error) {
 	logger := utils.LoggerWithContext(ctx, t.logger)
 
 	keyFunc := func(parsedToken *jwt.Token) (interface{}, error) {
@@ -101,7 +159,7 @@ func (t *tokenLogic) VerifyTokenString(ctx context.Context, tokenString string)
 			return nil, errors.New("cannot get token's kid claim")
 		}
 
-		tokenPublicKeyValue, err := t.getJWTPublicKeyValue(ctx, uint64(tokenPublicKeyID))
+		tokenPublicKeyValue, err := t.tokenKeyLogic.GetPublicKey(ctx, uint64(tokenPublicKeyID))
 		if err != nil {
 			logger.Error("cannot get public key's value")
 			return nil, err
@@ -112,68 +170,81 @@ func (t *tokenLogic) VerifyTokenString(ctx context.Context, tokenString string)
 	parsedToken, err := jwt.Parse(tokenString, keyFunc)
 	if err != nil {
 		logger.Error("cannot parse token", zap.Error(err))
-		return 0, "", 0, time.Time{}, err
+		return nil, err
 	}
 
 	if !parsedToken.Valid {
 		logger.Error("invalid token")
-		return 0, "", 0, time.Time{}, errors.New("invalid token")
+		return nil, errors.New("invalid token")
 	}
 
 	claims, ok := parsedToken.Claims.(jwt.MapClaims)
 	if !ok {
 		logger.Error("cannot get token's claims")
-		return 0, "", 0, time.Time{}, errors.New("cannot get token's claims")
+		return nil, errors.New("cannot get token's claims")
 	}
 
-	accountID, ok := claims["sub"].(float64)
+	sessionID, ok := claims["sid"].(float64)
 	if !ok {
-		logger.Error("cannot get token's sub claim")
-		return 0, "", 0, time.Time{}, errors.New("cannot get token's sub claim")
+		logger.Error("cannot get token's sid claim")
+		return nil, errors.New("cannot get token's sid claim")
 	}
 
-	accountName, ok := claims["name"].(string)
+	tokenID, ok := claims["jti"].(string)
 	if !ok {
-		logger.Error("cannot get token's name claim")
-		return 0, "", 0, time.Time{}, errors.New("cannot get token's name claim")
+		logger.Error("cannot get token's jti claim")
+		return nil, errors.New("cannot get token's jti claim")
 	}
 
-	accountRole, ok := claims["role"].(float64)
-	if !ok {
-		logger.Error("cannot get token's role claim")
-		return 0, "", 0, time.Time{}, errors.New("cannot get token's role claim")
+	revoked, err := t.isTokenRevoked(ctx, uint64(sessionID), tokenID)
+	if err != nil {
+		return nil, err
 	}
-
-	expiresAtUnix, ok := claims["exp"].(float64)
-	if !ok {
-		logger.Error("cannot get token's exp claim")
-		return 0, "", 0, time.Time{}, errors.New("cannot get token's exp claim")
+	if revoked {
+		logger.With(zap.String("token_id", tokenID)).Info("token is revoked")
+		return nil, errors.New("token is revoked")
 	}
 
-	return uint64(accountID), accountName, int8(accountRole), time.Unix(int64(expiresAtUnix), 0), nil
-
+	return claims, nil
 }
 
 // CreateTokenString implements Token.
-func (t *tokenLogic) CreateTokenString(ctx context.Context, accountID uint64, accountName string, accountRole int8) (string, time.Time, error) {
+func (t *tokenLogic) CreateTokenString(
+	ctx context.Context,
+	sessionID uint64,
+	accountID uint64,
+	accountName string,
+	accountRole int8,
+) (string, string, time.Time, error) {
 	logger := utils.LoggerWithContext(ctx, t.logger)
 
+	tokenPublicKeyID, tokenPrivateKey, err := t.tokenKeyLogic.GetSigningKey(ctx)
+	if err != nil {
+		logger.Error("failed to get signing key", zap.Error(err))
+		return "", "", time.Time{}, err
+	}
+
+	tokenID := uuid.NewString()
 	expiresAt := time.Now().Add(t.authConfig.Token.GetTokenDuration())
 	token := jwt.NewWithClaims(jwt.SigningMethodRS512, jwt.MapClaims{
+		"jti":  tokenID,
+		"sid":  sessionID,
 		"sub":  accountID,
 		"name": accountName,
 		"role": accountRole,
 		"exp":  expiresAt.Unix(),
-		"kid":  t.tokenPublicKeyID,
+		"kid":  tokenPublicKeyID,
 	})
+	// The key ID is also set in the header, where other services verifying tokens with the JSON Web Key Set look for it
+	token.Header["kid"] = fmt.Sprint(tokenPublicKeyID)
 
-	tokenString, err := token.SignedString(t.tokenPrivateKeyValue)
+	tokenString, err := token.SignedString(tokenPrivateKey)
 	if err != nil {
 		logger.Error("failed signing token", zap.Error(err))
-		return "", time.Time{}, nil
+		return "", "", time.Time{}, err
 	}
 
-	return tokenString, expiresAt, nil
+	return tokenString, tokenID, expiresAt, nil
 }
 
 // WithDatabase implements Token.
@@ -181,52 +252,26 @@ func (t *tokenLogic) WithDatabase(database database.Database) TokenLogic {
 	panic("unimplemented")
 }
 
-func (t *tokenLogic) getJWTPublicKeyValue(ctx context.Context, tokenPublicKeyID uint64) (*rsa.PublicKey, error) {
-	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("tokenPublicKeyID", tokenPublicKeyID))
-
-	var tokenPublicKeyValue database.TokenPublicKey
-
-	cacheHit := true
-	bytes, err := t.tokenPublicKeyCache.Get(ctx, fmt.Sprintf("%d", tokenPublicKeyID))
-	if err != nil {
-		logger.With(zap.Error(err)).Warn("failed to get tokenPublicKeyValue from cache, will fall back to database")
-		cacheHit = false
-	} else {
-		tokenPublicKeyValue = database.TokenPublicKey{
-			TokenPublicKeyID:    tokenPublicKeyID,
-			TokenPublicKeyValue: bytes,
-		}
-	}
+// isTokenRevoked looks the token up in the revoked tokens in cache. If the cache cannot be read, the token is checked
+// against its session in database instead, where only the last token issued with an unrevoked session is valid.
+func (t *tokenLogic) isTokenRevoked(ctx context.Context, sessionID uint64, tokenID string) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("session_id", sessionID)).With(zap.String("token_id", tokenID))
 
-	if !cacheHit {
-		tokenPublicKeyValue, err = t.tokenPublicKeyDataAccessor.GetPublicKey(ctx, tokenPublicKeyID)
-		if err != nil {
-			logger.Error("cannot get token's public key from database", zap.Error(err))
-			return nil, err
-		}
+	revoked, err := t.revokedTokenCache.Has(ctx, tokenID)
+	if err == nil {
+		return revoked, nil
 	}
 
-	return jwt.ParseRSAPublicKeyFromPEM(tokenPublicKeyValue.TokenPublicKeyValue)
-}
-
-func pemEncodePublicKey(pubKey *rsa.PublicKey) ([]byte, error) {
-	pubBytes, err := x509.MarshalPKIXPublicKey(pubKey)
+	logger.With(zap.Error(err)).Warn("failed to get token from revoked tokens in cache, will fall back to database")
+	session, err := t.sessionDataAccessor.GetSessionByID(ctx, sessionID)
 	if err != nil {
-		return nil, err
-	}
-	block := &pem.Block{
-		Type:  "PUBLIC KEY",
-		Bytes: pubBytes,
-	}
-
-	return pem.EncodeToMemory(block), nil
-}
+		if errors.Is(err, database.ErrSessionNotFound) {
+			return true, nil
+		}
 
-func generateRSAKeyPair(bits int) (*rsa.PrivateKey, error) {
-	privateKeyPair, err := rsa.GenerateKey(rand.Reader, bits)
-	if err != nil {
-		return nil, err
+		logger.With(zap.Error(err)).Error("cannot get token's session from database")
+		return false, err
 	}
 
-	return privateKeyPair, nil
+	return session.RevokedAt != nil || session.AccessTokenID != tokenID, nil
 }
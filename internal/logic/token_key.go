@@ -0,0 +1,348 @@
+package logic
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/maxuanquang/ojs/internal/configs"
+	"github.com/maxuanquang/ojs/internal/dataaccess/cache"
+	"github.com/maxuanquang/ojs/internal/dataaccess/database"
+	"github.com/maxuanquang/ojs/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	// signingKeyReloadInterval is how long an instance keeps signing with a key before checking whether it was
+	// rotated, a retired key is therefore kept until the tokens signed meanwhile have expired too.
+	signingKeyReloadInterval = time.Minute
+	jsonWebKeyAlgorithm      = "RS512"
+)
+
+// TokenKeyLogic manages the keys signing tokens. They are stored in database with their private key encrypted, so
+// that every instance signs with the same key, and are shared as a JSON Web Key Set for other services to verify
+// tokens.
+type TokenKeyLogic interface {
+	// GetSigningKey returns the key to sign tokens with, creating the first one if there is none yet.
+	GetSigningKey(ctx context.Context) (tokenPublicKeyID uint64, privateKey *rsa.PrivateKey, err error)
+	GetPublicKey(ctx context.Context, tokenPublicKeyID uint64) (*rsa.PublicKey, error)
+	// RotateTokenKeys replaces the signing key once it is older than keyMaxAge, retiring the previous ones, and
+	// deletes the retired keys no valid token can be signed with anymore.
+	RotateTokenKeys(ctx context.Context, keyMaxAge time.Duration) error
+	GetJSONWebKeySet(ctx context.Context) (GetJSONWebKeySetOutput, error)
+}
+
+func NewTokenKeyLogic(
+	tokenPublicKeyDataAccessor database.TokenPublicKeyDataAccessor,
+	tokenPublicKeyCache cache.TokenPublicKey,
+	database database.Database,
+	authConfig configs.Auth,
+	logger *zap.Logger,
+) (TokenKeyLogic, error) {
+	keyEncryptionKey, err := authConfig.Token.GetKeyEncryptionKey()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get token key encryption key")
+		return nil, err
+	}
+
+	keyEncryptionCipher, err := aes.NewCipher(keyEncryptionKey)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("invalid token key encryption key, expect a base64 encoded AES-256 key")
+		return nil, err
+	}
+
+	keyEncryptionAEAD, err := cipher.NewGCM(keyEncryptionCipher)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create token key encryption cipher")
+		return nil, err
+	}
+
+	return &tokenKeyLogic{
+		tokenPublicKeyDataAccessor: tokenPublicKeyDataAccessor,
+		tokenPublicKeyCache:        tokenPublicKeyCache,
+		database:                   database,
+		authConfig:                 authConfig,
+		keyEncryptionAEAD:          keyEncryptionAEAD,
+		logger:                     logger,
+	}, nil
+}
+
+type tokenKeyLogic struct {
+	tokenPublicKeyDataAccessor database.TokenPublicKeyDataAccessor
+	tokenPublicKeyCache        cache.TokenPublicKey
+	database                   database.Database
+	authConfig                 configs.Auth
+	keyEncryptionAEAD          cipher.AEAD
+	logger                     *zap.Logger
+
+	signingKeyMutex    sync.Mutex
+	signingKeyID       uint64
+	signingKey         *rsa.PrivateKey
+	signingKeyLoadedAt time.Time
+}
+
+// GetSigningKey implements TokenKeyLogic.
+func (t *tokenKeyLogic) GetSigningKey(ctx context.Context) (uint64, *rsa.PrivateKey, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	t.signingKeyMutex.Lock()
+	defer t.signingKeyMutex.Unlock()
+
+	if t.signingKey != nil && time.Since(t.signingKeyLoadedAt) < signingKeyReloadInterval {
+		return t.signingKeyID, t.signingKey, nil
+	}
+
+	signingKeyID, signingKey, err := t.loadSigningKey(ctx)
+	if err != nil {
+		if t.signingKey == nil {
+			return 0, nil, err
+		}
+
+		// The key is only rotated now and then, so it is likely still the active one
+		logger.With(zap.Error(err)).Warn("failed to reload signing key, will keep the current one")
+		return t.signingKeyID, t.signingKey, nil
+	}
+
+	t.signingKeyID = signingKeyID
+	t.signingKey = signingKey
+	t.signingKeyLoadedAt = time.Now()
+	return t.signingKeyID, t.signingKey, nil
+}
+
+// GetPublicKey implements TokenKeyLogic.
+func (t *tokenKeyLogic) GetPublicKey(ctx context.Context, tokenPublicKeyID uint64) (*rsa.PublicKey, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("tokenPublicKeyID", tokenPublicKeyID))
+
+	var tokenPublicKeyValue database.TokenPublicKey
+
+	cacheHit := true
+	bytes, err := t.tokenPublicKeyCache.Get(ctx, fmt.Sprintf("%d", tokenPublicKeyID))
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to get tokenPublicKeyValue from cache, will fall back to database")
+		cacheHit = false
+	} else {
+		tokenPublicKeyValue = database.TokenPublicKey{
+			TokenPublicKeyID:    tokenPublicKeyID,
+			TokenPublicKeyValue: bytes,
+		}
+	}
+
+	if !cacheHit {
+		tokenPublicKeyValue, err = t.tokenPublicKeyDataAccessor.GetPublicKey(ctx, tokenPublicKeyID)
+		if err != nil {
+			logger.Error("cannot get token's public key from database", zap.Error(err))
+			return nil, err
+		}
+
+		err = t.tokenPublicKeyCache.Set(ctx, fmt.Sprint(tokenPublicKeyID), tokenPublicKeyValue.TokenPublicKeyValue)
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("can not set token public key in cache")
+		}
+	}
+
+	return jwt.ParseRSAPublicKeyFromPEM(tokenPublicKeyValue.TokenPublicKeyValue)
+}
+
+// RotateTokenKeys implements TokenKeyLogic.
+func (t *tokenKeyLogic) RotateTokenKeys(ctx context.Context, keyMaxAge time.Duration) error {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	activeTokenPublicKey, err := t.tokenPublicKeyDataAccessor.GetActivePublicKey(ctx)
+	if err != nil && !errors.Is(err, database.ErrTokenPublicKeyNotFound) {
+		return err
+	}
+
+	if err != nil || time.Since(activeTokenPublicKey.CreatedAt) >= keyMaxAge {
+		txErr := t.database.Transaction(func(tx *gorm.DB) error {
+			tokenPublicKeyDataAccessor := t.tokenPublicKeyDataAccessor.WithDatabaseTransaction(tx)
+			tokenPublicKeyID, _, err := t.createSigningKey(ctx, tokenPublicKeyDataAccessor)
+			if err != nil {
+				return err
+			}
+
+			return tokenPublicKeyDataAccessor.RetirePublicKeyList(ctx, tokenPublicKeyID)
+		})
+		if txErr != nil {
+			logger.With(zap.Error(txErr)).Error("rotate token key transaction failed")
+			return txErr
+		}
+
+		logger.Info("token signing key rotated")
+	}
+
+	// Instances keep signing with a retired key until they reload their signing key
+	retiredBefore := time.Now().Add(-t.authConfig.Token.GetTokenDuration() - signingKeyReloadInterval)
+	return t.tokenPublicKeyDataAccessor.DeleteRetiredPublicKeyList(ctx, retiredBefore)
+}
+
+// GetJSONWebKeySet implements TokenKeyLogic.
+func (t *tokenKeyLogic) GetJSONWebKeySet(ctx context.Context) (GetJSONWebKeySetOutput, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	tokenPublicKeyList, err := t.tokenPublicKeyDataAccessor.GetPublicKeyList(ctx)
+	if err != nil {
+		return GetJSONWebKeySetOutput{}, ErrInternal
+	}
+
+	keys := make([]JSONWebKey, 0, len(tokenPublicKeyList))
+	for _, tokenPublicKey := range tokenPublicKeyList {
+		publicKey, err := jwt.ParseRSAPublicKeyFromPEM(tokenPublicKey.TokenPublicKeyValue)
+		if err != nil {
+			logger.With(zap.Uint64("token_public_key_id", tokenPublicKey.TokenPublicKeyID)).With(zap.Error(err)).
+				Error("failed to parse token public key")
+			return GetJSONWebKeySetOutput{}, ErrInternal
+		}
+
+		keys = append(keys, JSONWebKey{
+			KeyID:     fmt.Sprint(tokenPublicKey.TokenPublicKeyID),
+			KeyType:   "RSA",
+			Algorithm: jsonWebKeyAlgorithm,
+			Use:       "sig",
+			Modulus:   base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+			Exponent:  base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+		})
+	}
+
+	return GetJSONWebKeySetOutput{Keys: keys}, nil
+}
+
+// loadSigningKey decrypts the active key, the first instance needing a key while there is none creates it.
+func (t *tokenKeyLogic) loadSigningKey(ctx context.Context) (uint64, *rsa.PrivateKey, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	activeTokenPublicKey, err := t.tokenPublicKeyDataAccessor.GetActivePublicKey(ctx)
+	if err != nil {
+		if errors.Is(err, database.ErrTokenPublicKeyNotFound) {
+			return t.createSigningKey(ctx, t.tokenPublicKeyDataAccessor)
+		}
+
+		return 0, nil, err
+	}
+
+	privateKey, err := t.decryptPrivateKey(activeTokenPublicKey.EncryptedTokenPrivateKeyValue)
+	if err != nil {
+		logger.With(zap.Uint64("token_public_key_id", activeTokenPublicKey.TokenPublicKeyID)).With(zap.Error(err)).
+			Error("failed to decrypt token private key")
+		return 0, nil, err
+	}
+
+	return activeTokenPublicKey.TokenPublicKeyID, privateKey, nil
+}
+
+func (t *tokenKeyLogic) createSigningKey(
+	ctx context.Context,
+	tokenPublicKeyDataAccessor database.TokenPublicKeyDataAccessor,
+) (uint64, *rsa.PrivateKey, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	rsaKeyPair, err := generateRSAKeyPair(int(t.authConfig.Token.RS512KeyPairBitSize))
+	if err != nil {
+		logger.Error("failed to genereate RSA key pair", zap.Error(err))
+		return 0, nil, err
+	}
+
+	publicKeyBytes, err := pemEncodePublicKey(&rsaKeyPair.PublicKey)
+	if err != nil {
+		logger.Error("failed to encode public key in pem format", zap.Error(err))
+		return 0, nil, err
+	}
+
+	encryptedPrivateKeyBytes, err := t.encryptPrivateKey(rsaKeyPair)
+	if err != nil {
+		logger.Error("failed to encrypt private key", zap.Error(err))
+		return 0, nil, err
+	}
+
+	tokenPublicKeyID, err := tokenPublicKeyDataAccessor.CreatePublicKey(ctx, database.TokenPublicKey{
+		TokenPublicKeyValue:           publicKeyBytes,
+		EncryptedTokenPrivateKeyValue: encryptedPrivateKeyBytes,
+	})
+	if err != nil {
+		logger.Error("failed to create token public key", zap.Error(err))
+		return 0, nil, err
+	}
+
+	err = t.tokenPublicKeyCache.Set(ctx, fmt.Sprint(tokenPublicKeyID), publicKeyBytes)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("can not set token public key in cache")
+	}
+
+	return tokenPublicKeyID, rsaKeyPair, nil
+}
+
+// encryptPrivateKey encrypts the private key with AES-GCM, prefixing the result with the random nonce.
+func (t *tokenKeyLogic) encryptPrivateKey(privateKey *rsa.PrivateKey) ([]byte, error) {
+	nonce := make([]byte, t.keyEncryptionAEAD.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return t.keyEncryptionAEAD.Seal(nonce, nonce, x509.MarshalPKCS1PrivateKey(privateKey), nil), nil
+}
+
+func (t *tokenKeyLogic) decryptPrivateKey(encryptedPrivateKeyBytes []byte) (*rsa.PrivateKey, error) {
+	nonceSize := t.keyEncryptionAEAD.NonceSize()
+	if len(encryptedPrivateKeyBytes) < nonceSize {
+		return nil, errors.New("encrypted private key is too short")
+	}
+
+	privateKeyBytes, err := t.keyEncryptionAEAD.Open(
+		nil,
+		encryptedPrivateKeyBytes[:nonceSize],
+		encryptedPrivateKeyBytes[nonceSize:],
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return x509.ParsePKCS1PrivateKey(privateKeyBytes)
+}
+
+// JSONWebKey is a public key verifying tokens, in the JSON Web Key format of RFC 7517.
+type JSONWebKey struct {
+	KeyID     string
+	KeyType   string
+	Algorithm string
+	Use       string
+	Modulus   string
+	Exponent  string
+}
+
+type GetJSONWebKeySetOutput struct {
+	Keys []JSONWebKey
+}
+
+func pemEncodePublicKey(pubKey *rsa.PublicKey) ([]byte, error) {
+	pubBytes, err := x509.MarshalPKIXPublicKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	block := &pem.Block{
+		Type:  "PUBLIC KEY",
+		Bytes: pubBytes,
+	}
+
+	return pem.EncodeToMemory(block), nil
+}
+
+func generateRSAKeyPair(bits int) (*rsa.PrivateKey, error) {
+	privateKeyPair, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		return nil, err
+	}
+
+	return privateKeyPair, nil
+}
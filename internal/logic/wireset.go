@@ -6,10 +6,18 @@ var WireSet = wire.NewSet(
 	NewAccountLogic,
 	NewHashLogic,
 	NewTokenLogic,
+	NewTokenKeyLogic,
 	NewProblemLogic,
 	NewSubmissionLogic,
 	NewTestCaseLogic,
+	NewTestCaseGroupLogic,
 	NewJudgeLogic,
 	NewCompileLogic,
 	NewRoleLogic,
+	NewContestLogic,
+	NewScoreboardLogic,
+	NewWorkspaceLogic,
+	NewOutboxLogic,
+	NewDeadLetteredSubmissionLogic,
+	NewRejudgeLogic,
 )
@@ -0,0 +1,77 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/maxuanquang/ojs/internal/sandbox"
+	"go.uber.org/zap"
+)
+
+// Workspace is the directory a submission is judged in. It is shared by the compilation of the submission
+// and all of its executions.
+type Workspace interface {
+	GetDir() string
+	// Remove removes the workspace once judging is done, errors are only logged.
+	Remove(ctx context.Context)
+}
+
+type WorkspaceLogic interface {
+	CreateWorkspace(ctx context.Context) (Workspace, error)
+	PurgeStaleWorkspaces(ctx context.Context, maxAge time.Duration) error
+}
+
+func NewWorkspaceLogic(sandbox sandbox.Sandbox, logger *zap.Logger) WorkspaceLogic {
+	return &workspaceLogic{
+		sandbox: sandbox,
+		logger:  logger,
+	}
+}
+
+type workspaceLogic struct {
+	sandbox sandbox.Sandbox
+	logger  *zap.Logger
+}
+
+// CreateWorkspace implements WorkspaceLogic.
+func (w *workspaceLogic) CreateWorkspace(ctx context.Context) (Workspace, error) {
+	dir, err := w.sandbox.PrepareWorkspace(ctx)
+	if err != nil {
+		w.logger.With(zap.Error(err)).Error("failed to prepare workspace")
+		return nil, err
+	}
+
+	return &workspace{
+		sandbox: w.sandbox,
+		logger:  w.logger.With(zap.String("workspace", dir)),
+		dir:     dir,
+	}, nil
+}
+
+// PurgeStaleWorkspaces implements WorkspaceLogic.
+func (w *workspaceLogic) PurgeStaleWorkspaces(ctx context.Context, maxAge time.Duration) error {
+	if err := w.sandbox.PurgeWorkspaces(ctx, maxAge); err != nil {
+		w.logger.With(zap.Error(err)).Error("failed to purge stale workspaces")
+		return err
+	}
+
+	return nil
+}
+
+type workspace struct {
+	sandbox sandbox.Sandbox
+	logger  *zap.Logger
+	dir     string
+}
+
+// GetDir implements Workspace.
+func (w *workspace) GetDir() string {
+	return w.dir
+}
+
+// Remove implements Workspace.
+func (w *workspace) Remove(ctx context.Context) {
+	if err := w.sandbox.RemoveWorkspace(ctx, w.dir); err != nil {
+		w.logger.With(zap.Error(err)).Warn("failed to remove workspace")
+	}
+}
@@ -0,0 +1,52 @@
+package sandbox
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	cgroupCPUStatFileName    = "cpu.stat"
+	cgroupCPUUsageKey        = "usage_usec"
+	cgroupMemoryPeakFileName = "memory.peak"
+)
+
+// readCgroupUsage returns the CPU time and the peak memory usage of a cgroup v2. Both include the usage of
+// the cgroup's descendants, even after they have been removed.
+func readCgroupUsage(cgroupDir string) (time.Duration, uint64, error) {
+	cpuStat, err := os.ReadFile(filepath.Join(cgroupDir, cgroupCPUStatFileName))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var cpuTime time.Duration
+	for _, line := range strings.Split(string(cpuStat), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 || fields[0] != cgroupCPUUsageKey {
+			continue
+		}
+
+		usageInMicroseconds, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to parse %s: %w", cgroupCPUStatFileName, err)
+		}
+
+		cpuTime = time.Duration(usageInMicroseconds) * time.Microsecond
+	}
+
+	memoryPeak, err := os.ReadFile(filepath.Join(cgroupDir, cgroupMemoryPeakFileName))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	peakMemoryInBytes, err := strconv.ParseUint(strings.TrimSpace(string(memoryPeak)), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to parse %s: %w", cgroupMemoryPeakFileName, err)
+	}
+
+	return cpuTime, peakMemoryInBytes, nil
+}
@@ -0,0 +1,323 @@
+package sandbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	dockerimage "github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/google/uuid"
+	"github.com/maxuanquang/ojs/internal/configs"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultCPUPeriod = 100000
+	// statsSampleInterval is how often the usage of a container is sampled when it cannot be read from its cgroup
+	statsSampleInterval = 100 * time.Millisecond
+)
+
+func NewDockerSandbox(dockerSandboxConfig configs.DockerSandbox, dockerClient *client.Client, logger *zap.Logger) Sandbox {
+	return &dockerSandbox{
+		config:       dockerSandboxConfig,
+		dockerClient: dockerClient,
+		logger:       logger,
+	}
+}
+
+type dockerSandbox struct {
+	config       configs.DockerSandbox
+	dockerClient *client.Client
+	logger       *zap.Logger
+}
+
+// PrepareImage implements Sandbox.
+func (d *dockerSandbox) PrepareImage(ctx context.Context, image string) error {
+	logger := d.logger.With(zap.String("image", image))
+	logger.Info("pulling image")
+	pullResponse, err := d.dockerClient.ImagePull(ctx, image, dockerimage.PullOptions{})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to pull image")
+		return err
+	}
+
+	defer pullResponse.Close()
+
+	// The image is only pulled as its progress is read
+	_, err = io.Copy(io.Discard, pullResponse)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to pull image")
+		return err
+	}
+
+	logger.Info("image pulled successfully")
+	return nil
+}
+
+// PrepareWorkspace implements Sandbox.
+func (d *dockerSandbox) PrepareWorkspace(_ context.Context) (string, error) {
+	return prepareWorkspace(d.logger)
+}
+
+// RemoveWorkspace implements Sandbox.
+func (d *dockerSandbox) RemoveWorkspace(_ context.Context, workspace string) error {
+	return removeWorkspace(d.logger, workspace)
+}
+
+// PurgeWorkspaces implements Sandbox.
+func (d *dockerSandbox) PurgeWorkspaces(_ context.Context, maxAge time.Duration) error {
+	return purgeWorkspaces(d.logger, maxAge)
+}
+
+// Start implements Sandbox.
+func (d *dockerSandbox) Start(ctx context.Context, command Command) (Process, error) {
+	logger := d.logger.With(zap.Strings("args", command.Args))
+	stdout, stderr := getOutputWriters(command)
+
+	// The container's own cgroup is removed as soon as it exits, so its usage is read from a parent cgroup
+	// that is left behind. Without one, its usage is sampled from its stats while it runs.
+	var cgroupParent, cgroupDir string
+	if d.config.CgroupParent != "" {
+		cgroupParent = path.Join(d.config.CgroupParent, uuid.NewString())
+		cgroupDir = filepath.Join(d.config.CgroupMountPath, cgroupParent)
+	}
+
+	containerCreateResponse, err := d.dockerClient.ContainerCreate(
+		ctx,
+		&container.Config{
+			Image:        command.Image,
+			WorkingDir:   command.WorkingDir,
+			Cmd:          command.Args,
+			AttachStdin:  command.Stdin != nil,
+			AttachStdout: true,
+			AttachStderr: true,
+			OpenStdin:    command.Stdin != nil,
+			StdinOnce:    command.Stdin != nil,
+		},
+		&container.HostConfig{
+			Binds:       []string{fmt.Sprintf("%s:%s", command.WorkingDir, command.WorkingDir)},
+			NetworkMode: "none",
+			Resources: container.Resources{
+				CgroupParent: cgroupParent,
+				CPUPeriod:    defaultCPUPeriod,
+				CPUQuota:     int64(command.CPUs * defaultCPUPeriod),
+				CpusetCpus:   command.CPUSet,
+				Memory:       int64(command.MemoryLimitInBytes),
+			},
+		},
+		nil,
+		nil,
+		"",
+	)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create container")
+		return nil, err
+	}
+
+	process := &dockerProcess{
+		dockerClient: d.dockerClient,
+		logger:       logger.With(zap.String("container_id", containerCreateResponse.ID)),
+		containerID:  containerCreateResponse.ID,
+		cgroupDir:    cgroupDir,
+		outputDone:   make(chan struct{}),
+	}
+
+	process.attachResponse, err = d.dockerClient.ContainerAttach(
+		ctx,
+		process.containerID,
+		container.AttachOptions{
+			Stream: true,
+			Stdin:  command.Stdin != nil,
+			Stdout: true,
+			Stderr: true,
+		},
+	)
+	if err != nil {
+		process.logger.With(zap.Error(err)).Error("failed to attach container")
+		process.remove()
+		return nil, err
+	}
+
+	// Output is copied from the start, as a container blocks once the buffer of its attached connection is full
+	go process.copyOutput(stdout, stderr)
+	if command.Stdin != nil {
+		go process.copyInput(command.Stdin)
+	}
+
+	err = d.dockerClient.ContainerStart(ctx, process.containerID, container.StartOptions{})
+	if err != nil {
+		process.logger.With(zap.Error(err)).Error("failed to start container")
+		process.attachResponse.Close()
+		process.remove()
+		return nil, err
+	}
+
+	process.startedAt = time.Now()
+	if cgroupDir == "" {
+		process.statsStop = make(chan struct{})
+		process.statsDone = make(chan struct{})
+		go process.sampleStats()
+	}
+
+	return process, nil
+}
+
+type dockerProcess struct {
+	dockerClient   *client.Client
+	logger         *zap.Logger
+	containerID    string
+	cgroupDir      string
+	attachResponse types.HijackedResponse
+	outputDone     chan struct{}
+	startedAt      time.Time
+
+	statsStop              chan struct{}
+	statsDone              chan struct{}
+	statsStopOnce          sync.Once
+	sampledCPUTime         time.Duration
+	sampledPeakMemoryBytes uint64
+}
+
+// Wait implements Process.
+func (d *dockerProcess) Wait(ctx context.Context) (Result, error) {
+	defer d.remove()
+	defer d.attachResponse.Close()
+	defer d.stopSamplingStats()
+
+	dataChan, errChan := d.dockerClient.ContainerWait(ctx, d.containerID, container.WaitConditionNotRunning)
+	select {
+	case err := <-errChan:
+		d.logger.With(zap.Error(err)).Error("failed to wait for container")
+		return Result{}, err
+	case <-ctx.Done():
+		return Result{}, ctx.Err()
+	case data := <-dataChan:
+		result := Result{
+			ExitCode: int(data.StatusCode),
+			WallTime: time.Since(d.startedAt),
+		}
+
+		<-d.outputDone
+		if d.cgroupDir != "" {
+			cpuTime, peakMemoryInBytes, err := readCgroupUsage(d.cgroupDir)
+			if err != nil {
+				d.logger.With(zap.Error(err)).Warn("failed to read resource usage of container")
+			}
+
+			result.CPUTime = cpuTime
+			result.PeakMemoryInBytes = peakMemoryInBytes
+		} else {
+			d.stopSamplingStats()
+			result.CPUTime = d.sampledCPUTime
+			result.PeakMemoryInBytes = d.sampledPeakMemoryBytes
+		}
+
+		return result, nil
+	}
+}
+
+// sampleStats samples the usage of the container until it is stopped. The usage since the last sample is missed,
+// so it is less precise than the usage read from a cgroup.
+func (d *dockerProcess) sampleStats() {
+	defer close(d.statsDone)
+
+	ticker := time.NewTicker(statsSampleInterval)
+	defer ticker.Stop()
+
+	for {
+		d.sampleStatsOnce()
+
+		select {
+		case <-d.statsStop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *dockerProcess) sampleStatsOnce() {
+	containerStats, err := d.dockerClient.ContainerStatsOneShot(context.Background(), d.containerID)
+	if err != nil {
+		d.logger.With(zap.Error(err)).Debug("failed to sample stats of container")
+		return
+	}
+
+	defer containerStats.Body.Close()
+
+	var stats types.StatsJSON
+	if err = json.NewDecoder(containerStats.Body).Decode(&stats); err != nil {
+		d.logger.With(zap.Error(err)).Debug("failed to decode stats of container")
+		return
+	}
+
+	// A container that exited reports no usage, so only higher samples are kept
+	if cpuTime := time.Duration(stats.CPUStats.CPUUsage.TotalUsage); cpuTime > d.sampledCPUTime {
+		d.sampledCPUTime = cpuTime
+	}
+	for _, memoryBytes := range []uint64{stats.MemoryStats.Usage, stats.MemoryStats.MaxUsage} {
+		if memoryBytes > d.sampledPeakMemoryBytes {
+			d.sampledPeakMemoryBytes = memoryBytes
+		}
+	}
+}
+
+// stopSamplingStats stops sampling the usage of the container, once the last sample is taken.
+func (d *dockerProcess) stopSamplingStats() {
+	if d.statsStop == nil {
+		return
+	}
+
+	d.statsStopOnce.Do(func() {
+		close(d.statsStop)
+		<-d.statsDone
+	})
+}
+
+func (d *dockerProcess) copyOutput(stdout, stderr io.Writer) {
+	defer close(d.outputDone)
+
+	_, err := stdcopy.StdCopy(stdout, stderr, d.attachResponse.Reader)
+	if err != nil {
+		d.logger.With(zap.Error(err)).Warn("failed to copy output of container")
+	}
+}
+
+func (d *dockerProcess) copyInput(stdin io.Reader) {
+	_, err := io.Copy(d.attachResponse.Conn, stdin)
+	if err != nil && !errors.Is(err, io.ErrClosedPipe) {
+		d.logger.With(zap.Error(err)).Warn("failed to copy input to container")
+	}
+
+	err = d.attachResponse.CloseWrite()
+	if err != nil {
+		d.logger.With(zap.Error(err)).Warn("failed to close stdin of container")
+	}
+}
+
+// remove removes the container, killing it if it is still running.
+func (d *dockerProcess) remove() {
+	err := d.dockerClient.ContainerRemove(context.Background(), d.containerID, container.RemoveOptions{Force: true})
+	if err != nil {
+		d.logger.With(zap.Error(err)).Error("failed to remove container")
+	}
+
+	if d.cgroupDir == "" {
+		return
+	}
+
+	// The parent cgroup is created by the container runtime, but never removed by it
+	if err := os.Remove(d.cgroupDir); err != nil && !errors.Is(err, os.ErrNotExist) {
+		d.logger.With(zap.String("cgroup_dir", d.cgroupDir)).With(zap.Error(err)).Warn("failed to remove cgroup")
+	}
+}
@@ -0,0 +1,354 @@
+//go:build linux
+
+package sandbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	nativeSandboxHostname     = "sandbox"
+	nativeSandboxPath         = "PATH=/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin"
+	nativeSandboxHome         = "HOME=/tmp"
+	nativeSandboxOldRootDir   = ".old-root"
+	nativeSandboxMaxOpenFiles = 256
+	nativeSandboxMaxFileSize  = 256 << 20
+	nativeSandboxInitExitCode = 127
+	seccompDataNrOffset       = 0
+	seccompDataArchOffset     = 4
+	// seccompDataArg0Offset is where the low 32 bits of the first argument are, both supported architectures being
+	// little-endian.
+	seccompDataArg0Offset      = 16
+	x32SyscallBit              = 0x40000000
+	modeOwnerReadWriteAllRead  = 0644
+	modeAllReadWriteExecSticky = 01777
+)
+
+var (
+	// deniedSyscalls are never needed by contestant programs, compilers or runtimes,
+	// but could be used to escape the sandbox or to attack the host.
+	deniedSyscalls = []uintptr{
+		unix.SYS_ADD_KEY,
+		unix.SYS_BPF,
+		unix.SYS_DELETE_MODULE,
+		unix.SYS_FINIT_MODULE,
+		unix.SYS_FSCONFIG,
+		unix.SYS_FSMOUNT,
+		unix.SYS_FSOPEN,
+		unix.SYS_FSPICK,
+		unix.SYS_INIT_MODULE,
+		unix.SYS_IO_URING_ENTER,
+		unix.SYS_IO_URING_REGISTER,
+		unix.SYS_IO_URING_SETUP,
+		unix.SYS_KEXEC_LOAD,
+		unix.SYS_KEYCTL,
+		unix.SYS_MOUNT,
+		unix.SYS_MOUNT_SETATTR,
+		unix.SYS_MOVE_MOUNT,
+		unix.SYS_NAME_TO_HANDLE_AT,
+		unix.SYS_OPEN_BY_HANDLE_AT,
+		unix.SYS_OPEN_TREE,
+		unix.SYS_PERF_EVENT_OPEN,
+		unix.SYS_PIVOT_ROOT,
+		unix.SYS_PROCESS_VM_READV,
+		unix.SYS_PROCESS_VM_WRITEV,
+		unix.SYS_PTRACE,
+		unix.SYS_REBOOT,
+		unix.SYS_REQUEST_KEY,
+		unix.SYS_SETNS,
+		unix.SYS_SWAPOFF,
+		unix.SYS_SWAPON,
+		unix.SYS_UMOUNT2,
+		unix.SYS_UNSHARE,
+		unix.SYS_USERFAULTFD,
+	}
+
+	// unsupportedSyscalls fail as if the kernel did not have them, so that libraries fall back to older syscalls
+	// that can be filtered. clone3 passes its flags in memory, which seccomp filters can not read, and is replaced
+	// by clone, whose flags are filtered.
+	unsupportedSyscalls = []uintptr{
+		unix.SYS_CLONE3,
+	}
+
+	// deniedCloneFlags create new namespaces, from which the restrictions of the sandbox could be undone.
+	deniedCloneFlags uint32 = unix.CLONE_NEWCGROUP | unix.CLONE_NEWIPC | unix.CLONE_NEWNET | unix.CLONE_NEWNS |
+		unix.CLONE_NEWPID | unix.CLONE_NEWUSER | unix.CLONE_NEWUTS
+
+	// deviceFiles are bind mounted from the host, as creating device nodes is not allowed in the sandbox.
+	deviceFiles = []string{"/dev/null", "/dev/random", "/dev/urandom", "/dev/zero"}
+)
+
+// nativeSandboxInitSpec is passed from the sandbox to the sandbox-init command through the environment.
+type nativeSandboxInitSpec struct {
+	Args          []string `json:"args"`
+	WorkingDir    string   `json:"working_dir"`
+	ReadOnlyPaths []string `json:"read_only_paths"`
+	UID           int      `json:"uid"`
+	GID           int      `json:"gid"`
+}
+
+// RunNativeSandboxInit is the entrypoint of the sandbox-init command. It runs as the init process of the namespaces
+// created by the native sandbox, isolates itself further and then executes the command. It never returns.
+func RunNativeSandboxInit() {
+	err := runNativeSandboxInit()
+	fmt.Fprintf(os.Stderr, "sandbox init failed: %s\n", err)
+	os.Exit(nativeSandboxInitExitCode)
+}
+
+func runNativeSandboxInit() error {
+	// Seccomp filters and no_new_privs only apply to the calling thread, which has to be the one executing the command
+	runtime.LockOSThread()
+
+	var spec nativeSandboxInitSpec
+	if err := json.Unmarshal([]byte(os.Getenv(nativeSandboxInitEnv)), &spec); err != nil {
+		return fmt.Errorf("failed to parse sandbox init spec: %w", err)
+	}
+
+	if len(spec.Args) == 0 {
+		return errors.New("no command to execute")
+	}
+
+	if err := setUpRootFilesystem(spec); err != nil {
+		return fmt.Errorf("failed to set up root filesystem: %w", err)
+	}
+
+	if err := unix.Sethostname([]byte(nativeSandboxHostname)); err != nil {
+		return fmt.Errorf("failed to set hostname: %w", err)
+	}
+
+	if err := setResourceLimits(); err != nil {
+		return fmt.Errorf("failed to set resource limits: %w", err)
+	}
+
+	if err := os.Chdir(spec.WorkingDir); err != nil {
+		return fmt.Errorf("failed to change to working dir: %w", err)
+	}
+
+	// The path is looked up before dropping privileges and before syscalls are filtered
+	programPath, err := exec.LookPath(spec.Args[0])
+	if err != nil {
+		return fmt.Errorf("failed to find program: %w", err)
+	}
+
+	if err := dropPrivileges(spec.UID, spec.GID); err != nil {
+		return fmt.Errorf("failed to drop privileges: %w", err)
+	}
+
+	if err := installSeccompFilter(); err != nil {
+		return fmt.Errorf("failed to install seccomp filter: %w", err)
+	}
+
+	return syscall.Exec(programPath, spec.Args, []string{nativeSandboxPath, nativeSandboxHome})
+}
+
+// setUpRootFilesystem pivots into a new root, made of a tmpfs with the read-only paths and the working dir
+// bind mounted into it.
+func setUpRootFilesystem(spec nativeSandboxInitSpec) error {
+	// Mounts made from here on must not propagate back to the host
+	if err := unix.Mount("", "/", "", unix.MS_REC|unix.MS_PRIVATE, ""); err != nil {
+		return err
+	}
+
+	newRoot := nativeSandboxRootDir
+	if err := unix.Mount("tmpfs", newRoot, "tmpfs", unix.MS_NOSUID|unix.MS_NODEV, "mode=0755"); err != nil {
+		return err
+	}
+
+	for _, path := range spec.ReadOnlyPaths {
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+
+		if err := bindMount(path, filepath.Join(newRoot, path), true); err != nil {
+			return err
+		}
+	}
+
+	tmpDir := filepath.Join(newRoot, "tmp")
+	if err := os.MkdirAll(tmpDir, modeAllReadWriteExecSticky); err != nil {
+		return err
+	}
+
+	if err := unix.Mount("tmpfs", tmpDir, "tmpfs", unix.MS_NOSUID|unix.MS_NODEV, "mode=1777"); err != nil {
+		return err
+	}
+
+	if err := bindMount(spec.WorkingDir, filepath.Join(newRoot, spec.WorkingDir), false); err != nil {
+		return err
+	}
+
+	for _, deviceFile := range deviceFiles {
+		if err := bindMount(deviceFile, filepath.Join(newRoot, deviceFile), false); err != nil {
+			return err
+		}
+	}
+
+	procDir := filepath.Join(newRoot, "proc")
+	if err := os.MkdirAll(procDir, modeAllReadExecute); err != nil {
+		return err
+	}
+
+	if err := unix.Mount("proc", procDir, "proc", unix.MS_NOSUID|unix.MS_NODEV|unix.MS_NOEXEC, ""); err != nil {
+		return err
+	}
+
+	oldRoot := filepath.Join(newRoot, nativeSandboxOldRootDir)
+	if err := os.MkdirAll(oldRoot, modeOwnerAllPermission); err != nil {
+		return err
+	}
+
+	if err := unix.PivotRoot(newRoot, oldRoot); err != nil {
+		return err
+	}
+
+	if err := os.Chdir("/"); err != nil {
+		return err
+	}
+
+	if err := unix.Unmount(filepath.Join("/", nativeSandboxOldRootDir), unix.MNT_DETACH); err != nil {
+		return err
+	}
+
+	return os.Remove(filepath.Join("/", nativeSandboxOldRootDir))
+}
+
+// bindMount bind mounts the source path onto the target path, creating the target if needed.
+func bindMount(source, target string, readOnly bool) error {
+	sourceInfo, err := os.Stat(source)
+	if err != nil {
+		return err
+	}
+
+	if sourceInfo.IsDir() {
+		err = os.MkdirAll(target, modeAllReadExecute)
+	} else {
+		err = os.MkdirAll(filepath.Dir(target), modeAllReadExecute)
+		if err == nil {
+			err = os.WriteFile(target, nil, modeOwnerReadWriteAllRead)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := unix.Mount(source, target, "", unix.MS_BIND|unix.MS_REC, ""); err != nil {
+		return err
+	}
+
+	// Flags other than MS_BIND are ignored when creating a bind mount, so they are applied with a remount
+	flags := uintptr(unix.MS_BIND | unix.MS_REMOUNT | unix.MS_NOSUID)
+	if readOnly {
+		flags |= unix.MS_RDONLY
+	}
+	if !sourceInfo.IsDir() {
+		return unix.Mount("", target, "", flags, "")
+	}
+
+	return unix.Mount("", target, "", flags|unix.MS_NODEV, "")
+}
+
+func setResourceLimits() error {
+	for resource, limit := range map[int]uint64{
+		unix.RLIMIT_CORE:   0,
+		unix.RLIMIT_NOFILE: nativeSandboxMaxOpenFiles,
+		unix.RLIMIT_FSIZE:  nativeSandboxMaxFileSize,
+		unix.RLIMIT_STACK:  unix.RLIM_INFINITY, // memory is limited by the cgroup, recursive solutions need a deep stack
+	} {
+		if err := unix.Setrlimit(resource, &unix.Rlimit{Cur: limit, Max: limit}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func dropPrivileges(uid, gid int) error {
+	if err := unix.Setgroups(nil); err != nil {
+		return err
+	}
+
+	if err := unix.Setresgid(gid, gid, gid); err != nil {
+		return err
+	}
+
+	return unix.Setresuid(uid, uid, uid)
+}
+
+// installSeccompFilter makes the denied syscalls fail with EPERM. Processes of another architecture,
+// and x32 syscalls on amd64, are killed outright, as syscall numbers would not match the filter.
+func installSeccompFilter() error {
+	auditArch, err := getAuditArch()
+	if err != nil {
+		return err
+	}
+
+	filter := []unix.SockFilter{
+		{Code: unix.BPF_LD | unix.BPF_W | unix.BPF_ABS, K: seccompDataArchOffset},
+		{Code: unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K, K: auditArch, Jt: 1},
+		{Code: unix.BPF_RET | unix.BPF_K, K: unix.SECCOMP_RET_KILL_PROCESS},
+		{Code: unix.BPF_LD | unix.BPF_W | unix.BPF_ABS, K: seccompDataNrOffset},
+	}
+
+	if runtime.GOARCH == "amd64" {
+		filter = append(filter,
+			unix.SockFilter{Code: unix.BPF_JMP | unix.BPF_JGE | unix.BPF_K, K: x32SyscallBit, Jf: 1},
+			unix.SockFilter{Code: unix.BPF_RET | unix.BPF_K, K: unix.SECCOMP_RET_KILL_PROCESS},
+		)
+	}
+
+	for _, syscallNumber := range deniedSyscalls {
+		filter = append(filter,
+			unix.SockFilter{Code: unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K, K: uint32(syscallNumber), Jf: 1},
+			unix.SockFilter{Code: unix.BPF_RET | unix.BPF_K, K: unix.SECCOMP_RET_ERRNO | uint32(unix.EPERM)},
+		)
+	}
+
+	for _, syscallNumber := range unsupportedSyscalls {
+		filter = append(filter,
+			unix.SockFilter{Code: unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K, K: uint32(syscallNumber), Jf: 1},
+			unix.SockFilter{Code: unix.BPF_RET | unix.BPF_K, K: unix.SECCOMP_RET_ERRNO | uint32(unix.ENOSYS)},
+		)
+	}
+
+	// The flags of clone are checked last, as loading them replaces the syscall number
+	filter = append(filter,
+		unix.SockFilter{Code: unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K, K: unix.SYS_CLONE, Jf: 3},
+		unix.SockFilter{Code: unix.BPF_LD | unix.BPF_W | unix.BPF_ABS, K: seccompDataArg0Offset},
+		unix.SockFilter{Code: unix.BPF_JMP | unix.BPF_JSET | unix.BPF_K, K: deniedCloneFlags, Jf: 1},
+		unix.SockFilter{Code: unix.BPF_RET | unix.BPF_K, K: unix.SECCOMP_RET_ERRNO | uint32(unix.EPERM)},
+		unix.SockFilter{Code: unix.BPF_RET | unix.BPF_K, K: unix.SECCOMP_RET_ALLOW},
+	)
+
+	// Without no_new_privs, only privileged processes are allowed to install seccomp filters
+	if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+		return err
+	}
+
+	program := unix.SockFprog{
+		Len:    uint16(len(filter)),
+		Filter: &filter[0],
+	}
+
+	return unix.Prctl(unix.PR_SET_SECCOMP, unix.SECCOMP_MODE_FILTER, uintptr(unsafe.Pointer(&program)), 0, 0)
+}
+
+func getAuditArch() (uint32, error) {
+	switch runtime.GOARCH {
+	case "amd64":
+		return unix.AUDIT_ARCH_X86_64, nil
+	case "arm64":
+		return unix.AUDIT_ARCH_AARCH64, nil
+	default:
+		return 0, fmt.Errorf("seccomp filter is not supported on %s", runtime.GOARCH)
+	}
+}
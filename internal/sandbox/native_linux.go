@@ -0,0 +1,323 @@
+//go:build linux
+
+package sandbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maxuanquang/ojs/internal/configs"
+	"go.uber.org/zap"
+)
+
+const (
+	nativeSandboxInitCommand  = "sandbox-init"
+	nativeSandboxInitEnv      = "OJS_SANDBOX_INIT"
+	nativeSandboxRootDir      = "/tmp/ojs-sandbox-root"
+	cgroupControllers         = "+cpu +cpuset +memory +pids"
+	cgroupRemoveRetryCount    = 10
+	cgroupRemoveRetryInterval = 10 * time.Millisecond
+	modeAllReadExecute        = 0755
+)
+
+// NewNativeSandbox returns a sandbox running commands directly on the host, isolated in their own namespaces,
+// restricted by a cgroup v2 and a seccomp filter. Commands are started through the hidden sandbox-init command
+// of this binary, which sets up the isolation from inside the namespaces before executing the command.
+func NewNativeSandbox(nativeSandboxConfig configs.NativeSandbox, logger *zap.Logger) (Sandbox, error) {
+	if os.Geteuid() != 0 {
+		err := errors.New("native sandbox requires root")
+		logger.With(zap.Error(err)).Error("failed to create native sandbox")
+		return nil, err
+	}
+
+	executablePath, err := os.Executable()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get executable path")
+		return nil, err
+	}
+
+	// Controllers have to be enabled in the parent of the cgroup root, and in the cgroup root for its children
+	for _, cgroupDir := range []string{filepath.Dir(nativeSandboxConfig.CgroupRoot), nativeSandboxConfig.CgroupRoot} {
+		if err := os.MkdirAll(cgroupDir, modeAllReadExecute); err != nil {
+			logger.With(zap.String("cgroup_dir", cgroupDir)).With(zap.Error(err)).Error("failed to create cgroup")
+			return nil, err
+		}
+
+		err := os.WriteFile(filepath.Join(cgroupDir, "cgroup.subtree_control"), []byte(cgroupControllers), modeOwnerReadWriteAllRead)
+		if err != nil {
+			logger.With(zap.String("cgroup_dir", cgroupDir)).With(zap.Error(err)).Error("failed to enable cgroup controllers")
+			return nil, err
+		}
+	}
+
+	if err := os.MkdirAll(nativeSandboxRootDir, modeOwnerAllPermission); err != nil {
+		logger.With(zap.Error(err)).Error("failed to create sandbox root dir")
+		return nil, err
+	}
+
+	return &nativeSandbox{
+		config:         nativeSandboxConfig,
+		executablePath: executablePath,
+		logger:         logger,
+	}, nil
+}
+
+type nativeSandbox struct {
+	config         configs.NativeSandbox
+	executablePath string
+	logger         *zap.Logger
+}
+
+// PrepareImage implements Sandbox.
+func (n *nativeSandbox) PrepareImage(_ context.Context, _ string) error {
+	return nil
+}
+
+// PrepareWorkspace implements Sandbox.
+func (n *nativeSandbox) PrepareWorkspace(_ context.Context) (string, error) {
+	workspace, err := prepareWorkspace(n.logger)
+	if err != nil {
+		return "", err
+	}
+
+	// Commands do not run as root, they need to own their workspace
+	if err := os.Chown(workspace, n.config.UID, n.config.GID); err != nil {
+		n.logger.With(zap.String("workspace", workspace)).With(zap.Error(err)).Error("failed to change owner of workspace")
+		return "", err
+	}
+
+	return workspace, nil
+}
+
+// RemoveWorkspace implements Sandbox.
+func (n *nativeSandbox) RemoveWorkspace(_ context.Context, workspace string) error {
+	return removeWorkspace(n.logger, workspace)
+}
+
+// PurgeWorkspaces implements Sandbox.
+func (n *nativeSandbox) PurgeWorkspaces(_ context.Context, maxAge time.Duration) error {
+	return purgeWorkspaces(n.logger, maxAge)
+}
+
+// Start implements Sandbox.
+func (n *nativeSandbox) Start(_ context.Context, command Command) (Process, error) {
+	logger := n.logger.With(zap.Strings("args", command.Args))
+
+	initSpecBytes, err := json.Marshal(nativeSandboxInitSpec{
+		Args:          command.Args,
+		WorkingDir:    command.WorkingDir,
+		ReadOnlyPaths: n.config.ReadOnlyPaths,
+		UID:           n.config.UID,
+		GID:           n.config.GID,
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to marshal sandbox init spec")
+		return nil, err
+	}
+
+	cgroupDir, err := n.createCgroup(command)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create cgroup")
+		return nil, err
+	}
+
+	process := &nativeProcess{
+		logger:    logger.With(zap.String("cgroup_dir", cgroupDir)),
+		cgroupDir: cgroupDir,
+	}
+
+	cgroup, err := os.Open(cgroupDir)
+	if err != nil {
+		process.logger.With(zap.Error(err)).Error("failed to open cgroup")
+		process.removeCgroup()
+		return nil, err
+	}
+
+	defer cgroup.Close()
+
+	stdout, stderr := getOutputWriters(command)
+	process.cmd = exec.Command(n.executablePath, nativeSandboxInitCommand)
+	process.cmd.Env = []string{fmt.Sprintf("%s=%s", nativeSandboxInitEnv, initSpecBytes)}
+	process.cmd.Stdout = stdout
+	process.cmd.Stderr = stderr
+	process.cmd.SysProcAttr = &syscall.SysProcAttr{
+		Cloneflags: syscall.CLONE_NEWNS | syscall.CLONE_NEWPID | syscall.CLONE_NEWNET |
+			syscall.CLONE_NEWIPC | syscall.CLONE_NEWUTS,
+		UseCgroupFD: true,
+		CgroupFD:    int(cgroup.Fd()),
+		Pdeathsig:   syscall.SIGKILL,
+	}
+
+	// Stdin is given as a pipe rather than a reader, as exec.Cmd would otherwise wait for the reader to be drained
+	// even after the command has exited
+	var stdinWriter *os.File
+	if command.Stdin != nil {
+		var stdinReader *os.File
+		stdinReader, stdinWriter, err = os.Pipe()
+		if err != nil {
+			process.logger.With(zap.Error(err)).Error("failed to create stdin pipe")
+			process.removeCgroup()
+			return nil, err
+		}
+
+		defer stdinReader.Close()
+		process.cmd.Stdin = stdinReader
+		process.stdinWriter = stdinWriter
+	}
+
+	err = process.cmd.Start()
+	if err != nil {
+		process.logger.With(zap.Error(err)).Error("failed to start sandbox init")
+		process.closeStdin()
+		process.removeCgroup()
+		return nil, err
+	}
+
+	process.startedAt = time.Now()
+	if command.Stdin != nil {
+		go process.copyInput(command.Stdin)
+	}
+
+	return process, nil
+}
+
+func (n *nativeSandbox) createCgroup(command Command) (string, error) {
+	cgroupDir := filepath.Join(n.config.CgroupRoot, uuid.NewString())
+	if err := os.Mkdir(cgroupDir, modeAllReadExecute); err != nil {
+		return "", err
+	}
+
+	cgroupFiles := map[string]string{
+		"memory.swap.max": "0",
+		"pids.max":        strconv.Itoa(n.config.MaxProcesses),
+	}
+	if command.MemoryLimitInBytes > 0 {
+		cgroupFiles["memory.max"] = strconv.FormatUint(command.MemoryLimitInBytes, 10)
+	}
+	if command.CPUSet != "" {
+		cgroupFiles["cpuset.cpus"] = command.CPUSet
+	}
+	if command.CPUs > 0 {
+		cgroupFiles["cpu.max"] = fmt.Sprintf("%d %d", int64(command.CPUs*defaultCPUPeriod), defaultCPUPeriod)
+	}
+
+	for fileName, value := range cgroupFiles {
+		err := os.WriteFile(filepath.Join(cgroupDir, fileName), []byte(value), modeOwnerReadWriteAllRead)
+		if err != nil {
+			os.Remove(cgroupDir)
+			return "", fmt.Errorf("failed to write %s: %w", fileName, err)
+		}
+	}
+
+	return cgroupDir, nil
+}
+
+type nativeProcess struct {
+	logger      *zap.Logger
+	cmd         *exec.Cmd
+	cgroupDir   string
+	stdinWriter *os.File
+	startedAt   time.Time
+}
+
+// Wait implements Process.
+func (n *nativeProcess) Wait(ctx context.Context) (Result, error) {
+	defer n.removeCgroup()
+	defer n.closeStdin()
+
+	waitErrChan := make(chan error, 1)
+	go func() {
+		waitErrChan <- n.cmd.Wait()
+	}()
+
+	var waitErr error
+	select {
+	case waitErr = <-waitErrChan:
+	case <-ctx.Done():
+		// Killing the init process of the namespace kills every process in it
+		if err := n.cmd.Process.Kill(); err != nil {
+			n.logger.With(zap.Error(err)).Warn("failed to kill sandbox init")
+		}
+
+		<-waitErrChan
+		return Result{}, ctx.Err()
+	}
+
+	result := Result{WallTime: time.Since(n.startedAt)}
+	if waitErr != nil {
+		var exitErr *exec.ExitError
+		if !errors.As(waitErr, &exitErr) {
+			n.logger.With(zap.Error(waitErr)).Error("failed to wait for sandbox init")
+			return Result{}, waitErr
+		}
+	}
+
+	// The cgroup's usage includes the sandbox init, which is negligible next to the command
+	cpuTime, peakMemoryInBytes, err := readCgroupUsage(n.cgroupDir)
+	if err != nil {
+		n.logger.With(zap.Error(err)).Warn("failed to read resource usage of cgroup")
+	}
+
+	result.CPUTime = cpuTime
+	result.PeakMemoryInBytes = peakMemoryInBytes
+
+	waitStatus := n.cmd.ProcessState.Sys().(syscall.WaitStatus)
+	if waitStatus.Signaled() {
+		result.ExitCode = 128 + int(waitStatus.Signal())
+	} else {
+		result.ExitCode = waitStatus.ExitStatus()
+	}
+
+	return result, nil
+}
+
+func (n *nativeProcess) copyInput(stdin io.Reader) {
+	_, err := io.Copy(n.stdinWriter, stdin)
+	if err != nil && !errors.Is(err, os.ErrClosed) && !errors.Is(err, io.ErrClosedPipe) && !errors.Is(err, syscall.EPIPE) {
+		n.logger.With(zap.Error(err)).Warn("failed to copy input to sandbox")
+	}
+
+	n.closeStdin()
+}
+
+func (n *nativeProcess) closeStdin() {
+	if n.stdinWriter == nil {
+		return
+	}
+
+	if err := n.stdinWriter.Close(); err != nil && !errors.Is(err, os.ErrClosed) {
+		n.logger.With(zap.Error(err)).Warn("failed to close stdin of sandbox")
+	}
+}
+
+// removeCgroup kills whatever is left in the cgroup and removes it. Removing a cgroup fails until the kernel
+// has finished reaping its processes, so it is retried for a short while.
+func (n *nativeProcess) removeCgroup() {
+	err := os.WriteFile(filepath.Join(n.cgroupDir, "cgroup.kill"), []byte("1"), modeOwnerReadWriteAllRead)
+	if err != nil {
+		n.logger.With(zap.Error(err)).Warn("failed to kill cgroup")
+	}
+
+	for i := 0; i < cgroupRemoveRetryCount; i++ {
+		err = os.Remove(n.cgroupDir)
+		if !errors.Is(err, syscall.EBUSY) {
+			break
+		}
+
+		time.Sleep(cgroupRemoveRetryInterval)
+	}
+
+	if err != nil {
+		n.logger.With(zap.Error(err)).Error("failed to remove cgroup")
+	}
+}
@@ -0,0 +1,27 @@
+//go:build !linux
+
+package sandbox
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/maxuanquang/ojs/internal/configs"
+	"go.uber.org/zap"
+)
+
+var (
+	errNativeSandboxUnsupported = errors.New("native sandbox is only supported on linux")
+)
+
+func NewNativeSandbox(_ configs.NativeSandbox, logger *zap.Logger) (Sandbox, error) {
+	logger.With(zap.Error(errNativeSandboxUnsupported)).Error("failed to create native sandbox")
+	return nil, errNativeSandboxUnsupported
+}
+
+// RunNativeSandboxInit is the entrypoint of the sandbox-init command, which is only used on linux.
+func RunNativeSandboxInit() {
+	fmt.Fprintf(os.Stderr, "sandbox init failed: %s\n", errNativeSandboxUnsupported)
+	os.Exit(1)
+}
@@ -0,0 +1,163 @@
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/docker/docker/client"
+	"github.com/google/uuid"
+	"github.com/maxuanquang/ojs/internal/configs"
+	"go.uber.org/zap"
+)
+
+const (
+	hostTempWorkingDir     = "/tmp/ojs-compile"
+	modeOwnerAllPermission = 0700
+)
+
+// Command is a command to run in a sandbox. Its working directory is mounted into the sandbox at the same path,
+// everything else on the host is hidden from it.
+type Command struct {
+	Image              string // ignored by sandboxes that run commands on the host
+	WorkingDir         string
+	Args               []string
+	CPUs               float32
+	CPUSet             string // CPUs the command is pinned to, in the cpuset list format like "0-3,8", if not empty
+	MemoryLimitInBytes uint64
+	Stdin              io.Reader // the command's stdin is closed if nil
+	Stdout             io.Writer // the command's output is discarded if nil
+	Stderr             io.Writer
+}
+
+type Result struct {
+	ExitCode          int // 128 + the signal number if the command was killed by a signal, like in shells
+	WallTime          time.Duration
+	CPUTime           time.Duration // zero if the sandbox could not measure it, like the peak memory usage
+	PeakMemoryInBytes uint64
+}
+
+type Process interface {
+	// Wait waits for the process to exit and releases its resources. The process is killed if ctx is done first.
+	// Once Wait returns, all of the process's output has been written.
+	Wait(ctx context.Context) (Result, error)
+}
+
+// Sandbox runs untrusted commands isolated from the host and from each other.
+type Sandbox interface {
+	// PrepareImage makes the image available to commands, so that the first command using it does not wait for it.
+	PrepareImage(ctx context.Context, image string) error
+	// PrepareWorkspace creates an empty directory on the host, to be used as the working directory of commands.
+	PrepareWorkspace(ctx context.Context) (string, error)
+	// RemoveWorkspace removes a workspace and everything in it.
+	RemoveWorkspace(ctx context.Context, workspace string) error
+	// PurgeWorkspaces removes the workspaces that have not been modified within maxAge, such as the ones left behind
+	// by a crashed worker.
+	PurgeWorkspaces(ctx context.Context, maxAge time.Duration) error
+	// Start starts the command, ctx only bounds starting it and the running process is not tied to it. Wait must be
+	// called on the returned process, even if it is not needed anymore.
+	Start(ctx context.Context, command Command) (Process, error)
+}
+
+func NewSandbox(
+	sandboxConfig configs.Sandbox,
+	dockerClient *client.Client,
+	logger *zap.Logger,
+) (Sandbox, error) {
+	switch sandboxConfig.Type {
+	case configs.SandboxTypeDocker:
+		return NewDockerSandbox(sandboxConfig.Docker, dockerClient, logger), nil
+	case configs.SandboxTypeNative:
+		return NewNativeSandbox(sandboxConfig.Native, logger)
+	default:
+		err := fmt.Errorf(`invalid sandbox type, expect one of ["docker", "native"], got %s`, string(sandboxConfig.Type))
+		logger.With(zap.Error(err)).Error("invalid sandbox type")
+		return nil, err
+	}
+}
+
+// Run starts the command and waits for it to exit.
+func Run(ctx context.Context, sandbox Sandbox, command Command) (Result, error) {
+	process, err := sandbox.Start(ctx, command)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return process.Wait(ctx)
+}
+
+func prepareWorkspace(logger *zap.Logger) (string, error) {
+	workspace := filepath.Join(hostTempWorkingDir, uuid.NewString())
+	err := os.MkdirAll(workspace, modeOwnerAllPermission)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create workspace")
+		return "", err
+	}
+
+	logger.Info("workspace created", zap.String("workspace", workspace))
+	return workspace, nil
+}
+
+func removeWorkspace(logger *zap.Logger, workspace string) error {
+	logger = logger.With(zap.String("workspace", workspace))
+
+	// Workspaces are removed recursively, anything outside of the workspaces dir must never be touched
+	if filepath.Dir(filepath.Clean(workspace)) != hostTempWorkingDir {
+		err := fmt.Errorf("%s is not a workspace", workspace)
+		logger.With(zap.Error(err)).Error("failed to remove workspace")
+		return err
+	}
+
+	if err := os.RemoveAll(workspace); err != nil {
+		logger.With(zap.Error(err)).Error("failed to remove workspace")
+		return err
+	}
+
+	logger.Info("workspace removed")
+	return nil
+}
+
+func purgeWorkspaces(logger *zap.Logger, maxAge time.Duration) error {
+	entries, err := os.ReadDir(hostTempWorkingDir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+
+		logger.With(zap.Error(err)).Error("failed to read workspaces dir")
+		return err
+	}
+
+	var purgeErr error
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil {
+			// The workspace may have been removed since the dir was read
+			continue
+		}
+
+		if time.Since(info.ModTime()) < maxAge {
+			continue
+		}
+
+		purgeErr = errors.Join(purgeErr, removeWorkspace(logger, filepath.Join(hostTempWorkingDir, entry.Name())))
+	}
+
+	return purgeErr
+}
+
+func getOutputWriters(command Command) (io.Writer, io.Writer) {
+	stdout, stderr := command.Stdout, command.Stderr
+	if stdout == nil {
+		stdout = io.Discard
+	}
+	if stderr == nil {
+		stderr = io.Discard
+	}
+
+	return stdout, stderr
+}
@@ -0,0 +1,7 @@
+package sandbox
+
+import "github.com/google/wire"
+
+var WireSet = wire.NewSet(
+	NewSandbox,
+)
@@ -0,0 +1,19 @@
+package utils
+
+import "unicode/utf8"
+
+// TruncateString cuts s down to at most maxBytes bytes without splitting a UTF-8 character.
+func TruncateString(s string, maxBytes int) string {
+	if maxBytes <= 0 {
+		return ""
+	}
+	if len(s) <= maxBytes {
+		return s
+	}
+
+	for maxBytes > 0 && !utf8.RuneStart(s[maxBytes]) {
+		maxBytes--
+	}
+
+	return s[:maxBytes]
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/maxuanquang/ojs/internal/dataaccess"
 	"github.com/maxuanquang/ojs/internal/handler"
 	"github.com/maxuanquang/ojs/internal/logic"
+	"github.com/maxuanquang/ojs/internal/sandbox"
 	"github.com/maxuanquang/ojs/internal/utils"
 )
 
@@ -19,6 +20,7 @@ var WireSet = wire.NewSet(
 	dataaccess.WireSet,
 	handler.WireSet,
 	logic.WireSet,
+	sandbox.WireSet,
 	utils.WireSet,
 	app.WireSet,
 )
@@ -13,8 +13,8 @@ import (
 	"github.com/maxuanquang/ojs/internal/dataaccess"
 	"github.com/maxuanquang/ojs/internal/dataaccess/cache"
 	"github.com/maxuanquang/ojs/internal/dataaccess/database"
-	"github.com/maxuanquang/ojs/internal/dataaccess/mq/admin"
 	consumer2 "github.com/maxuanquang/ojs/internal/dataaccess/mq/consumer"
+	"github.com/maxuanquang/ojs/internal/dataaccess/mq/inmemory"
 	"github.com/maxuanquang/ojs/internal/dataaccess/mq/producer"
 	"github.com/maxuanquang/ojs/internal/handler"
 	"github.com/maxuanquang/ojs/internal/handler/consumer"
@@ -22,6 +22,7 @@ import (
 	"github.com/maxuanquang/ojs/internal/handler/http"
 	"github.com/maxuanquang/ojs/internal/handler/jobs"
 	"github.com/maxuanquang/ojs/internal/logic"
+	"github.com/maxuanquang/ojs/internal/sandbox"
 	"github.com/maxuanquang/ojs/internal/utils"
 )
 
@@ -46,6 +47,7 @@ func InitializeStandaloneServer(configFilePath configs.ConfigFilePath, appArgume
 	}
 	accountDataAccessor := database.NewAccountDataAccessor(databaseDatabase, logger)
 	accountPasswordDataAccessor := database.NewAccountPasswordDataAccessor(databaseDatabase, logger)
+	sessionDataAccessor := database.NewSessionDataAccessor(databaseDatabase, logger)
 	auth := config.Auth
 	hashLogic := logic.NewHashLogic(auth)
 	tokenPublicKeyDataAccessor, err := database.NewTokenPublicKeyDataAccessor(databaseDatabase, logger)
@@ -55,71 +57,109 @@ func InitializeStandaloneServer(configFilePath configs.ConfigFilePath, appArgume
 		return app.StandaloneServer{}, nil, err
 	}
 	configsCache := config.Cache
-	client, err := cache.NewClient(configsCache, logger)
+	client := cache.NewRedisConnection(configsCache)
+	cacheClient, err := cache.NewClient(configsCache, client, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.StandaloneServer{}, nil, err
 	}
-	tokenPublicKey, err := cache.NewTokenPublicKey(client)
+	tokenPublicKey, err := cache.NewTokenPublicKey(cacheClient)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.StandaloneServer{}, nil, err
 	}
-	tokenLogic, err := logic.NewTokenLogic(accountDataAccessor, tokenPublicKeyDataAccessor, logger, auth, tokenPublicKey)
+	tokenKeyLogic, err := logic.NewTokenKeyLogic(tokenPublicKeyDataAccessor, tokenPublicKey, databaseDatabase, auth, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.StandaloneServer{}, nil, err
 	}
+	revokedToken, err := cache.NewRevokedToken(cacheClient)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.StandaloneServer{}, nil, err
+	}
+	tokenLogic := logic.NewTokenLogic(accountDataAccessor, sessionDataAccessor, tokenKeyLogic, logger, auth, revokedToken)
 	roleLogic := logic.NewRoleLogic(logger)
-	takenAccountName, err := cache.NewTakenAccountName(client)
+	takenAccountName, err := cache.NewTakenAccountName(cacheClient)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.StandaloneServer{}, nil, err
 	}
-	accountLogic := logic.NewAccountLogic(databaseDatabase, accountDataAccessor, accountPasswordDataAccessor, hashLogic, tokenLogic, roleLogic, takenAccountName, logger)
+	accountLogic := logic.NewAccountLogic(databaseDatabase, accountDataAccessor, accountPasswordDataAccessor, sessionDataAccessor, hashLogic, tokenLogic, roleLogic, takenAccountName, auth, logger)
 	problemDataAccessor := database.NewProblemDataAccessor(databaseDatabase, logger)
 	submissionDataAccessor := database.NewSubmissionDataAccessor(databaseDatabase, logger)
 	testCaseDataAccessor := database.NewTestCaseDataAccessor(databaseDatabase, logger)
-	problemLogic := logic.NewProblemLogic(logger, accountDataAccessor, problemDataAccessor, submissionDataAccessor, testCaseDataAccessor, tokenLogic, roleLogic)
+	judge := config.Judge
+	problemLogic := logic.NewProblemLogic(logger, accountDataAccessor, problemDataAccessor, submissionDataAccessor, testCaseDataAccessor, tokenLogic, roleLogic, judge)
+	testCaseGroupDataAccessor := database.NewTestCaseGroupDataAccessor(databaseDatabase, logger)
+	submissionTestCaseResultDataAccessor := database.NewSubmissionTestCaseResultDataAccessor(databaseDatabase, logger)
+	contestDataAccessor := database.NewContestDataAccessor(databaseDatabase, logger)
+	contestProblemDataAccessor := database.NewContestProblemDataAccessor(databaseDatabase, logger)
+	contestParticipantDataAccessor := database.NewContestParticipantDataAccessor(databaseDatabase, logger)
+	submissionUpdatePubSub, err := cache.NewSubmissionUpdatePubSub(cacheClient, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.StandaloneServer{}, nil, err
+	}
+	configsSandbox := judge.Sandbox
 	clientClient, err := utils.InitializeDockerClient()
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.StandaloneServer{}, nil, err
 	}
-	judge := config.Judge
-	judgeLogic, err := logic.NewJudgeLogic(problemDataAccessor, submissionDataAccessor, testCaseDataAccessor, clientClient, judge, appArguments, logger)
+	sandboxSandbox, err := sandbox.NewSandbox(configsSandbox, clientClient, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.StandaloneServer{}, nil, err
 	}
-	mq := config.MQ
-	adminAdmin, err := admin.NewAdmin(logger, mq)
+	workspaceLogic := logic.NewWorkspaceLogic(sandboxSandbox, logger)
+	judgeLogic, err := logic.NewJudgeLogic(problemDataAccessor, submissionDataAccessor, testCaseDataAccessor, testCaseGroupDataAccessor, submissionTestCaseResultDataAccessor, submissionUpdatePubSub, workspaceLogic, sandboxSandbox, judge, appArguments, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.StandaloneServer{}, nil, err
+	}
+	contestScoreboard, err := cache.NewContestScoreboard(cacheClient)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.StandaloneServer{}, nil, err
 	}
-	producerClient, err := producer.NewClient(mq, logger, adminAdmin)
+	scoreboardLogic := logic.NewScoreboardLogic(logger, accountDataAccessor, submissionDataAccessor, contestParticipantDataAccessor, contestScoreboard)
+	outboxMessageDataAccessor := database.NewOutboxMessageDataAccessor(databaseDatabase, logger)
+	mq := config.MQ
+	broker := inmemory.NewBroker(logger)
+	producerClient, err := producer.NewClient(mq, broker, client, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.StandaloneServer{}, nil, err
 	}
-	submissionCreatedProducer, err := producer.NewSubmissionCreatedProducer(producerClient, logger)
+	outboxLogic := logic.NewOutboxLogic(outboxMessageDataAccessor, producerClient, databaseDatabase, logger)
+	worker := config.Worker
+	submissionLogic, err := logic.NewSubmissionLogic(logger, accountDataAccessor, problemDataAccessor, submissionDataAccessor, testCaseDataAccessor, testCaseGroupDataAccessor, submissionTestCaseResultDataAccessor, contestDataAccessor, contestProblemDataAccessor, contestParticipantDataAccessor, tokenLogic, judgeLogic, roleLogic, scoreboardLogic, outboxMessageDataAccessor, outboxLogic, submissionUpdatePubSub, databaseDatabase, worker, judge)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.StandaloneServer{}, nil, err
 	}
-	submissionLogic := logic.NewSubmissionLogic(logger, accountDataAccessor, problemDataAccessor, submissionDataAccessor, testCaseDataAccessor, tokenLogic, judgeLogic, roleLogic, submissionCreatedProducer, databaseDatabase)
-	testCaseLogic := logic.NewTestCaseLogic(logger, accountDataAccessor, problemDataAccessor, submissionDataAccessor, testCaseDataAccessor, tokenLogic, roleLogic)
-	ojsServiceServer := grpc.NewHandler(accountLogic, problemLogic, submissionLogic, testCaseLogic)
+	testCaseLogic := logic.NewTestCaseLogic(logger, accountDataAccessor, problemDataAccessor, submissionDataAccessor, testCaseDataAccessor, testCaseGroupDataAccessor, tokenLogic, roleLogic)
+	testCaseGroupLogic := logic.NewTestCaseGroupLogic(logger, problemDataAccessor, testCaseDataAccessor, testCaseGroupDataAccessor, tokenLogic, roleLogic, databaseDatabase)
+	contestLogic := logic.NewContestLogic(logger, contestDataAccessor, contestProblemDataAccessor, contestParticipantDataAccessor, problemDataAccessor, tokenLogic, roleLogic, scoreboardLogic, databaseDatabase)
+	deadLetteredSubmissionDataAccessor := database.NewDeadLetteredSubmissionDataAccessor(databaseDatabase, logger)
+	deadLetteredSubmissionLogic := logic.NewDeadLetteredSubmissionLogic(deadLetteredSubmissionDataAccessor, submissionDataAccessor, outboxMessageDataAccessor, outboxLogic, tokenLogic, roleLogic, databaseDatabase, logger)
+	rejudgeDataAccessor := database.NewRejudgeDataAccessor(databaseDatabase, logger)
+	submissionJudgeHistoryDataAccessor := database.NewSubmissionJudgeHistoryDataAccessor(databaseDatabase, logger)
+	rejudgeLogic := logic.NewRejudgeLogic(rejudgeDataAccessor, submissionJudgeHistoryDataAccessor, submissionDataAccessor, problemDataAccessor, contestDataAccessor, outboxMessageDataAccessor, outboxLogic, tokenLogic, roleLogic, submissionUpdatePubSub, databaseDatabase, logger)
+	ojsServiceServer := grpc.NewHandler(accountLogic, problemLogic, submissionLogic, testCaseLogic, testCaseGroupLogic, contestLogic, deadLetteredSubmissionLogic, rejudgeLogic, tokenKeyLogic)
 	server := grpc.NewServer(configsGRPC, ojsServiceServer)
 	configsHTTP := config.HTTP
 	httpServer := http.NewServer(configsHTTP, configsGRPC, auth, logger)
@@ -130,20 +170,51 @@ func InitializeStandaloneServer(configFilePath configs.ConfigFilePath, appArgume
 		cleanup()
 		return app.StandaloneServer{}, nil, err
 	}
-	submissionCreatedHandler, err := consumer.NewSubmissionCreatedHandler(accountLogic, cron, submissionLogic, createSystemAccountsJob, logger)
+	purgeStaleWorkspacesJob, err := jobs.NewPurgeStaleWorkspacesJob(workspaceLogic, cron, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.StandaloneServer{}, nil, err
+	}
+	submissionCreatedHandler, err := consumer.NewSubmissionCreatedHandler(accountLogic, cron, submissionLogic, createSystemAccountsJob, purgeStaleWorkspacesJob, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.StandaloneServer{}, nil, err
 	}
-	consumerConsumer, err := consumer2.NewConsumer(mq, logger)
+	submissionCreatedDeadLetterHandler := consumer.NewSubmissionCreatedDeadLetterHandler(deadLetteredSubmissionLogic, logger)
+	submissionCreatedDeadLetterProducer := producer.NewSubmissionCreatedDeadLetterProducer(producerClient, logger)
+	consumerConsumer, err := consumer2.NewConsumer(mq, broker, client, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.StandaloneServer{}, nil, err
 	}
-	rootConsumer := consumer.NewRootConsumer(submissionCreatedHandler, consumerConsumer, logger)
-	jobsCron, err := jobs.NewCron(logger)
+	rootConsumer, err := consumer.NewRootConsumer(submissionCreatedHandler, submissionCreatedDeadLetterHandler, submissionCreatedDeadLetterProducer, purgeStaleWorkspacesJob, consumerConsumer, mq, worker, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.StandaloneServer{}, nil, err
+	}
+	resetExpiredJudgingLeasesJob, err := jobs.NewResetExpiredJudgingLeasesJob(submissionLogic, cron, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.StandaloneServer{}, nil, err
+	}
+	relayOutboxMessagesJob, err := jobs.NewRelayOutboxMessagesJob(outboxLogic, cron, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.StandaloneServer{}, nil, err
+	}
+	rotateTokenKeysJob, err := jobs.NewRotateTokenKeysJob(tokenKeyLogic, cron, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.StandaloneServer{}, nil, err
+	}
+	jobsCron, err := jobs.NewCron(resetExpiredJudgingLeasesJob, relayOutboxMessagesJob, rotateTokenKeysJob, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
@@ -180,6 +251,7 @@ func InitializeHTTPServer(configFilePath configs.ConfigFilePath, appArguments ut
 	}
 	accountDataAccessor := database.NewAccountDataAccessor(databaseDatabase, logger)
 	accountPasswordDataAccessor := database.NewAccountPasswordDataAccessor(databaseDatabase, logger)
+	sessionDataAccessor := database.NewSessionDataAccessor(databaseDatabase, logger)
 	auth := config.Auth
 	hashLogic := logic.NewHashLogic(auth)
 	tokenPublicKeyDataAccessor, err := database.NewTokenPublicKeyDataAccessor(databaseDatabase, logger)
@@ -189,71 +261,109 @@ func InitializeHTTPServer(configFilePath configs.ConfigFilePath, appArguments ut
 		return app.HTTPServer{}, nil, err
 	}
 	configsCache := config.Cache
-	client, err := cache.NewClient(configsCache, logger)
+	client := cache.NewRedisConnection(configsCache)
+	cacheClient, err := cache.NewClient(configsCache, client, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.HTTPServer{}, nil, err
+	}
+	tokenPublicKey, err := cache.NewTokenPublicKey(cacheClient)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.HTTPServer{}, nil, err
 	}
-	tokenPublicKey, err := cache.NewTokenPublicKey(client)
+	tokenKeyLogic, err := logic.NewTokenKeyLogic(tokenPublicKeyDataAccessor, tokenPublicKey, databaseDatabase, auth, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.HTTPServer{}, nil, err
 	}
-	tokenLogic, err := logic.NewTokenLogic(accountDataAccessor, tokenPublicKeyDataAccessor, logger, auth, tokenPublicKey)
+	revokedToken, err := cache.NewRevokedToken(cacheClient)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.HTTPServer{}, nil, err
 	}
+	tokenLogic := logic.NewTokenLogic(accountDataAccessor, sessionDataAccessor, tokenKeyLogic, logger, auth, revokedToken)
 	roleLogic := logic.NewRoleLogic(logger)
-	takenAccountName, err := cache.NewTakenAccountName(client)
+	takenAccountName, err := cache.NewTakenAccountName(cacheClient)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.HTTPServer{}, nil, err
 	}
-	accountLogic := logic.NewAccountLogic(databaseDatabase, accountDataAccessor, accountPasswordDataAccessor, hashLogic, tokenLogic, roleLogic, takenAccountName, logger)
+	accountLogic := logic.NewAccountLogic(databaseDatabase, accountDataAccessor, accountPasswordDataAccessor, sessionDataAccessor, hashLogic, tokenLogic, roleLogic, takenAccountName, auth, logger)
 	problemDataAccessor := database.NewProblemDataAccessor(databaseDatabase, logger)
 	submissionDataAccessor := database.NewSubmissionDataAccessor(databaseDatabase, logger)
 	testCaseDataAccessor := database.NewTestCaseDataAccessor(databaseDatabase, logger)
-	problemLogic := logic.NewProblemLogic(logger, accountDataAccessor, problemDataAccessor, submissionDataAccessor, testCaseDataAccessor, tokenLogic, roleLogic)
+	judge := config.Judge
+	problemLogic := logic.NewProblemLogic(logger, accountDataAccessor, problemDataAccessor, submissionDataAccessor, testCaseDataAccessor, tokenLogic, roleLogic, judge)
+	testCaseGroupDataAccessor := database.NewTestCaseGroupDataAccessor(databaseDatabase, logger)
+	submissionTestCaseResultDataAccessor := database.NewSubmissionTestCaseResultDataAccessor(databaseDatabase, logger)
+	contestDataAccessor := database.NewContestDataAccessor(databaseDatabase, logger)
+	contestProblemDataAccessor := database.NewContestProblemDataAccessor(databaseDatabase, logger)
+	contestParticipantDataAccessor := database.NewContestParticipantDataAccessor(databaseDatabase, logger)
+	submissionUpdatePubSub, err := cache.NewSubmissionUpdatePubSub(cacheClient, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.HTTPServer{}, nil, err
+	}
+	configsSandbox := judge.Sandbox
 	clientClient, err := utils.InitializeDockerClient()
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.HTTPServer{}, nil, err
 	}
-	judge := config.Judge
-	judgeLogic, err := logic.NewJudgeLogic(problemDataAccessor, submissionDataAccessor, testCaseDataAccessor, clientClient, judge, appArguments, logger)
+	sandboxSandbox, err := sandbox.NewSandbox(configsSandbox, clientClient, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.HTTPServer{}, nil, err
 	}
-	mq := config.MQ
-	adminAdmin, err := admin.NewAdmin(logger, mq)
+	workspaceLogic := logic.NewWorkspaceLogic(sandboxSandbox, logger)
+	judgeLogic, err := logic.NewJudgeLogic(problemDataAccessor, submissionDataAccessor, testCaseDataAccessor, testCaseGroupDataAccessor, submissionTestCaseResultDataAccessor, submissionUpdatePubSub, workspaceLogic, sandboxSandbox, judge, appArguments, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.HTTPServer{}, nil, err
+	}
+	contestScoreboard, err := cache.NewContestScoreboard(cacheClient)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.HTTPServer{}, nil, err
 	}
-	producerClient, err := producer.NewClient(mq, logger, adminAdmin)
+	scoreboardLogic := logic.NewScoreboardLogic(logger, accountDataAccessor, submissionDataAccessor, contestParticipantDataAccessor, contestScoreboard)
+	outboxMessageDataAccessor := database.NewOutboxMessageDataAccessor(databaseDatabase, logger)
+	mq := config.MQ
+	broker := inmemory.NewBroker(logger)
+	producerClient, err := producer.NewClient(mq, broker, client, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.HTTPServer{}, nil, err
 	}
-	submissionCreatedProducer, err := producer.NewSubmissionCreatedProducer(producerClient, logger)
+	outboxLogic := logic.NewOutboxLogic(outboxMessageDataAccessor, producerClient, databaseDatabase, logger)
+	worker := config.Worker
+	submissionLogic, err := logic.NewSubmissionLogic(logger, accountDataAccessor, problemDataAccessor, submissionDataAccessor, testCaseDataAccessor, testCaseGroupDataAccessor, submissionTestCaseResultDataAccessor, contestDataAccessor, contestProblemDataAccessor, contestParticipantDataAccessor, tokenLogic, judgeLogic, roleLogic, scoreboardLogic, outboxMessageDataAccessor, outboxLogic, submissionUpdatePubSub, databaseDatabase, worker, judge)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.HTTPServer{}, nil, err
 	}
-	submissionLogic := logic.NewSubmissionLogic(logger, accountDataAccessor, problemDataAccessor, submissionDataAccessor, testCaseDataAccessor, tokenLogic, judgeLogic, roleLogic, submissionCreatedProducer, databaseDatabase)
-	testCaseLogic := logic.NewTestCaseLogic(logger, accountDataAccessor, problemDataAccessor, submissionDataAccessor, testCaseDataAccessor, tokenLogic, roleLogic)
-	ojsServiceServer := grpc.NewHandler(accountLogic, problemLogic, submissionLogic, testCaseLogic)
+	testCaseLogic := logic.NewTestCaseLogic(logger, accountDataAccessor, problemDataAccessor, submissionDataAccessor, testCaseDataAccessor, testCaseGroupDataAccessor, tokenLogic, roleLogic)
+	testCaseGroupLogic := logic.NewTestCaseGroupLogic(logger, problemDataAccessor, testCaseDataAccessor, testCaseGroupDataAccessor, tokenLogic, roleLogic, databaseDatabase)
+	contestLogic := logic.NewContestLogic(logger, contestDataAccessor, contestProblemDataAccessor, contestParticipantDataAccessor, problemDataAccessor, tokenLogic, roleLogic, scoreboardLogic, databaseDatabase)
+	deadLetteredSubmissionDataAccessor := database.NewDeadLetteredSubmissionDataAccessor(databaseDatabase, logger)
+	deadLetteredSubmissionLogic := logic.NewDeadLetteredSubmissionLogic(deadLetteredSubmissionDataAccessor, submissionDataAccessor, outboxMessageDataAccessor, outboxLogic, tokenLogic, roleLogic, databaseDatabase, logger)
+	rejudgeDataAccessor := database.NewRejudgeDataAccessor(databaseDatabase, logger)
+	submissionJudgeHistoryDataAccessor := database.NewSubmissionJudgeHistoryDataAccessor(databaseDatabase, logger)
+	rejudgeLogic := logic.NewRejudgeLogic(rejudgeDataAccessor, submissionJudgeHistoryDataAccessor, submissionDataAccessor, problemDataAccessor, contestDataAccessor, outboxMessageDataAccessor, outboxLogic, tokenLogic, roleLogic, submissionUpdatePubSub, databaseDatabase, logger)
+	ojsServiceServer := grpc.NewHandler(accountLogic, problemLogic, submissionLogic, testCaseLogic, testCaseGroupLogic, contestLogic, deadLetteredSubmissionLogic, rejudgeLogic, tokenKeyLogic)
 	server := grpc.NewServer(configsGRPC, ojsServiceServer)
 	configsHTTP := config.HTTP
 	httpServer := http.NewServer(configsHTTP, configsGRPC, auth, logger)
@@ -287,6 +397,7 @@ func InitializeWorker(configFilePath configs.ConfigFilePath, appArguments utils.
 	}
 	accountDataAccessor := database.NewAccountDataAccessor(databaseDatabase, logger)
 	accountPasswordDataAccessor := database.NewAccountPasswordDataAccessor(databaseDatabase, logger)
+	sessionDataAccessor := database.NewSessionDataAccessor(databaseDatabase, logger)
 	auth := config.Auth
 	hashLogic := logic.NewHashLogic(auth)
 	tokenPublicKeyDataAccessor, err := database.NewTokenPublicKeyDataAccessor(databaseDatabase, logger)
@@ -296,95 +407,141 @@ func InitializeWorker(configFilePath configs.ConfigFilePath, appArguments utils.
 		return app.Worker{}, nil, err
 	}
 	configsCache := config.Cache
-	client, err := cache.NewClient(configsCache, logger)
+	client := cache.NewRedisConnection(configsCache)
+	cacheClient, err := cache.NewClient(configsCache, client, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.Worker{}, nil, err
+	}
+	tokenPublicKey, err := cache.NewTokenPublicKey(cacheClient)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.Worker{}, nil, err
 	}
-	tokenPublicKey, err := cache.NewTokenPublicKey(client)
+	tokenKeyLogic, err := logic.NewTokenKeyLogic(tokenPublicKeyDataAccessor, tokenPublicKey, databaseDatabase, auth, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.Worker{}, nil, err
 	}
-	tokenLogic, err := logic.NewTokenLogic(accountDataAccessor, tokenPublicKeyDataAccessor, logger, auth, tokenPublicKey)
+	revokedToken, err := cache.NewRevokedToken(cacheClient)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.Worker{}, nil, err
 	}
+	tokenLogic := logic.NewTokenLogic(accountDataAccessor, sessionDataAccessor, tokenKeyLogic, logger, auth, revokedToken)
 	roleLogic := logic.NewRoleLogic(logger)
-	takenAccountName, err := cache.NewTakenAccountName(client)
+	takenAccountName, err := cache.NewTakenAccountName(cacheClient)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.Worker{}, nil, err
 	}
-	accountLogic := logic.NewAccountLogic(databaseDatabase, accountDataAccessor, accountPasswordDataAccessor, hashLogic, tokenLogic, roleLogic, takenAccountName, logger)
+	accountLogic := logic.NewAccountLogic(databaseDatabase, accountDataAccessor, accountPasswordDataAccessor, sessionDataAccessor, hashLogic, tokenLogic, roleLogic, takenAccountName, auth, logger)
 	cron := config.Cron
 	problemDataAccessor := database.NewProblemDataAccessor(databaseDatabase, logger)
 	submissionDataAccessor := database.NewSubmissionDataAccessor(databaseDatabase, logger)
 	testCaseDataAccessor := database.NewTestCaseDataAccessor(databaseDatabase, logger)
-	clientClient, err := utils.InitializeDockerClient()
+	testCaseGroupDataAccessor := database.NewTestCaseGroupDataAccessor(databaseDatabase, logger)
+	submissionTestCaseResultDataAccessor := database.NewSubmissionTestCaseResultDataAccessor(databaseDatabase, logger)
+	contestDataAccessor := database.NewContestDataAccessor(databaseDatabase, logger)
+	contestProblemDataAccessor := database.NewContestProblemDataAccessor(databaseDatabase, logger)
+	contestParticipantDataAccessor := database.NewContestParticipantDataAccessor(databaseDatabase, logger)
+	submissionUpdatePubSub, err := cache.NewSubmissionUpdatePubSub(cacheClient, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.Worker{}, nil, err
 	}
 	judge := config.Judge
-	judgeLogic, err := logic.NewJudgeLogic(problemDataAccessor, submissionDataAccessor, testCaseDataAccessor, clientClient, judge, appArguments, logger)
+	configsSandbox := judge.Sandbox
+	clientClient, err := utils.InitializeDockerClient()
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.Worker{}, nil, err
 	}
-	mq := config.MQ
-	adminAdmin, err := admin.NewAdmin(logger, mq)
+	sandboxSandbox, err := sandbox.NewSandbox(configsSandbox, clientClient, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.Worker{}, nil, err
 	}
-	producerClient, err := producer.NewClient(mq, logger, adminAdmin)
+	workspaceLogic := logic.NewWorkspaceLogic(sandboxSandbox, logger)
+	judgeLogic, err := logic.NewJudgeLogic(problemDataAccessor, submissionDataAccessor, testCaseDataAccessor, testCaseGroupDataAccessor, submissionTestCaseResultDataAccessor, submissionUpdatePubSub, workspaceLogic, sandboxSandbox, judge, appArguments, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.Worker{}, nil, err
 	}
-	submissionCreatedProducer, err := producer.NewSubmissionCreatedProducer(producerClient, logger)
+	contestScoreboard, err := cache.NewContestScoreboard(cacheClient)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.Worker{}, nil, err
+	}
+	scoreboardLogic := logic.NewScoreboardLogic(logger, accountDataAccessor, submissionDataAccessor, contestParticipantDataAccessor, contestScoreboard)
+	outboxMessageDataAccessor := database.NewOutboxMessageDataAccessor(databaseDatabase, logger)
+	mq := config.MQ
+	broker := inmemory.NewBroker(logger)
+	producerClient, err := producer.NewClient(mq, broker, client, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.Worker{}, nil, err
+	}
+	outboxLogic := logic.NewOutboxLogic(outboxMessageDataAccessor, producerClient, databaseDatabase, logger)
+	worker := config.Worker
+	submissionLogic, err := logic.NewSubmissionLogic(logger, accountDataAccessor, problemDataAccessor, submissionDataAccessor, testCaseDataAccessor, testCaseGroupDataAccessor, submissionTestCaseResultDataAccessor, contestDataAccessor, contestProblemDataAccessor, contestParticipantDataAccessor, tokenLogic, judgeLogic, roleLogic, scoreboardLogic, outboxMessageDataAccessor, outboxLogic, submissionUpdatePubSub, databaseDatabase, worker, judge)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.Worker{}, nil, err
 	}
-	submissionLogic := logic.NewSubmissionLogic(logger, accountDataAccessor, problemDataAccessor, submissionDataAccessor, testCaseDataAccessor, tokenLogic, judgeLogic, roleLogic, submissionCreatedProducer, databaseDatabase)
 	createSystemAccountsJob, err := jobs.NewCreateSystemAccountsJob(accountLogic, cron, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.Worker{}, nil, err
 	}
-	submissionCreatedHandler, err := consumer.NewSubmissionCreatedHandler(accountLogic, cron, submissionLogic, createSystemAccountsJob, logger)
+	purgeStaleWorkspacesJob, err := jobs.NewPurgeStaleWorkspacesJob(workspaceLogic, cron, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.Worker{}, nil, err
 	}
-	consumerConsumer, err := consumer2.NewConsumer(mq, logger)
+	submissionCreatedHandler, err := consumer.NewSubmissionCreatedHandler(accountLogic, cron, submissionLogic, createSystemAccountsJob, purgeStaleWorkspacesJob, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.Worker{}, nil, err
 	}
-	rootConsumer := consumer.NewRootConsumer(submissionCreatedHandler, consumerConsumer, logger)
-	worker, err := app.NewWorker(rootConsumer, logger)
+	deadLetteredSubmissionDataAccessor := database.NewDeadLetteredSubmissionDataAccessor(databaseDatabase, logger)
+	deadLetteredSubmissionLogic := logic.NewDeadLetteredSubmissionLogic(deadLetteredSubmissionDataAccessor, submissionDataAccessor, outboxMessageDataAccessor, outboxLogic, tokenLogic, roleLogic, databaseDatabase, logger)
+	submissionCreatedDeadLetterHandler := consumer.NewSubmissionCreatedDeadLetterHandler(deadLetteredSubmissionLogic, logger)
+	submissionCreatedDeadLetterProducer := producer.NewSubmissionCreatedDeadLetterProducer(producerClient, logger)
+	consumerConsumer, err := consumer2.NewConsumer(mq, broker, client, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return app.Worker{}, nil, err
 	}
-	return worker, func() {
+	rootConsumer, err := consumer.NewRootConsumer(submissionCreatedHandler, submissionCreatedDeadLetterHandler, submissionCreatedDeadLetterProducer, purgeStaleWorkspacesJob, consumerConsumer, mq, worker, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.Worker{}, nil, err
+	}
+	appWorker, err := app.NewWorker(rootConsumer, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.Worker{}, nil, err
+	}
+	return appWorker, func() {
 		cleanup2()
 		cleanup()
 	}, nil
@@ -400,21 +557,145 @@ func InitializeCron(configFilePath configs.ConfigFilePath, appArguments utils.Ar
 	if err != nil {
 		return app.Cron{}, nil, err
 	}
-	cron, err := jobs.NewCron(logger)
+	configsDatabase := config.Database
+	databaseDatabase, cleanup2, err := database.InitializeDB(configsDatabase)
 	if err != nil {
 		cleanup()
 		return app.Cron{}, nil, err
 	}
-	appCron, err := app.NewCron(cron, logger)
+	accountDataAccessor := database.NewAccountDataAccessor(databaseDatabase, logger)
+	problemDataAccessor := database.NewProblemDataAccessor(databaseDatabase, logger)
+	submissionDataAccessor := database.NewSubmissionDataAccessor(databaseDatabase, logger)
+	testCaseDataAccessor := database.NewTestCaseDataAccessor(databaseDatabase, logger)
+	testCaseGroupDataAccessor := database.NewTestCaseGroupDataAccessor(databaseDatabase, logger)
+	submissionTestCaseResultDataAccessor := database.NewSubmissionTestCaseResultDataAccessor(databaseDatabase, logger)
+	contestDataAccessor := database.NewContestDataAccessor(databaseDatabase, logger)
+	contestProblemDataAccessor := database.NewContestProblemDataAccessor(databaseDatabase, logger)
+	contestParticipantDataAccessor := database.NewContestParticipantDataAccessor(databaseDatabase, logger)
+	sessionDataAccessor := database.NewSessionDataAccessor(databaseDatabase, logger)
+	tokenPublicKeyDataAccessor, err := database.NewTokenPublicKeyDataAccessor(databaseDatabase, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.Cron{}, nil, err
+	}
+	configsCache := config.Cache
+	client := cache.NewRedisConnection(configsCache)
+	cacheClient, err := cache.NewClient(configsCache, client, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.Cron{}, nil, err
+	}
+	tokenPublicKey, err := cache.NewTokenPublicKey(cacheClient)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.Cron{}, nil, err
+	}
+	auth := config.Auth
+	tokenKeyLogic, err := logic.NewTokenKeyLogic(tokenPublicKeyDataAccessor, tokenPublicKey, databaseDatabase, auth, logger)
 	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.Cron{}, nil, err
+	}
+	revokedToken, err := cache.NewRevokedToken(cacheClient)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.Cron{}, nil, err
+	}
+	tokenLogic := logic.NewTokenLogic(accountDataAccessor, sessionDataAccessor, tokenKeyLogic, logger, auth, revokedToken)
+	submissionUpdatePubSub, err := cache.NewSubmissionUpdatePubSub(cacheClient, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.Cron{}, nil, err
+	}
+	judge := config.Judge
+	configsSandbox := judge.Sandbox
+	clientClient, err := utils.InitializeDockerClient()
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.Cron{}, nil, err
+	}
+	sandboxSandbox, err := sandbox.NewSandbox(configsSandbox, clientClient, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.Cron{}, nil, err
+	}
+	workspaceLogic := logic.NewWorkspaceLogic(sandboxSandbox, logger)
+	judgeLogic, err := logic.NewJudgeLogic(problemDataAccessor, submissionDataAccessor, testCaseDataAccessor, testCaseGroupDataAccessor, submissionTestCaseResultDataAccessor, submissionUpdatePubSub, workspaceLogic, sandboxSandbox, judge, appArguments, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.Cron{}, nil, err
+	}
+	roleLogic := logic.NewRoleLogic(logger)
+	contestScoreboard, err := cache.NewContestScoreboard(cacheClient)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.Cron{}, nil, err
+	}
+	scoreboardLogic := logic.NewScoreboardLogic(logger, accountDataAccessor, submissionDataAccessor, contestParticipantDataAccessor, contestScoreboard)
+	outboxMessageDataAccessor := database.NewOutboxMessageDataAccessor(databaseDatabase, logger)
+	mq := config.MQ
+	broker := inmemory.NewBroker(logger)
+	producerClient, err := producer.NewClient(mq, broker, client, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.Cron{}, nil, err
+	}
+	outboxLogic := logic.NewOutboxLogic(outboxMessageDataAccessor, producerClient, databaseDatabase, logger)
+	worker := config.Worker
+	submissionLogic, err := logic.NewSubmissionLogic(logger, accountDataAccessor, problemDataAccessor, submissionDataAccessor, testCaseDataAccessor, testCaseGroupDataAccessor, submissionTestCaseResultDataAccessor, contestDataAccessor, contestProblemDataAccessor, contestParticipantDataAccessor, tokenLogic, judgeLogic, roleLogic, scoreboardLogic, outboxMessageDataAccessor, outboxLogic, submissionUpdatePubSub, databaseDatabase, worker, judge)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.Cron{}, nil, err
+	}
+	cron := config.Cron
+	resetExpiredJudgingLeasesJob, err := jobs.NewResetExpiredJudgingLeasesJob(submissionLogic, cron, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.Cron{}, nil, err
+	}
+	relayOutboxMessagesJob, err := jobs.NewRelayOutboxMessagesJob(outboxLogic, cron, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.Cron{}, nil, err
+	}
+	rotateTokenKeysJob, err := jobs.NewRotateTokenKeysJob(tokenKeyLogic, cron, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.Cron{}, nil, err
+	}
+	jobsCron, err := jobs.NewCron(resetExpiredJudgingLeasesJob, relayOutboxMessagesJob, rotateTokenKeysJob, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return app.Cron{}, nil, err
+	}
+	appCron, err := app.NewCron(jobsCron, logger)
+	if err != nil {
+		cleanup2()
 		cleanup()
 		return app.Cron{}, nil, err
 	}
 	return appCron, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
 
 // wire.go:
 
-var WireSet = wire.NewSet(configs.WireSet, dataaccess.WireSet, handler.WireSet, logic.WireSet, utils.WireSet, app.WireSet)
+var WireSet = wire.NewSet(configs.WireSet, dataaccess.WireSet, handler.WireSet, logic.WireSet, sandbox.WireSet, utils.WireSet, app.WireSet)